@@ -8,8 +8,10 @@ import (
 	"bytes"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 	"github.com/spf13/cobra"
 )
@@ -87,6 +89,64 @@ func TestConvertUnknown(t *testing.T) {
 	deleteFile()
 }
 
+func TestConvertEncoding(t *testing.T) {
+	defer Convert.Flags().Set("encoding", "")
+	defer deleteFile()
+
+	_, err := executeCommand(rootCmd, "convert", "output", "--input", testXmlFileName, "--format", utils.DocumentTypeXml, "--encoding", "ISO-8859-1")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	output, err := os.ReadFile("output")
+	if err != nil || !bytes.HasPrefix(output, []byte(`<?xml version="1.0" encoding="ISO-8859-1"?>`)) {
+		t.Errorf("the output isn't declared as ISO-8859-1")
+	}
+
+	_, err = executeCommand(rootCmd, "convert", "output", "--input", testXmlFileName, "--format", utils.DocumentTypeXml, "--encoding", "X-UNKNOWN")
+	if err == nil {
+		t.Errorf("unsupported charset")
+	}
+}
+
+func TestConvertMt(t *testing.T) {
+	defer Convert.Flags().Set("format", "xml")
+	defer Convert.Flags().Set("mt-type", "")
+	defer os.Remove("output.xml")
+	defer deleteFile()
+
+	_, err := executeCommand(rootCmd, "convert", "output.xml", "--input", filepath.Join("..", "..", "test", "testdata", "valid_mt103.txt"), "--format", utils.DocumentTypeXml)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	output, err := os.ReadFile("output.xml")
+	if err != nil || !bytes.Contains(output, []byte(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08">`)) {
+		t.Errorf("the output isn't a pacs.008.001.08 message")
+	}
+
+	_, err = executeCommand(rootCmd, "convert", "output", "--input", "output.xml", "--format", utils.DocumentTypeMt)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	output, err = os.ReadFile("output")
+	if err != nil || !bytes.HasPrefix(output, []byte("{1:F01BANKBEBBAXXX0000000000}{2:I103")) {
+		t.Errorf("the output isn't an MT103 message")
+	}
+
+	_, err = executeCommand(rootCmd, "convert", "output", "--input", filepath.Join("..", "..", "test", "testdata", "valid_mt940.txt"), "--format", utils.DocumentTypeMt, "--mt-type", "MT950")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	output, err = os.ReadFile("output")
+	if err != nil || !bytes.Contains(output, []byte("{2:I950")) || bytes.Contains(output, []byte(":86:")) {
+		t.Errorf("the output isn't an MT950 message")
+	}
+
+	_, err = executeCommand(rootCmd, "convert", "output", "--input", testJsonFileName, "--format", utils.DocumentTypeMt)
+	if err == nil {
+		t.Errorf("a pacs.002 can't be translated into an MT message")
+	}
+}
+
 func TestPrintJson(t *testing.T) {
 	_, err := executeCommand(rootCmd, "print", "--input", testFileName, "--format", utils.DocumentTypeJson)
 	if err != nil {
@@ -210,9 +270,218 @@ func TestValidatorWithXmlData(t *testing.T) {
 	}
 }
 
+func TestValidatorWithSchema(t *testing.T) {
+	defer Validate.Flags().Set("schema", "false")
+
+	_, err := executeCommand(rootCmd, "validator", "--input", testXmlFileName, "--schema")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	_, err = executeCommand(rootCmd, "validator", "--input", filepath.Join("..", "..", "test", "testdata", "valid_remt_v04.xml"), "--schema")
+	if err == nil || !strings.Contains(err.Error(), "/Document/RmtAdvc/RmtInf") {
+		t.Errorf("missing element should be reported: %v", err)
+	}
+}
+
+func TestValidatorWithDuplicates(t *testing.T) {
+	defer Validate.Flags().Set("duplicates", "")
+	input := filepath.Join("..", "..", "test", "testdata", "valid_pain_v09.xml")
+	duplicates := filepath.Join(t.TempDir(), "duplicates.db")
+
+	_, err := executeCommand(rootCmd, "validator", "--input", input, "--duplicates", duplicates)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	_, err = executeCommand(rootCmd, "validator", "--input", input, "--duplicates", duplicates)
+	if err == nil || err.Error() != "The document duplicates the message id ABC/220315/CCT001 of message ABC/220315/CCT001" {
+		t.Errorf("the duplicate should be reported: %v", err)
+	}
+}
+
+func TestValidatorWithProfile(t *testing.T) {
+	defer Validate.Flags().Set("profile", "")
+
+	_, err := executeCommand(rootCmd, "validator", "--input", filepath.Join("..", "..", "test", "testdata", "valid_bah_pacs_v08.xml"), "--profile", "sepa")
+	if err == nil || !strings.Contains(err.Error(), "/BizMsg/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/ChrgBr") {
+		t.Errorf("the charge bearer should be reported: %v", err)
+	}
+
+	_, err = executeCommand(rootCmd, "validator", "--input", filepath.Join("..", "..", "test", "testdata", "valid_bah_pacs_v08.xml"), "--profile", "unknown")
+	if err == nil || err.Error() != "The profile unknown is unknown" {
+		t.Errorf("unknown profile should be reported: %v", err)
+	}
+}
+
+func TestValidatorWithBusinessMessage(t *testing.T) {
+	_, err := executeCommand(rootCmd, "validator", "--input", filepath.Join("..", "..", "test", "testdata", "valid_bah_pacs_v08.xml"))
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+}
+
+func TestMessages(t *testing.T) {
+	defer Messages.Flags().Set("namespace", "")
+	defer Messages.Flags().Set("root", "")
+
+	_, err := executeCommand(rootCmd, "messages")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	_, err = executeCommand(rootCmd, "messages", "--root", "FIToFICstmrCdtTrf")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	_, err = executeCommand(rootCmd, "messages", "--root", "Unknown")
+	if err == nil {
+		t.Errorf("unknown root element should be reported")
+	}
+	Messages.Flags().Set("root", "")
+	_, err = executeCommand(rootCmd, "messages", "--namespace", "unknown")
+	if err == nil {
+		t.Errorf("unknown namespace should be reported")
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	defer Migrate.Flags().Set("to", "")
+	defer Migrate.Flags().Set("format", "xml")
+	defer deleteFile()
+
+	_, err := executeCommand(rootCmd, "migrate", "output", "--input", testJsonFileName, "--to", "pacs.002.001.07")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	output, err := os.ReadFile("output")
+	if err != nil || !bytes.Contains(output, []byte(`xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.07"`)) {
+		t.Errorf("the output isn't a pacs.002.001.07 message")
+	}
+
+	_, err = executeCommand(rootCmd, "migrate", "output", "--input", testJsonFileName, "--to", "urn:iso:std:iso:20022:tech:xsd:pacs.002.001.10", "--format", utils.DocumentTypeJson)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	_, err = executeCommand(rootCmd, "migrate", "output", "--input", testJsonFileName, "--to", "pacs.008.001.08")
+	if err == nil {
+		t.Errorf("another message should be reported")
+	}
+	_, err = executeCommand(rootCmd, "migrate", "output", "--input", testJsonFileName, "--to", "pacs.002.001.99")
+	if err == nil {
+		t.Errorf("unknown message should be reported")
+	}
+}
+
 func TestWebTest(t *testing.T) {
 	_, err := executeCommand(rootCmd, "web", "--test=true")
 	if err != nil {
 		t.Errorf(err.Error())
 	}
 }
+
+func TestCodes(t *testing.T) {
+	defer codes.Reset()
+	defer func() { codeSetsFileName = "" }()
+
+	_, err := executeCommand(rootCmd, "codes")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	_, err = executeCommand(rootCmd, "codes", "ExternalCategoryPurpose1Code", "SALA")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	_, err = executeCommand(rootCmd, "codes", "ExternalCategoryPurpose1Code", "XYZ1")
+	if err == nil {
+		t.Errorf("unknown code should be reported")
+	}
+	_, err = executeCommand(rootCmd, "codes", "ExternalUnknown1Code")
+	if err == nil {
+		t.Errorf("unknown code set should be reported")
+	}
+
+	path := filepath.Join(t.TempDir(), "codes.json")
+	os.WriteFile(path, []byte(`{"codeSets": [{"name": "ExternalCategoryPurpose1Code", "codes": [{"code": "XYZ1"}]}]}`), 0600)
+	_, err = executeCommand(rootCmd, "codes", "--code-sets", path, "ExternalCategoryPurpose1Code", "XYZ1")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	_, err = executeCommand(rootCmd, "codes", "--code-sets", filepath.Join(t.TempDir(), "missing.json"))
+	if err == nil {
+		t.Errorf("missing code set file should be reported")
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	defer Reconcile.Flags().Set("format", "text")
+	defer Reconcile.Flags().Set("tolerance", "0")
+	defer Reconcile.Flags().Set("rules", "")
+	instructions := filepath.Join("..", "..", "test", "testdata", "valid_pain_v09.xml")
+	statement := filepath.Join("..", "..", "test", "testdata", "valid_camt053_v08.xml")
+
+	_, err := executeCommand(rootCmd, "reconcile", instructions, statement)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	_, err = executeCommand(rootCmd, "reconcile", instructions, statement, "--format", "json", "--rules", "EndToEndId,Batch", "--tolerance", "0.01")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	_, err = executeCommand(rootCmd, "reconcile", instructions, statement, "--rules", "Creditor")
+	if err == nil || err.Error() != "The reconciliation rule Creditor is unknown" {
+		t.Errorf("unknown rule should be reported: %v", err)
+	}
+	_, err = executeCommand(rootCmd, "reconcile", testFileName)
+	if err == nil {
+		t.Errorf("unsupported message should be reported")
+	}
+}
+
+func TestExport(t *testing.T) {
+	defer Export.Flags().Set("format", "csv")
+	defer Export.Flags().Set("delimiter", ",")
+	defer Export.Flags().Set("columns", "")
+	defer deleteFile()
+	statement := filepath.Join("..", "..", "test", "testdata", "valid_camt053_v08.xml")
+
+	_, err := executeCommand(rootCmd, "export", "output", "--input", statement, "--columns", "entry,amount,remittance", "--delimiter", ";")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	output, err := os.ReadFile("output")
+	if err != nil || !strings.Contains(string(output), "entry;amount;remittance\n;10000.00;\n") || !strings.Contains(string(output), "2;250.00;RF18539007547034\n") {
+		t.Errorf("the output isn't the csv of the statement: %s", output)
+	}
+
+	_, err = executeCommand(rootCmd, "export", "output", "--input", statement, "--format", "ofx")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	output, err = os.ReadFile("output")
+	if err != nil || !strings.Contains(string(output), "<TRNAMT>-1500.50</TRNAMT>") {
+		t.Errorf("the output isn't the ofx of the statement")
+	}
+
+	_, err = executeCommand(rootCmd, "export", "output", "--input", statement, "--format", "qif")
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	output, err = os.ReadFile("output")
+	if err != nil || !strings.HasPrefix(string(output), "!Type:Bank\nD03/16/2022\nT-1500.50\n") {
+		t.Errorf("the output isn't the qif of the statement")
+	}
+
+	_, err = executeCommand(rootCmd, "export", "output", "--input", statement, "--format", "xlsx")
+	if err == nil || err.Error() != "The export format xlsx is unsupported" {
+		t.Errorf("unsupported format should be reported: %v", err)
+	}
+	_, err = executeCommand(rootCmd, "export", "output", "--input", statement, "--format", "csv", "--columns", "creditor")
+	if err == nil || err.Error() != "The export column creditor is unknown" {
+		t.Errorf("unknown column should be reported: %v", err)
+	}
+	_, err = executeCommand(rootCmd, "export", "output", "--input", testXmlFileName)
+	if err == nil {
+		t.Errorf("unsupported message should be reported")
+	}
+}
@@ -5,6 +5,8 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
@@ -13,18 +15,29 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/moov-io/base/database"
 	baseLog "github.com/moov-io/base/log"
+	"github.com/moov-io/iso20022/pkg/codes"
+	"github.com/moov-io/iso20022/pkg/common"
 	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/duplicate"
+	"github.com/moov-io/iso20022/pkg/mt"
+	"github.com/moov-io/iso20022/pkg/reconcile"
 	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/moov-io/iso20022/pkg/statement"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
 var (
 	documentFileName string
 	documentBuffer   []byte
+	codeSetsFileName string
 )
 
 var WebCmd = &cobra.Command{
@@ -58,22 +71,91 @@ var Validate = &cobra.Command{
 	Short: "Validate iso20022 message",
 	Long:  "Validate an incoming iso20022 message",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var err error
-		doc, err := document.ParseIso20022Document(documentBuffer)
-		if err != nil {
-			return err
+		var opts []document.ParseOption
+		if withSchema, _ := cmd.Flags().GetBool("schema"); withSchema {
+			opts = append(opts, document.WithSchemaValidation(nil))
+		}
+
+		var profile *document.Profile
+		if name, _ := cmd.Flags().GetString("profile"); name != "" {
+			found, err := document.LookupProfile(name)
+			if err != nil {
+				return err
+			}
+			profile = found
 		}
 
-		err = doc.Validate()
+		doc, err := document.ParseIso20022Document(documentBuffer, opts...)
 		if err != nil {
 			return err
 		}
 
-		fmt.Println("the iso20022 (" + doc.NameSpace() + ") message is valid")
+		if profile != nil {
+			if err = profile.Validate(doc); err != nil {
+				return err
+			}
+			fmt.Println("the iso20022 (" + doc.NameSpace() + ") message is valid for the profile " + profile.Name)
+		} else {
+			err = doc.Validate()
+			if err != nil {
+				return err
+			}
+			fmt.Println("the iso20022 (" + doc.NameSpace() + ") message is valid")
+		}
+
+		if path, _ := cmd.Flags().GetString("duplicates"); path != "" {
+			window, _ := cmd.Flags().GetDuration("duplicates-window")
+			return checkDuplicates(path, window, doc)
+		}
 		return nil
 	},
 }
 
+// checkDuplicates compares the document with the documents validated within the window, whose fingerprints are
+// recorded in the SQLite database of path, and records it. The probable duplicates are printed, an exact duplicate
+// is returned as error.
+func checkDuplicates(path string, window time.Duration, doc document.Iso20022Document) error {
+	store, err := duplicate.OpenSQLStore(context.Background(), nil, database.DatabaseConfig{
+		SQLite: &database.SQLiteConfig{Path: path},
+	})
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	detector := duplicate.NewDetector(store, duplicate.WithWindow(window))
+	if _, err := detector.Purge(); err != nil {
+		return err
+	}
+	report, err := detector.Check("", doc)
+	if err != nil {
+		return err
+	}
+	if len(report.Duplicates) == 0 {
+		fmt.Println("the iso20022 message has no duplicate within " + detector.Window().String())
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DUPLICATE\tREASON\tREFERENCE\tTRANSACTION\tORIGINAL MESSAGE\tORIGINAL TRANSACTION\tSEEN AT")
+	for _, match := range report.Duplicates {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", match.Kind, match.Reason, match.Reference, transactionNumber(match.Transaction),
+			match.Original.MessageId, transactionNumber(match.Original.Transaction), match.Original.SeenAt.Format(time.RFC3339))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return report.Err()
+}
+
+// transactionNumber returns the position of a transaction counted from 1, or - for the whole document
+func transactionNumber(index int) string {
+	if index < 0 {
+		return "-"
+	}
+	return strconv.Itoa(index + 1)
+}
+
 var Print = &cobra.Command{
 	Use:   "print",
 	Short: "Print iso20022 message",
@@ -94,6 +176,9 @@ var Print = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		if canonical, _ := cmd.Flags().GetBool("canonical"); canonical {
+			document.Canonicalize(doc)
+		}
 
 		var output []byte
 		switch format {
@@ -114,7 +199,7 @@ var Print = &cobra.Command{
 var Convert = &cobra.Command{
 	Use:   "convert [output]",
 	Short: "Convert iso20022 document file format",
-	Long:  "Convert an incoming iso20022 document format into another format (options: json, xml)",
+	Long:  "Convert an incoming iso20022 document format into another format (options: json, xml, mt). A SWIFT MT103, MT202, MT202COV, MT940 or MT950 input is translated into its iso20022 message.",
 	Args: func(cmd *cobra.Command, args []string) error {
 		if len(args) < 1 {
 			return errors.New("requires output argument")
@@ -127,16 +212,31 @@ var Convert = &cobra.Command{
 			return err
 		}
 
-		if format != "" && (format != utils.DocumentTypeJson && format != utils.DocumentTypeXml) {
-			return errors.New("don't support the format")
-		} else {
+		if format == "" {
 			format = utils.DocumentTypeXml
 		}
+		if format != utils.DocumentTypeJson && format != utils.DocumentTypeXml && format != utils.DocumentTypeMt {
+			return errors.New("don't support the format")
+		}
 
-		doc, err := document.ParseIso20022Document(documentBuffer)
-		if err != nil {
+		var doc document.Iso20022Document
+		var reports []document.ConversionReport
+		if mt.IsMessage(documentBuffer) {
+			msg, err := mt.Parse(documentBuffer)
+			if err != nil {
+				return err
+			}
+			converted, report, err := mt.ToMX(msg)
+			if err != nil {
+				return err
+			}
+			doc, reports = converted, append(reports, report)
+		} else if doc, err = document.ParseIso20022Document(documentBuffer); err != nil {
 			return err
 		}
+		if canonical, _ := cmd.Flags().GetBool("canonical"); canonical {
+			document.Canonicalize(doc)
+		}
 
 		var output []byte
 		switch format {
@@ -144,10 +244,22 @@ var Convert = &cobra.Command{
 			output, err = json.MarshalIndent(doc, "", "\t")
 		case utils.DocumentTypeXml:
 			output, err = xml.MarshalIndent(doc, "", "\t")
+		case utils.DocumentTypeMt:
+			messageType, _ := cmd.Flags().GetString("mt-type")
+			msg, report, err := mt.FromMX(doc, mt.WithMessageType(messageType))
+			if err != nil {
+				return err
+			}
+			output, reports = msg.Bytes(), append(reports, report)
 		}
 		if err != nil {
 			return err
 		}
+		if charset, _ := cmd.Flags().GetString("encoding"); charset != "" {
+			if output, err = utils.EncodeFromUTF8(output, charset); err != nil {
+				return err
+			}
+		}
 
 		wFile, err := os.Create(args[0])
 		if err != nil {
@@ -155,7 +267,289 @@ var Convert = &cobra.Command{
 		}
 		_, err = wFile.Write(output)
 		wFile.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, report := range reports {
+			fmt.Printf("the message is translated from %s to %s\n", report.From, report.To)
+			if err = printLosses(report); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var Migrate = &cobra.Command{
+	Use:   "migrate [output]",
+	Short: "Migrate iso20022 message into another version",
+	Long:  "Migrate an incoming iso20022 message into another version of the message and report the elements which are dropped, truncated or defaulted",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("requires output argument")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := cmd.Flags().GetString("format")
+		if err != nil {
+			return err
+		}
+		if format == "" {
+			format = utils.DocumentTypeXml
+		}
+		if format != utils.DocumentTypeJson && format != utils.DocumentTypeXml {
+			return errors.New("don't support the format")
+		}
+
+		to, _ := cmd.Flags().GetString("to")
+		info, found := lookupMessage(to)
+		if !found {
+			return utils.NewErrUnsupportedNameSpace()
+		}
+
+		doc, err := document.ParseIso20022Document(documentBuffer)
+		if err != nil {
+			return err
+		}
+		converted, report, err := document.ConvertVersion(doc, info.NameSpace)
+		if err != nil {
+			return err
+		}
+
+		var output []byte
+		switch format {
+		case utils.DocumentTypeJson:
+			output, err = json.MarshalIndent(converted, "", "\t")
+		case utils.DocumentTypeXml:
+			output, err = xml.MarshalIndent(converted, "", "\t")
+		}
+		if err != nil {
+			return err
+		}
+		if err = ioutil.WriteFile(args[0], output, 0644); err != nil {
+			return err
+		}
 
+		fmt.Printf("the iso20022 message is migrated from %s to %s\n", report.From, report.To)
+		return printLosses(report)
+	},
+}
+
+// printLosses prints the table of the data which a conversion couldn't carry over unchanged
+func printLosses(report document.ConversionReport) error {
+	if report.Lossless() {
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LOSS\tPATH\tVALUE")
+	for _, loss := range report.Losses {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", loss.Kind, loss.Path, loss.Value)
+	}
+	return w.Flush()
+}
+
+// lookupMessage finds a registered message by its namespace or its message identifier, e.g. pacs.002.001.11
+func lookupMessage(name string) (document.MessageInfo, bool) {
+	if info, found := document.LookupMessage(name); found {
+		return info, true
+	}
+	for _, info := range document.RegisteredMessages() {
+		if name != "" && info.Identifier() == name {
+			return info, true
+		}
+	}
+	return document.MessageInfo{}, false
+}
+
+var Messages = &cobra.Command{
+	Use:   "messages",
+	Short: "List supported iso20022 messages",
+	Long:  "List the supported iso20022 messages, or look up the message of a namespace or root element",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		namespace, _ := cmd.Flags().GetString("namespace")
+		root, _ := cmd.Flags().GetString("root")
+
+		var list []document.MessageInfo
+		switch {
+		case namespace != "":
+			info, found := document.LookupMessage(namespace)
+			if !found {
+				return utils.NewErrUnsupportedNameSpace()
+			}
+			list = append(list, info)
+		case root != "":
+			list = document.LookupRootElement(root)
+			if len(list) == 0 {
+				return fmt.Errorf("The root element %s is unsupported", root)
+			}
+		default:
+			list = document.RegisteredMessages()
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "MESSAGE\tROOT ELEMENT\tGO TYPE\tNAMESPACE")
+		for _, info := range list {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", info.Identifier(), info.RootElement, info.GoType, info.NameSpace)
+		}
+		return w.Flush()
+	},
+}
+
+var Codes = &cobra.Command{
+	Use:   "codes [code set] [code]",
+	Short: "List external code sets",
+	Long:  "List the loaded iso20022 external code sets, the codes of a code set, or look up a code",
+	Args:  cobra.MaximumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		if len(args) == 0 {
+			fmt.Fprintf(w, "code sets version %s\n\n", codes.Version())
+			fmt.Fprintln(w, "CODE SET\tCODES")
+			for _, name := range codes.Sets() {
+				set, _ := codes.LookupSet(name)
+				fmt.Fprintf(w, "%s\t%d\n", name, len(set.Codes))
+			}
+			return w.Flush()
+		}
+
+		set, found := codes.LookupSet(args[0])
+		if !found {
+			return fmt.Errorf("The code set %s is not loaded", args[0])
+		}
+		list := set.Codes
+		if len(args) == 2 {
+			code, found := codes.Lookup(args[0], args[1])
+			if !found {
+				return codes.Validate(args[0], args[1])
+			}
+			list = []codes.Code{code}
+		}
+		fmt.Fprintln(w, "CODE\tNAME\tDEFINITION")
+		for _, code := range list {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", code.Code, code.Name, code.Definition)
+		}
+		return w.Flush()
+	},
+}
+
+var Reconcile = &cobra.Command{
+	Use:   "reconcile [files]",
+	Short: "Reconcile payments with statements",
+	Long:  "Match the transactions of pain.001 and pacs.008 files with the entries of camt.052, camt.053 and camt.054 files (options: text, json)",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")
+		if format != "text" && format != utils.DocumentTypeJson {
+			return errors.New("don't support the format")
+		}
+
+		var opts []reconcile.Option
+		if names, _ := cmd.Flags().GetStringSlice("rules"); len(names) > 0 {
+			var rules []reconcile.Rule
+			for _, name := range names {
+				rule, err := reconcile.ParseRule(name)
+				if err != nil {
+					return err
+				}
+				rules = append(rules, rule)
+			}
+			opts = append(opts, reconcile.WithRules(rules...))
+		}
+		if tolerance, _ := cmd.Flags().GetString("tolerance"); tolerance != "" {
+			amount, err := common.ParseDecimal(tolerance)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, reconcile.WithTolerance(amount))
+		}
+		days, _ := cmd.Flags().GetInt("date-tolerance")
+		opts = append(opts, reconcile.WithDateTolerance(days))
+
+		var docs []document.Iso20022Document
+		for _, path := range args {
+			buf, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			doc, err := document.ParseIso20022Document(buf)
+			if err != nil {
+				return err
+			}
+			docs = append(docs, doc)
+		}
+		report, err := reconcile.NewReconciler(opts...).ReconcileDocuments(docs...)
+		if err != nil {
+			return err
+		}
+
+		if format == utils.DocumentTypeJson {
+			output, err := json.MarshalIndent(report, "", "\t")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		}
+		return report.Write(os.Stdout)
+	},
+}
+
+var Export = &cobra.Command{
+	Use:   "export [output]",
+	Short: "Export statements to a flat file",
+	Long:  "Export the balances and entries of a camt.052, camt.053 or camt.054 document to a flat file (options: csv, ofx, qif)",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("requires output argument")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, _ := cmd.Flags().GetString("format")
+		format, err := statement.ParseFormat(name)
+		if err != nil {
+			return err
+		}
+		opts := []statement.Option{statement.WithFormat(format)}
+		if names, _ := cmd.Flags().GetStringSlice("columns"); len(names) > 0 {
+			var columns []statement.Column
+			for _, name := range names {
+				column, err := statement.ParseColumn(name)
+				if err != nil {
+					return err
+				}
+				columns = append(columns, column)
+			}
+			opts = append(opts, statement.WithColumns(columns...))
+		}
+		if delimiter, _ := cmd.Flags().GetString("delimiter"); delimiter != "" {
+			runes := []rune(delimiter)
+			if len(runes) != 1 {
+				return errors.New("the delimiter should be a single character")
+			}
+			opts = append(opts, statement.WithDelimiter(runes[0]))
+		}
+		if statuses, _ := cmd.Flags().GetStringSlice("statuses"); len(statuses) > 0 {
+			opts = append(opts, statement.WithStatuses(statuses...))
+		}
+
+		doc, err := document.ParseIso20022Document(documentBuffer)
+		if err != nil {
+			return err
+		}
+		var output bytes.Buffer
+		if err = statement.NewExporter(opts...).Export(&output, doc); err != nil {
+			return err
+		}
+
+		wFile, err := os.Create(args[0])
+		if err != nil {
+			return err
+		}
+		_, err = wFile.Write(output.Bytes())
+		wFile.Close()
 		return err
 	},
 }
@@ -165,7 +559,7 @@ var rootCmd = &cobra.Command{
 	Short: "",
 	Long:  "",
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		isWeb := false
+		withoutInput := false
 		cmdNames := make([]string, 0)
 		getName := func(c *cobra.Command) {}
 		getName = func(c *cobra.Command) {
@@ -173,14 +567,20 @@ var rootCmd = &cobra.Command{
 				return
 			}
 			cmdNames = append([]string{c.Name()}, cmdNames...)
-			if c.Name() == "web" {
-				isWeb = true
+			if c.Name() == "web" || c.Name() == "messages" || c.Name() == "codes" || c.Name() == "reconcile" {
+				withoutInput = true
 			}
 			getName(c.Parent())
 		}
 		getName(cmd)
 
-		if !isWeb {
+		if codeSetsFileName != "" {
+			if err := codes.LoadFile(codeSetsFileName); err != nil {
+				return err
+			}
+		}
+
+		if !withoutInput {
 			if documentFileName == "" {
 				path, err := os.Getwd()
 				if err != nil {
@@ -207,14 +607,40 @@ var rootCmd = &cobra.Command{
 func initRootCmd() {
 	WebCmd.Flags().BoolP("test", "t", false, "test server")
 	Convert.Flags().String("format", "xml", "format of document file")
+	Convert.Flags().Bool("canonical", false, "write the document without namespace prefixes instead of the style of the sender")
+	Convert.Flags().String("encoding", "", "charset of the output, e.g. ISO-8859-1 or UTF-16 (default UTF-8)")
+	Convert.Flags().String("mt-type", "", "MT message of a camt.053 statement with format mt, MT940 or MT950 (default MT940)")
 	Print.Flags().String("format", "xml", "print format")
+	Print.Flags().Bool("canonical", false, "print the document without namespace prefixes instead of the style of the sender")
+	Validate.Flags().Bool("schema", false, "validate xml input against the xsd specification of its namespace")
+	Validate.Flags().String("profile", "", "validate against the rules of a market practice (options: sepa, cbpr+, fednow, hvps+)")
+	Validate.Flags().String("duplicates", "", "sqlite file recording the validated documents, reports the duplicates of the input and fails on an exact duplicate")
+	Validate.Flags().Duration("duplicates-window", duplicate.DefaultWindow, "time a validated document is compared with the new ones")
+	Migrate.Flags().String("to", "", "message identifier (e.g. pacs.002.001.11) or namespace of the target version")
+	Migrate.Flags().String("format", "xml", "format of document file")
+	Messages.Flags().String("namespace", "", "look up the message of the namespace")
+	Messages.Flags().String("root", "", "look up the messages of the root element")
+	Reconcile.Flags().String("format", "text", "report format")
+	Reconcile.Flags().StringSlice("rules", nil, "matching rules applied (options: EndToEndId, AcctSvcrRef, Remittance, Amount, ValueDate, Batch. default all)")
+	Reconcile.Flags().String("tolerance", "0", "difference allowed between the amount of an entry and the amount of its instructions")
+	Reconcile.Flags().Int("date-tolerance", reconcile.DefaultDateTolerance, "number of days the value date of an entry may differ from the date of its instructions")
+	Export.Flags().String("format", "csv", "format of the exported file (options: csv, ofx, qif)")
+	Export.Flags().StringSlice("columns", nil, "columns of the csv file and their order (default all)")
+	Export.Flags().String("delimiter", ",", "field delimiter of the csv file")
+	Export.Flags().StringSlice("statuses", statement.DefaultStatuses, "statuses of the exported entries, e.g. BOOK,PDNG")
 
 	rootCmd.SilenceUsage = true
-	rootCmd.PersistentFlags().StringVar(&documentFileName, "input", "", "iso20022 document (valid types are xml, json. default is $PWD/iso20022_document.xml)")
+	rootCmd.PersistentFlags().StringVar(&documentFileName, "input", "", "iso20022 document (valid types are xml, json, SWIFT MT. default is $PWD/iso20022_document.xml)")
+	rootCmd.PersistentFlags().StringVar(&codeSetsFileName, "code-sets", "", "json file of iso20022 external code sets replacing the bundled ones of the same name")
 	rootCmd.AddCommand(WebCmd)
 	rootCmd.AddCommand(Convert)
 	rootCmd.AddCommand(Print)
 	rootCmd.AddCommand(Validate)
+	rootCmd.AddCommand(Migrate)
+	rootCmd.AddCommand(Messages)
+	rootCmd.AddCommand(Codes)
+	rootCmd.AddCommand(Reconcile)
+	rootCmd.AddCommand(Export)
 }
 
 func main() {
@@ -0,0 +1,13 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package specifications embeds the official ISO 20022 message schemas shipped with the repository
+package specifications
+
+import "embed"
+
+// Files holds every <message>.xsd file, grouped by message set directory
+//
+//go:embed */*.xsd
+var Files embed.FS
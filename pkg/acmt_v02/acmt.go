@@ -12,21 +12,21 @@ import (
 )
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification5 struct {
@@ -49,12 +49,12 @@ func (r BranchData2) Validate() error {
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -92,12 +92,12 @@ func (r DateAndPlaceOfBirth) Validate() error {
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification8 struct {
@@ -208,12 +208,12 @@ func (r OrganisationIdentification8) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OriginalTransactionReference18 struct {
@@ -228,21 +228,21 @@ func (r OriginalTransactionReference18) Validate() error {
 }
 
 type Party11Choice struct {
-	OrgId  OrganisationIdentification8 `xml:"OrgId"`
-	PrvtId PersonIdentification5       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification8 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification5       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party11Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Party12Choice struct {
-	Pty PartyIdentification43                        `xml:"Pty"`
-	Agt BranchAndFinancialInstitutionIdentification5 `xml:"Agt"`
+	Pty *PartyIdentification43                        `xml:"Pty,omitempty" json:",omitempty"`
+	Agt *BranchAndFinancialInstitutionIdentification5 `xml:"Agt,omitempty" json:",omitempty"`
 }
 
 func (r Party12Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification43 struct {
@@ -279,12 +279,12 @@ func (r PersonIdentification5) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress6 struct {
@@ -364,12 +364,12 @@ func (r MessageIdentification5) Validate() error {
 }
 
 type VerificationReason1Choice struct {
-	Cd    ExternalVerificationReason1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalVerificationReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r VerificationReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type VerificationReport2 struct {
@@ -413,12 +413,12 @@ func (r AccountSwitchRequestRedirectionV02) Validate() error {
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -456,12 +456,12 @@ func (r CashAccount39) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Contact4 struct {
@@ -546,12 +546,12 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification135 struct {
@@ -608,12 +608,12 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSwitchNotifyAccountSwitchCompleteV02 struct {
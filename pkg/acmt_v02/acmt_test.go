@@ -13,12 +13,14 @@ import (
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type2.Validate())
 	type2 = "test"
+	assert.NotNil(t, type2.Validate())
+	type2 = "USABA"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalFinancialInstitutionIdentification1Code
@@ -28,12 +30,12 @@ func TestTypes(t *testing.T) {
 
 	var type4 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type4.Validate())
-	type4 = "test"
+	type4 = "BANK"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalPersonIdentification1Code
 	assert.NotNil(t, type5.Validate())
-	type5 = "test"
+	type5 = "ARNU"
 	assert.Nil(t, type5.Validate())
 
 	var type6 ExternalVerificationReason1Code
@@ -71,7 +73,7 @@ func TestTypes(t *testing.T) {
 
 	var type11 ExternalCashAccountType1Code
 	assert.NotNil(t, type11.Validate())
-	type11 = "test"
+	type11 = "CACC"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalProxyAccountType1Code
@@ -102,8 +104,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification8{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.Nil(t, OriginalTransactionReference18{}.Validate())
-	assert.Nil(t, Party11Choice{}.Validate())
-	assert.Nil(t, Party12Choice{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
+	assert.NotNil(t, Party12Choice{}.Validate())
 	assert.Nil(t, PartyIdentification43{}.Validate())
 	assert.NotNil(t, PaymentIdentification4{}.Validate())
 	assert.Nil(t, PersonIdentification5{}.Validate())
@@ -131,7 +133,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, MessageIdentification1{}.Validate())
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PersonIdentification13{}.Validate())
 	assert.Nil(t, PostalAddress24{}.Validate())
@@ -7,6 +7,7 @@ package acmt_v02
 import (
 	"reflect"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -17,7 +18,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -27,7 +28,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -37,7 +38,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -47,7 +48,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -57,7 +58,7 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -67,7 +68,7 @@ func (r ExternalVerificationReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalVerificationReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalVerificationReason1Code", string(r))
 }
 
 // May be one of DAYH, EARL
@@ -133,7 +134,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -143,5 +144,5 @@ func (r ExternalProxyAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalProxyAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalProxyAccountType1Code", string(r))
 }
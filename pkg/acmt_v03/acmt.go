@@ -22,12 +22,12 @@ func (r AccountContract2) Validate() error {
 }
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountOpeningRequestV03 struct {
@@ -51,17 +51,17 @@ func (r AccountOpeningRequestV03) Validate() error {
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64                   `xml:",chardata"`
-	Ccy   common.ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -69,12 +69,12 @@ func (r ActiveCurrencyAndAmount) Validate() error {
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Authorisation2 struct {
@@ -147,30 +147,30 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Channel2Choice struct {
-	Cd    CommunicationMethod3Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *CommunicationMethod3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Channel2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -183,30 +183,30 @@ func (r ClearingSystemMemberIdentification2) Validate() error {
 }
 
 type CodeOrProprietary1Choice struct {
-	Cd    common.Max4Text         `xml:"Cd"`
-	Prtry GenericIdentification13 `xml:"Prtry"`
+	Cd    *common.Max4Text         `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification13 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CodeOrProprietary1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CommunicationFormat1Choice struct {
-	Cd    ExternalCommunicationFormat1Code `xml:"Cd"`
-	Prtry common.Max35Text                 `xml:"Prtry"`
+	Cd    *ExternalCommunicationFormat1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CommunicationFormat1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CommunicationMethod2Choice struct {
-	Cd    CommunicationMethod2Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *CommunicationMethod2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CommunicationMethod2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Contact4 struct {
@@ -239,21 +239,21 @@ func (r ContractDocument1) Validate() error {
 }
 
 type CustomerAccount4 struct {
-	Id               *AccountIdentification4Choice `xml:"Id,omitempty" json:",omitempty"`
-	Nm               *common.Max70Text             `xml:"Nm,omitempty" json:",omitempty"`
-	Sts              *common.AccountStatus3Code    `xml:"Sts,omitempty" json:",omitempty"`
-	Tp               *CashAccountType2Choice       `xml:"Tp,omitempty" json:",omitempty"`
-	Ccy              common.ActiveCurrencyCode     `xml:"Ccy"`
-	MnthlyPmtVal     float64                       `xml:"MnthlyPmtVal,omitempty" json:",omitempty"`
-	MnthlyRcvdVal    float64                       `xml:"MnthlyRcvdVal,omitempty" json:",omitempty"`
-	MnthlyTxNb       *common.Max5NumericText       `xml:"MnthlyTxNb,omitempty" json:",omitempty"`
-	AvrgBal          float64                       `xml:"AvrgBal,omitempty" json:",omitempty"`
-	AcctPurp         *common.Max140Text            `xml:"AcctPurp,omitempty" json:",omitempty"`
-	FlrNtfctnAmt     float64                       `xml:"FlrNtfctnAmt,omitempty" json:",omitempty"`
-	ClngNtfctnAmt    float64                       `xml:"ClngNtfctnAmt,omitempty" json:",omitempty"`
-	StmtFrqcyAndFrmt []StatementFrequencyAndForm1  `xml:"StmtFrqcyAndFrmt,omitempty" json:",omitempty"`
-	ClsgDt           *common.ISODate               `xml:"ClsgDt,omitempty" json:",omitempty"`
-	Rstrctn          []Restriction1                `xml:"Rstrctn,omitempty" json:",omitempty"`
+	Id               *AccountIdentification4Choice    `xml:"Id,omitempty" json:",omitempty"`
+	Nm               *common.Max70Text                `xml:"Nm,omitempty" json:",omitempty"`
+	Sts              *common.AccountStatus3Code       `xml:"Sts,omitempty" json:",omitempty"`
+	Tp               *CashAccountType2Choice          `xml:"Tp,omitempty" json:",omitempty"`
+	Ccy              common.ActiveCurrencyCode        `xml:"Ccy"`
+	MnthlyPmtVal     *common.ImpliedCurrencyAndAmount `xml:"MnthlyPmtVal,omitempty" json:",omitempty"`
+	MnthlyRcvdVal    *common.ImpliedCurrencyAndAmount `xml:"MnthlyRcvdVal,omitempty" json:",omitempty"`
+	MnthlyTxNb       *common.Max5NumericText          `xml:"MnthlyTxNb,omitempty" json:",omitempty"`
+	AvrgBal          *common.ImpliedCurrencyAndAmount `xml:"AvrgBal,omitempty" json:",omitempty"`
+	AcctPurp         *common.Max140Text               `xml:"AcctPurp,omitempty" json:",omitempty"`
+	FlrNtfctnAmt     *common.ImpliedCurrencyAndAmount `xml:"FlrNtfctnAmt,omitempty" json:",omitempty"`
+	ClngNtfctnAmt    *common.ImpliedCurrencyAndAmount `xml:"ClngNtfctnAmt,omitempty" json:",omitempty"`
+	StmtFrqcyAndFrmt []StatementFrequencyAndForm1     `xml:"StmtFrqcyAndFrmt,omitempty" json:",omitempty"`
+	ClsgDt           *common.ISODate                  `xml:"ClsgDt,omitempty" json:",omitempty"`
+	Rstrctn          []Restriction1                   `xml:"Rstrctn,omitempty" json:",omitempty"`
 }
 
 func (r CustomerAccount4) Validate() error {
@@ -272,12 +272,12 @@ func (r DateAndPlaceOfBirth1) Validate() error {
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -294,12 +294,12 @@ func (r FinancialInstitutionIdentification18) Validate() error {
 }
 
 type FixedAmountOrUnlimited1Choice struct {
-	Amt    ActiveCurrencyAndAmount `xml:"Amt"`
-	NotLtd Unlimited9Text          `xml:"NotLtd"`
+	Amt    *ActiveCurrencyAndAmount `xml:"Amt,omitempty" json:",omitempty"`
+	NotLtd *Unlimited9Text          `xml:"NotLtd,omitempty" json:",omitempty"`
 }
 
 func (r FixedAmountOrUnlimited1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericAccountIdentification1 struct {
@@ -436,12 +436,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OtherContact1 struct {
@@ -454,12 +454,12 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyAndAuthorisation4 struct {
@@ -515,12 +515,12 @@ func (r PartyIdentification137) Validate() error {
 }
 
 type PartyOrGroup2Choice struct {
-	GrpId common.Max4AlphaNumericText `xml:"GrpId"`
-	Pty   PartyAndCertificate4        `xml:"Pty"`
+	GrpId *common.Max4AlphaNumericText `xml:"GrpId,omitempty" json:",omitempty"`
+	Pty   *PartyAndCertificate4        `xml:"Pty,omitempty" json:",omitempty"`
 }
 
 func (r PartyOrGroup2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PersonIdentification13 struct {
@@ -533,12 +533,12 @@ func (r PersonIdentification13) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress24 struct {
@@ -583,12 +583,12 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type References4 struct {
@@ -812,21 +812,21 @@ func (r AccountReportV03) Validate() error {
 }
 
 type CustomerAccount5 struct {
-	Id               []AccountIdentification4Choice `xml:"Id" json:",omitempty"`
-	Nm               *common.Max70Text              `xml:"Nm,omitempty" json:",omitempty"`
-	Sts              *common.AccountStatus3Code     `xml:"Sts,omitempty" json:",omitempty"`
-	Tp               *CashAccountType2Choice        `xml:"Tp,omitempty" json:",omitempty"`
-	Ccy              common.ActiveCurrencyCode      `xml:"Ccy"`
-	MnthlyPmtVal     float64                        `xml:"MnthlyPmtVal,omitempty" json:",omitempty"`
-	MnthlyRcvdVal    float64                        `xml:"MnthlyRcvdVal,omitempty" json:",omitempty"`
-	MnthlyTxNb       *common.Max5NumericText        `xml:"MnthlyTxNb,omitempty" json:",omitempty"`
-	AvrgBal          float64                        `xml:"AvrgBal,omitempty" json:",omitempty"`
-	AcctPurp         *common.Max140Text             `xml:"AcctPurp,omitempty" json:",omitempty"`
-	FlrNtfctnAmt     float64                        `xml:"FlrNtfctnAmt,omitempty" json:",omitempty"`
-	ClngNtfctnAmt    float64                        `xml:"ClngNtfctnAmt,omitempty" json:",omitempty"`
-	StmtFrqcyAndFrmt []StatementFrequencyAndForm1   `xml:"StmtFrqcyAndFrmt,omitempty" json:",omitempty"`
-	ClsgDt           *common.ISODate                `xml:"ClsgDt,omitempty" json:",omitempty"`
-	Rstrctn          []Restriction1                 `xml:"Rstrctn,omitempty" json:",omitempty"`
+	Id               []AccountIdentification4Choice   `xml:"Id" json:",omitempty"`
+	Nm               *common.Max70Text                `xml:"Nm,omitempty" json:",omitempty"`
+	Sts              *common.AccountStatus3Code       `xml:"Sts,omitempty" json:",omitempty"`
+	Tp               *CashAccountType2Choice          `xml:"Tp,omitempty" json:",omitempty"`
+	Ccy              common.ActiveCurrencyCode        `xml:"Ccy"`
+	MnthlyPmtVal     *common.ImpliedCurrencyAndAmount `xml:"MnthlyPmtVal,omitempty" json:",omitempty"`
+	MnthlyRcvdVal    *common.ImpliedCurrencyAndAmount `xml:"MnthlyRcvdVal,omitempty" json:",omitempty"`
+	MnthlyTxNb       *common.Max5NumericText          `xml:"MnthlyTxNb,omitempty" json:",omitempty"`
+	AvrgBal          *common.ImpliedCurrencyAndAmount `xml:"AvrgBal,omitempty" json:",omitempty"`
+	AcctPurp         *common.Max140Text               `xml:"AcctPurp,omitempty" json:",omitempty"`
+	FlrNtfctnAmt     *common.ImpliedCurrencyAndAmount `xml:"FlrNtfctnAmt,omitempty" json:",omitempty"`
+	ClngNtfctnAmt    *common.ImpliedCurrencyAndAmount `xml:"ClngNtfctnAmt,omitempty" json:",omitempty"`
+	StmtFrqcyAndFrmt []StatementFrequencyAndForm1     `xml:"StmtFrqcyAndFrmt,omitempty" json:",omitempty"`
+	ClsgDt           *common.ISODate                  `xml:"ClsgDt,omitempty" json:",omitempty"`
+	Rstrctn          []Restriction1                   `xml:"Rstrctn,omitempty" json:",omitempty"`
 }
 
 func (r CustomerAccount5) Validate() error {
@@ -891,8 +891,8 @@ func (r AddressModification2) Validate() error {
 }
 
 type AmountModification1 struct {
-	ModCd *Modification1Code `xml:"ModCd,omitempty" json:",omitempty"`
-	Amt   float64            `xml:"Amt"`
+	ModCd *Modification1Code              `xml:"ModCd,omitempty" json:",omitempty"`
+	Amt   common.ImpliedCurrencyAndAmount `xml:"Amt"`
 }
 
 func (r AmountModification1) Validate() error {
@@ -1241,8 +1241,8 @@ func (r AccountSwitchInformationRequestV03) Validate() error {
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
-	Value float64                             `xml:",chardata"`
-	Ccy   common.ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
@@ -1409,12 +1409,12 @@ func (r Organisation35) Validate() error {
 }
 
 type OtherIdentification1Choice struct {
-	Cd    PersonIdentificationType5Code `xml:"Cd"`
-	Prtry GenericIdentification47       `xml:"Prtry"`
+	Cd    *PersonIdentificationType5Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification47       `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OtherIdentification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxInformation7 struct {
@@ -1600,12 +1600,12 @@ func (r CashAccount39) Validate() error {
 }
 
 type CategoryPurpose1Choice struct {
-	Cd    ExternalCategoryPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCategoryPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CategoryPurpose1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Cheque11 struct {
@@ -1628,12 +1628,12 @@ func (r Cheque11) Validate() error {
 }
 
 type ChequeDeliveryMethod1Choice struct {
-	Cd    ChequeDelivery1Code `xml:"Cd"`
-	Prtry common.Max35Text    `xml:"Prtry"`
+	Cd    *ChequeDelivery1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text    `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ChequeDeliveryMethod1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorReferenceInformation2 struct {
@@ -1646,12 +1646,12 @@ func (r CreditorReferenceInformation2) Validate() error {
 }
 
 type CreditorReferenceType1Choice struct {
-	Cd    DocumentType3Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorReferenceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorReferenceType2 struct {
@@ -1682,12 +1682,12 @@ func (r DiscountAmountAndType1) Validate() error {
 }
 
 type DiscountAmountType1Choice struct {
-	Cd    ExternalDiscountAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalDiscountAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DiscountAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentAdjustment1 struct {
@@ -1731,12 +1731,12 @@ func (r DocumentLineType1) Validate() error {
 }
 
 type DocumentLineType1Choice struct {
-	Cd    ExternalDocumentLineType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalDocumentLineType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DocumentLineType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EndPoint1Choice struct {
@@ -1745,7 +1745,7 @@ type EndPoint1Choice struct {
 }
 
 func (r EndPoint1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Frequency1 struct {
@@ -1761,12 +1761,12 @@ func (r Frequency1) Validate() error {
 }
 
 type Frequency37Choice struct {
-	Cd    Frequency10Code  `xml:"Cd"`
-	Prtry common.Max35Text `xml:"Prtry"`
+	Cd    *Frequency10Code  `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Frequency37Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Garnishment3 struct {
@@ -1794,12 +1794,12 @@ func (r GarnishmentType1) Validate() error {
 }
 
 type GarnishmentType1Choice struct {
-	Cd    ExternalGarnishmentType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalGarnishmentType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r GarnishmentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type InstructionForCreditorAgent3 struct {
@@ -1812,12 +1812,12 @@ func (r InstructionForCreditorAgent3) Validate() error {
 }
 
 type LocalInstrument2Choice struct {
-	Cd    ExternalLocalInstrument1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalLocalInstrument1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LocalInstrument2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type NameAndAddress16 struct {
@@ -1851,12 +1851,12 @@ func (r PaymentTypeInformation26) Validate() error {
 }
 
 type Purpose2Choice struct {
-	Cd    ExternalPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Purpose2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentInformation7 struct {
@@ -1871,12 +1871,12 @@ func (r ReferredDocumentInformation7) Validate() error {
 }
 
 type ReferredDocumentType3Choice struct {
-	Cd    DocumentType6Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType6Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReferredDocumentType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentType4 struct {
@@ -1963,21 +1963,21 @@ func (r ResponseDetails1) Validate() error {
 }
 
 type ServiceLevel8Choice struct {
-	Cd    ExternalServiceLevel1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalServiceLevel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ServiceLevel8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SettlementMethod3Choice struct {
-	Cdt CreditTransferTransaction41 `xml:"Cdt"`
-	Dbt CreditTransferTransaction41 `xml:"Dbt"`
+	Cdt *CreditTransferTransaction41 `xml:"Cdt,omitempty" json:",omitempty"`
+	Dbt *CreditTransferTransaction41 `xml:"Dbt,omitempty" json:",omitempty"`
 }
 
 func (r SettlementMethod3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StructuredRegulatoryReporting3 struct {
@@ -2029,12 +2029,12 @@ func (r TaxAmountAndType1) Validate() error {
 }
 
 type TaxAmountType1Choice struct {
-	Cd    ExternalTaxAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text           `xml:"Prtry"`
+	Cd    *ExternalTaxAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text           `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r TaxAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxAuthorisation1 struct {
@@ -2080,7 +2080,7 @@ type PaymentInstruction36 struct {
 	PmtMtd          PaymentMethod3Code                            `xml:"PmtMtd"`
 	BtchBookg       bool                                          `xml:"BtchBookg,omitempty" json:",omitempty"`
 	NbOfTxs         *common.Max15NumericText                      `xml:"NbOfTxs,omitempty" json:",omitempty"`
-	CtrlSum         float64                                       `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum         *common.DecimalNumber                         `xml:"CtrlSum,omitempty" json:",omitempty"`
 	PmtTpInf        *PaymentTypeInformation26                     `xml:"PmtTpInf,omitempty" json:",omitempty"`
 	ReqdExctnDt     common.ISODate                                `xml:"ReqdExctnDt"`
 	PoolgAdjstmntDt *common.ISODate                               `xml:"PoolgAdjstmntDt,omitempty" json:",omitempty"`
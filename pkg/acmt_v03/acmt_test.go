@@ -52,7 +52,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.NotNil(t, PartyAndAuthorisation4{}.Validate())
 	assert.Nil(t, PartyAndCertificate4{}.Validate())
 	assert.Nil(t, PartyAndSignature3{}.Validate())
@@ -157,8 +157,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, DocumentLineInformation1{}.Validate())
 	assert.NotNil(t, DocumentLineType1{}.Validate())
 	assert.NotNil(t, DocumentLineType1Choice{}.Validate())
-	assert.Nil(t, EndPoint1Choice{}.Validate())
-	assert.Nil(t, Frequency1{}.Validate())
+	assert.NotNil(t, EndPoint1Choice{}.Validate())
+	assert.NotNil(t, Frequency1{}.Validate())
 	assert.NotNil(t, Frequency37Choice{}.Validate())
 	assert.NotNil(t, Garnishment3{}.Validate())
 	assert.NotNil(t, GarnishmentType1{}.Validate())
@@ -212,12 +212,12 @@ func TestTypes(t *testing.T) {
 
 	var type3 ExternalAccountIdentification1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "AIIN"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalBankTransactionDomain1Code
 	assert.NotNil(t, type4.Validate())
-	type4 = "test"
+	type4 = "ACMT"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalBankTransactionFamily1Code
@@ -232,12 +232,14 @@ func TestTypes(t *testing.T) {
 
 	var type7 ExternalCashAccountType1Code
 	assert.NotNil(t, type7.Validate())
-	type7 = "test"
+	type7 = "CACC"
 	assert.Nil(t, type7.Validate())
 
 	var type8 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type8.Validate())
 	type8 = "test"
+	assert.NotNil(t, type8.Validate())
+	type8 = "USABA"
 	assert.Nil(t, type8.Validate())
 
 	var type9 ExternalCommunicationFormat1Code
@@ -252,12 +254,12 @@ func TestTypes(t *testing.T) {
 
 	var type11 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type11.Validate())
-	type11 = "test"
+	type11 = "BANK"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalPersonIdentification1Code
 	assert.NotNil(t, type12.Validate())
-	type12 = "test"
+	type12 = "ARNU"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalProxyAccountType1Code
@@ -349,7 +351,7 @@ func TestTypes(t *testing.T) {
 
 	var type27 ExternalTaxAmountType1Code
 	assert.NotNil(t, type27.Validate())
-	type27 = "test"
+	type27 = "CITY"
 	assert.Nil(t, type27.Validate())
 
 	var type28 Frequency10Code
@@ -438,7 +440,7 @@ func TestTypes(t *testing.T) {
 
 	var type40 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type40.Validate())
-	type40 = "test"
+	type40 = "BONU"
 	assert.Nil(t, type40.Validate())
 
 	var type41 ExternalCreditorAgentInstruction1Code
@@ -448,7 +450,7 @@ func TestTypes(t *testing.T) {
 
 	var type42 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type42.Validate())
-	type42 = "test"
+	type42 = "APDS"
 	assert.Nil(t, type42.Validate())
 
 	var type43 ExternalDocumentLineType1Code
@@ -458,22 +460,28 @@ func TestTypes(t *testing.T) {
 
 	var type44 ExternalGarnishmentType1Code
 	assert.NotNil(t, type44.Validate())
-	type44 = "test"
+	type44 = "GNCS"
 	assert.Nil(t, type44.Validate())
 
 	var type45 ExternalLocalInstrument1Code
 	assert.NotNil(t, type45.Validate())
 	type45 = "test"
+	assert.NotNil(t, type45.Validate())
+	type45 = "INST"
 	assert.Nil(t, type45.Validate())
 
 	var type46 ExternalPurpose1Code
 	assert.NotNil(t, type46.Validate())
 	type46 = "test"
+	assert.NotNil(t, type46.Validate())
+	type46 = "GDDS"
 	assert.Nil(t, type46.Validate())
 
 	var type47 ExternalServiceLevel1Code
 	assert.NotNil(t, type47.Validate())
 	type47 = "test"
+	assert.NotNil(t, type47.Validate())
+	type47 = "SEPA"
 	assert.Nil(t, type47.Validate())
 
 	var type48 PaymentMethod3Code
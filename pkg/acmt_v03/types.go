@@ -7,6 +7,7 @@ package acmt_v03
 import (
 	"reflect"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -45,7 +46,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -55,7 +56,7 @@ func (r ExternalBankTransactionDomain1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBankTransactionDomain1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBankTransactionDomain1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -65,7 +66,7 @@ func (r ExternalBankTransactionFamily1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBankTransactionFamily1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBankTransactionFamily1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -75,7 +76,7 @@ func (r ExternalBankTransactionSubFamily1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBankTransactionSubFamily1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBankTransactionSubFamily1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -85,7 +86,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -95,7 +96,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -105,7 +106,7 @@ func (r ExternalCommunicationFormat1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCommunicationFormat1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCommunicationFormat1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -115,7 +116,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -125,7 +126,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -135,7 +136,7 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -145,7 +146,7 @@ func (r ExternalProxyAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalProxyAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalProxyAccountType1Code", string(r))
 }
 
 // May be one of YEAR, DAIL, MNTH, QURT, MIAN, TEND, MOVE, WEEK, INDA
@@ -305,7 +306,7 @@ func (r ExternalTaxAmountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalTaxAmountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalTaxAmountType1Code", string(r))
 }
 
 // May be one of NEVR, YEAR, RATE, MIAN, QURT
@@ -483,7 +484,7 @@ func (r ExternalCategoryPurpose1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalCategoryPurpose1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalCategoryPurpose1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -493,7 +494,7 @@ func (r ExternalCreditorAgentInstruction1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCreditorAgentInstruction1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCreditorAgentInstruction1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -503,7 +504,7 @@ func (r ExternalDiscountAmountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDiscountAmountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDiscountAmountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -513,7 +514,7 @@ func (r ExternalDocumentLineType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDocumentLineType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDocumentLineType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -523,7 +524,7 @@ func (r ExternalGarnishmentType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalGarnishmentType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalGarnishmentType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -533,7 +534,7 @@ func (r ExternalLocalInstrument1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 35 {
 		return utils.NewErrTextLengthInvalid("ExternalLocalInstrument1Code", 1, 35)
 	}
-	return nil
+	return codes.Validate("ExternalLocalInstrument1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -543,7 +544,7 @@ func (r ExternalPurpose1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPurpose1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPurpose1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -553,7 +554,7 @@ func (r ExternalServiceLevel1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalServiceLevel1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalServiceLevel1Code", string(r))
 }
 
 // May be one of CHK, TRF, TRA
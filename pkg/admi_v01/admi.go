@@ -66,49 +66,49 @@ func (r Event1) Validate() error {
 }
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountIdentificationSearchCriteria2Choice struct {
-	EQ     AccountIdentification4Choice `xml:"EQ"`
-	CTTxt  common.Max35Text             `xml:"CTTxt"`
-	NCTTxt common.Max35Text             `xml:"NCTTxt"`
+	EQ     *AccountIdentification4Choice `xml:"EQ,omitempty" json:",omitempty"`
+	CTTxt  *common.Max35Text             `xml:"CTTxt,omitempty" json:",omitempty"`
+	NCTTxt *common.Max35Text             `xml:"NCTTxt,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentificationSearchCriteria2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BalanceType11Choice struct {
-	Cd    ExternalSystemBalanceType1Code `xml:"Cd"`
-	Prtry common.Max35Text               `xml:"Prtry"`
+	Cd    *ExternalSystemBalanceType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text               `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BalanceType11Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -144,12 +144,12 @@ func (r CashBalance12) Validate() error {
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -162,12 +162,12 @@ func (r ClearingSystemMemberIdentification2) Validate() error {
 }
 
 type DateAndDateTimeSearch4Choice struct {
-	DtTm DateTimeSearch2Choice   `xml:"DtTm"`
-	Dt   DatePeriodSearch1Choice `xml:"Dt"`
+	DtTm *DateTimeSearch2Choice   `xml:"DtTm,omitempty" json:",omitempty"`
+	Dt   *DatePeriodSearch1Choice `xml:"Dt,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTimeSearch4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DatePeriod2 struct {
@@ -180,15 +180,15 @@ func (r DatePeriod2) Validate() error {
 }
 
 type DatePeriodSearch1Choice struct {
-	FrDt   common.ISODate `xml:"FrDt"`
-	ToDt   common.ISODate `xml:"ToDt"`
-	FrToDt DatePeriod2    `xml:"FrToDt"`
-	EQDt   common.ISODate `xml:"EQDt"`
-	NEQDt  common.ISODate `xml:"NEQDt"`
+	FrDt   *common.ISODate `xml:"FrDt,omitempty" json:",omitempty"`
+	ToDt   *common.ISODate `xml:"ToDt,omitempty" json:",omitempty"`
+	FrToDt *DatePeriod2    `xml:"FrToDt,omitempty" json:",omitempty"`
+	EQDt   *common.ISODate `xml:"EQDt,omitempty" json:",omitempty"`
+	NEQDt  *common.ISODate `xml:"NEQDt,omitempty" json:",omitempty"`
 }
 
 func (r DatePeriodSearch1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateTimePeriod1 struct {
@@ -201,43 +201,43 @@ func (r DateTimePeriod1) Validate() error {
 }
 
 type DateTimePeriod1Choice struct {
-	FrDtTm common.ISODateTime `xml:"FrDtTm"`
-	ToDtTm common.ISODateTime `xml:"ToDtTm"`
-	DtTmRg DateTimePeriod1    `xml:"DtTmRg"`
+	FrDtTm *common.ISODateTime `xml:"FrDtTm,omitempty" json:",omitempty"`
+	ToDtTm *common.ISODateTime `xml:"ToDtTm,omitempty" json:",omitempty"`
+	DtTmRg *DateTimePeriod1    `xml:"DtTmRg,omitempty" json:",omitempty"`
 }
 
 func (r DateTimePeriod1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateTimeSearch2Choice struct {
-	FrDtTm   common.ISODateTime `xml:"FrDtTm"`
-	ToDtTm   common.ISODateTime `xml:"ToDtTm"`
-	FrToDtTm DateTimePeriod1    `xml:"FrToDtTm"`
-	EQDtTm   common.ISODateTime `xml:"EQDtTm"`
-	NEQDtTm  common.ISODateTime `xml:"NEQDtTm"`
+	FrDtTm   *common.ISODateTime `xml:"FrDtTm,omitempty" json:",omitempty"`
+	ToDtTm   *common.ISODateTime `xml:"ToDtTm,omitempty" json:",omitempty"`
+	FrToDtTm *DateTimePeriod1    `xml:"FrToDtTm,omitempty" json:",omitempty"`
+	EQDtTm   *common.ISODateTime `xml:"EQDtTm,omitempty" json:",omitempty"`
+	NEQDtTm  *common.ISODateTime `xml:"NEQDtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateTimeSearch2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EventType1Choice struct {
-	Cd    ExternalSystemEventType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalSystemEventType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r EventType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -335,13 +335,13 @@ func (r OriginalBusinessQuery1) Validate() error {
 }
 
 type PartyIdentification120Choice struct {
-	AnyBIC   common.AnyBICDec2014Identifier `xml:"AnyBIC"`
-	PrtryId  GenericIdentification36        `xml:"PrtryId"`
-	NmAndAdr NameAndAddress5                `xml:"NmAndAdr"`
+	AnyBIC   *common.AnyBICDec2014Identifier `xml:"AnyBIC,omitempty" json:",omitempty"`
+	PrtryId  *GenericIdentification36        `xml:"PrtryId,omitempty" json:",omitempty"`
+	NmAndAdr *NameAndAddress5                `xml:"NmAndAdr,omitempty" json:",omitempty"`
 }
 
 func (r PartyIdentification120Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification136 struct {
@@ -428,13 +428,13 @@ func (r ReportQuerySearchCriteria2) Validate() error {
 }
 
 type RequestType4Choice struct {
-	PmtCtrl ExternalPaymentControlRequestType1Code `xml:"PmtCtrl"`
-	Enqry   ExternalEnquiryRequestType1Code        `xml:"Enqry"`
-	Prtry   GenericIdentification1                 `xml:"Prtry"`
+	PmtCtrl *ExternalPaymentControlRequestType1Code `xml:"PmtCtrl,omitempty" json:",omitempty"`
+	Enqry   *ExternalEnquiryRequestType1Code        `xml:"Enqry,omitempty" json:",omitempty"`
+	Prtry   *GenericIdentification1                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r RequestType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ResendRequestV01 struct {
@@ -471,15 +471,15 @@ func (r SequenceRange1) Validate() error {
 }
 
 type SequenceRange1Choice struct {
-	FrSeq   common.Max35Text   `xml:"FrSeq"`
-	ToSeq   common.Max35Text   `xml:"ToSeq"`
+	FrSeq   *common.Max35Text  `xml:"FrSeq,omitempty" json:",omitempty"`
+	ToSeq   *common.Max35Text  `xml:"ToSeq,omitempty" json:",omitempty"`
 	FrToSeq []SequenceRange1   `xml:"FrToSeq"`
 	EQSeq   []common.Max35Text `xml:"EQSeq"`
 	NEQSeq  []common.Max35Text `xml:"NEQSeq"`
 }
 
 func (r SequenceRange1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SupplementaryData1 struct {
@@ -595,13 +595,13 @@ func (r PartyIdentification59) Validate() error {
 }
 
 type PartyIdentification73Choice struct {
-	NmAndAdr NameAndAddress8       `xml:"NmAndAdr"`
-	AnyBIC   PartyIdentification44 `xml:"AnyBIC"`
-	PtyId    PartyIdentification59 `xml:"PtyId"`
+	NmAndAdr *NameAndAddress8       `xml:"NmAndAdr,omitempty" json:",omitempty"`
+	AnyBIC   *PartyIdentification44 `xml:"AnyBIC,omitempty" json:",omitempty"`
+	PtyId    *PartyIdentification59 `xml:"PtyId,omitempty" json:",omitempty"`
 }
 
 func (r PartyIdentification73Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ProcessingRequestV01 struct {
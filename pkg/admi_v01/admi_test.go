@@ -25,11 +25,11 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, BranchData3{}.Validate())
 	assert.NotNil(t, CashBalance12{}.Validate())
 	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
-	assert.Nil(t, DateAndDateTimeSearch4Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTimeSearch4Choice{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
-	assert.Nil(t, DatePeriodSearch1Choice{}.Validate())
+	assert.NotNil(t, DatePeriodSearch1Choice{}.Validate())
 	assert.Nil(t, DateTimePeriod1{}.Validate())
-	assert.Nil(t, DateTimePeriod1Choice{}.Validate())
+	assert.NotNil(t, DateTimePeriod1Choice{}.Validate())
 	assert.NotNil(t, EventType1Choice{}.Validate())
 	assert.NotNil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
 	assert.Nil(t, FinancialInstitutionIdentification18{}.Validate())
@@ -72,12 +72,14 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type2.Validate())
 	type2 = "test"
+	assert.NotNil(t, type2.Validate())
+	type2 = "USABA"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalEnquiryRequestType1Code
@@ -7,6 +7,7 @@ package admi_v01
 import (
 	"reflect"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -17,7 +18,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -27,7 +28,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -37,7 +38,7 @@ func (r ExternalEnquiryRequestType1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalEnquiryRequestType1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalEnquiryRequestType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -47,7 +48,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -57,7 +58,7 @@ func (r ExternalPaymentControlRequestType1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalPaymentControlRequestType1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalPaymentControlRequestType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -67,7 +68,7 @@ func (r ExternalSystemBalanceType1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemBalanceType1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalSystemBalanceType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -77,7 +78,7 @@ func (r ExternalSystemEventType1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemEventType1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalSystemEventType1Code", string(r))
 }
 
 // May be one of BILA, MULT
@@ -62,21 +62,21 @@ func (r AccountAndParties1) Validate() error {
 }
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AuthorityInvestigation2 struct {
@@ -132,21 +132,21 @@ func (r CashAccount25) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -193,12 +193,12 @@ func (r DateAndPlaceOfBirth) Validate() error {
 }
 
 type DateOrDateTimePeriodChoice struct {
-	Dt   DatePeriodDetails     `xml:"Dt"`
-	DtTm DateTimePeriodDetails `xml:"DtTm"`
+	Dt   *DatePeriodDetails     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *DateTimePeriodDetails `xml:"DtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateOrDateTimePeriodChoice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DatePeriodDetails struct {
@@ -229,12 +229,12 @@ func (r DueDate1) Validate() error {
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification8 struct {
@@ -290,12 +290,12 @@ func (r GenericPersonIdentification1) Validate() error {
 }
 
 type InvestigatedParties1Choice struct {
-	Cd    InvestigatedParties1Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *InvestigatedParties1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r InvestigatedParties1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type LegalMandate1 struct {
@@ -317,21 +317,21 @@ func (r OrganisationIdentification8) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Party11Choice struct {
-	OrgId  OrganisationIdentification8 `xml:"OrgId"`
-	PrvtId PersonIdentification5       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification8 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification5       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party11Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification43 struct {
@@ -366,12 +366,12 @@ func (r PersonIdentification5) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress6 struct {
@@ -402,14 +402,14 @@ func (r RequestType1) Validate() error {
 }
 
 type SearchCriteria1Choice struct {
-	Acct      AccountAndParties1      `xml:"Acct"`
-	CstmrId   CustomerIdentification1 `xml:"CstmrId"`
-	PmtInstrm PaymentInstrumentType1  `xml:"PmtInstrm"`
-	OrgnlTxNb []RequestType1          `xml:"OrgnlTxNb" json:",omitempty"`
+	Acct      *AccountAndParties1      `xml:"Acct,omitempty" json:",omitempty"`
+	CstmrId   *CustomerIdentification1 `xml:"CstmrId,omitempty" json:",omitempty"`
+	PmtInstrm *PaymentInstrumentType1  `xml:"PmtInstrm,omitempty" json:",omitempty"`
+	OrgnlTxNb []RequestType1           `xml:"OrgnlTxNb" json:",omitempty"`
 }
 
 func (r SearchCriteria1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SupplementaryData1 struct {
@@ -430,12 +430,12 @@ func (r SupplementaryDataEnvelope1) Validate() error {
 }
 
 type InvestigationResult1Choice struct {
-	Rslt        SupplementaryDataEnvelope1 `xml:"Rslt"`
-	InvstgtnSts InvestigationStatus1Code   `xml:"InvstgtnSts"`
+	Rslt        *SupplementaryDataEnvelope1 `xml:"Rslt,omitempty" json:",omitempty"`
+	InvstgtnSts *InvestigationStatus1Code   `xml:"InvstgtnSts,omitempty" json:",omitempty"`
 }
 
 func (r InvestigationResult1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReturnIndicator1 struct {
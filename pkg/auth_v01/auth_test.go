@@ -28,7 +28,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, ContactDetails2{}.Validate())
 	assert.Nil(t, CustomerIdentification1{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth{}.Validate())
-	assert.Nil(t, DateOrDateTimePeriodChoice{}.Validate())
+	assert.NotNil(t, DateOrDateTimePeriodChoice{}.Validate())
 	assert.Nil(t, DatePeriodDetails{}.Validate())
 	assert.Nil(t, DateTimePeriodDetails{}.Validate())
 	assert.Nil(t, DueDate1{}.Validate())
@@ -42,7 +42,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, LegalMandate1{}.Validate())
 	assert.Nil(t, OrganisationIdentification8{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
-	assert.Nil(t, Party11Choice{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
 	assert.Nil(t, PartyIdentification43{}.Validate())
 	assert.NotNil(t, PaymentInstrumentType1{}.Validate())
 	assert.Nil(t, PersonIdentification5{}.Validate())
@@ -80,17 +80,19 @@ func TestTypes(t *testing.T) {
 
 	var type4 ExternalAccountIdentification1Code
 	assert.NotNil(t, type4.Validate())
-	type4 = "test"
+	type4 = "AIIN"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalCashAccountType1Code
 	assert.NotNil(t, type5.Validate())
-	type5 = "test"
+	type5 = "CACC"
 	assert.Nil(t, type5.Validate())
 
 	var type6 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type6.Validate())
 	type6 = "test"
+	assert.NotNil(t, type6.Validate())
+	type6 = "USABA"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalFinancialInstitutionIdentification1Code
@@ -100,12 +102,12 @@ func TestTypes(t *testing.T) {
 
 	var type8 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type8.Validate())
-	type8 = "test"
+	type8 = "BANK"
 	assert.Nil(t, type8.Validate())
 
 	var type9 ExternalPersonIdentification1Code
 	assert.NotNil(t, type9.Validate())
-	type9 = "test"
+	type9 = "ARNU"
 	assert.Nil(t, type9.Validate())
 
 	var type10 StatusResponse1Code
@@ -8,15 +8,17 @@ import (
 	"reflect"
 	"regexp"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
 // Must match the pattern [0-9]{8,28}
 type Min8Max28NumericText string
 
+var min8Max28NumericTextRegexp = regexp.MustCompile(`^[0-9]{8,28}$`)
+
 func (r Min8Max28NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{8,28}`)
-	if !reg.MatchString(string(r)) {
+	if !min8Max28NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Min8Max28NumericText")
 	}
 	return nil
@@ -57,7 +59,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -67,7 +69,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -77,7 +79,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -87,7 +89,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -97,7 +99,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -107,7 +109,7 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // May be one of NRES, PART, COMP
@@ -12,26 +12,26 @@ import (
 )
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64                   `xml:",chardata"`
-	Ccy   common.ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -39,22 +39,22 @@ func (r ActiveCurrencyAndAmount) Validate() error {
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BenchmarkCurveName4Choice struct {
-	ISIN ISINOct2015Identifier   `xml:"ISIN"`
-	Indx BenchmarkCurveName2Code `xml:"Indx"`
-	Nm   common.Max25Text        `xml:"Nm"`
+	ISIN *ISINOct2015Identifier   `xml:"ISIN,omitempty" json:",omitempty"`
+	Indx *BenchmarkCurveName2Code `xml:"Indx,omitempty" json:",omitempty"`
+	Nm   *common.Max25Text        `xml:"Nm,omitempty" json:",omitempty"`
 }
 
 func (r BenchmarkCurveName4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BinaryFile1 struct {
@@ -106,12 +106,12 @@ func (r CashCollateral5) Validate() error {
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -153,12 +153,12 @@ func (r ContractBalance1) Validate() error {
 }
 
 type ContractBalanceType1Choice struct {
-	Cd    ExternalContractBalanceType1Code `xml:"Cd"`
-	Prtry common.Max35Text                 `xml:"Prtry"`
+	Cd    *ExternalContractBalanceType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ContractBalanceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ContractCollateral1 struct {
@@ -268,12 +268,12 @@ func (r ExchangeRate1) Validate() error {
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -371,21 +371,21 @@ func (r InterestPaymentDateRange2) Validate() error {
 }
 
 type InterestPaymentSchedule1Choice struct {
-	DtRg     InterestPaymentDateRange1   `xml:"DtRg"`
+	DtRg     *InterestPaymentDateRange1  `xml:"DtRg,omitempty" json:",omitempty"`
 	SubSchdl []InterestPaymentDateRange2 `xml:"SubSchdl" json:",omitempty"`
 }
 
 func (r InterestPaymentSchedule1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type InterestRate2Choice struct {
-	Fxd  float64               `xml:"Fxd"`
-	Fltg FloatingInterestRate4 `xml:"Fltg"`
+	Fxd  *float64               `xml:"Fxd,omitempty" json:",omitempty"`
+	Fltg *FloatingInterestRate4 `xml:"Fltg,omitempty" json:",omitempty"`
 }
 
 func (r InterestRate2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type InterestRateContractTerm1 struct {
@@ -457,12 +457,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OtherContact1 struct {
@@ -475,12 +475,12 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification135 struct {
@@ -517,21 +517,21 @@ func (r PaymentDateRange2) Validate() error {
 }
 
 type PaymentSchedule1Choice struct {
-	DtRg     PaymentDateRange1   `xml:"DtRg"`
+	DtRg     *PaymentDateRange1  `xml:"DtRg,omitempty" json:",omitempty"`
 	SubSchdl []PaymentDateRange2 `xml:"SubSchdl" json:",omitempty"`
 }
 
 func (r PaymentSchedule1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentScheduleType1Choice struct {
-	Cd    PaymentScheduleType1Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *PaymentScheduleType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PaymentScheduleType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PersonIdentification13 struct {
@@ -544,12 +544,12 @@ func (r PersonIdentification13) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress24 struct {
@@ -595,12 +595,12 @@ func (r ShipmentDateRange2) Validate() error {
 }
 
 type ShipmentSchedule2Choice struct {
-	ShipmntDtRg     ShipmentDateRange1   `xml:"ShipmntDtRg"`
+	ShipmntDtRg     *ShipmentDateRange1  `xml:"ShipmntDtRg,omitempty" json:",omitempty"`
 	ShipmntSubSchdl []ShipmentDateRange2 `xml:"ShipmntSubSchdl" json:",omitempty"`
 }
 
 func (r ShipmentSchedule2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SignatureEnvelopeReference struct {
@@ -652,12 +652,12 @@ func (r SyndicatedLoan2) Validate() error {
 }
 
 type TaxExemptionReasonFormat1Choice struct {
-	Ustrd common.Max140Text    `xml:"Ustrd"`
-	Strd  TaxExemptReason1Code `xml:"Strd"`
+	Ustrd *common.Max140Text    `xml:"Ustrd,omitempty" json:",omitempty"`
+	Strd  *TaxExemptReason1Code `xml:"Strd,omitempty" json:",omitempty"`
 }
 
 func (r TaxExemptionReasonFormat1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxParty4 struct {
@@ -701,21 +701,21 @@ func (r TradeParty5) Validate() error {
 }
 
 type UnderlyingContract2Choice struct {
-	Ln   LoanContract2  `xml:"Ln"`
-	Trad TradeContract2 `xml:"Trad"`
+	Ln   *LoanContract2  `xml:"Ln,omitempty" json:",omitempty"`
+	Trad *TradeContract2 `xml:"Trad,omitempty" json:",omitempty"`
 }
 
 func (r UnderlyingContract2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ContractClosureReason1Choice struct {
-	Cd    ExternalContractClosureReason1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalContractClosureReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ContractClosureReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ContractRegistrationConfirmationV02 struct {
@@ -893,12 +893,12 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CertificateIdentification1 struct {
@@ -924,12 +924,12 @@ func (r CertificateReference1) Validate() error {
 }
 
 type ContractRegistrationReference1Choice struct {
-	RegdCtrctId common.Max35Text         `xml:"RegdCtrctId"`
-	Ctrct       DocumentIdentification28 `xml:"Ctrct"`
+	RegdCtrctId *common.Max35Text         `xml:"RegdCtrctId,omitempty" json:",omitempty"`
+	Ctrct       *DocumentIdentification28 `xml:"Ctrct,omitempty" json:",omitempty"`
 }
 
 func (r ContractRegistrationReference1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ContractRegistrationStatement2 struct {
@@ -1009,12 +1009,12 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type RegisteredContract8 struct {
@@ -1151,12 +1151,12 @@ func (r TransactionCertificateRecord1) Validate() error {
 }
 
 type ValidationRuleSchemeName1Choice struct {
-	Cd    ExternalValidationRuleIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalValidationRuleIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ValidationRuleSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ContractRegistrationStatementCriteria1 struct {
@@ -1208,12 +1208,12 @@ func (r CurrencyControlHeader5) Validate() error {
 }
 
 type Party40Choice struct {
-	Pty PartyIdentification135                       `xml:"Pty"`
-	Agt BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
+	Pty *PartyIdentification135                       `xml:"Pty,omitempty" json:",omitempty"`
+	Agt *BranchAndFinancialInstitutionIdentification6 `xml:"Agt,omitempty" json:",omitempty"`
 }
 
 func (r Party40Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentRegulatoryInformationNotificationV02 struct {
@@ -1363,23 +1363,23 @@ func (r Period2) Validate() error {
 }
 
 type Period4Choice struct {
-	Dt       common.ISODate `xml:"Dt"`
-	FrDt     common.ISODate `xml:"FrDt"`
-	ToDt     common.ISODate `xml:"ToDt"`
-	FrDtToDt Period2        `xml:"FrDtToDt"`
+	Dt       *common.ISODate `xml:"Dt,omitempty" json:",omitempty"`
+	FrDt     *common.ISODate `xml:"FrDt,omitempty" json:",omitempty"`
+	ToDt     *common.ISODate `xml:"ToDt,omitempty" json:",omitempty"`
+	FrDtToDt *Period2        `xml:"FrDtToDt,omitempty" json:",omitempty"`
 }
 
 func (r Period4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StatusReason6Choice struct {
-	Cd    ExternalStatusReason1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalStatusReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r StatusReason6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ValidationStatusReason2 struct {
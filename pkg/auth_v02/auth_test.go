@@ -44,7 +44,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, GenericPersonIdentification1{}.Validate())
 	assert.Nil(t, InterestPaymentDateRange1{}.Validate())
 	assert.NotNil(t, InterestPaymentDateRange2{}.Validate())
-	assert.Nil(t, InterestPaymentSchedule1Choice{}.Validate())
+	assert.NotNil(t, InterestPaymentSchedule1Choice{}.Validate())
 	assert.NotNil(t, InterestRate2Choice{}.Validate())
 	assert.NotNil(t, InterestRateContractTerm1{}.Validate())
 	assert.Nil(t, LegalOrganisation2{}.Validate())
@@ -53,18 +53,18 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PaymentDateRange1{}.Validate())
 	assert.NotNil(t, PaymentDateRange2{}.Validate())
-	assert.Nil(t, PaymentSchedule1Choice{}.Validate())
+	assert.NotNil(t, PaymentSchedule1Choice{}.Validate())
 	assert.NotNil(t, PaymentScheduleType1Choice{}.Validate())
 	assert.Nil(t, PersonIdentification13{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
 	assert.Nil(t, PostalAddress24{}.Validate())
 	assert.Nil(t, ShipmentDateRange1{}.Validate())
 	assert.Nil(t, ShipmentDateRange2{}.Validate())
-	assert.Nil(t, ShipmentSchedule2Choice{}.Validate())
+	assert.NotNil(t, ShipmentSchedule2Choice{}.Validate())
 	assert.Nil(t, SignatureEnvelopeReference{}.Validate())
 	assert.NotNil(t, SpecialCondition1{}.Validate())
 	assert.Nil(t, SupplementaryData1{}.Validate())
@@ -117,7 +117,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ContractRegistrationStatementRequest2{}.Validate())
 	assert.NotNil(t, ContractRegistrationStatementRequestV02{}.Validate())
 	assert.NotNil(t, CurrencyControlHeader5{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.NotNil(t, PaymentRegulatoryInformationNotificationV02{}.Validate())
 	assert.NotNil(t, RegulatoryReportingNotification2{}.Validate())
 	assert.NotNil(t, CurrencyControlSupportingDocumentDeliveryV02{}.Validate())
@@ -130,7 +130,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, CurrencyControlStatusAdviceV02{}.Validate())
 	assert.NotNil(t, OriginalMessage5{}.Validate())
 	assert.Nil(t, Period2{}.Validate())
-	assert.Nil(t, Period4Choice{}.Validate())
+	assert.NotNil(t, Period4Choice{}.Validate())
 	assert.NotNil(t, StatusReason6Choice{}.Validate())
 	assert.Nil(t, ValidationStatusReason2{}.Validate())
 }
@@ -160,6 +160,8 @@ func TestTypes(t *testing.T) {
 	var type4 Exact1NumericText
 	assert.NotNil(t, type4.Validate())
 	type4 = "111"
+	assert.NotNil(t, type4.Validate())
+	type4 = "1"
 	assert.Nil(t, type4.Validate())
 
 	var type5 Exact5NumericText
@@ -176,12 +178,14 @@ func TestTypes(t *testing.T) {
 
 	var type7 ExternalAccountIdentification1Code
 	assert.NotNil(t, type7.Validate())
-	type7 = "test"
+	type7 = "AIIN"
 	assert.Nil(t, type7.Validate())
 
 	var type8 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type8.Validate())
 	type8 = "test"
+	assert.NotNil(t, type8.Validate())
+	type8 = "USABA"
 	assert.Nil(t, type8.Validate())
 
 	var type9 ExternalContractBalanceType1Code
@@ -201,12 +205,12 @@ func TestTypes(t *testing.T) {
 
 	var type12 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type12.Validate())
-	type12 = "test"
+	type12 = "BANK"
 	assert.Nil(t, type12.Validate())
 
 	var type14 ExternalPersonIdentification1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "ARNU"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ISINOct2015Identifier
@@ -214,6 +218,8 @@ func TestTypes(t *testing.T) {
 	type15 = "test"
 	assert.NotNil(t, type15.Validate())
 	type15 = "AA00000000011"
+	assert.NotNil(t, type15.Validate())
+	type15 = "US0378331005"
 	assert.Nil(t, type15.Validate())
 
 	var type16 PaymentScheduleType1Code
@@ -265,7 +271,7 @@ func TestTypes(t *testing.T) {
 
 	var type23 ExternalCashAccountType1Code
 	assert.NotNil(t, type23.Validate())
-	type23 = "test"
+	type23 = "CACC"
 	assert.Nil(t, type23.Validate())
 
 	var type24 ExternalProxyAccountType1Code
@@ -307,6 +313,8 @@ func TestTypes(t *testing.T) {
 	var type30 ExternalStatusReason1Code
 	assert.NotNil(t, type30.Validate())
 	type30 = "test"
+	assert.NotNil(t, type30.Validate())
+	type30 = "AC01"
 	assert.Nil(t, type30.Validate())
 
 	var type31 StatisticalReportingStatus1Code
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -57,9 +58,10 @@ func (r DepositType1Code) Validate() error {
 // Must match the pattern [0-9]
 type Exact1NumericText string
 
+var exact1NumericTextRegexp = regexp.MustCompile(`^[0-9]$`)
+
 func (r Exact1NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]`)
-	if !reg.MatchString(string(r)) {
+	if !exact1NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Exact1NumericText")
 	}
 	return nil
@@ -68,9 +70,10 @@ func (r Exact1NumericText) Validate() error {
 // Must match the pattern [0-9]{5}
 type Exact5NumericText string
 
+var exact5NumericTextRegexp = regexp.MustCompile(`^[0-9]{5}$`)
+
 func (r Exact5NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{5}`)
-	if !reg.MatchString(string(r)) {
+	if !exact5NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Exact5NumericText")
 	}
 	return nil
@@ -97,7 +100,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -107,7 +110,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -117,7 +120,7 @@ func (r ExternalContractBalanceType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalContractBalanceType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalContractBalanceType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -127,7 +130,7 @@ func (r ExternalDocumentType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDocumentType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDocumentType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -137,7 +140,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -147,7 +150,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -157,15 +160,16 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // Must match the pattern [A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}
 type ISINOct2015Identifier string
 
+var isinOct2015IdentifierRegexp = regexp.MustCompile(`^[A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}$`)
+
 func (r ISINOct2015Identifier) Validate() error {
-	reg := regexp.MustCompile(`[A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}`)
-	if !reg.MatchString(string(r)) {
+	if !isinOct2015IdentifierRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("ISINOct2015Identifier")
 	}
 	return nil
@@ -264,7 +268,7 @@ func (r ExternalContractClosureReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalContractClosureReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalContractClosureReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -274,7 +278,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -284,7 +288,7 @@ func (r ExternalProxyAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalProxyAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalProxyAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -294,7 +298,7 @@ func (r ExternalShipmentCondition1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalShipmentCondition1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalShipmentCondition1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -304,7 +308,7 @@ func (r ExternalValidationRuleIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalValidationRuleIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalValidationRuleIdentification1Code", string(r))
 }
 
 // May be one of ALLL, CHNG, MODF
@@ -342,7 +346,7 @@ func (r ExternalStatusReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalStatusReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalStatusReason1Code", string(r))
 }
 
 // May be one of ACPT, ACTC, PART, PDNG, RCVD, RJCT, RMDR, INCF, CRPT
@@ -0,0 +1,212 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package builder creates credit transfer messages, and the status reports, returns, reversals, cancellation
+// requests and resolutions answering them, without filling the generated structs by hand.
+// The builders add transactions, keep NbOfTxs, CtrlSum and the totals of the group header consistent
+// and generate the identifiers which aren't given.
+package builder
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// IdGenerator returns a new, unique identifier on every call
+type IdGenerator func() string
+
+// NewUUIDv4 returns a random version 4 UUID in the lowercase form required by UETR
+func NewUUIDv4() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// NewRandomId returns 32 random hexadecimal characters, short enough for every Max35Text identifier
+func NewRandomId() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// SequenceGenerator returns identifiers made of prefix and a counter (prefix-000001, prefix-000002...).
+// The generator is safe for concurrent use.
+func SequenceGenerator(prefix string) IdGenerator {
+	var counter uint64
+	return func() string {
+		return fmt.Sprintf("%s-%06d", prefix, atomic.AddUint64(&counter, 1))
+	}
+}
+
+func now() time.Time {
+	return time.Now().Truncate(time.Second)
+}
+
+// Option changes the generators of a builder
+type Option func(*options)
+
+type options struct {
+	messageId  IdGenerator
+	endToEndId IdGenerator
+	uetr       IdGenerator
+	clock      func() time.Time
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		messageId:  NewRandomId,
+		endToEndId: NewRandomId,
+		uetr:       NewUUIDv4,
+		clock:      now,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithMessageIdGenerator generates MsgId, and PmtInfId of pain.001, with gen instead of random identifiers
+func WithMessageIdGenerator(gen IdGenerator) Option {
+	return func(o *options) {
+		o.messageId = gen
+	}
+}
+
+// WithEndToEndIdGenerator generates the EndToEndId of transfers without one with gen instead of random identifiers
+func WithEndToEndIdGenerator(gen IdGenerator) Option {
+	return func(o *options) {
+		o.endToEndId = gen
+	}
+}
+
+// WithUETRGenerator generates the UETR of transfers without one with gen instead of NewUUIDv4
+func WithUETRGenerator(gen IdGenerator) Option {
+	return func(o *options) {
+		o.uetr = gen
+	}
+}
+
+// WithClock takes CreDtTm and the default settlement or execution date from clock instead of the current time
+func WithClock(clock func() time.Time) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+// Party is the debtor or the creditor of a transfer
+type Party struct {
+	// Name of the party
+	Name string
+	// BIC of the party, used where the party is a financial institution (pacs.009)
+	BIC string
+	// Account of the party, an IBAN or another identifier
+	Account string
+	// Agent is the BIC of the financial institution servicing the account
+	Agent string
+}
+
+// Transfer is a credit transfer added to a message.
+// EndToEndId and UETR are generated when they are empty, ChargeBearer defaults to SLEV where it is required.
+type Transfer struct {
+	InstructionId string
+	EndToEndId    string
+	UETR          string
+
+	Amount   common.Decimal
+	Currency string
+
+	ChargeBearer string
+	Debtor       Party
+	Creditor     Party
+
+	// RemittanceInformation are unstructured lines of remittance information
+	RemittanceInformation []string
+}
+
+const defaultChargeBearer = "SLEV"
+
+func (o options) identify(transfer Transfer) Transfer {
+	if transfer.EndToEndId == "" {
+		transfer.EndToEndId = o.endToEndId()
+	}
+	if transfer.UETR == "" {
+		transfer.UETR = o.uetr()
+	}
+	return transfer
+}
+
+// totals counts and sums transactions, currency is only meaningful when they are not mixed
+type totals struct {
+	count    int
+	sum      common.Decimal
+	currency string
+	mixed    bool
+}
+
+func (t *totals) add(amount common.Decimal, currency string) {
+	if t.count == 0 {
+		t.currency = currency
+	} else if currency != t.currency {
+		t.mixed = true
+	}
+	t.count++
+	t.sum = t.sum.Add(amount)
+}
+
+// decimalNumber returns the exact value of a control sum
+func decimalNumber(sum common.Decimal) *common.DecimalNumber {
+	number := common.DecimalNumber(sum)
+	return &number
+}
+
+func (t totals) numberOfTransactions() common.Max15NumericText {
+	return common.Max15NumericText(fmt.Sprint(t.count))
+}
+
+func isIBAN(account string) bool {
+	return common.IBAN2007Identifier(account).Validate() == nil
+}
+
+func additionalInformation(lines []string) []common.Max105Text {
+	var info []common.Max105Text
+	for _, line := range lines {
+		info = append(info, common.Max105Text(line))
+	}
+	return info
+}
+
+func remittance(lines []string) []common.Max140Text {
+	var ustrd []common.Max140Text
+	for _, line := range lines {
+		ustrd = append(ustrd, common.Max140Text(line))
+	}
+	return ustrd
+}
+
+// newDocument wraps message into a validated Document of namespace
+func newDocument(namespace string, message document.Iso20022Message) (document.Iso20022Document, error) {
+	doc := &document.Iso20022DocumentObject{
+		XMLName: xml.Name{Space: namespace, Local: "Document"},
+		Attrs:   []xml.Attr{{Name: xml.Name{Local: utils.XmlDefaultNamespace}, Value: namespace}},
+		Message: message,
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
@@ -0,0 +1,396 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/camt_v09"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/pacs_v09"
+	"github.com/moov-io/iso20022/pkg/pacs_v10"
+	"github.com/moov-io/iso20022/pkg/pacs_v11"
+	"github.com/moov-io/iso20022/pkg/pain_v10"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var testTime = time.Date(2022, 3, 14, 9, 30, 0, 0, time.UTC)
+
+func testOptions() []Option {
+	return []Option{
+		WithMessageIdGenerator(SequenceGenerator("MSG")),
+		WithEndToEndIdGenerator(SequenceGenerator("E2E")),
+		WithUETRGenerator(func() string { return "8a562c67-ca16-48ba-b074-65581be6f001" }),
+		WithClock(func() time.Time { return testTime }),
+	}
+}
+
+// assertRoundTrip marshals the document, parses it again and checks that nothing is lost
+func assertRoundTrip(t *testing.T, doc document.Iso20022Document) {
+	buf, err := xml.MarshalIndent(doc, "", "\t")
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(buf), `<Document xmlns="`+doc.NameSpace()+`">`), string(buf))
+
+	parsed, err := document.ParseIso20022Document(buf)
+	assert.Nil(t, err)
+	assert.Nil(t, parsed.Validate())
+	again, err := xml.MarshalIndent(parsed, "", "\t")
+	assert.Nil(t, err)
+	assert.Equal(t, string(buf), string(again))
+}
+
+func TestGenerators(t *testing.T) {
+	first, second := NewUUIDv4(), NewUUIDv4()
+	assert.Nil(t, common.UUIDv4Identifier(first).Validate())
+	assert.Nil(t, common.UUIDv4Identifier(second).Validate())
+	assert.NotEqual(t, first, second)
+
+	id := NewRandomId()
+	assert.Len(t, id, 32)
+	assert.Nil(t, common.Max35Text(id).Validate())
+	assert.NotEqual(t, id, NewRandomId())
+
+	gen := SequenceGenerator("MSG")
+	assert.Equal(t, "MSG-000001", gen())
+	assert.Equal(t, "MSG-000002", gen())
+	assert.Equal(t, "OTHER-000001", SequenceGenerator("OTHER")())
+}
+
+func TestFIToFICustomerCreditTransferBuilder(t *testing.T) {
+	doc, err := NewFIToFICustomerCreditTransfer(testOptions()...).
+		InstructingAgent("BANKUS33XXX").
+		InstructedAgent("BANKDEFFXXX").
+		AddTransfer(Transfer{
+			Amount:   common.MustParseDecimal("1500.25"),
+			Currency: "USD",
+			Debtor:   Party{Name: "Debtor Inc", Account: "123456789", Agent: "BANKUS33XXX"},
+			Creditor: Party{Name: "Creditor GmbH", Account: "DE89370400440532013000", Agent: "BANKDEFFXXX"},
+
+			RemittanceInformation: []string{"Invoice 1"},
+		}).
+		AddTransfer(Transfer{
+			EndToEndId: "CUSTOM-E2E",
+			UETR:       "2ed9b0b4-1b2c-4a6e-9f7e-0c7e0a0b7d11",
+			Amount:     common.MustParseDecimal("99.75"),
+			Currency:   "USD",
+			Debtor:     Party{Name: "Debtor Inc", Agent: "BANKUS33XXX"},
+			Creditor:   Party{Name: "Other Creditor", Agent: "BANKDEFFXXX"},
+		}).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPacs00800108NameSpace, doc.NameSpace())
+
+	message := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	assert.Equal(t, "MSG-000001", string(message.GrpHdr.MsgId))
+	assert.Equal(t, testTime, time.Time(message.GrpHdr.CreDtTm))
+	assert.Equal(t, "2", string(message.GrpHdr.NbOfTxs))
+	assert.Equal(t, "1600.00", message.GrpHdr.CtrlSum.String())
+	assert.Equal(t, "1600.00", message.GrpHdr.TtlIntrBkSttlmAmt.Value.String())
+	assert.Equal(t, "USD", string(message.GrpHdr.TtlIntrBkSttlmAmt.Ccy))
+	assert.Equal(t, testTime, time.Time(*message.GrpHdr.IntrBkSttlmDt))
+	assert.Equal(t, "CLRG", string(message.GrpHdr.SttlmInf.SttlmMtd))
+
+	first, second := message.CdtTrfTxInf[0], message.CdtTrfTxInf[1]
+	assert.Equal(t, "E2E-000001", string(first.PmtId.EndToEndId))
+	assert.Equal(t, "8a562c67-ca16-48ba-b074-65581be6f001", string(*first.PmtId.UETR))
+	assert.Equal(t, "SLEV", string(first.ChrgBr))
+	assert.Equal(t, "123456789", string(first.DbtrAcct.Id.Othr.Id))
+	assert.Equal(t, "DE89370400440532013000", string(*first.CdtrAcct.Id.IBAN))
+	assert.Equal(t, "Invoice 1", string(first.RmtInf.Ustrd[0]))
+	assert.Equal(t, "CUSTOM-E2E", string(second.PmtId.EndToEndId))
+	assert.Equal(t, "2ed9b0b4-1b2c-4a6e-9f7e-0c7e0a0b7d11", string(*second.PmtId.UETR))
+	assert.Nil(t, second.DbtrAcct)
+
+	assertRoundTrip(t, doc)
+}
+
+func TestFIToFICustomerCreditTransferTotals(t *testing.T) {
+	builder := NewFIToFICustomerCreditTransfer(testOptions()...).
+		MessageId("FIXED").
+		SettlementMethod("INDA").
+		SettlementDate(time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	_, err := builder.Build()
+	assert.NotNil(t, err)
+	assert.Equal(t, "The transactions of message are omitted", err.Error())
+
+	// transactions built by hand get identifiers and are counted as well
+	tx := pacs_v08.CreditTransferTransaction39{
+		IntrBkSttlmAmt: pacs_v08.ActiveCurrencyAndAmount{Value: common.ActiveCurrencyAndAmountSimpleType(common.MustParseDecimal("10")), Ccy: "EUR"},
+		ChrgBr:         "SHAR",
+	}
+	doc, err := builder.
+		AddTransaction(tx).
+		AddTransfer(Transfer{Amount: common.MustParseDecimal("0.5"), Currency: "USD"}).
+		Build()
+	assert.Nil(t, err)
+
+	message := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	assert.Equal(t, "FIXED", string(message.GrpHdr.MsgId))
+	assert.Equal(t, "INDA", string(message.GrpHdr.SttlmInf.SttlmMtd))
+	assert.Equal(t, "2022-03-15", time.Time(*message.GrpHdr.IntrBkSttlmDt).Format("2006-01-02"))
+	assert.Equal(t, "2", string(message.GrpHdr.NbOfTxs))
+	assert.Equal(t, "10.5", message.GrpHdr.CtrlSum.String())
+	// the total amount needs a single currency
+	assert.Nil(t, message.GrpHdr.TtlIntrBkSttlmAmt)
+	assert.Equal(t, "E2E-000001", string(message.CdtTrfTxInf[0].PmtId.EndToEndId))
+	assert.NotNil(t, message.CdtTrfTxInf[0].PmtId.UETR)
+
+	// invalid values are reported by the validation of the document
+	_, err = NewFIToFICustomerCreditTransfer(testOptions()...).
+		AddTransfer(Transfer{Amount: common.MustParseDecimal("1"), Currency: "USD", Debtor: Party{Agent: "NOT A BIC"}}).
+		Build()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "BICFIDec2014Identifier")
+}
+
+func TestFinancialInstitutionCreditTransferBuilder(t *testing.T) {
+	doc, err := NewFinancialInstitutionCreditTransfer(testOptions()...).
+		InstructingAgent("BANKUS33XXX").
+		InstructedAgent("BANKDEFFXXX").
+		AddTransfer(Transfer{
+			InstructionId: "INSTR-1",
+			Amount:        common.MustParseDecimal("1000000"),
+			Currency:      "EUR",
+			Debtor:        Party{BIC: "BANKUS33XXX", Name: "Bank US"},
+			Creditor:      Party{BIC: "BANKDEFFXXX", Account: "DE89370400440532013000"},
+		}).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPacs00900109NameSpace, doc.NameSpace())
+
+	message := doc.InspectMessage().(*pacs_v09.FinancialInstitutionCreditTransferV09)
+	assert.Equal(t, "1", string(message.GrpHdr.NbOfTxs))
+	assert.Equal(t, "1000000", message.GrpHdr.TtlIntrBkSttlmAmt.Value.String())
+	tx := message.CdtTrfTxInf[0]
+	assert.Equal(t, "INSTR-1", string(*tx.PmtId.InstrId))
+	assert.Equal(t, "BANKUS33XXX", string(*tx.Dbtr.FinInstnId.BICFI))
+	assert.Equal(t, "Bank US", string(*tx.Dbtr.FinInstnId.Nm))
+	assert.Equal(t, "BANKDEFFXXX", string(*tx.Cdtr.FinInstnId.BICFI))
+	assert.Nil(t, tx.DbtrAgt)
+
+	assertRoundTrip(t, doc)
+}
+
+func TestCustomerCreditTransferInitiationBuilder(t *testing.T) {
+	first := Party{Name: "Debtor One", Account: "DE89370400440532013000", Agent: "BANKDEFFXXX"}
+	second := Party{Name: "Debtor Two", Account: "GB29NWBK60161331926819", Agent: "NWBKGB2LXXX"}
+
+	doc, err := NewCustomerCreditTransferInitiation(testOptions()...).
+		InitiatingParty("Initiator Ltd").
+		ExecutionDate(time.Date(2022, 3, 16, 0, 0, 0, 0, time.UTC)).
+		AddTransfer(Transfer{Amount: common.MustParseDecimal("100.10"), Currency: "EUR", Debtor: first, Creditor: Party{Name: "A", Account: "FR1420041010050500013M02606"}}).
+		AddTransfer(Transfer{Amount: common.MustParseDecimal("20"), Currency: "EUR", Debtor: second, Creditor: Party{Name: "B"}, ChargeBearer: "SLEV"}).
+		AddTransfer(Transfer{Amount: common.MustParseDecimal("0.90"), Currency: "EUR", Debtor: first, Creditor: Party{Name: "C"}}).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPain00100110NameSpace, doc.NameSpace())
+
+	message := doc.InspectMessage().(*pain_v10.CustomerCreditTransferInitiationV10)
+	assert.Equal(t, "MSG-000001", string(message.GrpHdr.MsgId))
+	assert.Equal(t, "3", string(message.GrpHdr.NbOfTxs))
+	assert.Equal(t, "121.00", message.GrpHdr.CtrlSum.String())
+	assert.Equal(t, "Initiator Ltd", string(*message.GrpHdr.InitgPty.Nm))
+
+	// transfers are grouped by debtor in the order the debtors were added
+	assert.Len(t, message.PmtInf, 2)
+	one, two := message.PmtInf[0], message.PmtInf[1]
+	assert.Equal(t, "MSG-000002", string(one.PmtInfId))
+	assert.Equal(t, "TRF", string(one.PmtMtd))
+	assert.Equal(t, "2", string(*one.NbOfTxs))
+	assert.Equal(t, "101.00", one.CtrlSum.String())
+	assert.Equal(t, "DE89370400440532013000", string(*one.DbtrAcct.Id.IBAN))
+	assert.Equal(t, "2022-03-16", time.Time(*one.ReqdExctnDt.Dt).Format("2006-01-02"))
+	assert.Equal(t, []string{"E2E-000001", "E2E-000003"}, []string{string(one.CdtTrfTxInf[0].PmtId.EndToEndId), string(one.CdtTrfTxInf[1].PmtId.EndToEndId)})
+	assert.Equal(t, "MSG-000003", string(two.PmtInfId))
+	assert.Equal(t, "1", string(*two.NbOfTxs))
+	assert.Equal(t, "20", two.CtrlSum.String())
+	assert.Equal(t, "SLEV", string(*two.CdtTrfTxInf[0].ChrgBr))
+	assert.Nil(t, two.CdtTrfTxInf[0].CdtrAcct)
+
+	assertRoundTrip(t, doc)
+}
+
+// TestLargeTotals builds every message kind summing amounts which a float64 writes in exponent notation
+func TestLargeTotals(t *testing.T) {
+	debtor := Party{Name: "Debtor", Account: "DE89370400440532013000", Agent: "BANKDEFFXXX"}
+	creditor := Party{Name: "Creditor", Account: "GB29NWBK60161331926819", Agent: "NWBKGB2LXXX"}
+	transfers := []Transfer{
+		{Amount: common.MustParseDecimal("1234567.89"), Currency: "EUR", Debtor: debtor, Creditor: creditor},
+		{Amount: common.MustParseDecimal("98765432.10"), Currency: "EUR", Debtor: debtor, Creditor: creditor},
+	}
+	exceptions := []Exception{{Index: 1, Reason: "AC04"}, {Index: 2, Reason: "AC04"}}
+
+	pacs008 := func() (document.Iso20022Document, error) {
+		b := NewFIToFICustomerCreditTransfer(testOptions()...)
+		for _, transfer := range transfers {
+			b.AddTransfer(transfer)
+		}
+		return b.Build()
+	}
+	pacs009 := func() (document.Iso20022Document, error) {
+		b := NewFinancialInstitutionCreditTransfer(testOptions()...)
+		for _, transfer := range transfers {
+			b.AddTransfer(transfer)
+		}
+		return b.Build()
+	}
+	pain001 := func() (document.Iso20022Document, error) {
+		b := NewCustomerCreditTransferInitiation(testOptions()...).InitiatingParty("Initiator")
+		for _, transfer := range transfers {
+			b.AddTransfer(transfer)
+		}
+		return b.Build()
+	}
+	// answer builds the message answering the original one
+	answer := func(original func() (document.Iso20022Document, error), build func(document.Iso20022Document) (document.Iso20022Document, error)) func() (document.Iso20022Document, error) {
+		return func() (document.Iso20022Document, error) {
+			doc, err := original()
+			if err != nil {
+				return nil, err
+			}
+			return build(doc)
+		}
+	}
+
+	tests := map[string]struct {
+		build func() (document.Iso20022Document, error)
+		sums  []string
+	}{
+		"pacs.008": {pacs008, []string{"<CtrlSum>99999999.99</CtrlSum>"}},
+		"pacs.009": {pacs009, []string{"<CtrlSum>99999999.99</CtrlSum>"}},
+		"pain.001": {pain001, []string{"<CtrlSum>99999999.99</CtrlSum>"}},
+		"pain.002": {answer(pain001, func(original document.Iso20022Document) (document.Iso20022Document, error) {
+			return NewStatusReport(original, testOptions()...).Build()
+		}), []string{"<OrgnlCtrlSum>99999999.99</OrgnlCtrlSum>", "<DtldCtrlSum>99999999.99</DtldCtrlSum>"}},
+		"pacs.002": {answer(pacs008, func(original document.Iso20022Document) (document.Iso20022Document, error) {
+			return NewStatusReport(original, testOptions()...).Build()
+		}), []string{"<OrgnlCtrlSum>99999999.99</OrgnlCtrlSum>", "<DtldCtrlSum>99999999.99</DtldCtrlSum>"}},
+		"pacs.004": {answer(pacs008, func(original document.Iso20022Document) (document.Iso20022Document, error) {
+			b := NewPaymentReturn(original, testOptions()...)
+			for _, exception := range exceptions {
+				b.Return(exception)
+			}
+			return b.Build()
+		}), []string{"<CtrlSum>99999999.99</CtrlSum>"}},
+		"pacs.007": {answer(pacs008, func(original document.Iso20022Document) (document.Iso20022Document, error) {
+			b := NewPaymentReversal(original, testOptions()...)
+			for _, exception := range exceptions {
+				b.Reverse(exception)
+			}
+			return b.Build()
+		}), []string{"<CtrlSum>99999999.99</CtrlSum>"}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			doc, err := test.build()
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			// the sums are written as xs:decimal, never in exponent notation
+			buf, err := xml.Marshal(doc)
+			assert.Nil(t, err)
+			for _, sum := range test.sums {
+				assert.Contains(t, string(buf), sum)
+			}
+			assert.NotContains(t, string(buf), "e+")
+			assertRoundTrip(t, doc)
+		})
+	}
+}
+
+// TestTransactionReferences selects the original transactions of every answering message kind
+func TestTransactionReferences(t *testing.T) {
+	tx := func(txId string) pacs_v08.CreditTransferTransaction39 {
+		id := common.Max35Text(txId)
+		return pacs_v08.CreditTransferTransaction39{
+			PmtId:          pacs_v08.PaymentIdentification7{EndToEndId: "DUP", TxId: &id},
+			IntrBkSttlmAmt: pacs_v08.ActiveCurrencyAndAmount{Value: common.ActiveCurrencyAndAmountSimpleType(common.MustParseDecimal("10")), Ccy: "EUR"},
+			ChrgBr:         "SHAR",
+		}
+	}
+	original, err := NewFIToFICustomerCreditTransfer(testOptions()...).
+		InstructingAgent("BANKDEFFXXX").
+		InstructedAgent("NWBKGB2LXXX").
+		AddTransaction(tx("TX-1")).
+		AddTransaction(tx("TX-2")).
+		AddTransfer(Transfer{EndToEndId: "DUP", UETR: "2ed9b0b4-1b2c-4a6e-9f7e-0c7e0a0b7d11", Amount: common.MustParseDecimal("5"), Currency: "EUR"}).
+		Build()
+	assert.Nil(t, err)
+
+	// the messages answer the transaction selected by the reference, they return its transaction id and UETR
+	kinds := map[string]func(TransactionReference) (*common.Max35Text, *common.UUIDv4Identifier, error){
+		"pacs.002": func(reference TransactionReference) (*common.Max35Text, *common.UUIDv4Identifier, error) {
+			doc, err := NewStatusReport(original, testOptions()...).TransactionStatusOf(reference, Status{Code: StatusRejected, Reason: "AC01"}).Build()
+			if err != nil {
+				return nil, nil, err
+			}
+			tx := doc.InspectMessage().(*pacs_v11.FIToFIPaymentStatusReportV11).TxInfAndSts[0]
+			return tx.OrgnlTxId, tx.OrgnlUETR, nil
+		},
+		"pacs.004": func(reference TransactionReference) (*common.Max35Text, *common.UUIDv4Identifier, error) {
+			doc, err := NewPaymentReturn(original, testOptions()...).Return(exception(reference, "AC04")).Build()
+			if err != nil {
+				return nil, nil, err
+			}
+			tx := doc.InspectMessage().(*pacs_v10.PaymentReturnV10).TxInf[0]
+			return tx.OrgnlTxId, tx.OrgnlUETR, nil
+		},
+		"pacs.007": func(reference TransactionReference) (*common.Max35Text, *common.UUIDv4Identifier, error) {
+			doc, err := NewPaymentReversal(original, testOptions()...).Reverse(exception(reference, "AM05")).Build()
+			if err != nil {
+				return nil, nil, err
+			}
+			tx := doc.InspectMessage().(*pacs_v10.FIToFIPaymentReversalV10).TxInf[0]
+			return tx.OrgnlTxId, tx.OrgnlUETR, nil
+		},
+		"camt.056": func(reference TransactionReference) (*common.Max35Text, *common.UUIDv4Identifier, error) {
+			doc, err := NewCancellationRequest(original, testOptions()...).Cancel(exception(reference, "DUPL")).Build()
+			if err != nil {
+				return nil, nil, err
+			}
+			tx := doc.InspectMessage().(*camt_v09.FIToFIPaymentCancellationRequestV09).Undrlyg[0].TxInf[0]
+			return tx.OrgnlTxId, tx.OrgnlUETR, nil
+		},
+	}
+	for name, answer := range kinds {
+		t.Run(name, func(t *testing.T) {
+			txId, uetr, err := answer(TransactionReference{TxId: "TX-2"})
+			assert.Nil(t, err)
+			assert.Equal(t, "TX-2", string(*txId))
+			_, uetr, err = answer(TransactionReference{UETR: "2ed9b0b4-1b2c-4a6e-9f7e-0c7e0a0b7d11"})
+			assert.Nil(t, err)
+			assert.Equal(t, "2ed9b0b4-1b2c-4a6e-9f7e-0c7e0a0b7d11", string(*uetr))
+			// the index selects one of the transactions sharing the end to end id
+			txId, _, err = answer(TransactionReference{Index: 1, EndToEndId: "DUP"})
+			assert.Nil(t, err)
+			assert.Equal(t, "TX-1", string(*txId))
+
+			// an end to end id shared by several transactions selects none of them
+			_, _, err = answer(TransactionReference{EndToEndId: "DUP"})
+			assert.EqualError(t, err, "The end to end id DUP of original message is ambiguous")
+			// the generated UETR of the transactions built by hand is shared by both
+			_, _, err = answer(TransactionReference{UETR: "8a562c67-ca16-48ba-b074-65581be6f001"})
+			assert.EqualError(t, err, "The UETR 8a562c67-ca16-48ba-b074-65581be6f001 of original message is ambiguous")
+			_, _, err = answer(TransactionReference{TxId: "TX-3"})
+			assert.EqualError(t, err, "The transaction id TX-3 of original message is unknown")
+			_, _, err = answer(TransactionReference{Index: 4})
+			assert.EqualError(t, err, "The transaction index 4 of original message is unknown")
+		})
+	}
+}
+
+// exception selects the referenced transaction for the reason
+func exception(reference TransactionReference, reason string) Exception {
+	return Exception{TxId: reference.TxId, UETR: reference.UETR, Index: reference.Index, EndToEndId: reference.EndToEndId, Reason: reason}
+}
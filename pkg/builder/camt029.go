@@ -0,0 +1,170 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"reflect"
+
+	"github.com/moov-io/iso20022/pkg/camt_v10"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Cancellation status codes used by the resolutions of investigation
+const (
+	CancellationAccepted = "ACCR"
+	CancellationRejected = "RJCR"
+	CancellationPending  = "PDCR"
+)
+
+// ResolutionOfInvestigationBuilder builds the camt.029.001.10 answering a camt.056 cancellation request.
+//
+// The assignment goes back from the assignee of the request to its assigner, and the case of the request is
+// resolved. Every requested transaction is reported with its status, PDCR when it isn't decided. The status of
+// the investigation is CNCL when every transaction is cancelled, RJCR or PDCR when all of them are rejected or
+// pending, and PECR when only some are cancelled.
+type ResolutionOfInvestigationBuilder struct {
+	opts         options
+	request      document.Iso20022Document
+	messageId    string
+	transactions map[string]Status
+}
+
+// NewResolutionOfInvestigation returns a builder of the resolution answering the cancellation request
+func NewResolutionOfInvestigation(request document.Iso20022Document, opts ...Option) *ResolutionOfInvestigationBuilder {
+	return &ResolutionOfInvestigationBuilder{
+		opts:         newOptions(opts),
+		request:      request,
+		transactions: map[string]Status{},
+	}
+}
+
+// MessageId sets the assignment Id instead of generating it
+func (b *ResolutionOfInvestigationBuilder) MessageId(id string) *ResolutionOfInvestigationBuilder {
+	b.messageId = id
+	return b
+}
+
+// TransactionStatus sets the cancellation status (ACCR, RJCR or PDCR) of the transaction with the EndToEndId,
+// the reason of a rejection is a code like LEGL or NOAS
+func (b *ResolutionOfInvestigationBuilder) TransactionStatus(endToEndId string, status Status) *ResolutionOfInvestigationBuilder {
+	b.transactions[endToEndId] = status
+	return b
+}
+
+// Build returns the validated document
+func (b *ResolutionOfInvestigationBuilder) Build() (document.Iso20022Document, error) {
+	if b.request == nil {
+		return nil, utils.NewErrOmittedNameSpace()
+	}
+	info, found := document.LookupMessage(b.request.NameSpace())
+	if !found {
+		return nil, utils.NewErrUnsupportedNameSpace()
+	}
+	if info.BusinessArea+"."+info.MessageId != "camt.056" {
+		return nil, utils.NewErrIncompatibleMessages(info.Identifier(), "resolution of investigation")
+	}
+	request := reflect.Indirect(reflect.ValueOf(b.request.InspectMessage()))
+
+	messageId := b.messageId
+	if messageId == "" {
+		messageId = b.opts.messageId()
+	}
+	message := &camt_v10.ResolutionOfInvestigationV10{
+		Assgnmt: camt_v10.CaseAssignment5{
+			Id:      common.Max35Text(messageId),
+			CreDtTm: common.ISODateTime(b.opts.clock()),
+		},
+	}
+	assignment := fieldValue(request, "Assgnmt")
+	document.CopyElements(&message.Assgnmt.Assgnr, fieldValue(assignment, "Assgne").Interface())
+	document.CopyElements(&message.Assgnmt.Assgne, fieldValue(assignment, "Assgnr").Interface())
+	if requestCase := fieldValue(request, "Case"); requestCase.IsValid() {
+		message.RslvdCase = &camt_v10.Case5{}
+		document.CopyElements(message.RslvdCase, requestCase.Interface())
+	}
+
+	var total statusCount
+	reported := map[string]bool{}
+	underlyings := fieldValue(request, "Undrlyg")
+	for i := 0; underlyings.Kind() == reflect.Slice && i < underlyings.Len(); i++ {
+		underlying := underlyings.Index(i)
+		var count statusCount
+		details := camt_v10.UnderlyingTransaction25{}
+
+		txs := fieldValue(underlying, "TxInf")
+		for j := 0; txs.Kind() == reflect.Slice && j < txs.Len(); j++ {
+			tx := txs.Index(j)
+			endToEndId := textValue(tx, "OrgnlEndToEndId")
+			status, decided := b.transactions[endToEndId]
+			if !decided {
+				status = Status{Code: CancellationPending}
+			}
+			reported[endToEndId] = true
+			// only the statuses are counted, a resolution doesn't report sums
+			count.add(status.Code, common.Decimal{})
+			total.add(status.Code, common.Decimal{})
+
+			result := camt_v10.PaymentTransaction127{}
+			document.CopyElements(&result, tx.Interface())
+			result.Assgnr, result.Assgne = nil, nil
+			txStatus := camt_v10.CancellationIndividualStatus1Code(status.Code)
+			cxlStsId := common.Max35Text(b.opts.endToEndId())
+			result.CxlStsId = &cxlStsId
+			result.TxCxlSts = &txStatus
+			result.CxlStsRsnInf = camt10CancellationStatusReason(status)
+			details.TxInfAndSts = append(details.TxInfAndSts, result)
+		}
+
+		if group := fieldValue(underlying, "OrgnlGrpInfAndCxl"); group.IsValid() {
+			groupStatus, _ := cancellationStatus(count)
+			details.OrgnlGrpInfAndSts = &camt_v10.OriginalGroupHeader14{
+				OrgnlGrpCxlId: common.OptionalMax35Text(textValue(group, "GrpCxlId")),
+				OrgnlNbOfTxs:  common.OptionalMax15NumericText(textValue(group, "NbOfTxs")),
+				OrgnlCtrlSum:  decimalNumberValue(group, "CtrlSum"),
+				GrpCxlSts:     (*camt_v10.GroupCancellationStatus1Code)(&groupStatus),
+			}
+			document.CopyElements(details.OrgnlGrpInfAndSts, group.Interface())
+		}
+		message.CxlDtls = append(message.CxlDtls, details)
+	}
+
+	for id := range b.transactions {
+		if !reported[id] {
+			return nil, utils.NewErrUnknownOriginal("end to end id", id)
+		}
+	}
+	_, confirmation := cancellationStatus(total)
+	message.Sts.Conf = (*camt_v10.ExternalInvestigationExecutionConfirmation1Code)(&confirmation)
+
+	return newDocument(utils.DocumentCamt02900110NameSpace, message)
+}
+
+// cancellationStatus returns the group cancellation status and the investigation status of the transactions:
+// their common status, else partially accepted when some are accepted, else pending
+func cancellationStatus(count statusCount) (string, string) {
+	switch {
+	case len(count.statuses) == 1 && count.statuses[0] == CancellationAccepted:
+		return CancellationAccepted, "CNCL"
+	case len(count.statuses) == 1:
+		return count.statuses[0], count.statuses[0]
+	case count.counts[CancellationAccepted] > 0:
+		return "PACR", "PECR"
+	}
+	return CancellationPending, CancellationPending
+}
+
+func camt10CancellationStatusReason(status Status) []camt_v10.CancellationStatusReason4 {
+	if status.Reason == "" && len(status.AdditionalInformation) == 0 {
+		return nil
+	}
+	info := camt_v10.CancellationStatusReason4{AddtlInf: additionalInformation(status.AdditionalInformation)}
+	if status.Reason != "" {
+		reason := camt_v10.ExternalPaymentCancellationRejection1Code(status.Reason)
+		info.Rsn = &camt_v10.CancellationStatusReason3Choice{Cd: &reason}
+	}
+	return []camt_v10.CancellationStatusReason4{info}
+}
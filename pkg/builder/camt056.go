@@ -0,0 +1,165 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"github.com/moov-io/iso20022/pkg/camt_v09"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// CancellationRequestBuilder builds the camt.056.001.09 requesting the cancellation of transactions of a pacs.003,
+// pacs.008 or pacs.009.
+//
+// The assignment is identified by the message id, it is assigned by the instructing agent of the original to its
+// instructed agent by default. Every transaction carries its original references and a copy of the original
+// transaction in OrgnlTxRef.
+type CancellationRequestBuilder struct {
+	opts          options
+	original      document.Iso20022Document
+	messageId     string
+	caseId        string
+	assigner      string
+	assignee      string
+	cancellations []Exception
+}
+
+// NewCancellationRequest returns a builder of the cancellation request of original
+func NewCancellationRequest(original document.Iso20022Document, opts ...Option) *CancellationRequestBuilder {
+	return &CancellationRequestBuilder{
+		opts:     newOptions(opts),
+		original: original,
+	}
+}
+
+// MessageId sets the assignment Id instead of generating it
+func (b *CancellationRequestBuilder) MessageId(id string) *CancellationRequestBuilder {
+	b.messageId = id
+	return b
+}
+
+// CaseId sets the Id of the case created by the assigner, the request has no case by default
+func (b *CancellationRequestBuilder) CaseId(id string) *CancellationRequestBuilder {
+	b.caseId = id
+	return b
+}
+
+// Assigner sets the BIC of the agent requesting the cancellation
+func (b *CancellationRequestBuilder) Assigner(bic string) *CancellationRequestBuilder {
+	b.assigner = bic
+	return b
+}
+
+// Assignee sets the BIC of the agent asked to cancel
+func (b *CancellationRequestBuilder) Assignee(bic string) *CancellationRequestBuilder {
+	b.assignee = bic
+	return b
+}
+
+// Cancel requests the cancellation of the original transaction selected by exception, e.g. with the
+// reason DUPL
+func (b *CancellationRequestBuilder) Cancel(exception Exception) *CancellationRequestBuilder {
+	exception.Charges = nil
+	b.cancellations = append(b.cancellations, exception)
+	return b
+}
+
+// Build returns the validated document, NbOfTxs of the control data is the number of transactions
+func (b *CancellationRequestBuilder) Build() (document.Iso20022Document, error) {
+	if b.original == nil {
+		return nil, utils.NewErrOmittedNameSpace()
+	}
+	original, err := readOriginal(b.original, "cancellation request", "pacs.003", "pacs.008", "pacs.009")
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := selectExceptions(original, b.cancellations)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId := b.messageId
+	if messageId == "" {
+		messageId = b.opts.messageId()
+	}
+	assigner, assignee := original.agents()
+	if b.assigner != "" {
+		assigner = b.assigner
+	}
+	if b.assignee != "" {
+		assignee = b.assignee
+	}
+
+	var sum totals
+	underlying := camt_v09.UnderlyingTransaction26{
+		OrgnlGrpInfAndCxl: &camt_v09.OriginalGroupHeader15{
+			OrgnlMsgId:   common.Max35Text(original.messageId),
+			OrgnlMsgNmId: common.Max35Text(original.identifier),
+			OrgnlCreDtTm: optionalDateTime(original.created),
+		},
+	}
+	for _, tx := range transactions {
+		sum.add(tx.amount, tx.original.currency)
+		ref := &camt_v09.OriginalTransactionReference31{}
+		original.copyReference(ref, tx.original)
+		cxlId := common.Max35Text(b.opts.endToEndId())
+		underlying.TxInf = append(underlying.TxInf, camt_v09.PaymentTransaction120{
+			CxlId:           &cxlId,
+			OrgnlInstrId:    common.OptionalMax35Text(tx.original.instructionId),
+			OrgnlEndToEndId: common.OptionalMax35Text(tx.original.endToEndId),
+			OrgnlTxId:       common.OptionalMax35Text(tx.original.txId),
+			OrgnlUETR:       common.OptionalUUIDv4Identifier(tx.original.uetr),
+			OrgnlIntrBkSttlmAmt: &camt_v09.ActiveOrHistoricCurrencyAndAmount{
+				Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(tx.original.amount),
+				Ccy:   common.ActiveOrHistoricCurrencyCode(tx.original.currency),
+			},
+			OrgnlIntrBkSttlmDt: optionalDate(tx.original.settlementDate),
+			CxlRsnInf:          camt09CancellationReason(tx.exception),
+			OrgnlTxRef:         ref,
+		})
+	}
+
+	number := sum.numberOfTransactions()
+	message := &camt_v09.FIToFIPaymentCancellationRequestV09{
+		Assgnmt: camt_v09.CaseAssignment5{
+			Id:      common.Max35Text(messageId),
+			Assgnr:  camt_v09.Party40Choice{Agt: camt09OptionalAgent(assigner)},
+			Assgne:  camt_v09.Party40Choice{Agt: camt09OptionalAgent(assignee)},
+			CreDtTm: common.ISODateTime(b.opts.clock()),
+		},
+		CtrlData: &camt_v09.ControlData1{NbOfTxs: &number},
+		Undrlyg:  []camt_v09.UnderlyingTransaction26{underlying},
+	}
+	if b.caseId != "" {
+		message.Case = &camt_v09.Case5{
+			Id:    common.Max35Text(b.caseId),
+			Cretr: camt_v09.Party40Choice{Agt: camt09OptionalAgent(assigner)},
+		}
+	}
+
+	return newDocument(utils.DocumentCamt05600109NameSpace, message)
+}
+
+func camt09CancellationReason(exception Exception) []camt_v09.PaymentCancellationReason5 {
+	if exception.Reason == "" && len(exception.AdditionalInformation) == 0 {
+		return nil
+	}
+	info := camt_v09.PaymentCancellationReason5{AddtlInf: additionalInformation(exception.AdditionalInformation)}
+	if exception.Reason != "" {
+		reason := camt_v09.ExternalCancellationReason1Code(exception.Reason)
+		info.Rsn = &camt_v09.CancellationReason33Choice{Cd: &reason}
+	}
+	return []camt_v09.PaymentCancellationReason5{info}
+}
+
+func camt09OptionalAgent(bic string) *camt_v09.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return nil
+	}
+	return &camt_v09.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: camt_v09.FinancialInstitutionIdentification18{BICFI: common.OptionalBICFIDec2014Identifier(bic)},
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Exception selects a transaction of the original message which is returned, reversed or cancelled, by the
+// first of TxId, UETR, Index and EndToEndId which is set like a TransactionReference
+type Exception struct {
+	TxId string
+	UETR string
+	// Index is the position of the original transaction counted from 1
+	Index int
+	// EndToEndId selects the original transaction when no other one has the same
+	EndToEndId string
+	// Reason is the external reason code, e.g. AC04 for a return, AM05 for a reversal or DUPL for a cancellation
+	Reason string
+	// AdditionalInformation are lines of up to 105 characters explaining the reason
+	AdditionalInformation []string
+	// Charges are deducted from the returned or reversed amount, they are ignored by a cancellation
+	Charges []Charge
+}
+
+// Charge is taken by an agent from a returned or reversed amount, in the currency of the original transaction
+type Charge struct {
+	Amount common.Decimal
+	// Agent is the BIC of the agent taking the charge
+	Agent string
+}
+
+// exceptionTransaction is an original transaction with the exception applied to it
+type exceptionTransaction struct {
+	original  originalTransaction
+	exception Exception
+	// amount is the original amount less the charges
+	amount common.Decimal
+}
+
+// selectExceptions finds the original transaction of every exception and deducts the charges from its amount
+func selectExceptions(original originalMessage, exceptions []Exception) ([]exceptionTransaction, error) {
+	if len(exceptions) == 0 {
+		return nil, utils.NewErrOmittedTransactions()
+	}
+	var transactions []originalTransaction
+	for _, instruction := range original.instructions {
+		transactions = append(transactions, instruction.transactions...)
+	}
+
+	var selected []exceptionTransaction
+	for _, exception := range exceptions {
+		position, err := original.find(exception.reference())
+		if err != nil {
+			return nil, err
+		}
+		tx := transactions[position]
+		amount := tx.amount
+		for _, charge := range exception.Charges {
+			amount = amount.Sub(charge.Amount)
+		}
+		if amount.Sign() <= 0 {
+			return nil, utils.NewErrChargesExceedAmount(tx.endToEndId)
+		}
+		selected = append(selected, exceptionTransaction{original: tx, exception: exception, amount: amount})
+	}
+	return selected, nil
+}
+
+func (e Exception) reference() TransactionReference {
+	return TransactionReference{TxId: e.TxId, UETR: e.UETR, Index: e.Index, EndToEndId: e.EndToEndId}
+}
+
+// agents returns the BICs of the instructing and the instructed agent of the original message, taken from
+// the first transaction when the group header has none
+func (o originalMessage) agents() (string, string) {
+	instructing, instructed := o.instructingAgent, o.instructedAgent
+	for _, instruction := range o.instructions {
+		for _, tx := range instruction.transactions {
+			for _, name := range []string{"InstgAgt", "DbtrAgt"} {
+				if instructing == "" {
+					instructing = textValue(tx.value, name, "FinInstnId", "BICFI")
+				}
+			}
+			for _, name := range []string{"InstdAgt", "CdtrAgt"} {
+				if instructed == "" {
+					instructed = textValue(tx.value, name, "FinInstnId", "BICFI")
+				}
+			}
+			return instructing, instructed
+		}
+	}
+	return instructing, instructed
+}
+
+// copyReference copies the original transaction and the settlement information of its group into ref,
+// a pointer to the original transaction reference of the answer
+func (o originalMessage) copyReference(ref interface{}, tx originalTransaction) {
+	document.CopyElements(ref, tx.value.Interface())
+	settlement := reflect.ValueOf(ref).Elem().FieldByName("SttlmInf")
+	if o.settlement.IsValid() && settlement.Kind() == reflect.Ptr && settlement.IsNil() {
+		value := reflect.New(settlement.Type().Elem())
+		document.CopyElements(value.Interface(), o.settlement.Interface())
+		settlement.Set(value)
+	}
+}
+
+func (o originalMessage) settlementMethodOrClearing() string {
+	if o.settlementMethod == "" {
+		return "CLRG"
+	}
+	return o.settlementMethod
+}
+
+func optionalDate(t *time.Time) *common.ISODate {
+	if t == nil {
+		return nil
+	}
+	date := common.ISODate(*t)
+	return &date
+}
+
+func optionalDateTime(t *time.Time) *common.ISODateTime {
+	if t == nil {
+		return nil
+	}
+	dateTime := common.ISODateTime(*t)
+	return &dateTime
+}
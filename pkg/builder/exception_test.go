@@ -0,0 +1,228 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/camt_v09"
+	"github.com/moov-io/iso20022/pkg/camt_v10"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v10"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func testCreditTransfer(t *testing.T) document.Iso20022Document {
+	debtor := Party{Name: "Debtor", Account: "DE89370400440532013000", Agent: "BANKDEFFXXX"}
+	creditor := Party{Name: "Creditor", Account: "GB29NWBK60161331926819", Agent: "NWBKGB2LXXX"}
+	doc, err := NewFIToFICustomerCreditTransfer(testOptions()...).
+		MessageId("ORIG-1").
+		InstructingAgent("BANKDEFFXXX").
+		InstructedAgent("NWBKGB2LXXX").
+		AddTransfer(Transfer{Amount: common.MustParseDecimal("100"), Currency: "EUR", Debtor: debtor, Creditor: creditor, RemittanceInformation: []string{"Invoice 1"}}).
+		AddTransfer(Transfer{InstructionId: "INSTR-2", Amount: common.MustParseDecimal("25.50"), Currency: "EUR", Debtor: debtor, Creditor: creditor}).
+		Build()
+	assert.Nil(t, err)
+	return doc
+}
+
+func TestPaymentReturn(t *testing.T) {
+	original := testCreditTransfer(t)
+
+	doc, err := NewPaymentReturn(original, testOptions()...).
+		Return(Exception{EndToEndId: "E2E-000001", Reason: "AC04", AdditionalInformation: []string{"Account closed"},
+			Charges: []Charge{{Amount: common.MustParseDecimal("2.50")}}}).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPacs00400110NameSpace, doc.NameSpace())
+
+	message := doc.InspectMessage().(*pacs_v10.PaymentReturnV10)
+	assert.Equal(t, "MSG-000001", string(message.GrpHdr.MsgId))
+	assert.Equal(t, "1", string(message.GrpHdr.NbOfTxs))
+	assert.Equal(t, "97.50", message.GrpHdr.CtrlSum.String())
+	assert.Equal(t, "97.50", common.Decimal(message.GrpHdr.TtlRtrdIntrBkSttlmAmt.Value).String())
+	assert.Equal(t, "CLRG", string(message.GrpHdr.SttlmInf.SttlmMtd))
+	assert.Equal(t, "NWBKGB2LXXX", string(*message.GrpHdr.InstgAgt.FinInstnId.BICFI))
+	assert.Equal(t, "BANKDEFFXXX", string(*message.GrpHdr.InstdAgt.FinInstnId.BICFI))
+	assert.Equal(t, "ORIG-1", string(message.OrgnlGrpInf.OrgnlMsgId))
+	assert.Equal(t, "pacs.008.001.08", string(message.OrgnlGrpInf.OrgnlMsgNmId))
+
+	assert.Len(t, message.TxInf, 1)
+	tx := message.TxInf[0]
+	assert.Equal(t, "E2E-000001", string(*tx.RtrId))
+	assert.Equal(t, "E2E-000001", string(*tx.OrgnlEndToEndId))
+	assert.Equal(t, "8a562c67-ca16-48ba-b074-65581be6f001", string(*tx.OrgnlUETR))
+	assert.Equal(t, "100", common.Decimal(tx.OrgnlIntrBkSttlmAmt.Value).String())
+	assert.Equal(t, testTime, time.Time(*tx.OrgnlIntrBkSttlmDt))
+	assert.Equal(t, "97.50", common.Decimal(tx.RtrdIntrBkSttlmAmt.Value).String())
+	assert.Equal(t, "EUR", string(tx.RtrdIntrBkSttlmAmt.Ccy))
+	assert.Equal(t, "100", common.Decimal(tx.RtrdInstdAmt.Value).String())
+	assert.Equal(t, "CRED", string(*tx.ChrgBr))
+	assert.Equal(t, "2.50", common.Decimal(tx.ChrgsInf[0].Amt.Value).String())
+	assert.Equal(t, "NWBKGB2LXXX", string(*tx.ChrgsInf[0].Agt.FinInstnId.BICFI))
+	assert.Equal(t, "AC04", string(*tx.RtrRsnInf[0].Rsn.Cd))
+	assert.Equal(t, []common.Max105Text{"Account closed"}, tx.RtrRsnInf[0].AddtlInf)
+
+	// the original transaction is copied into the reference
+	ref := tx.OrgnlTxRef
+	assert.Equal(t, "100", common.Decimal(ref.IntrBkSttlmAmt.Value).String())
+	assert.Equal(t, "CLRG", string(ref.SttlmInf.SttlmMtd))
+	assert.Equal(t, "Debtor", string(*ref.Dbtr.Pty.Nm))
+	assert.Equal(t, "DE89370400440532013000", string(*ref.DbtrAcct.Id.IBAN))
+	assert.Equal(t, "BANKDEFFXXX", string(*ref.DbtrAgt.FinInstnId.BICFI))
+	assert.Equal(t, "Creditor", string(*ref.Cdtr.Pty.Nm))
+	assert.Equal(t, []common.Max140Text{"Invoice 1"}, ref.RmtInf.Ustrd)
+	assertRoundTrip(t, doc)
+
+	// without charges the whole amount is returned
+	doc, err = NewPaymentReturn(original, testOptions()...).
+		InstructingAgent("CHASUS33XXX").
+		Return(Exception{EndToEndId: "E2E-000001", Reason: "AC04"}).
+		Return(Exception{EndToEndId: "E2E-000002", Reason: "MD07"}).
+		Build()
+	assert.Nil(t, err)
+	message = doc.InspectMessage().(*pacs_v10.PaymentReturnV10)
+	assert.Equal(t, "CHASUS33XXX", string(*message.GrpHdr.InstgAgt.FinInstnId.BICFI))
+	assert.Equal(t, "2", string(message.GrpHdr.NbOfTxs))
+	assert.Equal(t, "125.50", message.GrpHdr.CtrlSum.String())
+	assert.Equal(t, "INSTR-2", string(*message.TxInf[1].OrgnlInstrId))
+	assert.Nil(t, message.TxInf[1].ChrgBr)
+	assert.Nil(t, message.TxInf[1].RtrdInstdAmt)
+}
+
+func TestPaymentReversal(t *testing.T) {
+	original := testCreditTransfer(t)
+
+	doc, err := NewPaymentReversal(original, testOptions()...).
+		MessageId("RVSL-1").
+		Reverse(Exception{EndToEndId: "E2E-000002", Reason: "AM05", Charges: []Charge{{Amount: common.MustParseDecimal("0.50"), Agent: "BANKDEFFXXX"}}}).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPacs00700110NameSpace, doc.NameSpace())
+
+	message := doc.InspectMessage().(*pacs_v10.FIToFIPaymentReversalV10)
+	assert.Equal(t, "RVSL-1", string(message.GrpHdr.MsgId))
+	assert.Equal(t, "BANKDEFFXXX", string(*message.GrpHdr.InstgAgt.FinInstnId.BICFI))
+	assert.Equal(t, "NWBKGB2LXXX", string(*message.GrpHdr.InstdAgt.FinInstnId.BICFI))
+	assert.Equal(t, "25.00", common.Decimal(message.GrpHdr.TtlRvsdIntrBkSttlmAmt.Value).String())
+	assert.Equal(t, "ORIG-1", string(message.OrgnlGrpInf.OrgnlMsgId))
+
+	tx := message.TxInf[0]
+	assert.Equal(t, "E2E-000001", string(*tx.RvslId))
+	assert.Equal(t, "E2E-000002", string(*tx.OrgnlEndToEndId))
+	assert.Equal(t, "25.50", common.Decimal(tx.OrgnlIntrBkSttlmAmt.Value).String())
+	assert.Equal(t, "25.00", common.Decimal(tx.RvsdIntrBkSttlmAmt.Value).String())
+	assert.Equal(t, "BANKDEFFXXX", string(*tx.ChrgsInf[0].Agt.FinInstnId.BICFI))
+	assert.Equal(t, "AM05", string(*tx.RvslRsnInf[0].Rsn.Cd))
+	assert.Equal(t, "Creditor", string(*tx.OrgnlTxRef.Cdtr.Pty.Nm))
+	assertRoundTrip(t, doc)
+}
+
+func TestCancellationRequestAndResolution(t *testing.T) {
+	original := testCreditTransfer(t)
+
+	request, err := NewCancellationRequest(original, testOptions()...).
+		CaseId("CASE-1").
+		Cancel(Exception{EndToEndId: "E2E-000001", Reason: "DUPL"}).
+		Cancel(Exception{EndToEndId: "E2E-000002", Reason: "CUST", AdditionalInformation: []string{"Requested by debtor"}}).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentCamt05600109NameSpace, request.NameSpace())
+
+	message := request.InspectMessage().(*camt_v09.FIToFIPaymentCancellationRequestV09)
+	assert.Equal(t, "MSG-000001", string(message.Assgnmt.Id))
+	assert.Equal(t, "BANKDEFFXXX", string(*message.Assgnmt.Assgnr.Agt.FinInstnId.BICFI))
+	assert.Equal(t, "NWBKGB2LXXX", string(*message.Assgnmt.Assgne.Agt.FinInstnId.BICFI))
+	assert.Equal(t, "CASE-1", string(message.Case.Id))
+	assert.Equal(t, "2", string(*message.CtrlData.NbOfTxs))
+	assert.Len(t, message.Undrlyg, 1)
+	assert.Equal(t, "ORIG-1", string(message.Undrlyg[0].OrgnlGrpInfAndCxl.OrgnlMsgId))
+	assert.Len(t, message.Undrlyg[0].TxInf, 2)
+	tx := message.Undrlyg[0].TxInf[1]
+	assert.Equal(t, "E2E-000002", string(*tx.CxlId))
+	assert.Equal(t, "E2E-000002", string(*tx.OrgnlEndToEndId))
+	assert.Equal(t, "25.50", common.Decimal(tx.OrgnlIntrBkSttlmAmt.Value).String())
+	assert.Equal(t, "CUST", string(*tx.CxlRsnInf[0].Rsn.Cd))
+	assert.Equal(t, "Debtor", string(*tx.OrgnlTxRef.Dbtr.Pty.Nm))
+	assertRoundTrip(t, request)
+
+	// some cancellations are accepted
+	doc, err := NewResolutionOfInvestigation(request, testOptions()...).
+		MessageId("RSLTN-1").
+		TransactionStatus("E2E-000001", Status{Code: CancellationAccepted}).
+		TransactionStatus("E2E-000002", Status{Code: CancellationRejected, Reason: "LEGL"}).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentCamt02900110NameSpace, doc.NameSpace())
+
+	resolution := doc.InspectMessage().(*camt_v10.ResolutionOfInvestigationV10)
+	assert.Equal(t, "RSLTN-1", string(resolution.Assgnmt.Id))
+	assert.Equal(t, "NWBKGB2LXXX", string(*resolution.Assgnmt.Assgnr.Agt.FinInstnId.BICFI))
+	assert.Equal(t, "BANKDEFFXXX", string(*resolution.Assgnmt.Assgne.Agt.FinInstnId.BICFI))
+	assert.Equal(t, "CASE-1", string(resolution.RslvdCase.Id))
+	assert.Equal(t, "PECR", string(*resolution.Sts.Conf))
+	assert.Len(t, resolution.CxlDtls, 1)
+	details := resolution.CxlDtls[0]
+	assert.Equal(t, "ORIG-1", string(*details.OrgnlGrpInfAndSts.OrgnlMsgId))
+	assert.Equal(t, "pacs.008.001.08", string(*details.OrgnlGrpInfAndSts.OrgnlMsgNmId))
+	assert.Equal(t, "PACR", string(*details.OrgnlGrpInfAndSts.GrpCxlSts))
+	assert.Len(t, details.TxInfAndSts, 2)
+	assert.Equal(t, "ACCR", string(*details.TxInfAndSts[0].TxCxlSts))
+	assert.Nil(t, details.TxInfAndSts[0].CxlStsRsnInf)
+	assert.Equal(t, "E2E-000002", string(*details.TxInfAndSts[1].OrgnlEndToEndId))
+	assert.Equal(t, "RJCR", string(*details.TxInfAndSts[1].TxCxlSts))
+	assert.Equal(t, "LEGL", string(*details.TxInfAndSts[1].CxlStsRsnInf[0].Rsn.Cd))
+	assert.Equal(t, "Creditor", string(*details.TxInfAndSts[1].OrgnlTxRef.Cdtr.Pty.Nm))
+	assertRoundTrip(t, doc)
+
+	// every cancellation is accepted
+	doc, err = NewResolutionOfInvestigation(request, testOptions()...).
+		TransactionStatus("E2E-000001", Status{Code: CancellationAccepted}).
+		TransactionStatus("E2E-000002", Status{Code: CancellationAccepted}).
+		Build()
+	assert.Nil(t, err)
+	resolution = doc.InspectMessage().(*camt_v10.ResolutionOfInvestigationV10)
+	assert.Equal(t, "CNCL", string(*resolution.Sts.Conf))
+	assert.Equal(t, "ACCR", string(*resolution.CxlDtls[0].OrgnlGrpInfAndSts.GrpCxlSts))
+
+	// undecided cancellations are pending
+	doc, err = NewResolutionOfInvestigation(request, testOptions()...).Build()
+	assert.Nil(t, err)
+	resolution = doc.InspectMessage().(*camt_v10.ResolutionOfInvestigationV10)
+	assert.Equal(t, "PDCR", string(*resolution.Sts.Conf))
+}
+
+func TestExceptionErrors(t *testing.T) {
+	original := testCreditTransfer(t)
+
+	_, err := NewPaymentReturn(original).Build()
+	assert.Error(t, err)
+
+	_, err = NewPaymentReturn(original).Return(Exception{EndToEndId: "UNKNOWN", Reason: "AC04"}).Build()
+	assert.EqualError(t, err, "The end to end id UNKNOWN of original message is unknown")
+
+	_, err = NewPaymentReversal(original).
+		Reverse(Exception{EndToEndId: "E2E-000002", Reason: "AM05", Charges: []Charge{{Amount: common.MustParseDecimal("25.50")}}}).
+		Build()
+	assert.EqualError(t, err, "The charges of transaction E2E-000002 exceed its amount")
+
+	_, err = NewCancellationRequest(testInitiation(t)).Cancel(Exception{EndToEndId: "E2E-000001"}).Build()
+	assert.EqualError(t, err, "The message of pain.001.001.10 can't be converted into cancellation request")
+
+	_, err = NewResolutionOfInvestigation(original).Build()
+	assert.EqualError(t, err, "The message of pacs.008.001.08 can't be converted into resolution of investigation")
+
+	request, err := NewCancellationRequest(original).Cancel(Exception{EndToEndId: "E2E-000001", Reason: "DUPL"}).Build()
+	assert.Nil(t, err)
+	_, err = NewResolutionOfInvestigation(request).TransactionStatus("E2E-000002", Status{Code: CancellationAccepted}).Build()
+	assert.EqualError(t, err, "The end to end id E2E-000002 of original message is unknown")
+
+	_, err = NewPaymentReversal(nil).Build()
+	assert.Error(t, err)
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v11"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// buildPacs002 answers a pacs.003, pacs.008 or pacs.009 with pacs.002.001.11, the decided transactions are
+// reported after the group
+func (b *StatusReportBuilder) buildPacs002(messageId string, report statusReport) (document.Iso20022Document, error) {
+	original := report.original
+	groupStatus := pacs_v11.ExternalPaymentGroupStatus1Code(report.group.status())
+	group := pacs_v11.OriginalGroupHeader17{
+		OrgnlMsgId:    common.Max35Text(original.messageId),
+		OrgnlMsgNmId:  common.Max35Text(original.identifier),
+		OrgnlNbOfTxs:  common.OptionalMax15NumericText(original.nbOfTxs),
+		OrgnlCtrlSum:  original.ctrlSum,
+		GrpSts:        &groupStatus,
+		StsRsnInf:     pacs11Reason(report.reason),
+		NbOfTxsPerSts: pacs11Counts(report.group),
+	}
+	if original.created != nil {
+		created := common.ISODateTime(*original.created)
+		group.OrgnlCreDtTm = &created
+	}
+
+	message := &pacs_v11.FIToFIPaymentStatusReportV11{
+		GrpHdr: pacs_v11.GroupHeader91{
+			MsgId:    common.Max35Text(messageId),
+			CreDtTm:  common.ISODateTime(b.opts.clock()),
+			InstgAgt: pacs11OptionalAgent(b.instructingAgent),
+			InstdAgt: pacs11OptionalAgent(b.instructedAgent),
+		},
+		OrgnlGrpInfAndSts: []pacs_v11.OriginalGroupHeader17{group},
+	}
+	for _, instruction := range report.instructions {
+		for _, tx := range instruction.transactions {
+			txStatus := pacs_v11.ExternalPaymentTransactionStatus1Code(tx.status.Code)
+			message.TxInfAndSts = append(message.TxInfAndSts, pacs_v11.PaymentTransaction123{
+				OrgnlInstrId:    common.OptionalMax35Text(tx.original.instructionId),
+				OrgnlEndToEndId: common.OptionalMax35Text(tx.original.endToEndId),
+				OrgnlTxId:       common.OptionalMax35Text(tx.original.txId),
+				OrgnlUETR:       common.OptionalUUIDv4Identifier(tx.original.uetr),
+				TxSts:           &txStatus,
+				StsRsnInf:       pacs11Reason(&tx.status),
+			})
+		}
+	}
+
+	return newDocument(utils.DocumentPacs00200111NameSpace, message)
+}
+
+func pacs11Reason(status *Status) []pacs_v11.StatusReasonInformation12 {
+	if status == nil || (status.Reason == "" && len(status.AdditionalInformation) == 0) {
+		return nil
+	}
+	info := pacs_v11.StatusReasonInformation12{AddtlInf: additionalInformation(status.AdditionalInformation)}
+	if status.Reason != "" {
+		reason := pacs_v11.ExternalStatusReason1Code(status.Reason)
+		info.Rsn = &pacs_v11.StatusReason6Choice{Cd: &reason}
+	}
+	return []pacs_v11.StatusReasonInformation12{info}
+}
+
+func pacs11Counts(count statusCount) []pacs_v11.NumberOfTransactionsPerStatus5 {
+	var counts []pacs_v11.NumberOfTransactionsPerStatus5
+	for _, status := range count.statuses {
+		counts = append(counts, pacs_v11.NumberOfTransactionsPerStatus5{
+			DtldNbOfTxs: count.numberOfTransactions(status),
+			DtldSts:     pacs_v11.ExternalPaymentTransactionStatus1Code(status),
+			DtldCtrlSum: decimalNumber(count.sums[status]),
+		})
+	}
+	return counts
+}
+
+func pacs11OptionalAgent(bic string) *pacs_v11.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return nil
+	}
+	return &pacs_v11.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: pacs_v11.FinancialInstitutionIdentification18{BICFI: common.OptionalBICFIDec2014Identifier(bic)},
+	}
+}
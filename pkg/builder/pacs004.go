@@ -0,0 +1,201 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v10"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// PaymentReturnBuilder builds the pacs.004.001.10 returning transactions of a pacs.003, pacs.008 or pacs.009.
+//
+// Every returned transaction carries its original references and a copy of the original transaction in
+// OrgnlTxRef. The returned amount is the original amount less the charges of the return.
+type PaymentReturnBuilder struct {
+	opts             options
+	original         document.Iso20022Document
+	messageId        string
+	settlementDate   time.Time
+	instructingAgent string
+	instructedAgent  string
+	returns          []Exception
+}
+
+// NewPaymentReturn returns a builder of the payment return of original
+func NewPaymentReturn(original document.Iso20022Document, opts ...Option) *PaymentReturnBuilder {
+	return &PaymentReturnBuilder{
+		opts:     newOptions(opts),
+		original: original,
+	}
+}
+
+// MessageId sets MsgId instead of generating it
+func (b *PaymentReturnBuilder) MessageId(id string) *PaymentReturnBuilder {
+	b.messageId = id
+	return b
+}
+
+// SettlementDate sets the interbank settlement date of the return, the creation date is used by default
+func (b *PaymentReturnBuilder) SettlementDate(date time.Time) *PaymentReturnBuilder {
+	b.settlementDate = date
+	return b
+}
+
+// InstructingAgent sets the BIC of the instructing agent, the instructed agent of the original by default
+func (b *PaymentReturnBuilder) InstructingAgent(bic string) *PaymentReturnBuilder {
+	b.instructingAgent = bic
+	return b
+}
+
+// InstructedAgent sets the BIC of the instructed agent, the instructing agent of the original by default
+func (b *PaymentReturnBuilder) InstructedAgent(bic string) *PaymentReturnBuilder {
+	b.instructedAgent = bic
+	return b
+}
+
+// Return returns the original transaction selected by exception, e.g. with the reason AC04
+func (b *PaymentReturnBuilder) Return(exception Exception) *PaymentReturnBuilder {
+	b.returns = append(b.returns, exception)
+	return b
+}
+
+// Build returns the validated document.
+// NbOfTxs and CtrlSum are computed from the returned amounts, TtlRtrdIntrBkSttlmAmt when all of them are in the same currency.
+func (b *PaymentReturnBuilder) Build() (document.Iso20022Document, error) {
+	if b.original == nil {
+		return nil, utils.NewErrOmittedNameSpace()
+	}
+	original, err := readOriginal(b.original, "payment return", "pacs.003", "pacs.008", "pacs.009")
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := selectExceptions(original, b.returns)
+	if err != nil {
+		return nil, err
+	}
+
+	now := b.opts.clock()
+	messageId := b.messageId
+	if messageId == "" {
+		messageId = b.opts.messageId()
+	}
+	settlementDate := b.settlementDate
+	if settlementDate.IsZero() {
+		settlementDate = now
+	}
+	instructed, instructing := original.agents()
+	if b.instructingAgent != "" {
+		instructing = b.instructingAgent
+	}
+	if b.instructedAgent != "" {
+		instructed = b.instructedAgent
+	}
+
+	date := common.ISODate(settlementDate)
+	message := &pacs_v10.PaymentReturnV10{
+		GrpHdr: pacs_v10.GroupHeader90{
+			MsgId:         common.Max35Text(messageId),
+			CreDtTm:       common.ISODateTime(now),
+			IntrBkSttlmDt: &date,
+			SttlmInf:      pacs_v10.SettlementInstruction7{SttlmMtd: pacs_v10.SettlementMethod1Code(original.settlementMethodOrClearing())},
+			InstgAgt:      pacs10OptionalAgent(instructing),
+			InstdAgt:      pacs10OptionalAgent(instructed),
+		},
+		OrgnlGrpInf: &pacs_v10.OriginalGroupHeader18{
+			OrgnlMsgId:   common.Max35Text(original.messageId),
+			OrgnlMsgNmId: common.Max35Text(original.identifier),
+			OrgnlCreDtTm: optionalDateTime(original.created),
+		},
+	}
+
+	var sum totals
+	for _, tx := range transactions {
+		sum.add(tx.amount, tx.original.currency)
+		ref := &pacs_v10.OriginalTransactionReference32{}
+		original.copyReference(ref, tx.original)
+		rtrId := common.Max35Text(b.opts.endToEndId())
+		info := pacs_v10.PaymentTransaction118{
+			RtrId:               &rtrId,
+			OrgnlInstrId:        common.OptionalMax35Text(tx.original.instructionId),
+			OrgnlEndToEndId:     common.OptionalMax35Text(tx.original.endToEndId),
+			OrgnlTxId:           common.OptionalMax35Text(tx.original.txId),
+			OrgnlUETR:           common.OptionalUUIDv4Identifier(tx.original.uetr),
+			OrgnlIntrBkSttlmAmt: pacs10Amount(tx.original.amount, tx.original.currency),
+			OrgnlIntrBkSttlmDt:  optionalDate(tx.original.settlementDate),
+			RtrdIntrBkSttlmAmt: pacs_v10.ActiveCurrencyAndAmount{
+				Value: common.ActiveCurrencyAndAmountSimpleType(tx.amount),
+				Ccy:   common.ActiveCurrencyCode(tx.original.currency),
+			},
+			RtrRsnInf:  pacs10ReturnReason(tx.exception),
+			OrgnlTxRef: ref,
+		}
+		if len(tx.exception.Charges) > 0 {
+			info.RtrdInstdAmt = pacs10Amount(tx.original.amount, tx.original.currency)
+			info.ChrgBr, info.ChrgsInf = pacs10Charges(tx, instructing)
+		}
+		message.TxInf = append(message.TxInf, info)
+	}
+	message.GrpHdr.NbOfTxs = sum.numberOfTransactions()
+	message.GrpHdr.CtrlSum = decimalNumber(sum.sum)
+	if !sum.mixed {
+		message.GrpHdr.TtlRtrdIntrBkSttlmAmt = &pacs_v10.ActiveCurrencyAndAmount{
+			Value: common.ActiveCurrencyAndAmountSimpleType(sum.sum),
+			Ccy:   common.ActiveCurrencyCode(sum.currency),
+		}
+	}
+
+	return newDocument(utils.DocumentPacs00400110NameSpace, message)
+}
+
+func pacs10ReturnReason(exception Exception) []pacs_v10.PaymentReturnReason6 {
+	if exception.Reason == "" && len(exception.AdditionalInformation) == 0 {
+		return nil
+	}
+	info := pacs_v10.PaymentReturnReason6{AddtlInf: additionalInformation(exception.AdditionalInformation)}
+	if exception.Reason != "" {
+		reason := pacs_v10.ExternalReturnReason1Code(exception.Reason)
+		info.Rsn = &pacs_v10.ReturnReason5Choice{Cd: &reason}
+	}
+	return []pacs_v10.PaymentReturnReason6{info}
+}
+
+// pacs10Charges returns the charges borne by the creditor of the original transaction, an agent defaults to agent
+func pacs10Charges(tx exceptionTransaction, agent string) (*pacs_v10.ChargeBearerType1Code, []pacs_v10.Charges7) {
+	var charges []pacs_v10.Charges7
+	for _, charge := range tx.exception.Charges {
+		bic := charge.Agent
+		if bic == "" {
+			bic = agent
+		}
+		charges = append(charges, pacs_v10.Charges7{
+			Amt: *pacs10Amount(charge.Amount, tx.original.currency),
+			Agt: pacs_v10.BranchAndFinancialInstitutionIdentification6{
+				FinInstnId: pacs_v10.FinancialInstitutionIdentification18{BICFI: common.OptionalBICFIDec2014Identifier(bic)},
+			},
+		})
+	}
+	bearer := pacs_v10.ChargeBearerType1Code("CRED")
+	return &bearer, charges
+}
+
+func pacs10Amount(amount common.Decimal, currency string) *pacs_v10.ActiveOrHistoricCurrencyAndAmount {
+	return &pacs_v10.ActiveOrHistoricCurrencyAndAmount{
+		Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(amount),
+		Ccy:   common.ActiveOrHistoricCurrencyCode(currency),
+	}
+}
+
+func pacs10OptionalAgent(bic string) *pacs_v10.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return nil
+	}
+	return &pacs_v10.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: pacs_v10.FinancialInstitutionIdentification18{BICFI: common.OptionalBICFIDec2014Identifier(bic)},
+	}
+}
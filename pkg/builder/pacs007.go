@@ -0,0 +1,166 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v10"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// PaymentReversalBuilder builds the pacs.007.001.10 reversing transactions of a pacs.003, pacs.008 or pacs.009,
+// sent by the instructing agent of the original.
+//
+// Every reversed transaction carries its original references and a copy of the original transaction in
+// OrgnlTxRef. The reversed amount is the original amount less the charges of the reversal.
+type PaymentReversalBuilder struct {
+	opts             options
+	original         document.Iso20022Document
+	messageId        string
+	settlementDate   time.Time
+	instructingAgent string
+	instructedAgent  string
+	reversals        []Exception
+}
+
+// NewPaymentReversal returns a builder of the payment reversal of original
+func NewPaymentReversal(original document.Iso20022Document, opts ...Option) *PaymentReversalBuilder {
+	return &PaymentReversalBuilder{
+		opts:     newOptions(opts),
+		original: original,
+	}
+}
+
+// MessageId sets MsgId instead of generating it
+func (b *PaymentReversalBuilder) MessageId(id string) *PaymentReversalBuilder {
+	b.messageId = id
+	return b
+}
+
+// SettlementDate sets the interbank settlement date of the reversal, the creation date is used by default
+func (b *PaymentReversalBuilder) SettlementDate(date time.Time) *PaymentReversalBuilder {
+	b.settlementDate = date
+	return b
+}
+
+// InstructingAgent sets the BIC of the instructing agent, the instructing agent of the original by default
+func (b *PaymentReversalBuilder) InstructingAgent(bic string) *PaymentReversalBuilder {
+	b.instructingAgent = bic
+	return b
+}
+
+// InstructedAgent sets the BIC of the instructed agent, the instructed agent of the original by default
+func (b *PaymentReversalBuilder) InstructedAgent(bic string) *PaymentReversalBuilder {
+	b.instructedAgent = bic
+	return b
+}
+
+// Reverse reverses the original transaction selected by exception, e.g. with the reason AM05
+func (b *PaymentReversalBuilder) Reverse(exception Exception) *PaymentReversalBuilder {
+	b.reversals = append(b.reversals, exception)
+	return b
+}
+
+// Build returns the validated document.
+// NbOfTxs and CtrlSum are computed from the reversed amounts, TtlRvsdIntrBkSttlmAmt when all of them are in the same currency.
+func (b *PaymentReversalBuilder) Build() (document.Iso20022Document, error) {
+	if b.original == nil {
+		return nil, utils.NewErrOmittedNameSpace()
+	}
+	original, err := readOriginal(b.original, "payment reversal", "pacs.003", "pacs.008", "pacs.009")
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := selectExceptions(original, b.reversals)
+	if err != nil {
+		return nil, err
+	}
+
+	now := b.opts.clock()
+	messageId := b.messageId
+	if messageId == "" {
+		messageId = b.opts.messageId()
+	}
+	settlementDate := b.settlementDate
+	if settlementDate.IsZero() {
+		settlementDate = now
+	}
+	instructing, instructed := original.agents()
+	if b.instructingAgent != "" {
+		instructing = b.instructingAgent
+	}
+	if b.instructedAgent != "" {
+		instructed = b.instructedAgent
+	}
+
+	date := common.ISODate(settlementDate)
+	message := &pacs_v10.FIToFIPaymentReversalV10{
+		GrpHdr: pacs_v10.GroupHeader89{
+			MsgId:         common.Max35Text(messageId),
+			CreDtTm:       common.ISODateTime(now),
+			IntrBkSttlmDt: &date,
+			SttlmInf:      pacs_v10.SettlementInstruction7{SttlmMtd: pacs_v10.SettlementMethod1Code(original.settlementMethodOrClearing())},
+			InstgAgt:      pacs10OptionalAgent(instructing),
+			InstdAgt:      pacs10OptionalAgent(instructed),
+		},
+		OrgnlGrpInf: &pacs_v10.OriginalGroupHeader16{
+			OrgnlMsgId:   common.Max35Text(original.messageId),
+			OrgnlMsgNmId: common.Max35Text(original.identifier),
+			OrgnlCreDtTm: optionalDateTime(original.created),
+		},
+	}
+
+	var sum totals
+	for _, tx := range transactions {
+		sum.add(tx.amount, tx.original.currency)
+		ref := &pacs_v10.OriginalTransactionReference31{}
+		original.copyReference(ref, tx.original)
+		rvslId := common.Max35Text(b.opts.endToEndId())
+		info := pacs_v10.PaymentTransaction119{
+			RvslId:              &rvslId,
+			OrgnlInstrId:        common.OptionalMax35Text(tx.original.instructionId),
+			OrgnlEndToEndId:     common.OptionalMax35Text(tx.original.endToEndId),
+			OrgnlTxId:           common.OptionalMax35Text(tx.original.txId),
+			OrgnlUETR:           common.OptionalUUIDv4Identifier(tx.original.uetr),
+			OrgnlIntrBkSttlmAmt: pacs10Amount(tx.original.amount, tx.original.currency),
+			RvsdIntrBkSttlmAmt: pacs_v10.ActiveCurrencyAndAmount{
+				Value: common.ActiveCurrencyAndAmountSimpleType(tx.amount),
+				Ccy:   common.ActiveCurrencyCode(tx.original.currency),
+			},
+			RvslRsnInf: pacs10ReversalReason(tx.exception),
+			OrgnlTxRef: ref,
+		}
+		if len(tx.exception.Charges) > 0 {
+			info.RvsdInstdAmt = pacs10Amount(tx.original.amount, tx.original.currency)
+			info.ChrgBr, info.ChrgsInf = pacs10Charges(tx, instructing)
+		}
+		message.TxInf = append(message.TxInf, info)
+	}
+	message.GrpHdr.NbOfTxs = sum.numberOfTransactions()
+	message.GrpHdr.CtrlSum = decimalNumber(sum.sum)
+	if !sum.mixed {
+		message.GrpHdr.TtlRvsdIntrBkSttlmAmt = &pacs_v10.ActiveCurrencyAndAmount{
+			Value: common.ActiveCurrencyAndAmountSimpleType(sum.sum),
+			Ccy:   common.ActiveCurrencyCode(sum.currency),
+		}
+	}
+
+	return newDocument(utils.DocumentPacs00700110NameSpace, message)
+}
+
+func pacs10ReversalReason(exception Exception) []pacs_v10.PaymentReversalReason9 {
+	if exception.Reason == "" && len(exception.AdditionalInformation) == 0 {
+		return nil
+	}
+	info := pacs_v10.PaymentReversalReason9{AddtlInf: additionalInformation(exception.AdditionalInformation)}
+	if exception.Reason != "" {
+		reason := pacs_v10.ExternalReversalReason1Code(exception.Reason)
+		info.Rsn = &pacs_v10.ReversalReason4Choice{Cd: &reason}
+	}
+	return []pacs_v10.PaymentReversalReason9{info}
+}
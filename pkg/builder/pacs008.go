@@ -0,0 +1,181 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// FIToFICustomerCreditTransferBuilder builds pacs.008.001.08 messages
+type FIToFICustomerCreditTransferBuilder struct {
+	opts             options
+	messageId        string
+	settlementMethod string
+	settlementDate   time.Time
+	instructingAgent string
+	instructedAgent  string
+	transactions     []pacs_v08.CreditTransferTransaction39
+}
+
+// NewFIToFICustomerCreditTransfer returns a builder of pacs.008.001.08 messages settled by clearing (CLRG)
+func NewFIToFICustomerCreditTransfer(opts ...Option) *FIToFICustomerCreditTransferBuilder {
+	return &FIToFICustomerCreditTransferBuilder{
+		opts:             newOptions(opts),
+		settlementMethod: "CLRG",
+	}
+}
+
+// MessageId sets MsgId instead of generating it
+func (b *FIToFICustomerCreditTransferBuilder) MessageId(id string) *FIToFICustomerCreditTransferBuilder {
+	b.messageId = id
+	return b
+}
+
+// SettlementMethod sets the settlement method (INDA, INGA, COVE or CLRG)
+func (b *FIToFICustomerCreditTransferBuilder) SettlementMethod(method string) *FIToFICustomerCreditTransferBuilder {
+	b.settlementMethod = method
+	return b
+}
+
+// SettlementDate sets the interbank settlement date, the creation date is used by default
+func (b *FIToFICustomerCreditTransferBuilder) SettlementDate(date time.Time) *FIToFICustomerCreditTransferBuilder {
+	b.settlementDate = date
+	return b
+}
+
+// InstructingAgent sets the BIC of the instructing agent
+func (b *FIToFICustomerCreditTransferBuilder) InstructingAgent(bic string) *FIToFICustomerCreditTransferBuilder {
+	b.instructingAgent = bic
+	return b
+}
+
+// InstructedAgent sets the BIC of the instructed agent
+func (b *FIToFICustomerCreditTransferBuilder) InstructedAgent(bic string) *FIToFICustomerCreditTransferBuilder {
+	b.instructedAgent = bic
+	return b
+}
+
+// AddTransfer adds a credit transfer
+func (b *FIToFICustomerCreditTransferBuilder) AddTransfer(transfer Transfer) *FIToFICustomerCreditTransferBuilder {
+	transfer = b.opts.identify(transfer)
+	if transfer.ChargeBearer == "" {
+		transfer.ChargeBearer = defaultChargeBearer
+	}
+
+	uetr := common.UUIDv4Identifier(transfer.UETR)
+	tx := pacs_v08.CreditTransferTransaction39{
+		PmtId: pacs_v08.PaymentIdentification7{
+			InstrId:    common.OptionalMax35Text(transfer.InstructionId),
+			EndToEndId: common.Max35Text(transfer.EndToEndId),
+			UETR:       &uetr,
+		},
+		IntrBkSttlmAmt: pacs_v08.ActiveCurrencyAndAmount{
+			Value: common.ActiveCurrencyAndAmountSimpleType(transfer.Amount),
+			Ccy:   common.ActiveCurrencyCode(transfer.Currency),
+		},
+		ChrgBr:   pacs_v08.ChargeBearerType1Code(transfer.ChargeBearer),
+		Dbtr:     pacs_v08.PartyIdentification135{Nm: common.OptionalMax140Text(transfer.Debtor.Name)},
+		DbtrAcct: pacs08Account(transfer.Debtor.Account),
+		DbtrAgt:  pacs08Agent(transfer.Debtor.Agent),
+		CdtrAgt:  pacs08Agent(transfer.Creditor.Agent),
+		Cdtr:     pacs_v08.PartyIdentification135{Nm: common.OptionalMax140Text(transfer.Creditor.Name)},
+		CdtrAcct: pacs08Account(transfer.Creditor.Account),
+	}
+	if ustrd := remittance(transfer.RemittanceInformation); len(ustrd) > 0 {
+		tx.RmtInf = &pacs_v08.RemittanceInformation16{Ustrd: ustrd}
+	}
+	return b.AddTransaction(tx)
+}
+
+// AddTransaction adds a transaction built by hand, its EndToEndId and UETR are generated when they are empty
+func (b *FIToFICustomerCreditTransferBuilder) AddTransaction(tx pacs_v08.CreditTransferTransaction39) *FIToFICustomerCreditTransferBuilder {
+	if tx.PmtId.EndToEndId == "" {
+		tx.PmtId.EndToEndId = common.Max35Text(b.opts.endToEndId())
+	}
+	if tx.PmtId.UETR == nil {
+		uetr := common.UUIDv4Identifier(b.opts.uetr())
+		tx.PmtId.UETR = &uetr
+	}
+	b.transactions = append(b.transactions, tx)
+	return b
+}
+
+// Build returns the validated document.
+// NbOfTxs and CtrlSum are computed from the transactions, TtlIntrBkSttlmAmt when all of them are in the same currency.
+func (b *FIToFICustomerCreditTransferBuilder) Build() (document.Iso20022Document, error) {
+	if len(b.transactions) == 0 {
+		return nil, utils.NewErrOmittedTransactions()
+	}
+
+	now := b.opts.clock()
+	messageId := b.messageId
+	if messageId == "" {
+		messageId = b.opts.messageId()
+	}
+	settlementDate := b.settlementDate
+	if settlementDate.IsZero() {
+		settlementDate = now
+	}
+
+	var sum totals
+	for _, tx := range b.transactions {
+		sum.add(common.Decimal(tx.IntrBkSttlmAmt.Value), string(tx.IntrBkSttlmAmt.Ccy))
+	}
+
+	date := common.ISODate(settlementDate)
+	message := &pacs_v08.FIToFICustomerCreditTransferV08{
+		GrpHdr: pacs_v08.GroupHeader93{
+			MsgId:         common.Max35Text(messageId),
+			CreDtTm:       common.ISODateTime(now),
+			NbOfTxs:       sum.numberOfTransactions(),
+			CtrlSum:       decimalNumber(sum.sum),
+			IntrBkSttlmDt: &date,
+			SttlmInf:      pacs_v08.SettlementInstruction7{SttlmMtd: pacs_v08.SettlementMethod1Code(b.settlementMethod)},
+			InstgAgt:      pacs08OptionalAgent(b.instructingAgent),
+			InstdAgt:      pacs08OptionalAgent(b.instructedAgent),
+		},
+		CdtTrfTxInf: append([]pacs_v08.CreditTransferTransaction39{}, b.transactions...),
+	}
+	if !sum.mixed {
+		message.GrpHdr.TtlIntrBkSttlmAmt = &pacs_v08.ActiveCurrencyAndAmount{
+			Value: common.ActiveCurrencyAndAmountSimpleType(sum.sum),
+			Ccy:   common.ActiveCurrencyCode(sum.currency),
+		}
+	}
+
+	return newDocument(utils.DocumentPacs00800108NameSpace, message)
+}
+
+func pacs08Account(account string) *pacs_v08.CashAccount38 {
+	if account == "" {
+		return nil
+	}
+	if isIBAN(account) {
+		iban := common.IBAN2007Identifier(account)
+		return &pacs_v08.CashAccount38{Id: pacs_v08.AccountIdentification4Choice{IBAN: &iban}}
+	}
+	return &pacs_v08.CashAccount38{Id: pacs_v08.AccountIdentification4Choice{
+		Othr: &pacs_v08.GenericAccountIdentification1{Id: common.Max34Text(account)},
+	}}
+}
+
+func pacs08Agent(bic string) pacs_v08.BranchAndFinancialInstitutionIdentification6 {
+	return pacs_v08.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: pacs_v08.FinancialInstitutionIdentification18{BICFI: common.OptionalBICFIDec2014Identifier(bic)},
+	}
+}
+
+func pacs08OptionalAgent(bic string) *pacs_v08.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return nil
+	}
+	agent := pacs08Agent(bic)
+	return &agent
+}
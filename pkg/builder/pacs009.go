@@ -0,0 +1,187 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v09"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// FinancialInstitutionCreditTransferBuilder builds pacs.009.001.09 messages
+type FinancialInstitutionCreditTransferBuilder struct {
+	opts             options
+	messageId        string
+	settlementMethod string
+	settlementDate   time.Time
+	instructingAgent string
+	instructedAgent  string
+	transactions     []pacs_v09.CreditTransferTransaction44
+}
+
+// NewFinancialInstitutionCreditTransfer returns a builder of pacs.009.001.09 messages settled by clearing (CLRG)
+func NewFinancialInstitutionCreditTransfer(opts ...Option) *FinancialInstitutionCreditTransferBuilder {
+	return &FinancialInstitutionCreditTransferBuilder{
+		opts:             newOptions(opts),
+		settlementMethod: "CLRG",
+	}
+}
+
+// MessageId sets MsgId instead of generating it
+func (b *FinancialInstitutionCreditTransferBuilder) MessageId(id string) *FinancialInstitutionCreditTransferBuilder {
+	b.messageId = id
+	return b
+}
+
+// SettlementMethod sets the settlement method (INDA, INGA, COVE or CLRG)
+func (b *FinancialInstitutionCreditTransferBuilder) SettlementMethod(method string) *FinancialInstitutionCreditTransferBuilder {
+	b.settlementMethod = method
+	return b
+}
+
+// SettlementDate sets the interbank settlement date, the creation date is used by default
+func (b *FinancialInstitutionCreditTransferBuilder) SettlementDate(date time.Time) *FinancialInstitutionCreditTransferBuilder {
+	b.settlementDate = date
+	return b
+}
+
+// InstructingAgent sets the BIC of the instructing agent
+func (b *FinancialInstitutionCreditTransferBuilder) InstructingAgent(bic string) *FinancialInstitutionCreditTransferBuilder {
+	b.instructingAgent = bic
+	return b
+}
+
+// InstructedAgent sets the BIC of the instructed agent
+func (b *FinancialInstitutionCreditTransferBuilder) InstructedAgent(bic string) *FinancialInstitutionCreditTransferBuilder {
+	b.instructedAgent = bic
+	return b
+}
+
+// AddTransfer adds a credit transfer between financial institutions, the BIC and Name of both parties identify them.
+// pacs.009 has no charge bearer, ChargeBearer is ignored.
+func (b *FinancialInstitutionCreditTransferBuilder) AddTransfer(transfer Transfer) *FinancialInstitutionCreditTransferBuilder {
+	transfer = b.opts.identify(transfer)
+
+	uetr := common.UUIDv4Identifier(transfer.UETR)
+	tx := pacs_v09.CreditTransferTransaction44{
+		PmtId: pacs_v09.PaymentIdentification13{
+			InstrId:    common.OptionalMax35Text(transfer.InstructionId),
+			EndToEndId: common.Max35Text(transfer.EndToEndId),
+			UETR:       &uetr,
+		},
+		IntrBkSttlmAmt: pacs_v09.ActiveCurrencyAndAmount{
+			Value: common.ActiveCurrencyAndAmountSimpleType(transfer.Amount),
+			Ccy:   common.ActiveCurrencyCode(transfer.Currency),
+		},
+		Dbtr:     pacs09Institution(transfer.Debtor),
+		DbtrAcct: pacs09Account(transfer.Debtor.Account),
+		DbtrAgt:  pacs09OptionalAgent(transfer.Debtor.Agent),
+		CdtrAgt:  pacs09OptionalAgent(transfer.Creditor.Agent),
+		Cdtr:     pacs09Institution(transfer.Creditor),
+		CdtrAcct: pacs09Account(transfer.Creditor.Account),
+	}
+	if ustrd := remittance(transfer.RemittanceInformation); len(ustrd) > 0 {
+		tx.RmtInf = &pacs_v09.RemittanceInformation2{Ustrd: ustrd}
+	}
+	return b.AddTransaction(tx)
+}
+
+// AddTransaction adds a transaction built by hand, its EndToEndId and UETR are generated when they are empty
+func (b *FinancialInstitutionCreditTransferBuilder) AddTransaction(tx pacs_v09.CreditTransferTransaction44) *FinancialInstitutionCreditTransferBuilder {
+	if tx.PmtId.EndToEndId == "" {
+		tx.PmtId.EndToEndId = common.Max35Text(b.opts.endToEndId())
+	}
+	if tx.PmtId.UETR == nil {
+		uetr := common.UUIDv4Identifier(b.opts.uetr())
+		tx.PmtId.UETR = &uetr
+	}
+	b.transactions = append(b.transactions, tx)
+	return b
+}
+
+// Build returns the validated document.
+// NbOfTxs and CtrlSum are computed from the transactions, TtlIntrBkSttlmAmt when all of them are in the same currency.
+func (b *FinancialInstitutionCreditTransferBuilder) Build() (document.Iso20022Document, error) {
+	if len(b.transactions) == 0 {
+		return nil, utils.NewErrOmittedTransactions()
+	}
+
+	now := b.opts.clock()
+	messageId := b.messageId
+	if messageId == "" {
+		messageId = b.opts.messageId()
+	}
+	settlementDate := b.settlementDate
+	if settlementDate.IsZero() {
+		settlementDate = now
+	}
+
+	var sum totals
+	for _, tx := range b.transactions {
+		sum.add(common.Decimal(tx.IntrBkSttlmAmt.Value), string(tx.IntrBkSttlmAmt.Ccy))
+	}
+
+	date := common.ISODate(settlementDate)
+	message := &pacs_v09.FinancialInstitutionCreditTransferV09{
+		GrpHdr: pacs_v09.GroupHeader93{
+			MsgId:         common.Max35Text(messageId),
+			CreDtTm:       common.ISODateTime(now),
+			NbOfTxs:       sum.numberOfTransactions(),
+			CtrlSum:       decimalNumber(sum.sum),
+			IntrBkSttlmDt: &date,
+			SttlmInf:      pacs_v09.SettlementInstruction7{SttlmMtd: pacs_v09.SettlementMethod1Code(b.settlementMethod)},
+			InstgAgt:      pacs09OptionalAgent(b.instructingAgent),
+			InstdAgt:      pacs09OptionalAgent(b.instructedAgent),
+		},
+		CdtTrfTxInf: append([]pacs_v09.CreditTransferTransaction44{}, b.transactions...),
+	}
+	if !sum.mixed {
+		message.GrpHdr.TtlIntrBkSttlmAmt = &pacs_v09.ActiveCurrencyAndAmount{
+			Value: common.ActiveCurrencyAndAmountSimpleType(sum.sum),
+			Ccy:   common.ActiveCurrencyCode(sum.currency),
+		}
+	}
+
+	return newDocument(utils.DocumentPacs00900109NameSpace, message)
+}
+
+func pacs09Account(account string) *pacs_v09.CashAccount38 {
+	if account == "" {
+		return nil
+	}
+	if isIBAN(account) {
+		iban := common.IBAN2007Identifier(account)
+		return &pacs_v09.CashAccount38{Id: pacs_v09.AccountIdentification4Choice{IBAN: &iban}}
+	}
+	return &pacs_v09.CashAccount38{Id: pacs_v09.AccountIdentification4Choice{
+		Othr: &pacs_v09.GenericAccountIdentification1{Id: common.Max34Text(account)},
+	}}
+}
+
+func pacs09Agent(bic string) pacs_v09.BranchAndFinancialInstitutionIdentification6 {
+	return pacs_v09.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: pacs_v09.FinancialInstitutionIdentification18{BICFI: common.OptionalBICFIDec2014Identifier(bic)},
+	}
+}
+
+func pacs09Institution(party Party) pacs_v09.BranchAndFinancialInstitutionIdentification6 {
+	return pacs_v09.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: pacs_v09.FinancialInstitutionIdentification18{
+			BICFI: common.OptionalBICFIDec2014Identifier(party.BIC),
+			Nm:    common.OptionalMax140Text(party.Name),
+		},
+	}
+}
+
+func pacs09OptionalAgent(bic string) *pacs_v09.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return nil
+	}
+	agent := pacs09Agent(bic)
+	return &agent
+}
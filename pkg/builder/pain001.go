@@ -0,0 +1,202 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pain_v10"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// CustomerCreditTransferInitiationBuilder builds pain.001.001.10 messages.
+// Transfers of the same debtor are grouped into one payment information block (PmtInf).
+type CustomerCreditTransferInitiationBuilder struct {
+	opts            options
+	messageId       string
+	initiatingParty string
+	executionDate   time.Time
+	debtors         []Party
+	transactions    [][]pain_v10.CreditTransferTransaction40
+}
+
+// NewCustomerCreditTransferInitiation returns a builder of pain.001.001.10 messages
+func NewCustomerCreditTransferInitiation(opts ...Option) *CustomerCreditTransferInitiationBuilder {
+	return &CustomerCreditTransferInitiationBuilder{
+		opts: newOptions(opts),
+	}
+}
+
+// MessageId sets MsgId instead of generating it
+func (b *CustomerCreditTransferInitiationBuilder) MessageId(id string) *CustomerCreditTransferInitiationBuilder {
+	b.messageId = id
+	return b
+}
+
+// InitiatingParty sets the name of the party initiating the payments
+func (b *CustomerCreditTransferInitiationBuilder) InitiatingParty(name string) *CustomerCreditTransferInitiationBuilder {
+	b.initiatingParty = name
+	return b
+}
+
+// ExecutionDate sets the requested execution date, the creation date is used by default
+func (b *CustomerCreditTransferInitiationBuilder) ExecutionDate(date time.Time) *CustomerCreditTransferInitiationBuilder {
+	b.executionDate = date
+	return b
+}
+
+// AddTransfer adds a credit transfer to the payment information block of its debtor
+func (b *CustomerCreditTransferInitiationBuilder) AddTransfer(transfer Transfer) *CustomerCreditTransferInitiationBuilder {
+	transfer = b.opts.identify(transfer)
+
+	uetr := common.UUIDv4Identifier(transfer.UETR)
+	tx := pain_v10.CreditTransferTransaction40{
+		PmtId: pain_v10.PaymentIdentification6{
+			InstrId:    common.OptionalMax35Text(transfer.InstructionId),
+			EndToEndId: common.Max35Text(transfer.EndToEndId),
+			UETR:       &uetr,
+		},
+		Amt: pain_v10.AmountType4Choice{
+			InstdAmt: &pain_v10.ActiveOrHistoricCurrencyAndAmount{
+				Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(transfer.Amount),
+				Ccy:   common.ActiveOrHistoricCurrencyCode(transfer.Currency),
+			},
+		},
+		CdtrAgt:  pain10OptionalAgent(transfer.Creditor.Agent),
+		CdtrAcct: pain10OptionalAccount(transfer.Creditor.Account),
+	}
+	if transfer.ChargeBearer != "" {
+		chargeBearer := pain_v10.ChargeBearerType1Code(transfer.ChargeBearer)
+		tx.ChrgBr = &chargeBearer
+	}
+	if transfer.Creditor.Name != "" {
+		tx.Cdtr = &pain_v10.PartyIdentification135{Nm: common.OptionalMax140Text(transfer.Creditor.Name)}
+	}
+	if ustrd := remittance(transfer.RemittanceInformation); len(ustrd) > 0 {
+		tx.RmtInf = &pain_v10.RemittanceInformation16{Ustrd: ustrd}
+	}
+	return b.AddTransaction(transfer.Debtor, tx)
+}
+
+// AddTransaction adds a transaction built by hand to the payment information block of debtor,
+// its EndToEndId and UETR are generated when they are empty
+func (b *CustomerCreditTransferInitiationBuilder) AddTransaction(debtor Party, tx pain_v10.CreditTransferTransaction40) *CustomerCreditTransferInitiationBuilder {
+	if tx.PmtId.EndToEndId == "" {
+		tx.PmtId.EndToEndId = common.Max35Text(b.opts.endToEndId())
+	}
+	if tx.PmtId.UETR == nil {
+		uetr := common.UUIDv4Identifier(b.opts.uetr())
+		tx.PmtId.UETR = &uetr
+	}
+
+	debtor.BIC = ""
+	for i := range b.debtors {
+		if b.debtors[i] == debtor {
+			b.transactions[i] = append(b.transactions[i], tx)
+			return b
+		}
+	}
+	b.debtors = append(b.debtors, debtor)
+	b.transactions = append(b.transactions, []pain_v10.CreditTransferTransaction40{tx})
+	return b
+}
+
+// Build returns the validated document.
+// NbOfTxs and CtrlSum of the group header and of every payment information block are computed from the transactions,
+// PmtInfId is generated like MsgId.
+func (b *CustomerCreditTransferInitiationBuilder) Build() (document.Iso20022Document, error) {
+	if len(b.debtors) == 0 {
+		return nil, utils.NewErrOmittedTransactions()
+	}
+
+	now := b.opts.clock()
+	messageId := b.messageId
+	if messageId == "" {
+		messageId = b.opts.messageId()
+	}
+	executionDate := b.executionDate
+	if executionDate.IsZero() {
+		executionDate = now
+	}
+	date := common.ISODate(executionDate)
+
+	var sum totals
+	message := &pain_v10.CustomerCreditTransferInitiationV10{}
+	for i, debtor := range b.debtors {
+		var instruction totals
+		for _, tx := range b.transactions[i] {
+			amount := instructedAmount(tx)
+			instruction.add(common.Decimal(amount.Value), string(amount.Ccy))
+			sum.add(common.Decimal(amount.Value), string(amount.Ccy))
+		}
+
+		numberOfTransactions := instruction.numberOfTransactions()
+		message.PmtInf = append(message.PmtInf, pain_v10.PaymentInstruction34{
+			PmtInfId:    common.Max35Text(b.opts.messageId()),
+			PmtMtd:      "TRF",
+			NbOfTxs:     &numberOfTransactions,
+			CtrlSum:     decimalNumber(instruction.sum),
+			ReqdExctnDt: pain_v10.DateAndDateTime2Choice{Dt: &date},
+			Dbtr:        pain_v10.PartyIdentification135{Nm: common.OptionalMax140Text(debtor.Name)},
+			DbtrAcct:    pain10Account(debtor.Account),
+			DbtrAgt:     pain10Agent(debtor.Agent),
+			CdtTrfTxInf: append([]pain_v10.CreditTransferTransaction40{}, b.transactions[i]...),
+		})
+	}
+	message.GrpHdr = pain_v10.GroupHeader95{
+		MsgId:    common.Max35Text(messageId),
+		CreDtTm:  common.ISODateTime(now),
+		NbOfTxs:  sum.numberOfTransactions(),
+		CtrlSum:  decimalNumber(sum.sum),
+		InitgPty: pain_v10.PartyIdentification135{Nm: common.OptionalMax140Text(b.initiatingParty)},
+	}
+
+	return newDocument(utils.DocumentPain00100110NameSpace, message)
+}
+
+// instructedAmount returns the amount of the transaction, the equivalent amount when it's given instead
+func instructedAmount(tx pain_v10.CreditTransferTransaction40) pain_v10.ActiveOrHistoricCurrencyAndAmount {
+	switch {
+	case tx.Amt.InstdAmt != nil:
+		return *tx.Amt.InstdAmt
+	case tx.Amt.EqvtAmt != nil:
+		return tx.Amt.EqvtAmt.Amt
+	}
+	return pain_v10.ActiveOrHistoricCurrencyAndAmount{}
+}
+
+func pain10Account(account string) pain_v10.CashAccount38 {
+	if isIBAN(account) {
+		iban := common.IBAN2007Identifier(account)
+		return pain_v10.CashAccount38{Id: pain_v10.AccountIdentification4Choice{IBAN: &iban}}
+	}
+	return pain_v10.CashAccount38{Id: pain_v10.AccountIdentification4Choice{
+		Othr: &pain_v10.GenericAccountIdentification1{Id: common.Max34Text(account)},
+	}}
+}
+
+func pain10OptionalAccount(account string) *pain_v10.CashAccount38 {
+	if account == "" {
+		return nil
+	}
+	cashAccount := pain10Account(account)
+	return &cashAccount
+}
+
+func pain10Agent(bic string) pain_v10.BranchAndFinancialInstitutionIdentification6 {
+	return pain_v10.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: pain_v10.FinancialInstitutionIdentification18{BICFI: common.OptionalBICFIDec2014Identifier(bic)},
+	}
+}
+
+func pain10OptionalAgent(bic string) *pain_v10.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return nil
+	}
+	agent := pain10Agent(bic)
+	return &agent
+}
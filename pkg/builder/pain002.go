@@ -0,0 +1,100 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pain_v11"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// buildPain002 answers a pain.001 or pain.008 with pain.002.001.11, the decided payment information blocks and
+// transactions are reported with the group
+func (b *StatusReportBuilder) buildPain002(messageId string, report statusReport) (document.Iso20022Document, error) {
+	original := report.original
+	groupStatus := pain_v11.ExternalPaymentGroupStatus1Code(report.group.status())
+	message := &pain_v11.CustomerPaymentStatusReportV11{
+		GrpHdr: pain_v11.GroupHeader86{
+			MsgId:   common.Max35Text(messageId),
+			CreDtTm: common.ISODateTime(b.opts.clock()),
+			DbtrAgt: pain11OptionalAgent(b.instructingAgent),
+		},
+		OrgnlGrpInfAndSts: pain_v11.OriginalGroupHeader17{
+			OrgnlMsgId:    common.Max35Text(original.messageId),
+			OrgnlMsgNmId:  common.Max35Text(original.identifier),
+			OrgnlNbOfTxs:  common.OptionalMax15NumericText(original.nbOfTxs),
+			OrgnlCtrlSum:  original.ctrlSum,
+			GrpSts:        &groupStatus,
+			StsRsnInf:     pain11Reason(report.reason),
+			NbOfTxsPerSts: pain11Counts(report.group),
+		},
+	}
+	if original.created != nil {
+		created := common.ISODateTime(*original.created)
+		message.OrgnlGrpInfAndSts.OrgnlCreDtTm = &created
+	}
+
+	for _, instruction := range report.instructions {
+		if !instruction.reported {
+			continue
+		}
+		status := pain_v11.ExternalPaymentGroupStatus1Code(instruction.count.status())
+		block := pain_v11.OriginalPaymentInstruction38{
+			OrgnlPmtInfId: common.Max35Text(instruction.original.id),
+			OrgnlNbOfTxs:  common.OptionalMax15NumericText(instruction.original.nbOfTxs),
+			OrgnlCtrlSum:  instruction.original.ctrlSum,
+			PmtInfSts:     &status,
+			StsRsnInf:     pain11Reason(instruction.reason),
+			NbOfTxsPerSts: pain11Counts(instruction.count),
+		}
+		for _, tx := range instruction.transactions {
+			txStatus := pain_v11.ExternalPaymentTransactionStatus1Code(tx.status.Code)
+			block.TxInfAndSts = append(block.TxInfAndSts, pain_v11.PaymentTransaction126{
+				OrgnlInstrId:    common.OptionalMax35Text(tx.original.instructionId),
+				OrgnlEndToEndId: common.OptionalMax35Text(tx.original.endToEndId),
+				OrgnlUETR:       common.OptionalUUIDv4Identifier(tx.original.uetr),
+				TxSts:           &txStatus,
+				StsRsnInf:       pain11Reason(&tx.status),
+			})
+		}
+		message.OrgnlPmtInfAndSts = append(message.OrgnlPmtInfAndSts, block)
+	}
+
+	return newDocument(utils.DocumentPain00200111NameSpace, message)
+}
+
+func pain11Reason(status *Status) []pain_v11.StatusReasonInformation12 {
+	if status == nil || (status.Reason == "" && len(status.AdditionalInformation) == 0) {
+		return nil
+	}
+	info := pain_v11.StatusReasonInformation12{AddtlInf: additionalInformation(status.AdditionalInformation)}
+	if status.Reason != "" {
+		reason := pain_v11.ExternalStatusReason1Code(status.Reason)
+		info.Rsn = &pain_v11.StatusReason6Choice{Cd: &reason}
+	}
+	return []pain_v11.StatusReasonInformation12{info}
+}
+
+func pain11Counts(count statusCount) []pain_v11.NumberOfTransactionsPerStatus5 {
+	var counts []pain_v11.NumberOfTransactionsPerStatus5
+	for _, status := range count.statuses {
+		counts = append(counts, pain_v11.NumberOfTransactionsPerStatus5{
+			DtldNbOfTxs: count.numberOfTransactions(status),
+			DtldSts:     pain_v11.ExternalPaymentTransactionStatus1Code(status),
+			DtldCtrlSum: decimalNumber(count.sums[status]),
+		})
+	}
+	return counts
+}
+
+func pain11OptionalAgent(bic string) *pain_v11.BranchAndFinancialInstitutionIdentification6 {
+	if bic == "" {
+		return nil
+	}
+	return &pain_v11.BranchAndFinancialInstitutionIdentification6{
+		FinInstnId: pain_v11.FinancialInstitutionIdentification18{BICFI: common.OptionalBICFIDec2014Identifier(bic)},
+	}
+}
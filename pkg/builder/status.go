@@ -0,0 +1,454 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Payment status codes used by the status reports
+const (
+	StatusAccepted          = "ACCP"
+	StatusRejected          = "RJCT"
+	StatusPartiallyAccepted = "PART"
+)
+
+// Status is the decision taken on the whole message, a payment information block or a transaction
+type Status struct {
+	// Code is the payment status, e.g. ACCP, ACSC, ACTC, PDNG or RJCT
+	Code string
+	// Reason is the external status reason code, e.g. AC01 or AM04
+	Reason string
+	// AdditionalInformation are lines of up to 105 characters explaining the status
+	AdditionalInformation []string
+}
+
+// TransactionReference selects a transaction of the original message by the first of its identifications which
+// is set: TxId, UETR, Index or EndToEndId. An identification shared by several transactions selects none of them.
+type TransactionReference struct {
+	TxId string
+	UETR string
+	// Index is the position of the transaction in the original message counted from 1, across its payment
+	// information blocks
+	Index      int
+	EndToEndId string
+}
+
+// StatusReportBuilder builds the status report answering a payment message of any version:
+// pain.002.001.11 for pain.001 and pain.008, pacs.002.001.11 for pacs.003, pacs.008 and pacs.009.
+//
+// A transaction takes its own status, else the status of its payment information block, else the status of
+// the group. Transactions without any decision are accepted (ACCP). The status of the group and of the
+// payment information blocks is derived from their transactions: their common status, or PART when they differ.
+type StatusReportBuilder struct {
+	opts             options
+	original         document.Iso20022Document
+	messageId        string
+	instructingAgent string
+	instructedAgent  string
+	group            *Status
+	instructions     map[string]Status
+	transactions     []transactionDecision
+}
+
+type transactionDecision struct {
+	reference TransactionReference
+	status    Status
+}
+
+// NewStatusReport returns a builder of the status report answering original
+func NewStatusReport(original document.Iso20022Document, opts ...Option) *StatusReportBuilder {
+	return &StatusReportBuilder{
+		opts:         newOptions(opts),
+		original:     original,
+		instructions: map[string]Status{},
+	}
+}
+
+// MessageId sets MsgId instead of generating it
+func (b *StatusReportBuilder) MessageId(id string) *StatusReportBuilder {
+	b.messageId = id
+	return b
+}
+
+// InstructingAgent sets the BIC of the agent sending the report, InstgAgt of pacs.002 and DbtrAgt of pain.002
+func (b *StatusReportBuilder) InstructingAgent(bic string) *StatusReportBuilder {
+	b.instructingAgent = bic
+	return b
+}
+
+// InstructedAgent sets the BIC of the agent receiving a pacs.002
+func (b *StatusReportBuilder) InstructedAgent(bic string) *StatusReportBuilder {
+	b.instructedAgent = bic
+	return b
+}
+
+// GroupStatus sets the status of the whole message, its reason is reported with the group status
+func (b *StatusReportBuilder) GroupStatus(status Status) *StatusReportBuilder {
+	b.group = &status
+	return b
+}
+
+// InstructionStatus sets the status of the payment information block PmtInfId of a pain message
+func (b *StatusReportBuilder) InstructionStatus(paymentInformationId string, status Status) *StatusReportBuilder {
+	b.instructions[paymentInformationId] = status
+	return b
+}
+
+// TransactionStatus sets the status of the transaction with the EndToEndId, it is reported on its own. The
+// EndToEndId must be unique in the original message, TransactionStatusOf selects a transaction otherwise.
+func (b *StatusReportBuilder) TransactionStatus(endToEndId string, status Status) *StatusReportBuilder {
+	return b.TransactionStatusOf(TransactionReference{EndToEndId: endToEndId}, status)
+}
+
+// TransactionStatusOf sets the status of the transaction selected by reference, it is reported on its own
+func (b *StatusReportBuilder) TransactionStatusOf(reference TransactionReference, status Status) *StatusReportBuilder {
+	b.transactions = append(b.transactions, transactionDecision{reference: reference, status: status})
+	return b
+}
+
+// Build returns the validated status report
+func (b *StatusReportBuilder) Build() (document.Iso20022Document, error) {
+	if b.original == nil {
+		return nil, utils.NewErrOmittedNameSpace()
+	}
+	original, err := readOriginal(b.original, "status report", "pain.001", "pain.008", "pacs.003", "pacs.008", "pacs.009")
+	if err != nil {
+		return nil, err
+	}
+	report, err := b.decide(original)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId := b.messageId
+	if messageId == "" {
+		messageId = b.opts.messageId()
+	}
+	if original.area == "pain" {
+		return b.buildPain002(messageId, report)
+	}
+	return b.buildPacs002(messageId, report)
+}
+
+// originalMessage holds the references of the answered message, a pacs message has a single instruction
+// without identification
+type originalMessage struct {
+	area             string
+	identifier       string
+	messageId        string
+	created          *time.Time
+	nbOfTxs          string
+	ctrlSum          *common.DecimalNumber
+	settlementMethod string
+	settlement       reflect.Value
+	instructingAgent string
+	instructedAgent  string
+	instructions     []originalInstruction
+}
+
+type originalInstruction struct {
+	id           string
+	nbOfTxs      string
+	ctrlSum      *common.DecimalNumber
+	transactions []originalTransaction
+}
+
+type originalTransaction struct {
+	// position is the index of the transaction in the original message, across its instructions
+	position       int
+	instructionId  string
+	endToEndId     string
+	txId           string
+	uetr           string
+	amount         common.Decimal
+	currency       string
+	settlementDate *time.Time
+	value          reflect.Value
+}
+
+// readOriginal collects the references of the original message, one of messages like pacs.008, which is
+// answered by the message named answer
+func readOriginal(doc document.Iso20022Document, answer string, messages ...string) (originalMessage, error) {
+	info, found := document.LookupMessage(doc.NameSpace())
+	if !found {
+		return originalMessage{}, utils.NewErrUnsupportedNameSpace()
+	}
+	original := originalMessage{area: info.BusinessArea, identifier: info.Identifier()}
+	supported := false
+	for _, message := range messages {
+		supported = supported || message == info.BusinessArea+"."+info.MessageId
+	}
+	if !supported {
+		return originalMessage{}, utils.NewErrIncompatibleMessages(original.identifier, answer)
+	}
+
+	message := reflect.Indirect(reflect.ValueOf(doc.InspectMessage()))
+	grpHdr := message.FieldByName("GrpHdr")
+	original.messageId = textValue(grpHdr, "MsgId")
+	original.created = timeValue(grpHdr, "CreDtTm")
+	original.nbOfTxs = textValue(grpHdr, "NbOfTxs")
+	original.ctrlSum = decimalNumberValue(grpHdr, "CtrlSum")
+	original.settlementMethod = textValue(grpHdr, "SttlmInf", "SttlmMtd")
+	original.settlement = fieldValue(grpHdr, "SttlmInf")
+	original.instructingAgent = textValue(grpHdr, "InstgAgt", "FinInstnId", "BICFI")
+	original.instructedAgent = textValue(grpHdr, "InstdAgt", "FinInstnId", "BICFI")
+	settlementDate := timeValue(grpHdr, "IntrBkSttlmDt")
+
+	if original.area == "pain" {
+		pmtInf := message.FieldByName("PmtInf")
+		for i := 0; i < pmtInf.Len(); i++ {
+			block := pmtInf.Index(i)
+			original.instructions = append(original.instructions, originalInstruction{
+				id:           textValue(block, "PmtInfId"),
+				nbOfTxs:      textValue(block, "NbOfTxs"),
+				ctrlSum:      decimalNumberValue(block, "CtrlSum"),
+				transactions: readTransactions(block, nil, original.count()),
+			})
+		}
+	} else {
+		original.instructions = []originalInstruction{{transactions: readTransactions(message, settlementDate, 0)}}
+	}
+	return original, nil
+}
+
+// readTransactions reads the transactions of a block, position is the one of its first transaction
+func readTransactions(block reflect.Value, settlementDate *time.Time, position int) []originalTransaction {
+	var transactions []originalTransaction
+	for _, name := range []string{"CdtTrfTxInf", "DrctDbtTxInf"} {
+		txs := block.FieldByName(name)
+		if txs.Kind() != reflect.Slice {
+			continue
+		}
+		for i := 0; i < txs.Len(); i++ {
+			tx := txs.Index(i)
+			pmtId := fieldValue(tx, "PmtId")
+			transaction := originalTransaction{
+				position:       position + len(transactions),
+				instructionId:  textValue(pmtId, "InstrId"),
+				endToEndId:     textValue(pmtId, "EndToEndId"),
+				txId:           textValue(pmtId, "TxId"),
+				uetr:           textValue(pmtId, "UETR"),
+				settlementDate: timeValue(tx, "IntrBkSttlmDt"),
+				value:          tx,
+			}
+			if transaction.settlementDate == nil {
+				transaction.settlementDate = settlementDate
+			}
+			transaction.amount, transaction.currency = originalAmount(tx)
+			transactions = append(transactions, transaction)
+		}
+	}
+	return transactions
+}
+
+// count returns the number of transactions of the original message
+func (o originalMessage) count() int {
+	count := 0
+	for _, instruction := range o.instructions {
+		count += len(instruction.transactions)
+	}
+	return count
+}
+
+// find returns the position of the transaction selected by reference
+func (o originalMessage) find(reference TransactionReference) (int, error) {
+	kind, id, matches := "end to end id", reference.EndToEndId, func(tx originalTransaction) bool {
+		return tx.endToEndId == reference.EndToEndId
+	}
+	switch {
+	case reference.TxId != "":
+		kind, id, matches = "transaction id", reference.TxId, func(tx originalTransaction) bool {
+			return tx.txId == reference.TxId
+		}
+	case reference.UETR != "":
+		kind, id, matches = "UETR", reference.UETR, func(tx originalTransaction) bool {
+			return tx.uetr == reference.UETR
+		}
+	case reference.Index != 0:
+		if reference.Index < 1 || reference.Index > o.count() {
+			return 0, utils.NewErrUnknownOriginal("transaction index", strconv.Itoa(reference.Index))
+		}
+		return reference.Index - 1, nil
+	}
+
+	position := -1
+	for _, instruction := range o.instructions {
+		for _, tx := range instruction.transactions {
+			if !matches(tx) {
+				continue
+			}
+			if position >= 0 {
+				return 0, utils.NewErrAmbiguousOriginal(kind, id)
+			}
+			position = tx.position
+		}
+	}
+	if position < 0 {
+		return 0, utils.NewErrUnknownOriginal(kind, id)
+	}
+	return position, nil
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	decimalType       = reflect.TypeOf(common.Decimal{})
+	decimalNumberType = reflect.TypeOf(common.DecimalNumber{})
+)
+
+// fieldValue follows the field names through optional elements, the result is invalid when one is omitted
+func fieldValue(value reflect.Value, names ...string) reflect.Value {
+	for _, name := range names {
+		if value = reflect.Indirect(value); value.Kind() != reflect.Struct {
+			return reflect.Value{}
+		}
+		value = value.FieldByName(name)
+	}
+	return reflect.Indirect(value)
+}
+
+func textValue(value reflect.Value, names ...string) string {
+	if value = fieldValue(value, names...); value.Kind() == reflect.String {
+		return value.String()
+	}
+	return ""
+}
+
+func decimalNumberValue(value reflect.Value, names ...string) *common.DecimalNumber {
+	if value = fieldValue(value, names...); value.IsValid() && value.Type() == decimalNumberType {
+		number := value.Interface().(common.DecimalNumber)
+		return &number
+	}
+	return nil
+}
+
+func timeValue(value reflect.Value, names ...string) *time.Time {
+	if value = fieldValue(value, names...); !value.IsValid() || !value.Type().ConvertibleTo(timeType) {
+		return nil
+	}
+	t := value.Convert(timeType).Interface().(time.Time)
+	return &t
+}
+
+// originalAmount returns the interbank settlement amount or the instructed amount of a transaction
+func originalAmount(tx reflect.Value) (common.Decimal, string) {
+	for _, path := range [][]string{{"IntrBkSttlmAmt"}, {"Amt", "InstdAmt"}, {"Amt", "EqvtAmt", "Amt"}, {"InstdAmt"}} {
+		if value := fieldValue(tx, append(path, "Value")...); value.IsValid() && value.Type().ConvertibleTo(decimalType) {
+			return value.Convert(decimalType).Interface().(common.Decimal), textValue(tx, append(path, "Ccy")...)
+		}
+	}
+	return common.Decimal{}, ""
+}
+
+// statusReport is the decision on every transaction of the original message
+type statusReport struct {
+	original     originalMessage
+	group        statusCount
+	reason       *Status
+	instructions []instructionReport
+}
+
+type instructionReport struct {
+	original     originalInstruction
+	count        statusCount
+	reason       *Status
+	reported     bool
+	transactions []transactionReport
+}
+
+type transactionReport struct {
+	original originalTransaction
+	status   Status
+}
+
+// statusCount counts the transactions per status in the order the statuses occur
+type statusCount struct {
+	statuses []string
+	counts   map[string]int
+	sums     map[string]common.Decimal
+}
+
+func (c *statusCount) add(status string, amount common.Decimal) {
+	if c.counts == nil {
+		c.counts, c.sums = map[string]int{}, map[string]common.Decimal{}
+	}
+	if _, found := c.counts[status]; !found {
+		c.statuses = append(c.statuses, status)
+	}
+	c.counts[status]++
+	c.sums[status] = c.sums[status].Add(amount)
+}
+
+// status is the common status of the transactions, or PART when they differ
+func (c statusCount) status() string {
+	switch len(c.statuses) {
+	case 0:
+		return StatusAccepted
+	case 1:
+		return c.statuses[0]
+	}
+	return StatusPartiallyAccepted
+}
+
+func (c statusCount) numberOfTransactions(status string) common.Max15NumericText {
+	return common.Max15NumericText(strconv.Itoa(c.counts[status]))
+}
+
+func (b *StatusReportBuilder) decide(original originalMessage) (statusReport, error) {
+	report := statusReport{original: original, reason: b.group}
+
+	transactions := map[int]Status{}
+	for _, decision := range b.transactions {
+		position, err := original.find(decision.reference)
+		if err != nil {
+			return statusReport{}, err
+		}
+		transactions[position] = decision.status
+	}
+
+	instructions := map[string]bool{}
+	for _, instruction := range original.instructions {
+		instructionStatus, instructionDecided := b.instructions[instruction.id]
+		if original.area != "pain" {
+			instructionDecided = false
+		}
+		instructions[instruction.id] = instructionDecided
+
+		result := instructionReport{original: instruction, reported: instructionDecided}
+		if instructionDecided {
+			result.reason = &instructionStatus
+		}
+		for _, tx := range instruction.transactions {
+			status, decided := transactions[tx.position]
+			switch {
+			case decided:
+				result.transactions = append(result.transactions, transactionReport{original: tx, status: status})
+				result.reported = true
+			case instructionDecided:
+				status = instructionStatus
+			case b.group != nil:
+				status = *b.group
+			default:
+				status = Status{Code: StatusAccepted}
+			}
+			result.count.add(status.Code, tx.amount)
+			report.group.add(status.Code, tx.amount)
+		}
+		report.instructions = append(report.instructions, result)
+	}
+
+	for id := range b.instructions {
+		if !instructions[id] {
+			return statusReport{}, utils.NewErrUnknownOriginal("payment information id", id)
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package builder
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v11"
+	"github.com/moov-io/iso20022/pkg/pain_v11"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func testInitiation(t *testing.T) document.Iso20022Document {
+	first := Party{Name: "Debtor One", Account: "DE89370400440532013000", Agent: "BANKDEFFXXX"}
+	second := Party{Name: "Debtor Two", Account: "GB29NWBK60161331926819", Agent: "NWBKGB2LXXX"}
+	doc, err := NewCustomerCreditTransferInitiation(testOptions()...).
+		AddTransfer(Transfer{Amount: common.MustParseDecimal("100.10"), Currency: "EUR", Debtor: first, Creditor: Party{Name: "A"}}).
+		AddTransfer(Transfer{Amount: common.MustParseDecimal("20"), Currency: "EUR", Debtor: second, Creditor: Party{Name: "B"}}).
+		AddTransfer(Transfer{Amount: common.MustParseDecimal("0.90"), Currency: "EUR", Debtor: first, Creditor: Party{Name: "C"}}).
+		Build()
+	assert.Nil(t, err)
+	return doc
+}
+
+func TestPaymentStatusReport(t *testing.T) {
+	original := testInitiation(t)
+
+	// one rejected transaction makes the group partially accepted
+	doc, err := NewStatusReport(original, testOptions()...).
+		MessageId("STS-1").
+		InstructingAgent("BANKDEFFXXX").
+		TransactionStatus("E2E-000003", Status{Code: StatusRejected, Reason: "AM04", AdditionalInformation: []string{"Insufficient funds"}}).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPain00200111NameSpace, doc.NameSpace())
+
+	message := doc.InspectMessage().(*pain_v11.CustomerPaymentStatusReportV11)
+	assert.Equal(t, "STS-1", string(message.GrpHdr.MsgId))
+	assert.Equal(t, "BANKDEFFXXX", string(*message.GrpHdr.DbtrAgt.FinInstnId.BICFI))
+	group := message.OrgnlGrpInfAndSts
+	assert.Equal(t, "MSG-000001", string(group.OrgnlMsgId))
+	assert.Equal(t, "pain.001.001.10", string(group.OrgnlMsgNmId))
+	assert.Equal(t, testTime, time.Time(*group.OrgnlCreDtTm))
+	assert.Equal(t, "3", string(*group.OrgnlNbOfTxs))
+	assert.Equal(t, "121.00", group.OrgnlCtrlSum.String())
+	assert.Equal(t, "PART", string(*group.GrpSts))
+	assert.Nil(t, group.StsRsnInf)
+	assert.Equal(t, []pain_v11.NumberOfTransactionsPerStatus5{
+		{DtldNbOfTxs: "2", DtldSts: "ACCP", DtldCtrlSum: decimalNumber(common.MustParseDecimal("120.10"))},
+		{DtldNbOfTxs: "1", DtldSts: "RJCT", DtldCtrlSum: decimalNumber(common.MustParseDecimal("0.90"))},
+	}, group.NbOfTxsPerSts)
+
+	// only the payment information block of the decided transaction is reported
+	assert.Len(t, message.OrgnlPmtInfAndSts, 1)
+	block := message.OrgnlPmtInfAndSts[0]
+	assert.Equal(t, "MSG-000002", string(block.OrgnlPmtInfId))
+	assert.Equal(t, "2", string(*block.OrgnlNbOfTxs))
+	assert.Equal(t, "PART", string(*block.PmtInfSts))
+	assert.Len(t, block.TxInfAndSts, 1)
+	tx := block.TxInfAndSts[0]
+	assert.Equal(t, "E2E-000003", string(*tx.OrgnlEndToEndId))
+	assert.Equal(t, "8a562c67-ca16-48ba-b074-65581be6f001", string(*tx.OrgnlUETR))
+	assert.Equal(t, "RJCT", string(*tx.TxSts))
+	assert.Equal(t, "AM04", string(*tx.StsRsnInf[0].Rsn.Cd))
+	assert.Equal(t, []common.Max105Text{"Insufficient funds"}, tx.StsRsnInf[0].AddtlInf)
+	assertRoundTrip(t, doc)
+
+	// a rejected block rejects its transactions
+	doc, err = NewStatusReport(original, testOptions()...).
+		InstructionStatus("MSG-000003", Status{Code: StatusRejected, Reason: "AC04"}).
+		Build()
+	assert.Nil(t, err)
+	message = doc.InspectMessage().(*pain_v11.CustomerPaymentStatusReportV11)
+	assert.Equal(t, "PART", string(*message.OrgnlGrpInfAndSts.GrpSts))
+	assert.Len(t, message.OrgnlPmtInfAndSts, 1)
+	block = message.OrgnlPmtInfAndSts[0]
+	assert.Equal(t, "MSG-000003", string(block.OrgnlPmtInfId))
+	assert.Equal(t, "RJCT", string(*block.PmtInfSts))
+	assert.Equal(t, "AC04", string(*block.StsRsnInf[0].Rsn.Cd))
+	assert.Nil(t, block.TxInfAndSts)
+
+	// a group decision applies to every transaction
+	doc, err = NewStatusReport(original, testOptions()...).
+		GroupStatus(Status{Code: StatusRejected, Reason: "FF01"}).
+		Build()
+	assert.Nil(t, err)
+	message = doc.InspectMessage().(*pain_v11.CustomerPaymentStatusReportV11)
+	assert.Equal(t, "RJCT", string(*message.OrgnlGrpInfAndSts.GrpSts))
+	assert.Equal(t, "FF01", string(*message.OrgnlGrpInfAndSts.StsRsnInf[0].Rsn.Cd))
+	assert.Equal(t, []pain_v11.NumberOfTransactionsPerStatus5{{DtldNbOfTxs: "3", DtldSts: "RJCT", DtldCtrlSum: decimalNumber(common.MustParseDecimal("121.00"))}}, message.OrgnlGrpInfAndSts.NbOfTxsPerSts)
+	assert.Nil(t, message.OrgnlPmtInfAndSts)
+
+	// without decisions everything is accepted
+	doc, err = NewStatusReport(original, testOptions()...).Build()
+	assert.Nil(t, err)
+	message = doc.InspectMessage().(*pain_v11.CustomerPaymentStatusReportV11)
+	assert.Equal(t, "ACCP", string(*message.OrgnlGrpInfAndSts.GrpSts))
+}
+
+func TestFIToFIPaymentStatusReport(t *testing.T) {
+	original, err := NewFIToFICustomerCreditTransfer(testOptions()...).
+		AddTransfer(Transfer{Amount: common.MustParseDecimal("10"), Currency: "USD", Debtor: Party{Name: "A", Agent: "BANKUS33XXX"}, Creditor: Party{Name: "B", Agent: "BANKDEFFXXX"}}).
+		AddTransfer(Transfer{InstructionId: "INSTR-2", Amount: common.MustParseDecimal("5"), Currency: "USD", Debtor: Party{Name: "A", Agent: "BANKUS33XXX"}, Creditor: Party{Name: "B", Agent: "BANKDEFFXXX"}}).
+		Build()
+	assert.Nil(t, err)
+
+	doc, err := NewStatusReport(original, testOptions()...).
+		InstructingAgent("BANKDEFFXXX").
+		InstructedAgent("BANKUS33XXX").
+		TransactionStatus("E2E-000001", Status{Code: "ACSC"}).
+		TransactionStatus("E2E-000002", Status{Code: StatusRejected, Reason: "AC01"}).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPacs00200111NameSpace, doc.NameSpace())
+
+	message := doc.InspectMessage().(*pacs_v11.FIToFIPaymentStatusReportV11)
+	assert.Equal(t, "MSG-000001", string(message.GrpHdr.MsgId))
+	assert.Equal(t, "BANKDEFFXXX", string(*message.GrpHdr.InstgAgt.FinInstnId.BICFI))
+	assert.Equal(t, "BANKUS33XXX", string(*message.GrpHdr.InstdAgt.FinInstnId.BICFI))
+	assert.Len(t, message.OrgnlGrpInfAndSts, 1)
+	group := message.OrgnlGrpInfAndSts[0]
+	assert.Equal(t, "pacs.008.001.08", string(group.OrgnlMsgNmId))
+	assert.Equal(t, "PART", string(*group.GrpSts))
+	assert.Equal(t, "2", string(*group.OrgnlNbOfTxs))
+	assert.Equal(t, "15", group.OrgnlCtrlSum.String())
+	assert.Len(t, group.NbOfTxsPerSts, 2)
+
+	assert.Len(t, message.TxInfAndSts, 2)
+	assert.Equal(t, "ACSC", string(*message.TxInfAndSts[0].TxSts))
+	assert.Nil(t, message.TxInfAndSts[0].StsRsnInf)
+	assert.Equal(t, "E2E-000002", string(*message.TxInfAndSts[1].OrgnlEndToEndId))
+	assert.Equal(t, "INSTR-2", string(*message.TxInfAndSts[1].OrgnlInstrId))
+	assert.Equal(t, "RJCT", string(*message.TxInfAndSts[1].TxSts))
+	assert.Equal(t, "AC01", string(*message.TxInfAndSts[1].StsRsnInf[0].Rsn.Cd))
+	assertRoundTrip(t, doc)
+
+	// parsed business messages are answered too
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_bah_pacs_v08.xml"))
+	assert.Nil(t, err)
+	parsed, err := document.ParseIso20022Document(input)
+	assert.Nil(t, err)
+	doc, err = NewStatusReport(parsed, testOptions()...).TransactionStatus("E2E-1", Status{Code: "ACSC"}).Build()
+	assert.Nil(t, err)
+	message = doc.InspectMessage().(*pacs_v11.FIToFIPaymentStatusReportV11)
+	assert.Equal(t, "MSG-1", string(message.OrgnlGrpInfAndSts[0].OrgnlMsgId))
+	assert.Equal(t, "ACSC", string(*message.OrgnlGrpInfAndSts[0].GrpSts))
+}
+
+func TestStatusReportErrors(t *testing.T) {
+	original := testInitiation(t)
+
+	_, err := NewStatusReport(original).TransactionStatus("UNKNOWN", Status{Code: StatusRejected}).Build()
+	assert.EqualError(t, err, "The end to end id UNKNOWN of original message is unknown")
+
+	_, err = NewStatusReport(original).InstructionStatus("UNKNOWN", Status{Code: StatusRejected}).Build()
+	assert.EqualError(t, err, "The payment information id UNKNOWN of original message is unknown")
+
+	_, err = NewStatusReport(original).TransactionStatus("E2E-000001", Status{}).Build()
+	assert.Error(t, err)
+
+	report, err := NewStatusReport(original).Build()
+	assert.Nil(t, err)
+	_, err = NewStatusReport(report).Build()
+	assert.EqualError(t, err, "The message of pain.002.001.11 can't be converted into status report")
+
+	_, err = NewStatusReport(nil).Build()
+	assert.Error(t, err)
+}
@@ -12,26 +12,26 @@ import (
 )
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64                   `xml:",chardata"`
-	Ccy   common.ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -39,21 +39,21 @@ func (r ActiveCurrencyAndAmount) Validate() error {
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Amount2Choice struct {
-	AmtWthtCcy float64                 `xml:"AmtWthtCcy"`
-	AmtWthCcy  ActiveCurrencyAndAmount `xml:"AmtWthCcy"`
+	AmtWthtCcy *common.ImpliedCurrencyAndAmount `xml:"AmtWthtCcy,omitempty" json:",omitempty"`
+	AmtWthCcy  *ActiveCurrencyAndAmount         `xml:"AmtWthCcy,omitempty" json:",omitempty"`
 }
 
 func (r Amount2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -77,12 +77,12 @@ func (r BranchData3) Validate() error {
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -106,21 +106,21 @@ func (r CreateLimitV01) Validate() error {
 }
 
 type DateAndDateTime2Choice struct {
-	Dt   common.ISODate     `xml:"Dt"`
-	DtTm common.ISODateTime `xml:"DtTm"`
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTime2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -190,21 +190,21 @@ func (r LimitStructure4) Validate() error {
 }
 
 type LimitType1Choice struct {
-	Cd    LimitType3Code   `xml:"Cd"`
-	Prtry common.Max35Text `xml:"Prtry"`
+	Cd    *LimitType3Code   `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LimitType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MarketInfrastructureIdentification1Choice struct {
-	Cd    ExternalMarketInfrastructure1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalMarketInfrastructure1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MarketInfrastructureIdentification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MessageHeader1 struct {
@@ -257,12 +257,12 @@ func (r SupplementaryDataEnvelope1) Validate() error {
 }
 
 type SystemIdentification2Choice struct {
-	MktInfrstrctrId MarketInfrastructureIdentification1Choice `xml:"MktInfrstrctrId"`
-	Ctry            common.CountryCode                        `xml:"Ctry"`
+	MktInfrstrctrId *MarketInfrastructureIdentification1Choice `xml:"MktInfrstrctrId,omitempty" json:",omitempty"`
+	Ctry            *common.CountryCode                        `xml:"Ctry,omitempty" json:",omitempty"`
 }
 
 func (r SystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CashAccount38 struct {
@@ -278,12 +278,12 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreateStandingOrderV01 struct {
@@ -308,31 +308,31 @@ func (r DatePeriod2) Validate() error {
 }
 
 type DatePeriod2Choice struct {
-	FrDt   common.ISODate `xml:"FrDt"`
-	ToDt   common.ISODate `xml:"ToDt"`
-	FrToDt DatePeriod2    `xml:"FrToDt"`
+	FrDt   *common.ISODate `xml:"FrDt,omitempty" json:",omitempty"`
+	ToDt   *common.ISODate `xml:"ToDt,omitempty" json:",omitempty"`
+	FrToDt *DatePeriod2    `xml:"FrToDt,omitempty" json:",omitempty"`
 }
 
 func (r DatePeriod2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EventType1Choice struct {
-	Cd    ExternalSystemEventType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalSystemEventType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r EventType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ExecutionType1Choice struct {
-	Tm  common.ISOTime   `xml:"Tm"`
-	Evt EventType1Choice `xml:"Evt"`
+	Tm  *common.ISOTime   `xml:"Tm,omitempty" json:",omitempty"`
+	Evt *EventType1Choice `xml:"Evt,omitempty" json:",omitempty"`
 }
 
 func (r ExecutionType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ProxyAccountIdentification1 struct {
@@ -345,12 +345,12 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StandingOrder7 struct {
@@ -413,12 +413,12 @@ func (r ReservationIdentification2) Validate() error {
 }
 
 type ReservationType1Choice struct {
-	Cd    ReservationType2Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ReservationType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReservationType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CommunicationAddress8 struct {
@@ -455,12 +455,12 @@ func (r CreateMemberV01) Validate() error {
 }
 
 type LongPostalAddress1Choice struct {
-	Ustrd common.Max140Text            `xml:"Ustrd"`
-	Strd  StructuredLongPostalAddress1 `xml:"Strd"`
+	Ustrd *common.Max140Text            `xml:"Ustrd,omitempty" json:",omitempty"`
+	Strd  *StructuredLongPostalAddress1 `xml:"Strd,omitempty" json:",omitempty"`
 }
 
 func (r LongPostalAddress1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Member6 struct {
@@ -474,13 +474,13 @@ func (r Member6) Validate() error {
 }
 
 type MemberIdentification3Choice struct {
-	BICFI       common.BICFIDec2014Identifier       `xml:"BICFI"`
-	ClrSysMmbId ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId"`
-	Othr        GenericFinancialIdentification1     `xml:"Othr"`
+	BICFI       *common.BICFIDec2014Identifier       `xml:"BICFI,omitempty" json:",omitempty"`
+	ClrSysMmbId *ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId,omitempty" json:",omitempty"`
+	Othr        *GenericFinancialIdentification1     `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r MemberIdentification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StructuredLongPostalAddress1 struct {
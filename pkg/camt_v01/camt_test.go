@@ -13,12 +13,14 @@ import (
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type2.Validate())
 	type2 = "test"
+	assert.NotNil(t, type2.Validate())
+	type2 = "USABA"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalFinancialInstitutionIdentification1Code
@@ -40,7 +42,7 @@ func TestTypes(t *testing.T) {
 
 	var type6 ExternalCashAccountType1Code
 	assert.NotNil(t, type6.Validate())
-	type6 = "test"
+	type6 = "CACC"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalProxyAccountType1Code
@@ -86,7 +88,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
 	assert.NotNil(t, ClearingSystemMemberIdentification2{}.Validate())
 	assert.NotNil(t, CreateLimitV01{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
 	assert.Nil(t, FinancialInstitutionIdentification18{}.Validate())
 	assert.NotNil(t, GenericAccountIdentification1{}.Validate())
@@ -105,7 +107,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, CashAccountType2Choice{}.Validate())
 	assert.NotNil(t, CreateStandingOrderV01{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
-	assert.Nil(t, DatePeriod2Choice{}.Validate())
+	assert.NotNil(t, DatePeriod2Choice{}.Validate())
 	assert.NotNil(t, EventType1Choice{}.Validate())
 	assert.NotNil(t, ExecutionType1Choice{}.Validate())
 	assert.NotNil(t, ProxyAccountIdentification1{}.Validate())
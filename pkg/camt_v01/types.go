@@ -7,6 +7,7 @@ package camt_v01
 import (
 	"reflect"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -17,7 +18,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -27,7 +28,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -37,7 +38,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -47,7 +48,7 @@ func (r ExternalMarketInfrastructure1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 3 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 3)
 	}
-	return nil
+	return codes.Validate("ExternalMarketInfrastructure1Code", string(r))
 }
 
 // May be one of MULT, BILI, MAND, DISC, NELI, INBI, GLBL, DIDB, SPLC, SPLF, TDLC, TDLF, UCDT, ACOL, EXGT
@@ -71,7 +72,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -81,7 +82,7 @@ func (r ExternalProxyAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalProxyAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalProxyAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -91,7 +92,7 @@ func (r ExternalSystemEventType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemEventType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalSystemEventType1Code", string(r))
 }
 
 // May be one of YEAR, MNTH, QURT, MIAN, WEEK, DAIL, ADHO, INDA, OVNG
@@ -0,0 +1,1290 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package camt_v02
+
+import (
+	"encoding/xml"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+type AccountIdentification4Choice struct {
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r AccountIdentification4Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type AccountInterest2 struct {
+	Tp     *InterestType1Choice   `xml:"Tp,omitempty" json:",omitempty"`
+	Rate   []Rate3                `xml:"Rate,omitempty" json:",omitempty"`
+	FrToDt *DateTimePeriodDetails `xml:"FrToDt,omitempty" json:",omitempty"`
+	Rsn    *common.Max35Text      `xml:"Rsn,omitempty" json:",omitempty"`
+}
+
+func (r AccountInterest2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AccountNotification2 struct {
+	Id             common.Max35Text           `xml:"Id"`
+	ElctrncSeqNb   float64                    `xml:"ElctrncSeqNb,omitempty" json:",omitempty"`
+	LglSeqNb       float64                    `xml:"LglSeqNb,omitempty" json:",omitempty"`
+	CreDtTm        common.ISODateTime         `xml:"CreDtTm"`
+	FrToDt         *DateTimePeriodDetails     `xml:"FrToDt,omitempty" json:",omitempty"`
+	CpyDplctInd    *common.CopyDuplicate1Code `xml:"CpyDplctInd,omitempty" json:",omitempty"`
+	RptgSrc        *ReportingSource1Choice    `xml:"RptgSrc,omitempty" json:",omitempty"`
+	Acct           CashAccount20              `xml:"Acct"`
+	RltdAcct       *CashAccount16             `xml:"RltdAcct,omitempty" json:",omitempty"`
+	Intrst         []AccountInterest2         `xml:"Intrst,omitempty" json:",omitempty"`
+	TxsSummry      *TotalTransactions2        `xml:"TxsSummry,omitempty" json:",omitempty"`
+	Ntry           []ReportEntry2             `xml:"Ntry" json:",omitempty"`
+	AddtlNtfctnInf *common.Max500Text         `xml:"AddtlNtfctnInf,omitempty" json:",omitempty"`
+}
+
+func (r AccountNotification2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AccountReport11 struct {
+	Id           common.Max35Text           `xml:"Id"`
+	ElctrncSeqNb float64                    `xml:"ElctrncSeqNb,omitempty" json:",omitempty"`
+	LglSeqNb     float64                    `xml:"LglSeqNb,omitempty" json:",omitempty"`
+	CreDtTm      common.ISODateTime         `xml:"CreDtTm"`
+	FrToDt       *DateTimePeriodDetails     `xml:"FrToDt,omitempty" json:",omitempty"`
+	CpyDplctInd  *common.CopyDuplicate1Code `xml:"CpyDplctInd,omitempty" json:",omitempty"`
+	RptgSrc      *ReportingSource1Choice    `xml:"RptgSrc,omitempty" json:",omitempty"`
+	Acct         CashAccount20              `xml:"Acct"`
+	RltdAcct     *CashAccount16             `xml:"RltdAcct,omitempty" json:",omitempty"`
+	Intrst       []AccountInterest2         `xml:"Intrst,omitempty" json:",omitempty"`
+	Bal          []CashBalance3             `xml:"Bal,omitempty" json:",omitempty"`
+	TxsSummry    *TotalTransactions2        `xml:"TxsSummry,omitempty" json:",omitempty"`
+	Ntry         []ReportEntry2             `xml:"Ntry,omitempty" json:",omitempty"`
+	AddtlRptInf  *common.Max500Text         `xml:"AddtlRptInf,omitempty" json:",omitempty"`
+}
+
+func (r AccountReport11) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AccountSchemeName1Choice struct {
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r AccountSchemeName1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type AccountStatement2 struct {
+	Id           common.Max35Text           `xml:"Id"`
+	ElctrncSeqNb float64                    `xml:"ElctrncSeqNb,omitempty" json:",omitempty"`
+	LglSeqNb     float64                    `xml:"LglSeqNb,omitempty" json:",omitempty"`
+	CreDtTm      common.ISODateTime         `xml:"CreDtTm"`
+	FrToDt       *DateTimePeriodDetails     `xml:"FrToDt,omitempty" json:",omitempty"`
+	CpyDplctInd  *common.CopyDuplicate1Code `xml:"CpyDplctInd,omitempty" json:",omitempty"`
+	RptgSrc      *ReportingSource1Choice    `xml:"RptgSrc,omitempty" json:",omitempty"`
+	Acct         CashAccount20              `xml:"Acct"`
+	RltdAcct     *CashAccount16             `xml:"RltdAcct,omitempty" json:",omitempty"`
+	Intrst       []AccountInterest2         `xml:"Intrst,omitempty" json:",omitempty"`
+	Bal          []CashBalance3             `xml:"Bal" json:",omitempty"`
+	TxsSummry    *TotalTransactions2        `xml:"TxsSummry,omitempty" json:",omitempty"`
+	Ntry         []ReportEntry2             `xml:"Ntry,omitempty" json:",omitempty"`
+	AddtlStmtInf *common.Max500Text         `xml:"AddtlStmtInf,omitempty" json:",omitempty"`
+}
+
+func (r AccountStatement2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ActiveOrHistoricCurrencyAnd13DecimalAmount struct {
+	Value common.ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                         `xml:"Ccy,attr"`
+}
+
+func (r ActiveOrHistoricCurrencyAnd13DecimalAmount) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ActiveOrHistoricCurrencyAndAmount struct {
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
+}
+
+func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AlternateSecurityIdentification2 struct {
+	Tp common.Max35Text `xml:"Tp"`
+	Id common.Max35Text `xml:"Id"`
+}
+
+func (r AlternateSecurityIdentification2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AmountAndCurrencyExchange3 struct {
+	InstdAmt      *AmountAndCurrencyExchangeDetails3  `xml:"InstdAmt,omitempty" json:",omitempty"`
+	TxAmt         *AmountAndCurrencyExchangeDetails3  `xml:"TxAmt,omitempty" json:",omitempty"`
+	CntrValAmt    *AmountAndCurrencyExchangeDetails3  `xml:"CntrValAmt,omitempty" json:",omitempty"`
+	AnncdPstngAmt *AmountAndCurrencyExchangeDetails3  `xml:"AnncdPstngAmt,omitempty" json:",omitempty"`
+	PrtryAmt      []AmountAndCurrencyExchangeDetails4 `xml:"PrtryAmt,omitempty" json:",omitempty"`
+}
+
+func (r AmountAndCurrencyExchange3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AmountAndCurrencyExchangeDetails3 struct {
+	Amt     ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CcyXchg *CurrencyExchange5                `xml:"CcyXchg,omitempty" json:",omitempty"`
+}
+
+func (r AmountAndCurrencyExchangeDetails3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AmountAndCurrencyExchangeDetails4 struct {
+	Tp      common.Max35Text                  `xml:"Tp"`
+	Amt     ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CcyXchg *CurrencyExchange5                `xml:"CcyXchg,omitempty" json:",omitempty"`
+}
+
+func (r AmountAndCurrencyExchangeDetails4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AmountRangeBoundary1 struct {
+	BdryAmt common.ImpliedCurrencyAndAmount `xml:"BdryAmt"`
+	Incl    bool                            `xml:"Incl"`
+}
+
+func (r AmountRangeBoundary1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BalanceSubType1Choice struct {
+	Cd    *ExternalBalanceSubType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text            `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r BalanceSubType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type BalanceType12 struct {
+	CdOrPrtry BalanceType5Choice     `xml:"CdOrPrtry"`
+	SubTp     *BalanceSubType1Choice `xml:"SubTp,omitempty" json:",omitempty"`
+}
+
+func (r BalanceType12) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BalanceType5Choice struct {
+	Cd    *BalanceType12Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r BalanceType5Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type BankToCustomerAccountReportV02 struct {
+	XMLName xml.Name          `xml:"BkToCstmrAcctRpt"`
+	GrpHdr  GroupHeader42     `xml:"GrpHdr"`
+	Rpt     []AccountReport11 `xml:"Rpt" json:",omitempty"`
+}
+
+func (r BankToCustomerAccountReportV02) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BankToCustomerDebitCreditNotificationV02 struct {
+	XMLName xml.Name               `xml:"BkToCstmrDbtCdtNtfctn"`
+	GrpHdr  GroupHeader42          `xml:"GrpHdr"`
+	Ntfctn  []AccountNotification2 `xml:"Ntfctn" json:",omitempty"`
+}
+
+func (r BankToCustomerDebitCreditNotificationV02) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BankToCustomerStatementV02 struct {
+	XMLName xml.Name            `xml:"BkToCstmrStmt"`
+	GrpHdr  GroupHeader42       `xml:"GrpHdr"`
+	Stmt    []AccountStatement2 `xml:"Stmt" json:",omitempty"`
+}
+
+func (r BankToCustomerStatementV02) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BankTransactionCodeStructure4 struct {
+	Domn  *BankTransactionCodeStructure5            `xml:"Domn,omitempty" json:",omitempty"`
+	Prtry *ProprietaryBankTransactionCodeStructure1 `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r BankTransactionCodeStructure4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BankTransactionCodeStructure5 struct {
+	Cd   ExternalBankTransactionDomain1Code `xml:"Cd"`
+	Fmly BankTransactionCodeStructure6      `xml:"Fmly"`
+}
+
+func (r BankTransactionCodeStructure5) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BankTransactionCodeStructure6 struct {
+	Cd        ExternalBankTransactionFamily1Code    `xml:"Cd"`
+	SubFmlyCd ExternalBankTransactionSubFamily1Code `xml:"SubFmlyCd"`
+}
+
+func (r BankTransactionCodeStructure6) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BatchInformation2 struct {
+	MsgId     *common.Max35Text                  `xml:"MsgId,omitempty" json:",omitempty"`
+	PmtInfId  *common.Max35Text                  `xml:"PmtInfId,omitempty" json:",omitempty"`
+	NbOfTxs   *common.Max15NumericText           `xml:"NbOfTxs,omitempty" json:",omitempty"`
+	TtlAmt    *ActiveOrHistoricCurrencyAndAmount `xml:"TtlAmt,omitempty" json:",omitempty"`
+	CdtDbtInd *common.CreditDebitCode            `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+}
+
+func (r BatchInformation2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BranchAndFinancialInstitutionIdentification4 struct {
+	FinInstnId FinancialInstitutionIdentification7 `xml:"FinInstnId"`
+	BrnchId    *BranchData2                        `xml:"BrnchId,omitempty" json:",omitempty"`
+}
+
+func (r BranchAndFinancialInstitutionIdentification4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BranchData2 struct {
+	Id      *common.Max35Text  `xml:"Id,omitempty" json:",omitempty"`
+	Nm      *common.Max140Text `xml:"Nm,omitempty" json:",omitempty"`
+	PstlAdr *PostalAddress6    `xml:"PstlAdr,omitempty" json:",omitempty"`
+}
+
+func (r BranchData2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashAccount16 struct {
+	Id  AccountIdentification4Choice         `xml:"Id"`
+	Tp  *CashAccountType2                    `xml:"Tp,omitempty" json:",omitempty"`
+	Ccy *common.ActiveOrHistoricCurrencyCode `xml:"Ccy,omitempty" json:",omitempty"`
+	Nm  *common.Max70Text                    `xml:"Nm,omitempty" json:",omitempty"`
+}
+
+func (r CashAccount16) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashAccount20 struct {
+	Id   AccountIdentification4Choice                  `xml:"Id"`
+	Tp   *CashAccountType2                             `xml:"Tp,omitempty" json:",omitempty"`
+	Ccy  *common.ActiveOrHistoricCurrencyCode          `xml:"Ccy,omitempty" json:",omitempty"`
+	Nm   *common.Max70Text                             `xml:"Nm,omitempty" json:",omitempty"`
+	Ownr *PartyIdentification32                        `xml:"Ownr,omitempty" json:",omitempty"`
+	Svcr *BranchAndFinancialInstitutionIdentification4 `xml:"Svcr,omitempty" json:",omitempty"`
+}
+
+func (r CashAccount20) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashAccountType2 struct {
+	Cd    *CashAccountType4Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r CashAccountType2) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type CashBalance3 struct {
+	Tp        BalanceType12                     `xml:"Tp"`
+	CdtLine   *CreditLine2                      `xml:"CdtLine,omitempty" json:",omitempty"`
+	Amt       ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd common.CreditDebitCode            `xml:"CdtDbtInd"`
+	Dt        DateAndDateTimeChoice             `xml:"Dt"`
+	Avlbty    []CashBalanceAvailability2        `xml:"Avlbty,omitempty" json:",omitempty"`
+}
+
+func (r CashBalance3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashBalanceAvailability2 struct {
+	Dt        CashBalanceAvailabilityDate1      `xml:"Dt"`
+	Amt       ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd common.CreditDebitCode            `xml:"CdtDbtInd"`
+}
+
+func (r CashBalanceAvailability2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashBalanceAvailabilityDate1 struct {
+	NbOfDays *common.Max15PlusSignedNumericText `xml:"NbOfDays,omitempty" json:",omitempty"`
+	ActlDt   *common.ISODate                    `xml:"ActlDt,omitempty" json:",omitempty"`
+}
+
+func (r CashBalanceAvailabilityDate1) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ChargeType2Choice struct {
+	Cd    *ChargeType1Code        `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification3 `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ChargeType2Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ChargesInformation6 struct {
+	TtlChrgsAndTaxAmt *ActiveOrHistoricCurrencyAndAmount            `xml:"TtlChrgsAndTaxAmt,omitempty" json:",omitempty"`
+	Amt               ActiveOrHistoricCurrencyAndAmount             `xml:"Amt"`
+	CdtDbtInd         *common.CreditDebitCode                       `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	Tp                *ChargeType2Choice                            `xml:"Tp,omitempty" json:",omitempty"`
+	Rate              float64                                       `xml:"Rate,omitempty" json:",omitempty"`
+	Br                *ChargeBearerType1Code                        `xml:"Br,omitempty" json:",omitempty"`
+	Pty               *BranchAndFinancialInstitutionIdentification4 `xml:"Pty,omitempty" json:",omitempty"`
+	Tax               *TaxCharges2                                  `xml:"Tax,omitempty" json:",omitempty"`
+}
+
+func (r ChargesInformation6) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ClearingSystemIdentification2Choice struct {
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ClearingSystemIdentification2Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ClearingSystemMemberIdentification2 struct {
+	ClrSysId *ClearingSystemIdentification2Choice `xml:"ClrSysId,omitempty" json:",omitempty"`
+	MmbId    common.Max35Text                     `xml:"MmbId"`
+}
+
+func (r ClearingSystemMemberIdentification2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ContactDetails2 struct {
+	NmPrfx   *common.NamePrefix1Code `xml:"NmPrfx,omitempty" json:",omitempty"`
+	Nm       *common.Max140Text      `xml:"Nm,omitempty" json:",omitempty"`
+	PhneNb   *common.PhoneNumber     `xml:"PhneNb,omitempty" json:",omitempty"`
+	MobNb    *common.PhoneNumber     `xml:"MobNb,omitempty" json:",omitempty"`
+	FaxNb    *common.PhoneNumber     `xml:"FaxNb,omitempty" json:",omitempty"`
+	EmailAdr *common.Max2048Text     `xml:"EmailAdr,omitempty" json:",omitempty"`
+	Othr     *common.Max35Text       `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r ContactDetails2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CorporateAction1 struct {
+	Cd    *common.Max35Text `xml:"Cd,omitempty" json:",omitempty"`
+	Nb    *common.Max35Text `xml:"Nb,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r CorporateAction1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CreditLine2 struct {
+	Incl bool                               `xml:"Incl"`
+	Amt  *ActiveOrHistoricCurrencyAndAmount `xml:"Amt,omitempty" json:",omitempty"`
+}
+
+func (r CreditLine2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CreditorReferenceInformation2 struct {
+	Tp  *CreditorReferenceType2 `xml:"Tp,omitempty" json:",omitempty"`
+	Ref *common.Max35Text       `xml:"Ref,omitempty" json:",omitempty"`
+}
+
+func (r CreditorReferenceInformation2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CreditorReferenceType1Choice struct {
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r CreditorReferenceType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type CreditorReferenceType2 struct {
+	CdOrPrtry CreditorReferenceType1Choice `xml:"CdOrPrtry"`
+	Issr      *common.Max35Text            `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r CreditorReferenceType2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CurrencyAndAmountRange2 struct {
+	Amt       ImpliedCurrencyAmountRangeChoice    `xml:"Amt"`
+	CdtDbtInd *common.CreditDebitCode             `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	Ccy       common.ActiveOrHistoricCurrencyCode `xml:"Ccy"`
+}
+
+func (r CurrencyAndAmountRange2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CurrencyExchange5 struct {
+	SrcCcy   common.ActiveOrHistoricCurrencyCode  `xml:"SrcCcy"`
+	TrgtCcy  *common.ActiveOrHistoricCurrencyCode `xml:"TrgtCcy,omitempty" json:",omitempty"`
+	UnitCcy  *common.ActiveOrHistoricCurrencyCode `xml:"UnitCcy,omitempty" json:",omitempty"`
+	XchgRate float64                              `xml:"XchgRate"`
+	CtrctId  *common.Max35Text                    `xml:"CtrctId,omitempty" json:",omitempty"`
+	QtnDt    *common.ISODateTime                  `xml:"QtnDt,omitempty" json:",omitempty"`
+}
+
+func (r CurrencyExchange5) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DateAndDateTimeChoice struct {
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
+}
+
+func (r DateAndDateTimeChoice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type DateAndPlaceOfBirth struct {
+	BirthDt     common.ISODate     `xml:"BirthDt"`
+	PrvcOfBirth *common.Max35Text  `xml:"PrvcOfBirth,omitempty" json:",omitempty"`
+	CityOfBirth common.Max35Text   `xml:"CityOfBirth"`
+	CtryOfBirth common.CountryCode `xml:"CtryOfBirth"`
+}
+
+func (r DateAndPlaceOfBirth) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DatePeriodDetails struct {
+	FrDt common.ISODate `xml:"FrDt"`
+	ToDt common.ISODate `xml:"ToDt"`
+}
+
+func (r DatePeriodDetails) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DateTimePeriodDetails struct {
+	FrDtTm common.ISODateTime `xml:"FrDtTm"`
+	ToDtTm common.ISODateTime `xml:"ToDtTm"`
+}
+
+func (r DateTimePeriodDetails) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DocumentAdjustment1 struct {
+	Amt       ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd *common.CreditDebitCode           `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	Rsn       *common.Max4Text                  `xml:"Rsn,omitempty" json:",omitempty"`
+	AddtlInf  *common.Max140Text                `xml:"AddtlInf,omitempty" json:",omitempty"`
+}
+
+func (r DocumentAdjustment1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type EntryDetails1 struct {
+	Btch   *BatchInformation2  `xml:"Btch,omitempty" json:",omitempty"`
+	TxDtls []EntryTransaction2 `xml:"TxDtls,omitempty" json:",omitempty"`
+}
+
+func (r EntryDetails1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type EntryTransaction2 struct {
+	Refs        *TransactionReferences2        `xml:"Refs,omitempty" json:",omitempty"`
+	AmtDtls     *AmountAndCurrencyExchange3    `xml:"AmtDtls,omitempty" json:",omitempty"`
+	Avlbty      []CashBalanceAvailability2     `xml:"Avlbty,omitempty" json:",omitempty"`
+	BkTxCd      *BankTransactionCodeStructure4 `xml:"BkTxCd,omitempty" json:",omitempty"`
+	Chrgs       []ChargesInformation6          `xml:"Chrgs,omitempty" json:",omitempty"`
+	Intrst      []TransactionInterest2         `xml:"Intrst,omitempty" json:",omitempty"`
+	RltdPties   *TransactionParty2             `xml:"RltdPties,omitempty" json:",omitempty"`
+	RltdAgts    *TransactionAgents2            `xml:"RltdAgts,omitempty" json:",omitempty"`
+	Purp        *Purpose2Choice                `xml:"Purp,omitempty" json:",omitempty"`
+	RltdRmtInf  []RemittanceLocation2          `xml:"RltdRmtInf,omitempty" json:",omitempty"`
+	RmtInf      *RemittanceInformation5        `xml:"RmtInf,omitempty" json:",omitempty"`
+	RltdDts     *TransactionDates2             `xml:"RltdDts,omitempty" json:",omitempty"`
+	RltdPric    *TransactionPrice2Choice       `xml:"RltdPric,omitempty" json:",omitempty"`
+	RltdQties   []TransactionQuantities1Choice `xml:"RltdQties,omitempty" json:",omitempty"`
+	FinInstrmId *SecurityIdentification4Choice `xml:"FinInstrmId,omitempty" json:",omitempty"`
+	Tax         *TaxInformation3               `xml:"Tax,omitempty" json:",omitempty"`
+	RtrInf      *ReturnReasonInformation10     `xml:"RtrInf,omitempty" json:",omitempty"`
+	CorpActn    *CorporateAction1              `xml:"CorpActn,omitempty" json:",omitempty"`
+	SfkpgAcct   *CashAccount16                 `xml:"SfkpgAcct,omitempty" json:",omitempty"`
+	AddtlTxInf  *common.Max500Text             `xml:"AddtlTxInf,omitempty" json:",omitempty"`
+}
+
+func (r EntryTransaction2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type FinancialIdentificationSchemeName1Choice struct {
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r FinancialIdentificationSchemeName1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type FinancialInstitutionIdentification7 struct {
+	BIC         *common.BICIdentifier                `xml:"BIC,omitempty" json:",omitempty"`
+	ClrSysMmbId *ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId,omitempty" json:",omitempty"`
+	Nm          *common.Max140Text                   `xml:"Nm,omitempty" json:",omitempty"`
+	PstlAdr     *PostalAddress6                      `xml:"PstlAdr,omitempty" json:",omitempty"`
+	Othr        *GenericFinancialIdentification1     `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r FinancialInstitutionIdentification7) Validate() error {
+	return utils.Validate(&r)
+}
+
+type FinancialInstrumentQuantityChoice struct {
+	Unit     *float64                         `xml:"Unit,omitempty" json:",omitempty"`
+	FaceAmt  *common.ImpliedCurrencyAndAmount `xml:"FaceAmt,omitempty" json:",omitempty"`
+	AmtsdVal *common.ImpliedCurrencyAndAmount `xml:"AmtsdVal,omitempty" json:",omitempty"`
+}
+
+func (r FinancialInstrumentQuantityChoice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type FromToAmountRange struct {
+	FrAmt AmountRangeBoundary1 `xml:"FrAmt"`
+	ToAmt AmountRangeBoundary1 `xml:"ToAmt"`
+}
+
+func (r FromToAmountRange) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericAccountIdentification1 struct {
+	Id      common.Max34Text          `xml:"Id"`
+	SchmeNm *AccountSchemeName1Choice `xml:"SchmeNm,omitempty" json:",omitempty"`
+	Issr    *common.Max35Text         `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r GenericAccountIdentification1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericFinancialIdentification1 struct {
+	Id      common.Max35Text                          `xml:"Id"`
+	SchmeNm *FinancialIdentificationSchemeName1Choice `xml:"SchmeNm,omitempty" json:",omitempty"`
+	Issr    *common.Max35Text                         `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r GenericFinancialIdentification1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericIdentification3 struct {
+	Id   common.Max35Text  `xml:"Id"`
+	Issr *common.Max35Text `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r GenericIdentification3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericOrganisationIdentification1 struct {
+	Id      common.Max35Text                             `xml:"Id"`
+	SchmeNm *OrganisationIdentificationSchemeName1Choice `xml:"SchmeNm,omitempty" json:",omitempty"`
+	Issr    *common.Max35Text                            `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r GenericOrganisationIdentification1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericPersonIdentification1 struct {
+	Id      common.Max35Text                       `xml:"Id"`
+	SchmeNm *PersonIdentificationSchemeName1Choice `xml:"SchmeNm,omitempty" json:",omitempty"`
+	Issr    *common.Max35Text                      `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r GenericPersonIdentification1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GroupHeader42 struct {
+	MsgId    common.Max35Text       `xml:"MsgId"`
+	CreDtTm  common.ISODateTime     `xml:"CreDtTm"`
+	MsgRcpt  *PartyIdentification32 `xml:"MsgRcpt,omitempty" json:",omitempty"`
+	MsgPgntn *Pagination            `xml:"MsgPgntn,omitempty" json:",omitempty"`
+	AddtlInf *common.Max500Text     `xml:"AddtlInf,omitempty" json:",omitempty"`
+}
+
+func (r GroupHeader42) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ImpliedCurrencyAmountRangeChoice struct {
+	FrAmt   *AmountRangeBoundary1            `xml:"FrAmt,omitempty" json:",omitempty"`
+	ToAmt   *AmountRangeBoundary1            `xml:"ToAmt,omitempty" json:",omitempty"`
+	FrToAmt *FromToAmountRange               `xml:"FrToAmt,omitempty" json:",omitempty"`
+	EQAmt   *common.ImpliedCurrencyAndAmount `xml:"EQAmt,omitempty" json:",omitempty"`
+	NEQAmt  *common.ImpliedCurrencyAndAmount `xml:"NEQAmt,omitempty" json:",omitempty"`
+}
+
+func (r ImpliedCurrencyAmountRangeChoice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type InterestType1Choice struct {
+	Cd    *common.InterestType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r InterestType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type MessageIdentification2 struct {
+	MsgNmId *common.Max35Text `xml:"MsgNmId,omitempty" json:",omitempty"`
+	MsgId   *common.Max35Text `xml:"MsgId,omitempty" json:",omitempty"`
+}
+
+func (r MessageIdentification2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type NameAndAddress10 struct {
+	Nm  common.Max140Text `xml:"Nm"`
+	Adr PostalAddress6    `xml:"Adr"`
+}
+
+func (r NameAndAddress10) Validate() error {
+	return utils.Validate(&r)
+}
+
+type NumberAndSumOfTransactions1 struct {
+	NbOfNtries *common.Max15NumericText `xml:"NbOfNtries,omitempty" json:",omitempty"`
+	Sum        *common.DecimalNumber    `xml:"Sum,omitempty" json:",omitempty"`
+}
+
+func (r NumberAndSumOfTransactions1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type NumberAndSumOfTransactions2 struct {
+	NbOfNtries    *common.Max15NumericText `xml:"NbOfNtries,omitempty" json:",omitempty"`
+	Sum           *common.DecimalNumber    `xml:"Sum,omitempty" json:",omitempty"`
+	TtlNetNtryAmt *common.DecimalNumber    `xml:"TtlNetNtryAmt,omitempty" json:",omitempty"`
+	CdtDbtInd     *common.CreditDebitCode  `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+}
+
+func (r NumberAndSumOfTransactions2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type OrganisationIdentification4 struct {
+	BICOrBEI *common.AnyBICIdentifier             `xml:"BICOrBEI,omitempty" json:",omitempty"`
+	Othr     []GenericOrganisationIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r OrganisationIdentification4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type OrganisationIdentificationSchemeName1Choice struct {
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type Pagination struct {
+	PgNb      common.Max5NumericText `xml:"PgNb"`
+	LastPgInd bool                   `xml:"LastPgInd"`
+}
+
+func (r Pagination) Validate() error {
+	return utils.Validate(&r)
+}
+
+type Party6Choice struct {
+	OrgId  *OrganisationIdentification4 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification5       `xml:"PrvtId,omitempty" json:",omitempty"`
+}
+
+func (r Party6Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type PartyIdentification32 struct {
+	Nm        *common.Max140Text  `xml:"Nm,omitempty" json:",omitempty"`
+	PstlAdr   *PostalAddress6     `xml:"PstlAdr,omitempty" json:",omitempty"`
+	Id        *Party6Choice       `xml:"Id,omitempty" json:",omitempty"`
+	CtryOfRes *common.CountryCode `xml:"CtryOfRes,omitempty" json:",omitempty"`
+	CtctDtls  *ContactDetails2    `xml:"CtctDtls,omitempty" json:",omitempty"`
+}
+
+func (r PartyIdentification32) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PersonIdentification5 struct {
+	DtAndPlcOfBirth *DateAndPlaceOfBirth           `xml:"DtAndPlcOfBirth,omitempty" json:",omitempty"`
+	Othr            []GenericPersonIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r PersonIdentification5) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PersonIdentificationSchemeName1Choice struct {
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r PersonIdentificationSchemeName1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type PostalAddress6 struct {
+	AdrTp       *common.AddressType2Code `xml:"AdrTp,omitempty" json:",omitempty"`
+	Dept        *common.Max70Text        `xml:"Dept,omitempty" json:",omitempty"`
+	SubDept     *common.Max70Text        `xml:"SubDept,omitempty" json:",omitempty"`
+	StrtNm      *common.Max70Text        `xml:"StrtNm,omitempty" json:",omitempty"`
+	BldgNb      *common.Max16Text        `xml:"BldgNb,omitempty" json:",omitempty"`
+	PstCd       *common.Max16Text        `xml:"PstCd,omitempty" json:",omitempty"`
+	TwnNm       *common.Max35Text        `xml:"TwnNm,omitempty" json:",omitempty"`
+	CtrySubDvsn *common.Max35Text        `xml:"CtrySubDvsn,omitempty" json:",omitempty"`
+	Ctry        *common.CountryCode      `xml:"Ctry,omitempty" json:",omitempty"`
+	AdrLine     []common.Max70Text       `xml:"AdrLine,omitempty" json:",omitempty"`
+}
+
+func (r PostalAddress6) Validate() error {
+	return utils.Validate(&r)
+}
+
+type Price2 struct {
+	Tp  YieldedOrValueType1Choice `xml:"Tp"`
+	Val PriceRateOrAmountChoice   `xml:"Val"`
+}
+
+func (r Price2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PriceRateOrAmountChoice struct {
+	Rate *float64                                    `xml:"Rate,omitempty" json:",omitempty"`
+	Amt  *ActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"Amt,omitempty" json:",omitempty"`
+}
+
+func (r PriceRateOrAmountChoice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ProprietaryAgent2 struct {
+	Tp  common.Max35Text                             `xml:"Tp"`
+	Agt BranchAndFinancialInstitutionIdentification4 `xml:"Agt"`
+}
+
+func (r ProprietaryAgent2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryBankTransactionCodeStructure1 struct {
+	Cd   common.Max35Text  `xml:"Cd"`
+	Issr *common.Max35Text `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r ProprietaryBankTransactionCodeStructure1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryDate2 struct {
+	Tp common.Max35Text      `xml:"Tp"`
+	Dt DateAndDateTimeChoice `xml:"Dt"`
+}
+
+func (r ProprietaryDate2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryParty2 struct {
+	Tp  common.Max35Text      `xml:"Tp"`
+	Pty PartyIdentification32 `xml:"Pty"`
+}
+
+func (r ProprietaryParty2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryPrice2 struct {
+	Tp   common.Max35Text                  `xml:"Tp"`
+	Pric ActiveOrHistoricCurrencyAndAmount `xml:"Pric"`
+}
+
+func (r ProprietaryPrice2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryQuantity1 struct {
+	Tp  common.Max35Text `xml:"Tp"`
+	Qty common.Max35Text `xml:"Qty"`
+}
+
+func (r ProprietaryQuantity1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryReference1 struct {
+	Tp  common.Max35Text `xml:"Tp"`
+	Ref common.Max35Text `xml:"Ref"`
+}
+
+func (r ProprietaryReference1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type Purpose2Choice struct {
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r Purpose2Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type Rate3 struct {
+	Tp      RateType4Choice          `xml:"Tp"`
+	VldtyRg *CurrencyAndAmountRange2 `xml:"VldtyRg,omitempty" json:",omitempty"`
+}
+
+func (r Rate3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RateType4Choice struct {
+	Pctg *float64          `xml:"Pctg,omitempty" json:",omitempty"`
+	Othr *common.Max35Text `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r RateType4Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ReferredDocumentInformation3 struct {
+	Tp     *ReferredDocumentType2 `xml:"Tp,omitempty" json:",omitempty"`
+	Nb     *common.Max35Text      `xml:"Nb,omitempty" json:",omitempty"`
+	RltdDt *common.ISODate        `xml:"RltdDt,omitempty" json:",omitempty"`
+}
+
+func (r ReferredDocumentInformation3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ReferredDocumentType1Choice struct {
+	Cd    *DocumentType5Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ReferredDocumentType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ReferredDocumentType2 struct {
+	CdOrPrtry ReferredDocumentType1Choice `xml:"CdOrPrtry"`
+	Issr      *common.Max35Text           `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r ReferredDocumentType2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RemittanceAmount1 struct {
+	DuePyblAmt        *ActiveOrHistoricCurrencyAndAmount `xml:"DuePyblAmt,omitempty" json:",omitempty"`
+	DscntApldAmt      *ActiveOrHistoricCurrencyAndAmount `xml:"DscntApldAmt,omitempty" json:",omitempty"`
+	CdtNoteAmt        *ActiveOrHistoricCurrencyAndAmount `xml:"CdtNoteAmt,omitempty" json:",omitempty"`
+	TaxAmt            *ActiveOrHistoricCurrencyAndAmount `xml:"TaxAmt,omitempty" json:",omitempty"`
+	AdjstmntAmtAndRsn []DocumentAdjustment1              `xml:"AdjstmntAmtAndRsn,omitempty" json:",omitempty"`
+	RmtdAmt           *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt,omitempty" json:",omitempty"`
+}
+
+func (r RemittanceAmount1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RemittanceInformation5 struct {
+	Ustrd []common.Max140Text                `xml:"Ustrd,omitempty" json:",omitempty"`
+	Strd  []StructuredRemittanceInformation7 `xml:"Strd,omitempty" json:",omitempty"`
+}
+
+func (r RemittanceInformation5) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RemittanceLocation2 struct {
+	RmtId             *common.Max35Text              `xml:"RmtId,omitempty" json:",omitempty"`
+	RmtLctnMtd        *RemittanceLocationMethod2Code `xml:"RmtLctnMtd,omitempty" json:",omitempty"`
+	RmtLctnElctrncAdr *common.Max2048Text            `xml:"RmtLctnElctrncAdr,omitempty" json:",omitempty"`
+	RmtLctnPstlAdr    *NameAndAddress10              `xml:"RmtLctnPstlAdr,omitempty" json:",omitempty"`
+}
+
+func (r RemittanceLocation2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ReportEntry2 struct {
+	NtryRef       *common.Max35Text                 `xml:"NtryRef,omitempty" json:",omitempty"`
+	Amt           ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd     common.CreditDebitCode            `xml:"CdtDbtInd"`
+	RvslInd       bool                              `xml:"RvslInd,omitempty" json:",omitempty"`
+	Sts           EntryStatus2Code                  `xml:"Sts"`
+	BookgDt       *DateAndDateTimeChoice            `xml:"BookgDt,omitempty" json:",omitempty"`
+	ValDt         *DateAndDateTimeChoice            `xml:"ValDt,omitempty" json:",omitempty"`
+	AcctSvcrRef   *common.Max35Text                 `xml:"AcctSvcrRef,omitempty" json:",omitempty"`
+	Avlbty        []CashBalanceAvailability2        `xml:"Avlbty,omitempty" json:",omitempty"`
+	BkTxCd        BankTransactionCodeStructure4     `xml:"BkTxCd"`
+	ComssnWvrInd  bool                              `xml:"ComssnWvrInd,omitempty" json:",omitempty"`
+	AddtlInfInd   *MessageIdentification2           `xml:"AddtlInfInd,omitempty" json:",omitempty"`
+	AmtDtls       *AmountAndCurrencyExchange3       `xml:"AmtDtls,omitempty" json:",omitempty"`
+	Chrgs         []ChargesInformation6             `xml:"Chrgs,omitempty" json:",omitempty"`
+	TechInptChanl *TechnicalInputChannel1Choice     `xml:"TechInptChanl,omitempty" json:",omitempty"`
+	Intrst        []TransactionInterest2            `xml:"Intrst,omitempty" json:",omitempty"`
+	NtryDtls      []EntryDetails1                   `xml:"NtryDtls,omitempty" json:",omitempty"`
+	AddtlNtryInf  *common.Max500Text                `xml:"AddtlNtryInf,omitempty" json:",omitempty"`
+}
+
+func (r ReportEntry2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ReportingSource1Choice struct {
+	Cd    *ExternalReportingSource1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ReportingSource1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ReturnReason5Choice struct {
+	Cd    *ExternalReturnReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ReturnReason5Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ReturnReasonInformation10 struct {
+	OrgnlBkTxCd *BankTransactionCodeStructure4 `xml:"OrgnlBkTxCd,omitempty" json:",omitempty"`
+	Orgtr       *PartyIdentification32         `xml:"Orgtr,omitempty" json:",omitempty"`
+	Rsn         *ReturnReason5Choice           `xml:"Rsn,omitempty" json:",omitempty"`
+	AddtlInf    []common.Max105Text            `xml:"AddtlInf,omitempty" json:",omitempty"`
+}
+
+func (r ReturnReasonInformation10) Validate() error {
+	return utils.Validate(&r)
+}
+
+type SecurityIdentification4Choice struct {
+	ISIN  *ISINIdentifier                   `xml:"ISIN,omitempty" json:",omitempty"`
+	Prtry *AlternateSecurityIdentification2 `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r SecurityIdentification4Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type StructuredRemittanceInformation7 struct {
+	RfrdDocInf  []ReferredDocumentInformation3 `xml:"RfrdDocInf,omitempty" json:",omitempty"`
+	RfrdDocAmt  *RemittanceAmount1             `xml:"RfrdDocAmt,omitempty" json:",omitempty"`
+	CdtrRefInf  *CreditorReferenceInformation2 `xml:"CdtrRefInf,omitempty" json:",omitempty"`
+	Invcr       *PartyIdentification32         `xml:"Invcr,omitempty" json:",omitempty"`
+	Invcee      *PartyIdentification32         `xml:"Invcee,omitempty" json:",omitempty"`
+	AddtlRmtInf []common.Max140Text            `xml:"AddtlRmtInf,omitempty" json:",omitempty"`
+}
+
+func (r StructuredRemittanceInformation7) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxAmount1 struct {
+	Rate         float64                            `xml:"Rate,omitempty" json:",omitempty"`
+	TaxblBaseAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TaxblBaseAmt,omitempty" json:",omitempty"`
+	TtlAmt       *ActiveOrHistoricCurrencyAndAmount `xml:"TtlAmt,omitempty" json:",omitempty"`
+	Dtls         []TaxRecordDetails1                `xml:"Dtls,omitempty" json:",omitempty"`
+}
+
+func (r TaxAmount1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxAuthorisation1 struct {
+	Titl *common.Max35Text  `xml:"Titl,omitempty" json:",omitempty"`
+	Nm   *common.Max140Text `xml:"Nm,omitempty" json:",omitempty"`
+}
+
+func (r TaxAuthorisation1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxCharges2 struct {
+	Id   *common.Max35Text                  `xml:"Id,omitempty" json:",omitempty"`
+	Rate float64                            `xml:"Rate,omitempty" json:",omitempty"`
+	Amt  *ActiveOrHistoricCurrencyAndAmount `xml:"Amt,omitempty" json:",omitempty"`
+}
+
+func (r TaxCharges2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxInformation3 struct {
+	Cdtr            *TaxParty1                         `xml:"Cdtr,omitempty" json:",omitempty"`
+	Dbtr            *TaxParty2                         `xml:"Dbtr,omitempty" json:",omitempty"`
+	AdmstnZn        *common.Max35Text                  `xml:"AdmstnZn,omitempty" json:",omitempty"`
+	RefNb           *common.Max140Text                 `xml:"RefNb,omitempty" json:",omitempty"`
+	Mtd             *common.Max35Text                  `xml:"Mtd,omitempty" json:",omitempty"`
+	TtlTaxblBaseAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlTaxblBaseAmt,omitempty" json:",omitempty"`
+	TtlTaxAmt       *ActiveOrHistoricCurrencyAndAmount `xml:"TtlTaxAmt,omitempty" json:",omitempty"`
+	Dt              *common.ISODate                    `xml:"Dt,omitempty" json:",omitempty"`
+	SeqNb           float64                            `xml:"SeqNb,omitempty" json:",omitempty"`
+	Rcrd            []TaxRecord1                       `xml:"Rcrd,omitempty" json:",omitempty"`
+}
+
+func (r TaxInformation3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxParty1 struct {
+	TaxId  *common.Max35Text `xml:"TaxId,omitempty" json:",omitempty"`
+	RegnId *common.Max35Text `xml:"RegnId,omitempty" json:",omitempty"`
+	TaxTp  *common.Max35Text `xml:"TaxTp,omitempty" json:",omitempty"`
+}
+
+func (r TaxParty1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxParty2 struct {
+	TaxId   *common.Max35Text  `xml:"TaxId,omitempty" json:",omitempty"`
+	RegnId  *common.Max35Text  `xml:"RegnId,omitempty" json:",omitempty"`
+	TaxTp   *common.Max35Text  `xml:"TaxTp,omitempty" json:",omitempty"`
+	Authstn *TaxAuthorisation1 `xml:"Authstn,omitempty" json:",omitempty"`
+}
+
+func (r TaxParty2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxPeriod1 struct {
+	Yr     *common.ISODate       `xml:"Yr,omitempty" json:",omitempty"`
+	Tp     *TaxRecordPeriod1Code `xml:"Tp,omitempty" json:",omitempty"`
+	FrToDt *DatePeriodDetails    `xml:"FrToDt,omitempty" json:",omitempty"`
+}
+
+func (r TaxPeriod1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxRecord1 struct {
+	Tp       *common.Max35Text  `xml:"Tp,omitempty" json:",omitempty"`
+	Ctgy     *common.Max35Text  `xml:"Ctgy,omitempty" json:",omitempty"`
+	CtgyDtls *common.Max35Text  `xml:"CtgyDtls,omitempty" json:",omitempty"`
+	DbtrSts  *common.Max35Text  `xml:"DbtrSts,omitempty" json:",omitempty"`
+	CertId   *common.Max35Text  `xml:"CertId,omitempty" json:",omitempty"`
+	FrmsCd   *common.Max35Text  `xml:"FrmsCd,omitempty" json:",omitempty"`
+	Prd      *TaxPeriod1        `xml:"Prd,omitempty" json:",omitempty"`
+	TaxAmt   *TaxAmount1        `xml:"TaxAmt,omitempty" json:",omitempty"`
+	AddtlInf *common.Max140Text `xml:"AddtlInf,omitempty" json:",omitempty"`
+}
+
+func (r TaxRecord1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxRecordDetails1 struct {
+	Prd *TaxPeriod1                       `xml:"Prd,omitempty" json:",omitempty"`
+	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+}
+
+func (r TaxRecordDetails1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TechnicalInputChannel1Choice struct {
+	Cd    *ExternalTechnicalInputChannel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TechnicalInputChannel1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type TotalTransactions2 struct {
+	TtlNtries          *NumberAndSumOfTransactions2    `xml:"TtlNtries,omitempty" json:",omitempty"`
+	TtlCdtNtries       *NumberAndSumOfTransactions1    `xml:"TtlCdtNtries,omitempty" json:",omitempty"`
+	TtlDbtNtries       *NumberAndSumOfTransactions1    `xml:"TtlDbtNtries,omitempty" json:",omitempty"`
+	TtlNtriesPerBkTxCd []TotalsPerBankTransactionCode2 `xml:"TtlNtriesPerBkTxCd,omitempty" json:",omitempty"`
+}
+
+func (r TotalTransactions2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TotalsPerBankTransactionCode2 struct {
+	NbOfNtries    *common.Max15NumericText      `xml:"NbOfNtries,omitempty" json:",omitempty"`
+	Sum           *common.DecimalNumber         `xml:"Sum,omitempty" json:",omitempty"`
+	TtlNetNtryAmt *common.DecimalNumber         `xml:"TtlNetNtryAmt,omitempty" json:",omitempty"`
+	CdtDbtInd     *common.CreditDebitCode       `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	FcstInd       bool                          `xml:"FcstInd,omitempty" json:",omitempty"`
+	BkTxCd        BankTransactionCodeStructure4 `xml:"BkTxCd"`
+	Avlbty        []CashBalanceAvailability2    `xml:"Avlbty,omitempty" json:",omitempty"`
+}
+
+func (r TotalsPerBankTransactionCode2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionAgents2 struct {
+	DbtrAgt    *BranchAndFinancialInstitutionIdentification4 `xml:"DbtrAgt,omitempty" json:",omitempty"`
+	CdtrAgt    *BranchAndFinancialInstitutionIdentification4 `xml:"CdtrAgt,omitempty" json:",omitempty"`
+	IntrmyAgt1 *BranchAndFinancialInstitutionIdentification4 `xml:"IntrmyAgt1,omitempty" json:",omitempty"`
+	IntrmyAgt2 *BranchAndFinancialInstitutionIdentification4 `xml:"IntrmyAgt2,omitempty" json:",omitempty"`
+	IntrmyAgt3 *BranchAndFinancialInstitutionIdentification4 `xml:"IntrmyAgt3,omitempty" json:",omitempty"`
+	RcvgAgt    *BranchAndFinancialInstitutionIdentification4 `xml:"RcvgAgt,omitempty" json:",omitempty"`
+	DlvrgAgt   *BranchAndFinancialInstitutionIdentification4 `xml:"DlvrgAgt,omitempty" json:",omitempty"`
+	IssgAgt    *BranchAndFinancialInstitutionIdentification4 `xml:"IssgAgt,omitempty" json:",omitempty"`
+	SttlmPlc   *BranchAndFinancialInstitutionIdentification4 `xml:"SttlmPlc,omitempty" json:",omitempty"`
+	Prtry      []ProprietaryAgent2                           `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionAgents2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionDates2 struct {
+	AccptncDtTm             *common.ISODateTime `xml:"AccptncDtTm,omitempty" json:",omitempty"`
+	TradActvtyCtrctlSttlmDt *common.ISODate     `xml:"TradActvtyCtrctlSttlmDt,omitempty" json:",omitempty"`
+	TradDt                  *common.ISODate     `xml:"TradDt,omitempty" json:",omitempty"`
+	IntrBkSttlmDt           *common.ISODate     `xml:"IntrBkSttlmDt,omitempty" json:",omitempty"`
+	StartDt                 *common.ISODate     `xml:"StartDt,omitempty" json:",omitempty"`
+	EndDt                   *common.ISODate     `xml:"EndDt,omitempty" json:",omitempty"`
+	TxDtTm                  *common.ISODateTime `xml:"TxDtTm,omitempty" json:",omitempty"`
+	Prtry                   []ProprietaryDate2  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionDates2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionInterest2 struct {
+	Amt       ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd common.CreditDebitCode            `xml:"CdtDbtInd"`
+	Tp        *InterestType1Choice              `xml:"Tp,omitempty" json:",omitempty"`
+	Rate      []Rate3                           `xml:"Rate,omitempty" json:",omitempty"`
+	FrToDt    *DateTimePeriodDetails            `xml:"FrToDt,omitempty" json:",omitempty"`
+	Rsn       *common.Max35Text                 `xml:"Rsn,omitempty" json:",omitempty"`
+}
+
+func (r TransactionInterest2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionParty2 struct {
+	InitgPty  *PartyIdentification32 `xml:"InitgPty,omitempty" json:",omitempty"`
+	Dbtr      *PartyIdentification32 `xml:"Dbtr,omitempty" json:",omitempty"`
+	DbtrAcct  *CashAccount16         `xml:"DbtrAcct,omitempty" json:",omitempty"`
+	UltmtDbtr *PartyIdentification32 `xml:"UltmtDbtr,omitempty" json:",omitempty"`
+	Cdtr      *PartyIdentification32 `xml:"Cdtr,omitempty" json:",omitempty"`
+	CdtrAcct  *CashAccount16         `xml:"CdtrAcct,omitempty" json:",omitempty"`
+	UltmtCdtr *PartyIdentification32 `xml:"UltmtCdtr,omitempty" json:",omitempty"`
+	TradgPty  *PartyIdentification32 `xml:"TradgPty,omitempty" json:",omitempty"`
+	Prtry     []ProprietaryParty2    `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionParty2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionPrice2Choice struct {
+	DealPric *Price2             `xml:"DealPric,omitempty" json:",omitempty"`
+	Prtry    []ProprietaryPrice2 `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionPrice2Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type TransactionQuantities1Choice struct {
+	Qty   *FinancialInstrumentQuantityChoice `xml:"Qty,omitempty" json:",omitempty"`
+	Prtry *ProprietaryQuantity1              `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionQuantities1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type TransactionReferences2 struct {
+	MsgId       *common.Max35Text      `xml:"MsgId,omitempty" json:",omitempty"`
+	AcctSvcrRef *common.Max35Text      `xml:"AcctSvcrRef,omitempty" json:",omitempty"`
+	PmtInfId    *common.Max35Text      `xml:"PmtInfId,omitempty" json:",omitempty"`
+	InstrId     *common.Max35Text      `xml:"InstrId,omitempty" json:",omitempty"`
+	EndToEndId  *common.Max35Text      `xml:"EndToEndId,omitempty" json:",omitempty"`
+	TxId        *common.Max35Text      `xml:"TxId,omitempty" json:",omitempty"`
+	MndtId      *common.Max35Text      `xml:"MndtId,omitempty" json:",omitempty"`
+	ChqNb       *common.Max35Text      `xml:"ChqNb,omitempty" json:",omitempty"`
+	ClrSysRef   *common.Max35Text      `xml:"ClrSysRef,omitempty" json:",omitempty"`
+	Prtry       *ProprietaryReference1 `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionReferences2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type YieldedOrValueType1Choice struct {
+	Yldd  *bool                `xml:"Yldd,omitempty" json:",omitempty"`
+	ValTp *PriceValueType1Code `xml:"ValTp,omitempty" json:",omitempty"`
+}
+
+func (r YieldedOrValueType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
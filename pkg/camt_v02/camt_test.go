@@ -0,0 +1,282 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package camt_v02
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypes(t *testing.T) {
+	var type1 BalanceType12Code
+	assert.NotNil(t, type1.Validate())
+	type1 = "test"
+	assert.NotNil(t, type1.Validate())
+	type1 = "XPCD"
+	assert.Nil(t, type1.Validate())
+
+	var type2 CashAccountType4Code
+	assert.NotNil(t, type2.Validate())
+	type2 = "test"
+	assert.NotNil(t, type2.Validate())
+	type2 = "CASH"
+	assert.Nil(t, type2.Validate())
+
+	var type3 ChargeBearerType1Code
+	assert.NotNil(t, type3.Validate())
+	type3 = "test"
+	assert.NotNil(t, type3.Validate())
+	type3 = "DEBT"
+	assert.Nil(t, type3.Validate())
+
+	var type4 ChargeType1Code
+	assert.NotNil(t, type4.Validate())
+	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "BRKF"
+	assert.Nil(t, type4.Validate())
+
+	var type5 DocumentType3Code
+	assert.NotNil(t, type5.Validate())
+	type5 = "test"
+	assert.NotNil(t, type5.Validate())
+	type5 = "RADM"
+	assert.Nil(t, type5.Validate())
+
+	var type6 DocumentType5Code
+	assert.NotNil(t, type6.Validate())
+	type6 = "test"
+	assert.NotNil(t, type6.Validate())
+	type6 = "MSIN"
+	assert.Nil(t, type6.Validate())
+
+	var type7 EntryStatus2Code
+	assert.NotNil(t, type7.Validate())
+	type7 = "test"
+	assert.NotNil(t, type7.Validate())
+	type7 = "BOOK"
+	assert.Nil(t, type7.Validate())
+
+	var type8 ExternalAccountIdentification1Code
+	assert.NotNil(t, type8.Validate())
+	type8 = "AIIN"
+	assert.Nil(t, type8.Validate())
+
+	var type9 ExternalBalanceSubType1Code
+	assert.NotNil(t, type9.Validate())
+	type9 = "test"
+	assert.Nil(t, type9.Validate())
+
+	var type10 ExternalBankTransactionDomain1Code
+	assert.NotNil(t, type10.Validate())
+	type10 = "ACMT"
+	assert.Nil(t, type10.Validate())
+
+	var type11 ExternalBankTransactionFamily1Code
+	assert.NotNil(t, type11.Validate())
+	type11 = "test"
+	assert.Nil(t, type11.Validate())
+
+	var type12 ExternalBankTransactionSubFamily1Code
+	assert.NotNil(t, type12.Validate())
+	type12 = "test"
+	assert.Nil(t, type12.Validate())
+
+	var type13 ExternalClearingSystemIdentification1Code
+	assert.NotNil(t, type13.Validate())
+	type13 = "test"
+	assert.NotNil(t, type13.Validate())
+	type13 = "USABA"
+	assert.Nil(t, type13.Validate())
+
+	var type14 ExternalFinancialInstitutionIdentification1Code
+	assert.NotNil(t, type14.Validate())
+	type14 = "test"
+	assert.Nil(t, type14.Validate())
+
+	var type15 ExternalOrganisationIdentification1Code
+	assert.NotNil(t, type15.Validate())
+	type15 = "BANK"
+	assert.Nil(t, type15.Validate())
+
+	var type16 ExternalPersonIdentification1Code
+	assert.NotNil(t, type16.Validate())
+	type16 = "ARNU"
+	assert.Nil(t, type16.Validate())
+
+	var type17 ExternalPurpose1Code
+	assert.NotNil(t, type17.Validate())
+	type17 = "test"
+	assert.NotNil(t, type17.Validate())
+	type17 = "GDDS"
+	assert.Nil(t, type17.Validate())
+
+	var type18 ExternalReportingSource1Code
+	assert.NotNil(t, type18.Validate())
+	type18 = "test"
+	assert.Nil(t, type18.Validate())
+
+	var type19 ExternalReturnReason1Code
+	assert.NotNil(t, type19.Validate())
+	type19 = "test"
+	assert.NotNil(t, type19.Validate())
+	type19 = "AC04"
+	assert.Nil(t, type19.Validate())
+
+	var type20 ExternalTechnicalInputChannel1Code
+	assert.NotNil(t, type20.Validate())
+	type20 = "test"
+	assert.Nil(t, type20.Validate())
+
+	var type21 ISINIdentifier
+	assert.NotNil(t, type21.Validate())
+	type21 = "test"
+	assert.NotNil(t, type21.Validate())
+	type21 = "US0378331005"
+	assert.Nil(t, type21.Validate())
+
+	var type22 PriceValueType1Code
+	assert.NotNil(t, type22.Validate())
+	type22 = "test"
+	assert.NotNil(t, type22.Validate())
+	type22 = "DISC"
+	assert.Nil(t, type22.Validate())
+
+	var type23 RemittanceLocationMethod2Code
+	assert.NotNil(t, type23.Validate())
+	type23 = "test"
+	assert.NotNil(t, type23.Validate())
+	type23 = "FAXI"
+	assert.Nil(t, type23.Validate())
+
+	var type24 TaxRecordPeriod1Code
+	assert.NotNil(t, type24.Validate())
+	type24 = "test"
+	assert.NotNil(t, type24.Validate())
+	type24 = "MM01"
+	assert.Nil(t, type24.Validate())
+}
+
+func TestNestedTypes(t *testing.T) {
+	assert.NotNil(t, AccountIdentification4Choice{}.Validate())
+	assert.Nil(t, AccountInterest2{}.Validate())
+	assert.NotNil(t, AccountNotification2{}.Validate())
+	assert.NotNil(t, AccountReport11{}.Validate())
+	assert.NotNil(t, AccountSchemeName1Choice{}.Validate())
+	assert.NotNil(t, AccountStatement2{}.Validate())
+	assert.NotNil(t, ActiveOrHistoricCurrencyAnd13DecimalAmount{}.Validate())
+	assert.NotNil(t, ActiveOrHistoricCurrencyAndAmount{}.Validate())
+	assert.NotNil(t, AlternateSecurityIdentification2{}.Validate())
+	assert.Nil(t, AmountAndCurrencyExchange3{}.Validate())
+	assert.NotNil(t, AmountAndCurrencyExchangeDetails3{}.Validate())
+	assert.NotNil(t, AmountAndCurrencyExchangeDetails4{}.Validate())
+	assert.Nil(t, AmountRangeBoundary1{}.Validate())
+	assert.NotNil(t, BalanceSubType1Choice{}.Validate())
+	assert.NotNil(t, BalanceType12{}.Validate())
+	assert.NotNil(t, BalanceType5Choice{}.Validate())
+	assert.NotNil(t, BankToCustomerAccountReportV02{}.Validate())
+	assert.NotNil(t, BankToCustomerDebitCreditNotificationV02{}.Validate())
+	assert.NotNil(t, BankToCustomerStatementV02{}.Validate())
+	assert.Nil(t, BankTransactionCodeStructure4{}.Validate())
+	assert.NotNil(t, BankTransactionCodeStructure5{}.Validate())
+	assert.NotNil(t, BankTransactionCodeStructure6{}.Validate())
+	assert.Nil(t, BatchInformation2{}.Validate())
+	assert.Nil(t, BranchAndFinancialInstitutionIdentification4{}.Validate())
+	assert.Nil(t, BranchData2{}.Validate())
+	assert.NotNil(t, CashAccount16{}.Validate())
+	assert.NotNil(t, CashAccount20{}.Validate())
+	assert.NotNil(t, CashAccountType2{}.Validate())
+	assert.NotNil(t, CashBalance3{}.Validate())
+	assert.NotNil(t, CashBalanceAvailability2{}.Validate())
+	assert.NotNil(t, CashBalanceAvailabilityDate1{}.Validate())
+	assert.NotNil(t, ChargeType2Choice{}.Validate())
+	assert.NotNil(t, ChargesInformation6{}.Validate())
+	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
+	assert.NotNil(t, ClearingSystemMemberIdentification2{}.Validate())
+	assert.Nil(t, ContactDetails2{}.Validate())
+	assert.Nil(t, CorporateAction1{}.Validate())
+	assert.Nil(t, CreditLine2{}.Validate())
+	assert.Nil(t, CreditorReferenceInformation2{}.Validate())
+	assert.NotNil(t, CreditorReferenceType1Choice{}.Validate())
+	assert.NotNil(t, CreditorReferenceType2{}.Validate())
+	assert.NotNil(t, CurrencyAndAmountRange2{}.Validate())
+	assert.NotNil(t, CurrencyExchange5{}.Validate())
+	assert.NotNil(t, DateAndDateTimeChoice{}.Validate())
+	assert.NotNil(t, DateAndPlaceOfBirth{}.Validate())
+	assert.Nil(t, DatePeriodDetails{}.Validate())
+	assert.Nil(t, DateTimePeriodDetails{}.Validate())
+	assert.NotNil(t, DocumentAdjustment1{}.Validate())
+	assert.Nil(t, EntryDetails1{}.Validate())
+	assert.Nil(t, EntryTransaction2{}.Validate())
+	assert.NotNil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
+	assert.Nil(t, FinancialInstitutionIdentification7{}.Validate())
+	assert.NotNil(t, FinancialInstrumentQuantityChoice{}.Validate())
+	assert.Nil(t, FromToAmountRange{}.Validate())
+	assert.NotNil(t, GenericAccountIdentification1{}.Validate())
+	assert.NotNil(t, GenericFinancialIdentification1{}.Validate())
+	assert.NotNil(t, GenericIdentification3{}.Validate())
+	assert.NotNil(t, GenericOrganisationIdentification1{}.Validate())
+	assert.NotNil(t, GenericPersonIdentification1{}.Validate())
+	assert.NotNil(t, GroupHeader42{}.Validate())
+	assert.NotNil(t, ImpliedCurrencyAmountRangeChoice{}.Validate())
+	assert.NotNil(t, InterestType1Choice{}.Validate())
+	assert.Nil(t, MessageIdentification2{}.Validate())
+	assert.NotNil(t, NameAndAddress10{}.Validate())
+	assert.Nil(t, NumberAndSumOfTransactions1{}.Validate())
+	assert.Nil(t, NumberAndSumOfTransactions2{}.Validate())
+	assert.Nil(t, OrganisationIdentification4{}.Validate())
+	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
+	assert.NotNil(t, Pagination{}.Validate())
+	assert.NotNil(t, Party6Choice{}.Validate())
+	assert.Nil(t, PartyIdentification32{}.Validate())
+	assert.Nil(t, PersonIdentification5{}.Validate())
+	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
+	assert.Nil(t, PostalAddress6{}.Validate())
+	assert.NotNil(t, Price2{}.Validate())
+	assert.NotNil(t, PriceRateOrAmountChoice{}.Validate())
+	assert.NotNil(t, ProprietaryAgent2{}.Validate())
+	assert.NotNil(t, ProprietaryBankTransactionCodeStructure1{}.Validate())
+	assert.NotNil(t, ProprietaryDate2{}.Validate())
+	assert.NotNil(t, ProprietaryParty2{}.Validate())
+	assert.NotNil(t, ProprietaryPrice2{}.Validate())
+	assert.NotNil(t, ProprietaryQuantity1{}.Validate())
+	assert.NotNil(t, ProprietaryReference1{}.Validate())
+	assert.NotNil(t, Purpose2Choice{}.Validate())
+	assert.NotNil(t, Rate3{}.Validate())
+	assert.NotNil(t, RateType4Choice{}.Validate())
+	assert.Nil(t, ReferredDocumentInformation3{}.Validate())
+	assert.NotNil(t, ReferredDocumentType1Choice{}.Validate())
+	assert.NotNil(t, ReferredDocumentType2{}.Validate())
+	assert.Nil(t, RemittanceAmount1{}.Validate())
+	assert.Nil(t, RemittanceInformation5{}.Validate())
+	assert.Nil(t, RemittanceLocation2{}.Validate())
+	assert.NotNil(t, ReportEntry2{}.Validate())
+	assert.NotNil(t, ReportingSource1Choice{}.Validate())
+	assert.NotNil(t, ReturnReason5Choice{}.Validate())
+	assert.Nil(t, ReturnReasonInformation10{}.Validate())
+	assert.NotNil(t, SecurityIdentification4Choice{}.Validate())
+	assert.Nil(t, StructuredRemittanceInformation7{}.Validate())
+	assert.Nil(t, TaxAmount1{}.Validate())
+	assert.Nil(t, TaxAuthorisation1{}.Validate())
+	assert.Nil(t, TaxCharges2{}.Validate())
+	assert.Nil(t, TaxInformation3{}.Validate())
+	assert.Nil(t, TaxParty1{}.Validate())
+	assert.Nil(t, TaxParty2{}.Validate())
+	assert.Nil(t, TaxPeriod1{}.Validate())
+	assert.Nil(t, TaxRecord1{}.Validate())
+	assert.NotNil(t, TaxRecordDetails1{}.Validate())
+	assert.NotNil(t, TechnicalInputChannel1Choice{}.Validate())
+	assert.Nil(t, TotalTransactions2{}.Validate())
+	assert.Nil(t, TotalsPerBankTransactionCode2{}.Validate())
+	assert.Nil(t, TransactionAgents2{}.Validate())
+	assert.Nil(t, TransactionDates2{}.Validate())
+	assert.NotNil(t, TransactionInterest2{}.Validate())
+	assert.Nil(t, TransactionParty2{}.Validate())
+	assert.NotNil(t, TransactionPrice2Choice{}.Validate())
+	assert.NotNil(t, TransactionQuantities1Choice{}.Validate())
+	assert.Nil(t, TransactionReferences2{}.Validate())
+	assert.NotNil(t, YieldedOrValueType1Choice{}.Validate())
+}
@@ -0,0 +1,295 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package camt_v02
+
+import (
+	"reflect"
+	"regexp"
+
+	"github.com/moov-io/iso20022/pkg/codes"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// May be one of XPCD, OPAV, ITAV, CLAV, FWAV, CLBD, ITBD, OPBD, PRCD, INFO
+type BalanceType12Code string
+
+func (r BalanceType12Code) Validate() error {
+	for _, vv := range []string{
+		"XPCD", "OPAV", "ITAV", "CLAV", "FWAV", "CLBD", "ITBD", "OPBD", "PRCD", "INFO",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("BalanceType12Code")
+}
+
+// May be one of CASH, CHAR, COMM, TAXE, CISH, TRAS, SACC, CACC, SVGS, ONDP, MGLD, NREX, MOMA, LOAN, SLRY, ODFT
+type CashAccountType4Code string
+
+func (r CashAccountType4Code) Validate() error {
+	for _, vv := range []string{
+		"CASH", "CHAR", "COMM", "TAXE", "CISH", "TRAS", "SACC", "CACC", "SVGS", "ONDP", "MGLD", "NREX", "MOMA", "LOAN", "SLRY", "ODFT",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("CashAccountType4Code")
+}
+
+// May be one of DEBT, CRED, SHAR, SLEV
+type ChargeBearerType1Code string
+
+func (r ChargeBearerType1Code) Validate() error {
+	for _, vv := range []string{
+		"DEBT", "CRED", "SHAR", "SLEV",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("ChargeBearerType1Code")
+}
+
+// May be one of BRKF, COMM
+type ChargeType1Code string
+
+func (r ChargeType1Code) Validate() error {
+	for _, vv := range []string{
+		"BRKF", "COMM",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("ChargeType1Code")
+}
+
+// May be one of RADM, RPIN, FXDR, DISP, PUOR, SCOR
+type DocumentType3Code string
+
+func (r DocumentType3Code) Validate() error {
+	for _, vv := range []string{
+		"RADM", "RPIN", "FXDR", "DISP", "PUOR", "SCOR",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("DocumentType3Code")
+}
+
+// May be one of MSIN, CNFA, DNFA, CINV, CREN, DEBN, HIRI, SBIN, CMCN, SOAC, DISP, BOLD, VCHR, AROI, TSUT
+type DocumentType5Code string
+
+func (r DocumentType5Code) Validate() error {
+	for _, vv := range []string{
+		"MSIN", "CNFA", "DNFA", "CINV", "CREN", "DEBN", "HIRI", "SBIN", "CMCN", "SOAC", "DISP", "BOLD", "VCHR", "AROI", "TSUT",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("DocumentType5Code")
+}
+
+// May be one of BOOK, PDNG, INFO
+type EntryStatus2Code string
+
+func (r EntryStatus2Code) Validate() error {
+	for _, vv := range []string{
+		"BOOK", "PDNG", "INFO",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("EntryStatus2Code")
+}
+
+// Must be at least 1 items long
+type ExternalAccountIdentification1Code string
+
+func (r ExternalAccountIdentification1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
+	}
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalBalanceSubType1Code string
+
+func (r ExternalBalanceSubType1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalBalanceSubType1Code", 1, 4)
+	}
+	return codes.Validate("ExternalBalanceSubType1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalBankTransactionDomain1Code string
+
+func (r ExternalBankTransactionDomain1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalBankTransactionDomain1Code", 1, 4)
+	}
+	return codes.Validate("ExternalBankTransactionDomain1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalBankTransactionFamily1Code string
+
+func (r ExternalBankTransactionFamily1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalBankTransactionFamily1Code", 1, 4)
+	}
+	return codes.Validate("ExternalBankTransactionFamily1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalBankTransactionSubFamily1Code string
+
+func (r ExternalBankTransactionSubFamily1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalBankTransactionSubFamily1Code", 1, 4)
+	}
+	return codes.Validate("ExternalBankTransactionSubFamily1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalClearingSystemIdentification1Code string
+
+func (r ExternalClearingSystemIdentification1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 5 {
+		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
+	}
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalFinancialInstitutionIdentification1Code string
+
+func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
+	}
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalOrganisationIdentification1Code string
+
+func (r ExternalOrganisationIdentification1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
+	}
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalPersonIdentification1Code string
+
+func (r ExternalPersonIdentification1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
+	}
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalPurpose1Code string
+
+func (r ExternalPurpose1Code) Validate() error {
+	if len(string(r)) < 1 {
+		return utils.NewErrTextLengthInvalid("ExternalPurpose1Code", 1, 0)
+	}
+	return codes.Validate("ExternalPurpose1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalReportingSource1Code string
+
+func (r ExternalReportingSource1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalReportingSource1Code", 1, 4)
+	}
+	return codes.Validate("ExternalReportingSource1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalReturnReason1Code string
+
+func (r ExternalReturnReason1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalReturnReason1Code", 1, 4)
+	}
+	return codes.Validate("ExternalReturnReason1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalTechnicalInputChannel1Code string
+
+func (r ExternalTechnicalInputChannel1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalTechnicalInputChannel1Code", 1, 4)
+	}
+	return codes.Validate("ExternalTechnicalInputChannel1Code", string(r))
+}
+
+// Must match the pattern [A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}
+type ISINIdentifier string
+
+var isinIdentifierRegexp = regexp.MustCompile(`^[A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}$`)
+
+func (r ISINIdentifier) Validate() error {
+	if !isinIdentifierRegexp.MatchString(string(r)) {
+		return utils.NewErrValueInvalid("ISINIdentifier")
+	}
+	return nil
+}
+
+// May be one of DISC, PREM, PARV
+type PriceValueType1Code string
+
+func (r PriceValueType1Code) Validate() error {
+	for _, vv := range []string{
+		"DISC", "PREM", "PARV",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("PriceValueType1Code")
+}
+
+// May be one of FAXI, EDIC, URID, EMAL, POST, SMSM
+type RemittanceLocationMethod2Code string
+
+func (r RemittanceLocationMethod2Code) Validate() error {
+	for _, vv := range []string{
+		"FAXI", "EDIC", "URID", "EMAL", "POST", "SMSM",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("RemittanceLocationMethod2Code")
+}
+
+// May be one of MM01, MM02, MM03, MM04, MM05, MM06, MM07, MM08, MM09, MM10, MM11, MM12, QTR1, QTR2, QTR3, QTR4, HLF1, HLF2
+type TaxRecordPeriod1Code string
+
+func (r TaxRecordPeriod1Code) Validate() error {
+	for _, vv := range []string{
+		"MM01", "MM02", "MM03", "MM04", "MM05", "MM06", "MM07", "MM08", "MM09", "MM10", "MM11", "MM12", "QTR1", "QTR2", "QTR3", "QTR4", "HLF1", "HLF2",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("TaxRecordPeriod1Code")
+}
@@ -12,30 +12,30 @@ import (
 )
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -71,21 +71,21 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -107,22 +107,22 @@ func (r DatePeriod2) Validate() error {
 }
 
 type DatePeriod2Choice struct {
-	FrDt   common.ISODate `xml:"FrDt"`
-	ToDt   common.ISODate `xml:"ToDt"`
-	FrToDt DatePeriod2    `xml:"FrToDt"`
+	FrDt   *common.ISODate `xml:"FrDt,omitempty" json:",omitempty"`
+	ToDt   *common.ISODate `xml:"ToDt,omitempty" json:",omitempty"`
+	FrToDt *DatePeriod2    `xml:"FrToDt,omitempty" json:",omitempty"`
 }
 
 func (r DatePeriod2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -232,21 +232,21 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type RequestType3Choice struct {
-	Cd    StandingOrderQueryType1Code `xml:"Cd"`
-	Prtry GenericIdentification1      `xml:"Prtry"`
+	Cd    *StandingOrderQueryType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification1      `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r RequestType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StandingOrderCriteria3 struct {
@@ -260,12 +260,12 @@ func (r StandingOrderCriteria3) Validate() error {
 }
 
 type StandingOrderCriteria3Choice struct {
-	QryNm   common.Max35Text       `xml:"QryNm"`
-	NewCrit StandingOrderCriteria3 `xml:"NewCrit"`
+	QryNm   *common.Max35Text       `xml:"QryNm,omitempty" json:",omitempty"`
+	NewCrit *StandingOrderCriteria3 `xml:"NewCrit,omitempty" json:",omitempty"`
 }
 
 func (r StandingOrderCriteria3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StandingOrderQuery3 struct {
@@ -322,12 +322,12 @@ func (r StandingOrderSearchCriteria3) Validate() error {
 }
 
 type StandingOrderType1Choice struct {
-	Cd    StandingOrderType1Code `xml:"Cd"`
-	Prtry GenericIdentification1 `xml:"Prtry"`
+	Cd    *StandingOrderType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification1 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r StandingOrderType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SupplementaryData1 struct {
@@ -392,7 +392,7 @@ type StandingOrderOrAll2Choice struct {
 }
 
 func (r StandingOrderOrAll2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountTax1 struct {
@@ -406,8 +406,8 @@ func (r AccountTax1) Validate() error {
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
-	Value float64                             `xml:",chardata"`
-	Ccy   common.ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
@@ -486,12 +486,12 @@ func (r BillingBalance1) Validate() error {
 }
 
 type BillingBalanceType1Choice struct {
-	Cd    ExternalBillingBalanceType1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalBillingBalanceType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BillingBalanceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BillingCompensation1 struct {
@@ -505,12 +505,12 @@ func (r BillingCompensation1) Validate() error {
 }
 
 type BillingCompensationType1Choice struct {
-	Cd    ExternalBillingCompensationType1Code `xml:"Cd"`
-	Prtry common.Max35Text                     `xml:"Prtry"`
+	Cd    *ExternalBillingCompensationType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BillingCompensationType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BillingMethod1 struct {
@@ -525,13 +525,13 @@ func (r BillingMethod1) Validate() error {
 }
 
 type BillingMethod1Choice struct {
-	MtdA BillingMethod1 `xml:"MtdA"`
-	MtdB BillingMethod2 `xml:"MtdB"`
-	MtdD BillingMethod3 `xml:"MtdD"`
+	MtdA *BillingMethod1 `xml:"MtdA,omitempty" json:",omitempty"`
+	MtdB *BillingMethod2 `xml:"MtdB,omitempty" json:",omitempty"`
+	MtdD *BillingMethod3 `xml:"MtdD,omitempty" json:",omitempty"`
 }
 
 func (r BillingMethod1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BillingMethod2 struct {
@@ -585,12 +585,12 @@ func (r BillingRate1) Validate() error {
 }
 
 type BillingRateIdentification1Choice struct {
-	Cd    ExternalBillingRateIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                       `xml:"Prtry"`
+	Cd    *ExternalBillingRateIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                       `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BillingRateIdentification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BillingService2 struct {
@@ -774,12 +774,12 @@ func (r BillingSubServiceIdentification1) Validate() error {
 }
 
 type BillingSubServiceQualifier1Choice struct {
-	Cd    BillingSubServiceQualifier1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *BillingSubServiceQualifier1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BillingSubServiceQualifier1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BillingTaxIdentification2 struct {
@@ -902,12 +902,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OtherContact1 struct {
@@ -939,12 +939,12 @@ func (r ParentCashAccount3) Validate() error {
 }
 
 type Party43Choice struct {
-	OrgId OrganisationIdentification29         `xml:"OrgId"`
-	FIId  FinancialInstitutionIdentification19 `xml:"FIId"`
+	OrgId *OrganisationIdentification29         `xml:"OrgId,omitempty" json:",omitempty"`
+	FIId  *FinancialInstitutionIdentification19 `xml:"FIId,omitempty" json:",omitempty"`
 }
 
 func (r Party43Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification138 struct {
@@ -979,12 +979,12 @@ func (r ReportHeader6) Validate() error {
 }
 
 type ResidenceLocation1Choice struct {
-	Ctry common.CountryCode `xml:"Ctry"`
-	Area common.Max35Text   `xml:"Area"`
+	Ctry *common.CountryCode `xml:"Ctry,omitempty" json:",omitempty"`
+	Area *common.Max35Text   `xml:"Area,omitempty" json:",omitempty"`
 }
 
 func (r ResidenceLocation1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ServiceTaxDesignation1 struct {
@@ -1128,21 +1128,21 @@ func (r OrganisationIdentification8) Validate() error {
 }
 
 type Party11Choice struct {
-	OrgId  OrganisationIdentification8 `xml:"OrgId"`
-	PrvtId PersonIdentification5       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification8 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification5       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party11Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Party12Choice struct {
-	Pty PartyIdentification43                        `xml:"Pty"`
-	Agt BranchAndFinancialInstitutionIdentification5 `xml:"Agt"`
+	Pty *PartyIdentification43                        `xml:"Pty,omitempty" json:",omitempty"`
+	Agt *BranchAndFinancialInstitutionIdentification5 `xml:"Agt,omitempty" json:",omitempty"`
 }
 
 func (r Party12Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification43 struct {
@@ -1167,12 +1167,12 @@ func (r PersonIdentification5) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress6 struct {
@@ -13,17 +13,19 @@ import (
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type3.Validate())
 	type3 = "test"
+	assert.NotNil(t, type3.Validate())
+	type3 = "USABA"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalFinancialInstitutionIdentification1Code
@@ -59,7 +61,7 @@ func TestTypes(t *testing.T) {
 
 	var type9 ExternalBankTransactionDomain1Code
 	assert.NotNil(t, type9.Validate())
-	type9 = "test"
+	type9 = "ACMT"
 	assert.Nil(t, type9.Validate())
 
 	var type10 ExternalBankTransactionFamily1Code
@@ -89,7 +91,7 @@ func TestTypes(t *testing.T) {
 
 	var type15 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type15.Validate())
-	type15 = "test"
+	type15 = "BANK"
 	assert.Nil(t, type15.Validate())
 
 	var type16 CompensationMethod1Code
@@ -202,7 +204,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
 	assert.NotNil(t, ClearingSystemMemberIdentification2{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
-	assert.Nil(t, DatePeriod2Choice{}.Validate())
+	assert.NotNil(t, DatePeriod2Choice{}.Validate())
 	assert.NotNil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
 	assert.Nil(t, FinancialInstitutionIdentification18{}.Validate())
 	assert.NotNil(t, GenericAccountIdentification1{}.Validate())
@@ -227,7 +229,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, MessageHeader1{}.Validate())
 	assert.NotNil(t, StandingOrderIdentification4{}.Validate())
 	assert.NotNil(t, StandingOrderIdentification5{}.Validate())
-	assert.Nil(t, StandingOrderOrAll2Choice{}.Validate())
+	assert.NotNil(t, StandingOrderOrAll2Choice{}.Validate())
 	assert.NotNil(t, AccountTax1{}.Validate())
 	assert.NotNil(t, ActiveOrHistoricCurrencyAndAmount{}.Validate())
 	assert.NotNil(t, AmountAndDirection34{}.Validate())
@@ -277,7 +279,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OtherContact1{}.Validate())
 	assert.NotNil(t, Pagination1{}.Validate())
 	assert.NotNil(t, ParentCashAccount3{}.Validate())
-	assert.Nil(t, Party43Choice{}.Validate())
+	assert.NotNil(t, Party43Choice{}.Validate())
 	assert.NotNil(t, PartyIdentification138{}.Validate())
 	assert.NotNil(t, ProprietaryBankTransactionCodeStructure1{}.Validate())
 	assert.NotNil(t, ReportHeader6{}.Validate())
@@ -295,8 +297,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, FinancialInstitutionIdentification8{}.Validate())
 	assert.NotNil(t, GenericPersonIdentification1{}.Validate())
 	assert.Nil(t, OrganisationIdentification8{}.Validate())
-	assert.Nil(t, Party11Choice{}.Validate())
-	assert.Nil(t, Party12Choice{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
+	assert.NotNil(t, Party12Choice{}.Validate())
 	assert.Nil(t, PartyIdentification43{}.Validate())
 	assert.Nil(t, PersonIdentification5{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
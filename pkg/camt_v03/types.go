@@ -7,6 +7,7 @@ package camt_v03
 import (
 	"reflect"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -17,7 +18,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -27,7 +28,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -37,7 +38,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -47,7 +48,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -57,7 +58,7 @@ func (r ExternalProxyAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalProxyAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalProxyAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -67,7 +68,7 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // May be one of ALLL, CHNG, MODF, DELD
@@ -119,7 +120,7 @@ func (r ExternalBankTransactionDomain1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBankTransactionDomain1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBankTransactionDomain1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -129,7 +130,7 @@ func (r ExternalBankTransactionFamily1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBankTransactionFamily1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBankTransactionFamily1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -139,7 +140,7 @@ func (r ExternalBankTransactionSubFamily1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBankTransactionSubFamily1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBankTransactionSubFamily1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -149,7 +150,7 @@ func (r ExternalBillingBalanceType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBillingBalanceType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBillingBalanceType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -159,7 +160,7 @@ func (r ExternalBillingCompensationType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBillingCompensationType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBillingCompensationType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -169,7 +170,7 @@ func (r ExternalBillingRateIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBillingRateIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBillingRateIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -179,7 +180,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // May be one of NOCP, DBTD, INVD, DDBT
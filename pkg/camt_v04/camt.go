@@ -12,12 +12,12 @@ import (
 )
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -30,12 +30,12 @@ func (r ClearingSystemMemberIdentification2) Validate() error {
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericFinancialIdentification1 struct {
@@ -80,22 +80,22 @@ func (r MemberCriteria4) Validate() error {
 }
 
 type MemberCriteriaDefinition2Choice struct {
-	QryNm   common.Max35Text `xml:"QryNm"`
-	NewCrit MemberCriteria4  `xml:"NewCrit"`
+	QryNm   *common.Max35Text `xml:"QryNm,omitempty" json:",omitempty"`
+	NewCrit *MemberCriteria4  `xml:"NewCrit,omitempty" json:",omitempty"`
 }
 
 func (r MemberCriteriaDefinition2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MemberIdentification3Choice struct {
-	BICFI       common.BICFIDec2014Identifier       `xml:"BICFI"`
-	ClrSysMmbId ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId"`
-	Othr        GenericFinancialIdentification1     `xml:"Othr"`
+	BICFI       *common.BICFIDec2014Identifier       `xml:"BICFI,omitempty" json:",omitempty"`
+	ClrSysMmbId *ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId,omitempty" json:",omitempty"`
+	Othr        *GenericFinancialIdentification1     `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r MemberIdentification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MemberQueryDefinition4 struct {
@@ -142,13 +142,13 @@ func (r MessageHeader9) Validate() error {
 }
 
 type RequestType4Choice struct {
-	PmtCtrl ExternalPaymentControlRequestType1Code `xml:"PmtCtrl"`
-	Enqry   ExternalEnquiryRequestType1Code        `xml:"Enqry"`
-	Prtry   GenericIdentification1                 `xml:"Prtry"`
+	PmtCtrl *ExternalPaymentControlRequestType1Code `xml:"PmtCtrl,omitempty" json:",omitempty"`
+	Enqry   *ExternalEnquiryRequestType1Code        `xml:"Enqry,omitempty" json:",omitempty"`
+	Prtry   *GenericIdentification1                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r RequestType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SupplementaryData1 struct {
@@ -169,39 +169,39 @@ func (r SupplementaryDataEnvelope1) Validate() error {
 }
 
 type SystemMemberStatus1Choice struct {
-	Cd    MemberStatus1Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *MemberStatus1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r SystemMemberStatus1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SystemMemberType1Choice struct {
-	Cd    ExternalSystemMemberType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalSystemMemberType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r SystemMemberType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CashAccount38 struct {
@@ -217,12 +217,12 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CommunicationAddress10 struct {
@@ -247,12 +247,12 @@ func (r ContactIdentificationAndAddress2) Validate() error {
 }
 
 type ErrorHandling1Choice struct {
-	Cd    ErrorHandling1Code          `xml:"Cd"`
-	Prtry common.Max4AlphaNumericText `xml:"Prtry"`
+	Cd    *ErrorHandling1Code          `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max4AlphaNumericText `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ErrorHandling1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ErrorHandling3 struct {
@@ -303,16 +303,16 @@ type MemberReportOrError5Choice struct {
 }
 
 func (r MemberReportOrError5Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MemberReportOrError6Choice struct {
-	Mmb    Member5        `xml:"Mmb"`
-	BizErr ErrorHandling3 `xml:"BizErr"`
+	Mmb    *Member5        `xml:"Mmb,omitempty" json:",omitempty"`
+	BizErr *ErrorHandling3 `xml:"BizErr,omitempty" json:",omitempty"`
 }
 
 func (r MemberReportOrError6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MessageHeader7 struct {
@@ -338,12 +338,12 @@ func (r OriginalBusinessQuery1) Validate() error {
 }
 
 type PaymentRole1Choice struct {
-	Cd    ExternalPaymentRole1Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *ExternalPaymentRole1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PaymentRole1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ProxyAccountIdentification1 struct {
@@ -356,12 +356,12 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReturnMemberV04 struct {
@@ -397,12 +397,12 @@ func (r ContactIdentificationAndAddress1) Validate() error {
 }
 
 type LongPostalAddress1Choice struct {
-	Ustrd common.Max140Text            `xml:"Ustrd"`
-	Strd  StructuredLongPostalAddress1 `xml:"Strd"`
+	Ustrd *common.Max140Text            `xml:"Ustrd,omitempty" json:",omitempty"`
+	Strd  *StructuredLongPostalAddress1 `xml:"Strd,omitempty" json:",omitempty"`
 }
 
 func (r LongPostalAddress1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Member6 struct {
@@ -456,12 +456,12 @@ func (r StructuredLongPostalAddress1) Validate() error {
 }
 
 type CurrencyCriteriaDefinition1Choice struct {
-	QryNm   common.Max35Text          `xml:"QryNm"`
-	NewCrit CurrencyExchangeCriteria2 `xml:"NewCrit"`
+	QryNm   *common.Max35Text          `xml:"QryNm,omitempty" json:",omitempty"`
+	NewCrit *CurrencyExchangeCriteria2 `xml:"NewCrit,omitempty" json:",omitempty"`
 }
 
 func (r CurrencyCriteriaDefinition1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CurrencyExchangeCriteria2 struct {
@@ -536,16 +536,16 @@ type ExchangeRateReportOrError1Choice struct {
 }
 
 func (r ExchangeRateReportOrError1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ExchangeRateReportOrError2Choice struct {
-	BizErr  []ErrorHandling3  `xml:"BizErr" json:",omitempty"`
-	CcyXchg CurrencyExchange7 `xml:"CcyXchg"`
+	BizErr  []ErrorHandling3   `xml:"BizErr" json:",omitempty"`
+	CcyXchg *CurrencyExchange7 `xml:"CcyXchg,omitempty" json:",omitempty"`
 }
 
 func (r ExchangeRateReportOrError2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReturnCurrencyExchangeRateV04 struct {
@@ -579,23 +579,23 @@ func (r BusinessInformationQueryDefinition3) Validate() error {
 }
 
 type CharacterSearch1Choice struct {
-	EQ  common.Max35Text `xml:"EQ"`
-	NEQ common.Max35Text `xml:"NEQ"`
-	CT  common.Max35Text `xml:"CT"`
-	NCT common.Max35Text `xml:"NCT"`
+	EQ  *common.Max35Text `xml:"EQ,omitempty" json:",omitempty"`
+	NEQ *common.Max35Text `xml:"NEQ,omitempty" json:",omitempty"`
+	CT  *common.Max35Text `xml:"CT,omitempty" json:",omitempty"`
+	NCT *common.Max35Text `xml:"NCT,omitempty" json:",omitempty"`
 }
 
 func (r CharacterSearch1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GeneralBusinessInformationCriteriaDefinition1Choice struct {
-	QryNm   common.Max35Text             `xml:"QryNm"`
-	NewCrit BusinessInformationCriteria1 `xml:"NewCrit"`
+	QryNm   *common.Max35Text             `xml:"QryNm,omitempty" json:",omitempty"`
+	NewCrit *BusinessInformationCriteria1 `xml:"NewCrit,omitempty" json:",omitempty"`
 }
 
 func (r GeneralBusinessInformationCriteriaDefinition1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GeneralBusinessInformationReturnCriteria1 struct {
@@ -661,12 +661,12 @@ func (r CaseAssignment5) Validate() error {
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -794,12 +794,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OtherContact1 struct {
@@ -812,21 +812,21 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Party40Choice struct {
-	Pty PartyIdentification135                       `xml:"Pty"`
-	Agt BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
+	Pty *PartyIdentification135                       `xml:"Pty,omitempty" json:",omitempty"`
+	Agt *BranchAndFinancialInstitutionIdentification6 `xml:"Agt,omitempty" json:",omitempty"`
 }
 
 func (r Party40Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification135 struct {
@@ -851,12 +851,12 @@ func (r PersonIdentification13) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress24 struct {
@@ -894,8 +894,8 @@ func (r ReportHeader5) Validate() error {
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64                   `xml:",chardata"`
-	Ccy   common.ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -903,12 +903,12 @@ func (r ActiveCurrencyAndAmount) Validate() error {
 }
 
 type Amount2Choice struct {
-	AmtWthtCcy float64                 `xml:"AmtWthtCcy"`
-	AmtWthCcy  ActiveCurrencyAndAmount `xml:"AmtWthCcy"`
+	AmtWthtCcy *common.ImpliedCurrencyAndAmount `xml:"AmtWthtCcy,omitempty" json:",omitempty"`
+	AmtWthCcy  *ActiveCurrencyAndAmount         `xml:"AmtWthCcy,omitempty" json:",omitempty"`
 }
 
 func (r Amount2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DatePeriodDetails1 struct {
@@ -921,12 +921,12 @@ func (r DatePeriodDetails1) Validate() error {
 }
 
 type ErrorHandling3Choice struct {
-	Cd    ExternalSystemErrorHandling1Code `xml:"Cd"`
-	Prtry common.Max35Text                 `xml:"Prtry"`
+	Cd    *ExternalSystemErrorHandling1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ErrorHandling3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ErrorHandling5 struct {
@@ -939,21 +939,21 @@ func (r ErrorHandling5) Validate() error {
 }
 
 type EventType1Choice struct {
-	Cd    ExternalSystemEventType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalSystemEventType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r EventType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ExecutionType1Choice struct {
-	Tm  common.ISOTime   `xml:"Tm"`
-	Evt EventType1Choice `xml:"Evt"`
+	Tm  *common.ISOTime   `xml:"Tm,omitempty" json:",omitempty"`
+	Evt *EventType1Choice `xml:"Evt,omitempty" json:",omitempty"`
 }
 
 func (r ExecutionType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MessageHeader6 struct {
@@ -969,12 +969,12 @@ func (r MessageHeader6) Validate() error {
 }
 
 type RequestType3Choice struct {
-	Cd    StandingOrderQueryType1Code `xml:"Cd"`
-	Prtry GenericIdentification1      `xml:"Prtry"`
+	Cd    *StandingOrderQueryType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification1      `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r RequestType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReturnStandingOrderV04 struct {
@@ -1031,16 +1031,16 @@ type StandingOrderOrError5Choice struct {
 }
 
 func (r StandingOrderOrError5Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StandingOrderOrError6Choice struct {
-	StgOrdr StandingOrder6   `xml:"StgOrdr"`
+	StgOrdr *StandingOrder6  `xml:"StgOrdr,omitempty" json:",omitempty"`
 	BizErr  []ErrorHandling5 `xml:"BizErr" json:",omitempty"`
 }
 
 func (r StandingOrderOrError6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StandingOrderReport1 struct {
@@ -1064,20 +1064,1516 @@ func (r StandingOrderTotalAmount1) Validate() error {
 }
 
 type StandingOrderType1Choice struct {
-	Cd    StandingOrderType1Code `xml:"Cd"`
-	Prtry GenericIdentification1 `xml:"Prtry"`
+	Cd    *StandingOrderType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification1 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r StandingOrderType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TotalAmountAndCurrency1 struct {
-	TtlAmt    float64                    `xml:"TtlAmt"`
-	CdtDbtInd *common.CreditDebitCode    `xml:"CdtDbtInd,omitempty" json:",omitempty"`
-	Ccy       *common.ActiveCurrencyCode `xml:"Ccy,omitempty" json:",omitempty"`
+	TtlAmt    common.ImpliedCurrencyAndAmount `xml:"TtlAmt"`
+	CdtDbtInd *common.CreditDebitCode         `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	Ccy       *common.ActiveCurrencyCode      `xml:"Ccy,omitempty" json:",omitempty"`
 }
 
 func (r TotalAmountAndCurrency1) Validate() error {
 	return utils.Validate(&r)
 }
+
+type AccountInterest3 struct {
+	Tp     *InterestType1Choice   `xml:"Tp,omitempty" json:",omitempty"`
+	Rate   []Rate3                `xml:"Rate,omitempty" json:",omitempty"`
+	FrToDt *DateTimePeriodDetails `xml:"FrToDt,omitempty" json:",omitempty"`
+	Rsn    *common.Max35Text      `xml:"Rsn,omitempty" json:",omitempty"`
+	Tax    *TaxCharges2           `xml:"Tax,omitempty" json:",omitempty"`
+}
+
+func (r AccountInterest3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AccountNotification7 struct {
+	Id             common.Max35Text           `xml:"Id"`
+	NtfctnPgntn    *Pagination                `xml:"NtfctnPgntn,omitempty" json:",omitempty"`
+	ElctrncSeqNb   float64                    `xml:"ElctrncSeqNb,omitempty" json:",omitempty"`
+	LglSeqNb       float64                    `xml:"LglSeqNb,omitempty" json:",omitempty"`
+	CreDtTm        common.ISODateTime         `xml:"CreDtTm"`
+	FrToDt         *DateTimePeriodDetails     `xml:"FrToDt,omitempty" json:",omitempty"`
+	CpyDplctInd    *common.CopyDuplicate1Code `xml:"CpyDplctInd,omitempty" json:",omitempty"`
+	RptgSrc        *ReportingSource1Choice    `xml:"RptgSrc,omitempty" json:",omitempty"`
+	Acct           CashAccount25              `xml:"Acct"`
+	RltdAcct       *CashAccount24             `xml:"RltdAcct,omitempty" json:",omitempty"`
+	Intrst         []AccountInterest3         `xml:"Intrst,omitempty" json:",omitempty"`
+	TxsSummry      *TotalTransactions4        `xml:"TxsSummry,omitempty" json:",omitempty"`
+	Ntry           []ReportEntry4             `xml:"Ntry,omitempty" json:",omitempty"`
+	AddtlNtfctnInf *common.Max500Text         `xml:"AddtlNtfctnInf,omitempty" json:",omitempty"`
+}
+
+func (r AccountNotification7) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AccountReport16 struct {
+	Id           common.Max35Text           `xml:"Id"`
+	RptPgntn     *Pagination                `xml:"RptPgntn,omitempty" json:",omitempty"`
+	ElctrncSeqNb float64                    `xml:"ElctrncSeqNb,omitempty" json:",omitempty"`
+	LglSeqNb     float64                    `xml:"LglSeqNb,omitempty" json:",omitempty"`
+	CreDtTm      common.ISODateTime         `xml:"CreDtTm"`
+	FrToDt       *DateTimePeriodDetails     `xml:"FrToDt,omitempty" json:",omitempty"`
+	CpyDplctInd  *common.CopyDuplicate1Code `xml:"CpyDplctInd,omitempty" json:",omitempty"`
+	RptgSrc      *ReportingSource1Choice    `xml:"RptgSrc,omitempty" json:",omitempty"`
+	Acct         CashAccount25              `xml:"Acct"`
+	RltdAcct     *CashAccount24             `xml:"RltdAcct,omitempty" json:",omitempty"`
+	Intrst       []AccountInterest3         `xml:"Intrst,omitempty" json:",omitempty"`
+	Bal          []CashBalance3             `xml:"Bal,omitempty" json:",omitempty"`
+	TxsSummry    *TotalTransactions4        `xml:"TxsSummry,omitempty" json:",omitempty"`
+	Ntry         []ReportEntry4             `xml:"Ntry,omitempty" json:",omitempty"`
+	AddtlRptInf  *common.Max500Text         `xml:"AddtlRptInf,omitempty" json:",omitempty"`
+}
+
+func (r AccountReport16) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AccountStatement4 struct {
+	Id           common.Max35Text           `xml:"Id"`
+	StmtPgntn    *Pagination                `xml:"StmtPgntn,omitempty" json:",omitempty"`
+	ElctrncSeqNb float64                    `xml:"ElctrncSeqNb,omitempty" json:",omitempty"`
+	LglSeqNb     float64                    `xml:"LglSeqNb,omitempty" json:",omitempty"`
+	CreDtTm      common.ISODateTime         `xml:"CreDtTm"`
+	FrToDt       *DateTimePeriodDetails     `xml:"FrToDt,omitempty" json:",omitempty"`
+	CpyDplctInd  *common.CopyDuplicate1Code `xml:"CpyDplctInd,omitempty" json:",omitempty"`
+	RptgSrc      *ReportingSource1Choice    `xml:"RptgSrc,omitempty" json:",omitempty"`
+	Acct         CashAccount25              `xml:"Acct"`
+	RltdAcct     *CashAccount24             `xml:"RltdAcct,omitempty" json:",omitempty"`
+	Intrst       []AccountInterest3         `xml:"Intrst,omitempty" json:",omitempty"`
+	Bal          []CashBalance3             `xml:"Bal" json:",omitempty"`
+	TxsSummry    *TotalTransactions4        `xml:"TxsSummry,omitempty" json:",omitempty"`
+	Ntry         []ReportEntry4             `xml:"Ntry,omitempty" json:",omitempty"`
+	AddtlStmtInf *common.Max500Text         `xml:"AddtlStmtInf,omitempty" json:",omitempty"`
+}
+
+func (r AccountStatement4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ActiveOrHistoricCurrencyAnd13DecimalAmount struct {
+	Value common.ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                         `xml:"Ccy,attr"`
+}
+
+func (r ActiveOrHistoricCurrencyAnd13DecimalAmount) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ActiveOrHistoricCurrencyAndAmount struct {
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
+}
+
+func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AmountAndCurrencyExchange3 struct {
+	InstdAmt      *AmountAndCurrencyExchangeDetails3  `xml:"InstdAmt,omitempty" json:",omitempty"`
+	TxAmt         *AmountAndCurrencyExchangeDetails3  `xml:"TxAmt,omitempty" json:",omitempty"`
+	CntrValAmt    *AmountAndCurrencyExchangeDetails3  `xml:"CntrValAmt,omitempty" json:",omitempty"`
+	AnncdPstngAmt *AmountAndCurrencyExchangeDetails3  `xml:"AnncdPstngAmt,omitempty" json:",omitempty"`
+	PrtryAmt      []AmountAndCurrencyExchangeDetails4 `xml:"PrtryAmt,omitempty" json:",omitempty"`
+}
+
+func (r AmountAndCurrencyExchange3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AmountAndCurrencyExchangeDetails3 struct {
+	Amt     ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CcyXchg *CurrencyExchange5                `xml:"CcyXchg,omitempty" json:",omitempty"`
+}
+
+func (r AmountAndCurrencyExchangeDetails3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AmountAndCurrencyExchangeDetails4 struct {
+	Tp      common.Max35Text                  `xml:"Tp"`
+	Amt     ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CcyXchg *CurrencyExchange5                `xml:"CcyXchg,omitempty" json:",omitempty"`
+}
+
+func (r AmountAndCurrencyExchangeDetails4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AmountAndDirection35 struct {
+	Amt       common.NonNegativeDecimalNumber `xml:"Amt"`
+	CdtDbtInd common.CreditDebitCode          `xml:"CdtDbtInd"`
+}
+
+func (r AmountAndDirection35) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AmountRangeBoundary1 struct {
+	BdryAmt common.ImpliedCurrencyAndAmount `xml:"BdryAmt"`
+	Incl    bool                            `xml:"Incl"`
+}
+
+func (r AmountRangeBoundary1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BalanceSubType1Choice struct {
+	Cd    *ExternalBalanceSubType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text            `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r BalanceSubType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type BalanceType12 struct {
+	CdOrPrtry BalanceType5Choice     `xml:"CdOrPrtry"`
+	SubTp     *BalanceSubType1Choice `xml:"SubTp,omitempty" json:",omitempty"`
+}
+
+func (r BalanceType12) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BalanceType5Choice struct {
+	Cd    *BalanceType12Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r BalanceType5Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type BankToCustomerAccountReportV04 struct {
+	XMLName     xml.Name             `xml:"BkToCstmrAcctRpt"`
+	GrpHdr      GroupHeader58        `xml:"GrpHdr"`
+	Rpt         []AccountReport16    `xml:"Rpt" json:",omitempty"`
+	SplmtryData []SupplementaryData1 `xml:"SplmtryData,omitempty" json:",omitempty"`
+}
+
+func (r BankToCustomerAccountReportV04) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BankToCustomerDebitCreditNotificationV04 struct {
+	XMLName     xml.Name               `xml:"BkToCstmrDbtCdtNtfctn"`
+	GrpHdr      GroupHeader58          `xml:"GrpHdr"`
+	Ntfctn      []AccountNotification7 `xml:"Ntfctn" json:",omitempty"`
+	SplmtryData []SupplementaryData1   `xml:"SplmtryData,omitempty" json:",omitempty"`
+}
+
+func (r BankToCustomerDebitCreditNotificationV04) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BankToCustomerStatementV04 struct {
+	XMLName     xml.Name             `xml:"BkToCstmrStmt"`
+	GrpHdr      GroupHeader58        `xml:"GrpHdr"`
+	Stmt        []AccountStatement4  `xml:"Stmt" json:",omitempty"`
+	SplmtryData []SupplementaryData1 `xml:"SplmtryData,omitempty" json:",omitempty"`
+}
+
+func (r BankToCustomerStatementV04) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BankTransactionCodeStructure4 struct {
+	Domn  *BankTransactionCodeStructure5            `xml:"Domn,omitempty" json:",omitempty"`
+	Prtry *ProprietaryBankTransactionCodeStructure1 `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r BankTransactionCodeStructure4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BankTransactionCodeStructure5 struct {
+	Cd   ExternalBankTransactionDomain1Code `xml:"Cd"`
+	Fmly BankTransactionCodeStructure6      `xml:"Fmly"`
+}
+
+func (r BankTransactionCodeStructure5) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BankTransactionCodeStructure6 struct {
+	Cd        ExternalBankTransactionFamily1Code    `xml:"Cd"`
+	SubFmlyCd ExternalBankTransactionSubFamily1Code `xml:"SubFmlyCd"`
+}
+
+func (r BankTransactionCodeStructure6) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BatchInformation2 struct {
+	MsgId     *common.Max35Text                  `xml:"MsgId,omitempty" json:",omitempty"`
+	PmtInfId  *common.Max35Text                  `xml:"PmtInfId,omitempty" json:",omitempty"`
+	NbOfTxs   *common.Max15NumericText           `xml:"NbOfTxs,omitempty" json:",omitempty"`
+	TtlAmt    *ActiveOrHistoricCurrencyAndAmount `xml:"TtlAmt,omitempty" json:",omitempty"`
+	CdtDbtInd *common.CreditDebitCode            `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+}
+
+func (r BatchInformation2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BranchAndFinancialInstitutionIdentification5 struct {
+	FinInstnId FinancialInstitutionIdentification8 `xml:"FinInstnId"`
+	BrnchId    *BranchData2                        `xml:"BrnchId,omitempty" json:",omitempty"`
+}
+
+func (r BranchAndFinancialInstitutionIdentification5) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BranchData2 struct {
+	Id      *common.Max35Text  `xml:"Id,omitempty" json:",omitempty"`
+	Nm      *common.Max140Text `xml:"Nm,omitempty" json:",omitempty"`
+	PstlAdr *PostalAddress6    `xml:"PstlAdr,omitempty" json:",omitempty"`
+}
+
+func (r BranchData2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CardAggregated1 struct {
+	AddtlSvc      *CardPaymentServiceType2Code          `xml:"AddtlSvc,omitempty" json:",omitempty"`
+	TxCtgy        *ExternalCardTransactionCategory1Code `xml:"TxCtgy,omitempty" json:",omitempty"`
+	SaleRcncltnId *common.Max35Text                     `xml:"SaleRcncltnId,omitempty" json:",omitempty"`
+	SeqNbRg       *CardSequenceNumberRange1             `xml:"SeqNbRg,omitempty" json:",omitempty"`
+	TxDtRg        *DateOrDateTimePeriodChoice           `xml:"TxDtRg,omitempty" json:",omitempty"`
+}
+
+func (r CardAggregated1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CardEntry1 struct {
+	Card      *PaymentCard4        `xml:"Card,omitempty" json:",omitempty"`
+	POI       *PointOfInteraction1 `xml:"POI,omitempty" json:",omitempty"`
+	AggtdNtry *CardAggregated1     `xml:"AggtdNtry,omitempty" json:",omitempty"`
+}
+
+func (r CardEntry1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CardIndividualTransaction1 struct {
+	AddtlSvc      *CardPaymentServiceType2Code          `xml:"AddtlSvc,omitempty" json:",omitempty"`
+	TxCtgy        *ExternalCardTransactionCategory1Code `xml:"TxCtgy,omitempty" json:",omitempty"`
+	SaleRcncltnId *common.Max35Text                     `xml:"SaleRcncltnId,omitempty" json:",omitempty"`
+	SaleRefNb     *common.Max35Text                     `xml:"SaleRefNb,omitempty" json:",omitempty"`
+	SeqNb         *common.Max35Text                     `xml:"SeqNb,omitempty" json:",omitempty"`
+	TxId          *TransactionIdentifier1               `xml:"TxId,omitempty" json:",omitempty"`
+	Pdct          *Product2                             `xml:"Pdct,omitempty" json:",omitempty"`
+	VldtnDt       *common.ISODate                       `xml:"VldtnDt,omitempty" json:",omitempty"`
+	VldtnSeqNb    *common.Max35Text                     `xml:"VldtnSeqNb,omitempty" json:",omitempty"`
+}
+
+func (r CardIndividualTransaction1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CardSecurityInformation1 struct {
+	CSCMgmt CSCManagement1Code          `xml:"CSCMgmt"`
+	CSCVal  *common.Min3Max4NumericText `xml:"CSCVal,omitempty" json:",omitempty"`
+}
+
+func (r CardSecurityInformation1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CardSequenceNumberRange1 struct {
+	FrstTx *common.Max35Text `xml:"FrstTx,omitempty" json:",omitempty"`
+	LastTx *common.Max35Text `xml:"LastTx,omitempty" json:",omitempty"`
+}
+
+func (r CardSequenceNumberRange1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CardTransaction1 struct {
+	Card *PaymentCard4           `xml:"Card,omitempty" json:",omitempty"`
+	POI  *PointOfInteraction1    `xml:"POI,omitempty" json:",omitempty"`
+	Tx   *CardTransaction1Choice `xml:"Tx,omitempty" json:",omitempty"`
+}
+
+func (r CardTransaction1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CardTransaction1Choice struct {
+	Aggtd *CardAggregated1            `xml:"Aggtd,omitempty" json:",omitempty"`
+	Indv  *CardIndividualTransaction1 `xml:"Indv,omitempty" json:",omitempty"`
+}
+
+func (r CardTransaction1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type CashAccount24 struct {
+	Id  AccountIdentification4Choice         `xml:"Id"`
+	Tp  *CashAccountType2Choice              `xml:"Tp,omitempty" json:",omitempty"`
+	Ccy *common.ActiveOrHistoricCurrencyCode `xml:"Ccy,omitempty" json:",omitempty"`
+	Nm  *common.Max70Text                    `xml:"Nm,omitempty" json:",omitempty"`
+}
+
+func (r CashAccount24) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashAccount25 struct {
+	Id   AccountIdentification4Choice                  `xml:"Id"`
+	Tp   *CashAccountType2Choice                       `xml:"Tp,omitempty" json:",omitempty"`
+	Ccy  *common.ActiveOrHistoricCurrencyCode          `xml:"Ccy,omitempty" json:",omitempty"`
+	Nm   *common.Max70Text                             `xml:"Nm,omitempty" json:",omitempty"`
+	Ownr *PartyIdentification43                        `xml:"Ownr,omitempty" json:",omitempty"`
+	Svcr *BranchAndFinancialInstitutionIdentification5 `xml:"Svcr,omitempty" json:",omitempty"`
+}
+
+func (r CashAccount25) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashBalance3 struct {
+	Tp        BalanceType12                     `xml:"Tp"`
+	CdtLine   *CreditLine2                      `xml:"CdtLine,omitempty" json:",omitempty"`
+	Amt       ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd common.CreditDebitCode            `xml:"CdtDbtInd"`
+	Dt        DateAndDateTimeChoice             `xml:"Dt"`
+	Avlbty    []CashBalanceAvailability2        `xml:"Avlbty,omitempty" json:",omitempty"`
+}
+
+func (r CashBalance3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashBalanceAvailability2 struct {
+	Dt        CashBalanceAvailabilityDate1      `xml:"Dt"`
+	Amt       ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd common.CreditDebitCode            `xml:"CdtDbtInd"`
+}
+
+func (r CashBalanceAvailability2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashBalanceAvailabilityDate1 struct {
+	NbOfDays *common.Max15PlusSignedNumericText `xml:"NbOfDays,omitempty" json:",omitempty"`
+	ActlDt   *common.ISODate                    `xml:"ActlDt,omitempty" json:",omitempty"`
+}
+
+func (r CashBalanceAvailabilityDate1) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type CashDeposit1 struct {
+	NoteDnmtn ActiveCurrencyAndAmount `xml:"NoteDnmtn"`
+	NbOfNotes common.Max15NumericText `xml:"NbOfNotes"`
+	Amt       ActiveCurrencyAndAmount `xml:"Amt"`
+}
+
+func (r CashDeposit1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ChargeType3Choice struct {
+	Cd    *ExternalChargeType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification3  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ChargeType3Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type Charges4 struct {
+	TtlChrgsAndTaxAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlChrgsAndTaxAmt,omitempty" json:",omitempty"`
+	Rcrd              []ChargesRecord2                   `xml:"Rcrd,omitempty" json:",omitempty"`
+}
+
+func (r Charges4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ChargesRecord2 struct {
+	Amt         ActiveOrHistoricCurrencyAndAmount             `xml:"Amt"`
+	CdtDbtInd   *common.CreditDebitCode                       `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	ChrgInclInd bool                                          `xml:"ChrgInclInd,omitempty" json:",omitempty"`
+	Tp          *ChargeType3Choice                            `xml:"Tp,omitempty" json:",omitempty"`
+	Rate        float64                                       `xml:"Rate,omitempty" json:",omitempty"`
+	Br          *ChargeBearerType1Code                        `xml:"Br,omitempty" json:",omitempty"`
+	Agt         *BranchAndFinancialInstitutionIdentification5 `xml:"Agt,omitempty" json:",omitempty"`
+	Tax         *TaxCharges2                                  `xml:"Tax,omitempty" json:",omitempty"`
+}
+
+func (r ChargesRecord2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ContactDetails2 struct {
+	NmPrfx   *common.NamePrefix1Code `xml:"NmPrfx,omitempty" json:",omitempty"`
+	Nm       *common.Max140Text      `xml:"Nm,omitempty" json:",omitempty"`
+	PhneNb   *common.PhoneNumber     `xml:"PhneNb,omitempty" json:",omitempty"`
+	MobNb    *common.PhoneNumber     `xml:"MobNb,omitempty" json:",omitempty"`
+	FaxNb    *common.PhoneNumber     `xml:"FaxNb,omitempty" json:",omitempty"`
+	EmailAdr *common.Max2048Text     `xml:"EmailAdr,omitempty" json:",omitempty"`
+	Othr     *common.Max35Text       `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r ContactDetails2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CorporateAction9 struct {
+	EvtTp common.Max35Text `xml:"EvtTp"`
+	EvtId common.Max35Text `xml:"EvtId"`
+}
+
+func (r CorporateAction9) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CreditLine2 struct {
+	Incl bool                               `xml:"Incl"`
+	Amt  *ActiveOrHistoricCurrencyAndAmount `xml:"Amt,omitempty" json:",omitempty"`
+}
+
+func (r CreditLine2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CreditorReferenceInformation2 struct {
+	Tp  *CreditorReferenceType2 `xml:"Tp,omitempty" json:",omitempty"`
+	Ref *common.Max35Text       `xml:"Ref,omitempty" json:",omitempty"`
+}
+
+func (r CreditorReferenceInformation2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CreditorReferenceType1Choice struct {
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r CreditorReferenceType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type CreditorReferenceType2 struct {
+	CdOrPrtry CreditorReferenceType1Choice `xml:"CdOrPrtry"`
+	Issr      common.Max35Text             `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r CreditorReferenceType2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CurrencyAndAmountRange2 struct {
+	Amt       ImpliedCurrencyAmountRangeChoice    `xml:"Amt"`
+	CdtDbtInd *common.CreditDebitCode             `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	Ccy       common.ActiveOrHistoricCurrencyCode `xml:"Ccy"`
+}
+
+func (r CurrencyAndAmountRange2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CurrencyExchange5 struct {
+	SrcCcy   common.ActiveOrHistoricCurrencyCode  `xml:"SrcCcy"`
+	TrgtCcy  *common.ActiveOrHistoricCurrencyCode `xml:"TrgtCcy,omitempty" json:",omitempty"`
+	UnitCcy  *common.ActiveOrHistoricCurrencyCode `xml:"UnitCcy,omitempty" json:",omitempty"`
+	XchgRate float64                              `xml:"XchgRate"`
+	CtrctId  *common.Max35Text                    `xml:"CtrctId,omitempty" json:",omitempty"`
+	QtnDt    *common.ISODateTime                  `xml:"QtnDt,omitempty" json:",omitempty"`
+}
+
+func (r CurrencyExchange5) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DateAndDateTimeChoice struct {
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
+}
+
+func (r DateAndDateTimeChoice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type DateAndPlaceOfBirth struct {
+	BirthDt     common.ISODate     `xml:"BirthDt"`
+	PrvcOfBirth *common.Max35Text  `xml:"PrvcOfBirth,omitempty" json:",omitempty"`
+	CityOfBirth common.Max35Text   `xml:"CityOfBirth"`
+	CtryOfBirth common.CountryCode `xml:"CtryOfBirth"`
+}
+
+func (r DateAndPlaceOfBirth) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DateOrDateTimePeriodChoice struct {
+	Dt   *DatePeriodDetails     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *DateTimePeriodDetails `xml:"DtTm,omitempty" json:",omitempty"`
+}
+
+func (r DateOrDateTimePeriodChoice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type DatePeriodDetails struct {
+	FrDt common.ISODate `xml:"FrDt"`
+	ToDt common.ISODate `xml:"ToDt"`
+}
+
+func (r DatePeriodDetails) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DateTimePeriodDetails struct {
+	FrDtTm common.ISODateTime `xml:"FrDtTm"`
+	ToDtTm common.ISODateTime `xml:"ToDtTm"`
+}
+
+func (r DateTimePeriodDetails) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DiscountAmountAndType1 struct {
+	Tp  *DiscountAmountType1Choice        `xml:"Tp,omitempty" json:",omitempty"`
+	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+}
+
+func (r DiscountAmountAndType1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DiscountAmountType1Choice struct {
+	Cd    *ExternalDiscountAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r DiscountAmountType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type DisplayCapabilities1 struct {
+	DispTp    UserInterface2Code      `xml:"DispTp"`
+	NbOfLines *common.Max3NumericText `xml:"NbOfLines"`
+	LineWidth *common.Max3NumericText `xml:"LineWidth"`
+}
+
+func (r DisplayCapabilities1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DocumentAdjustment1 struct {
+	Amt       ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd *common.CreditDebitCode           `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	Rsn       *common.Max4Text                  `xml:"Rsn,omitempty" json:",omitempty"`
+	AddtlInf  *common.Max140Text                `xml:"AddtlInf,omitempty" json:",omitempty"`
+}
+
+func (r DocumentAdjustment1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type EntryDetails3 struct {
+	Btch   *BatchInformation2  `xml:"Btch,omitempty" json:",omitempty"`
+	TxDtls []EntryTransaction4 `xml:"TxDtls,omitempty" json:",omitempty"`
+}
+
+func (r EntryDetails3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type EntryTransaction4 struct {
+	Refs        *TransactionReferences3            `xml:"Refs,omitempty" json:",omitempty"`
+	Amt         *ActiveOrHistoricCurrencyAndAmount `xml:"Amt,omitempty" json:",omitempty"`
+	CdtDbtInd   *common.CreditDebitCode            `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	AmtDtls     *AmountAndCurrencyExchange3        `xml:"AmtDtls,omitempty" json:",omitempty"`
+	Avlbty      []CashBalanceAvailability2         `xml:"Avlbty,omitempty" json:",omitempty"`
+	BkTxCd      *BankTransactionCodeStructure4     `xml:"BkTxCd,omitempty" json:",omitempty"`
+	Chrgs       *Charges4                          `xml:"Chrgs,omitempty" json:",omitempty"`
+	Intrst      *TransactionInterest3              `xml:"Intrst,omitempty" json:",omitempty"`
+	RltdPties   *TransactionParties3               `xml:"RltdPties,omitempty" json:",omitempty"`
+	RltdAgts    *TransactionAgents3                `xml:"RltdAgts,omitempty" json:",omitempty"`
+	Purp        *Purpose2Choice                    `xml:"Purp,omitempty" json:",omitempty"`
+	RltdRmtInf  []RemittanceLocation2              `xml:"RltdRmtInf,omitempty" json:",omitempty"`
+	RmtInf      *RemittanceInformation7            `xml:"RmtInf,omitempty" json:",omitempty"`
+	RltdDts     *TransactionDates2                 `xml:"RltdDts,omitempty" json:",omitempty"`
+	RltdPric    *TransactionPrice3Choice           `xml:"RltdPric,omitempty" json:",omitempty"`
+	RltdQties   []TransactionQuantities2Choice     `xml:"RltdQties,omitempty" json:",omitempty"`
+	FinInstrmId *SecurityIdentification14          `xml:"FinInstrmId,omitempty" json:",omitempty"`
+	Tax         *TaxInformation3                   `xml:"Tax,omitempty" json:",omitempty"`
+	RtrInf      *PaymentReturnReason2              `xml:"RtrInf,omitempty" json:",omitempty"`
+	CorpActn    *CorporateAction9                  `xml:"CorpActn,omitempty" json:",omitempty"`
+	SfkpgAcct   *SecuritiesAccount13               `xml:"SfkpgAcct,omitempty" json:",omitempty"`
+	CshDpst     []CashDeposit1                     `xml:"CshDpst,omitempty" json:",omitempty"`
+	CardTx      *CardTransaction1                  `xml:"CardTx,omitempty" json:",omitempty"`
+	AddtlTxInf  *common.Max500Text                 `xml:"AddtlTxInf,omitempty" json:",omitempty"`
+	SplmtryData []SupplementaryData1               `xml:"SplmtryData,omitempty" json:",omitempty"`
+}
+
+func (r EntryTransaction4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type FinancialInstitutionIdentification8 struct {
+	BICFI       *common.BICFIIdentifier              `xml:"BICFI,omitempty" json:",omitempty"`
+	ClrSysMmbId *ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId,omitempty" json:",omitempty"`
+	Nm          *common.Max140Text                   `xml:"Nm,omitempty" json:",omitempty"`
+	PstlAdr     *PostalAddress6                      `xml:"PstlAdr,omitempty" json:",omitempty"`
+	Othr        *GenericFinancialIdentification1     `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r FinancialInstitutionIdentification8) Validate() error {
+	return utils.Validate(&r)
+}
+
+type FinancialInstrumentQuantityChoice struct {
+	Unit     *float64                         `xml:"Unit,omitempty" json:",omitempty"`
+	FaceAmt  *common.ImpliedCurrencyAndAmount `xml:"FaceAmt,omitempty" json:",omitempty"`
+	AmtsdVal *common.ImpliedCurrencyAndAmount `xml:"AmtsdVal,omitempty" json:",omitempty"`
+}
+
+func (r FinancialInstrumentQuantityChoice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type FromToAmountRange struct {
+	FrAmt AmountRangeBoundary1 `xml:"FrAmt"`
+	ToAmt AmountRangeBoundary1 `xml:"ToAmt"`
+}
+
+func (r FromToAmountRange) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericIdentification20 struct {
+	Id      common.Exact4AlphaNumericText `xml:"Id"`
+	Issr    common.Max35Text              `xml:"Issr"`
+	SchmeNm *common.Max35Text             `xml:"SchmeNm,omitempty" json:",omitempty"`
+}
+
+func (r GenericIdentification20) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericIdentification3 struct {
+	Id   common.Max35Text  `xml:"Id"`
+	Issr *common.Max35Text `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r GenericIdentification3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericIdentification32 struct {
+	Id     common.Max35Text  `xml:"Id"`
+	Tp     *PartyType3Code   `xml:"Tp,omitempty" json:",omitempty"`
+	Issr   *PartyType4Code   `xml:"Issr,omitempty" json:",omitempty"`
+	ShrtNm *common.Max35Text `xml:"ShrtNm,omitempty" json:",omitempty"`
+}
+
+func (r GenericIdentification32) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GroupHeader58 struct {
+	MsgId       common.Max35Text        `xml:"MsgId"`
+	CreDtTm     common.ISODateTime      `xml:"CreDtTm"`
+	MsgRcpt     *PartyIdentification43  `xml:"MsgRcpt,omitempty" json:",omitempty"`
+	MsgPgntn    *Pagination             `xml:"MsgPgntn,omitempty" json:",omitempty"`
+	OrgnlBizQry *OriginalBusinessQuery1 `xml:"OrgnlBizQry,omitempty" json:",omitempty"`
+	AddtlInf    *common.Max500Text      `xml:"AddtlInf,omitempty" json:",omitempty"`
+}
+
+func (r GroupHeader58) Validate() error {
+	return utils.Validate(&r)
+}
+
+type IdentificationSource3Choice struct {
+	Cd    *ExternalFinancialInstrumentIdentificationType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                   `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r IdentificationSource3Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ImpliedCurrencyAmountRangeChoice struct {
+	FrAmt   *AmountRangeBoundary1            `xml:"FrAmt,omitempty" json:",omitempty"`
+	ToAmt   *AmountRangeBoundary1            `xml:"ToAmt,omitempty" json:",omitempty"`
+	FrToAmt *FromToAmountRange               `xml:"FrToAmt,omitempty" json:",omitempty"`
+	EQAmt   *common.ImpliedCurrencyAndAmount `xml:"EQAmt,omitempty" json:",omitempty"`
+	NEQAmt  *common.ImpliedCurrencyAndAmount `xml:"NEQAmt,omitempty" json:",omitempty"`
+}
+
+func (r ImpliedCurrencyAmountRangeChoice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type InterestRecord1 struct {
+	Amt       ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd common.CreditDebitCode            `xml:"CdtDbtInd"`
+	Tp        *InterestType1Choice              `xml:"Tp,omitempty" json:",omitempty"`
+	Rate      *Rate3                            `xml:"Rate,omitempty" json:",omitempty"`
+	FrToDt    *DateTimePeriodDetails            `xml:"FrToDt,omitempty" json:",omitempty"`
+	Rsn       *common.Max35Text                 `xml:"Rsn,omitempty" json:",omitempty"`
+	Tax       *TaxCharges2                      `xml:"Tax,omitempty" json:",omitempty"`
+}
+
+func (r InterestRecord1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type InterestType1Choice struct {
+	Cd    *common.InterestType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r InterestType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type MessageIdentification2 struct {
+	MsgNmId *common.Max35Text `xml:"MsgNmId,omitempty" json:",omitempty"`
+	MsgId   *common.Max35Text `xml:"MsgId,omitempty" json:",omitempty"`
+}
+
+func (r MessageIdentification2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type NameAndAddress10 struct {
+	Nm  common.Max140Text `xml:"Nm"`
+	Adr PostalAddress6    `xml:"Adr"`
+}
+
+func (r NameAndAddress10) Validate() error {
+	return utils.Validate(&r)
+}
+
+type NumberAndSumOfTransactions1 struct {
+	NbOfNtries *common.Max15NumericText `xml:"NbOfNtries,omitempty" json:",omitempty"`
+	Sum        *common.DecimalNumber    `xml:"Sum,omitempty" json:",omitempty"`
+}
+
+func (r NumberAndSumOfTransactions1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type NumberAndSumOfTransactions3 struct {
+	NbOfNtries *common.Max15NumericText `xml:"NbOfNtries,omitempty" json:",omitempty"`
+	Sum        *common.DecimalNumber    `xml:"Sum,omitempty" json:",omitempty"`
+	TtlNetNtry *AmountAndDirection35    `xml:"TtlNetNtry,omitempty" json:",omitempty"`
+}
+
+func (r NumberAndSumOfTransactions3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type OrganisationIdentification8 struct {
+	AnyBIC *common.AnyBICIdentifier             `xml:"AnyBIC,omitempty" json:",omitempty"`
+	Othr   []GenericOrganisationIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r OrganisationIdentification8) Validate() error {
+	return utils.Validate(&r)
+}
+
+type OriginalAndCurrentQuantities1 struct {
+	FaceAmt  common.ImpliedCurrencyAndAmount `xml:"FaceAmt"`
+	AmtsdVal common.ImpliedCurrencyAndAmount `xml:"AmtsdVal"`
+}
+
+func (r OriginalAndCurrentQuantities1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type OtherIdentification1 struct {
+	Id  common.Max35Text            `xml:"Id"`
+	Sfx *common.Max16Text           `xml:"Sfx,omitempty" json:",omitempty"`
+	Tp  IdentificationSource3Choice `xml:"Tp"`
+}
+
+func (r OtherIdentification1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type Pagination struct {
+	PgNb      common.Max5NumericText `xml:"PgNb"`
+	LastPgInd bool                   `xml:"LastPgInd"`
+}
+
+func (r Pagination) Validate() error {
+	return utils.Validate(&r)
+}
+
+type Party11Choice struct {
+	OrgId  *OrganisationIdentification8 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification5       `xml:"PrvtId,omitempty" json:",omitempty"`
+}
+
+func (r Party11Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type PartyIdentification43 struct {
+	Nm        *common.Max140Text  `xml:"Nm,omitempty" json:",omitempty"`
+	PstlAdr   *PostalAddress6     `xml:"PstlAdr,omitempty" json:",omitempty"`
+	Id        *Party11Choice      `xml:"Id,omitempty" json:",omitempty"`
+	CtryOfRes *common.CountryCode `xml:"CtryOfRes,omitempty" json:",omitempty"`
+	CtctDtls  *ContactDetails2    `xml:"CtctDtls,omitempty" json:",omitempty"`
+}
+
+func (r PartyIdentification43) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PaymentCard4 struct {
+	PlainCardData *PlainCardData1         `xml:"PlainCardData,omitempty" json:",omitempty"`
+	CardCtryCd    *Exact3NumericText      `xml:"CardCtryCd,omitempty" json:",omitempty"`
+	CardBrnd      *GenericIdentification1 `xml:"CardBrnd,omitempty" json:",omitempty"`
+	AddtlCardData *common.Max70Text       `xml:"AddtlCardData,omitempty" json:",omitempty"`
+}
+
+func (r PaymentCard4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PaymentReturnReason2 struct {
+	OrgnlBkTxCd *BankTransactionCodeStructure4 `xml:"OrgnlBkTxCd,omitempty" json:",omitempty"`
+	Orgtr       *PartyIdentification43         `xml:"Orgtr,omitempty" json:",omitempty"`
+	Rsn         *ReturnReason5Choice           `xml:"Rsn,omitempty" json:",omitempty"`
+	AddtlInf    []common.Max105Text            `xml:"AddtlInf,omitempty" json:",omitempty"`
+}
+
+func (r PaymentReturnReason2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PersonIdentification5 struct {
+	DtAndPlcOfBirth *DateAndPlaceOfBirth           `xml:"DtAndPlcOfBirth,omitempty" json:",omitempty"`
+	Othr            []GenericPersonIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r PersonIdentification5) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PlainCardData1 struct {
+	PAN        common.Min8Max28NumericText `xml:"PAN"`
+	CardSeqNb  *common.Min2Max3NumericText `xml:"CardSeqNb,omitempty" json:",omitempty"`
+	FctvDt     *common.ISOYearMonth        `xml:"FctvDt,omitempty" json:",omitempty"`
+	XpryDt     common.ISOYearMonth         `xml:"XpryDt"`
+	SvcCd      *Exact3NumericText          `xml:"SvcCd,omitempty" json:",omitempty"`
+	TrckData   []TrackData1                `xml:"TrckData,omitempty" json:",omitempty"`
+	CardSctyCd *CardSecurityInformation1   `xml:"CardSctyCd,omitempty" json:",omitempty"`
+}
+
+func (r PlainCardData1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PointOfInteraction1 struct {
+	Id       GenericIdentification32          `xml:"Id"`
+	SysNm    *common.Max70Text                `xml:"SysNm,omitempty" json:",omitempty"`
+	GrpId    *common.Max35Text                `xml:"GrpId,omitempty" json:",omitempty"`
+	Cpblties *PointOfInteractionCapabilities1 `xml:"Cpblties,omitempty" json:",omitempty"`
+	Cmpnt    []PointOfInteractionComponent1   `xml:"Cmpnt,omitempty" json:",omitempty"`
+}
+
+func (r PointOfInteraction1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PointOfInteractionCapabilities1 struct {
+	CardRdngCpblties      []CardDataReading1Code                  `xml:"CardRdngCpblties,omitempty" json:",omitempty"`
+	CrdhldrVrfctnCpblties []CardholderVerificationCapability1Code `xml:"CrdhldrVrfctnCpblties,omitempty" json:",omitempty"`
+	OnLineCpblties        *OnLineCapability1Code                  `xml:"OnLineCpblties,omitempty" json:",omitempty"`
+	DispCpblties          []DisplayCapabilities1                  `xml:"DispCpblties,omitempty" json:",omitempty"`
+	PrtLineWidth          *common.Max3NumericText                 `xml:"PrtLineWidth,omitempty" json:",omitempty"`
+}
+
+func (r PointOfInteractionCapabilities1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PointOfInteractionComponent1 struct {
+	POICmpntTp POIComponentType1Code `xml:"POICmpntTp"`
+	ManfctrId  *common.Max35Text     `xml:"ManfctrId,omitempty" json:",omitempty"`
+	Mdl        *common.Max35Text     `xml:"Mdl,omitempty" json:",omitempty"`
+	VrsnNb     *common.Max16Text     `xml:"VrsnNb,omitempty" json:",omitempty"`
+	SrlNb      *common.Max35Text     `xml:"SrlNb,omitempty" json:",omitempty"`
+	ApprvlNb   []common.Max70Text    `xml:"ApprvlNb,omitempty" json:",omitempty"`
+}
+
+func (r PointOfInteractionComponent1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PostalAddress6 struct {
+	AdrTp       *common.AddressType2Code `xml:"AdrTp,omitempty" json:",omitempty"`
+	Dept        *common.Max70Text        `xml:"Dept,omitempty" json:",omitempty"`
+	SubDept     *common.Max70Text        `xml:"SubDept,omitempty" json:",omitempty"`
+	StrtNm      *common.Max70Text        `xml:"StrtNm,omitempty" json:",omitempty"`
+	BldgNb      *common.Max16Text        `xml:"BldgNb,omitempty" json:",omitempty"`
+	PstCd       *common.Max16Text        `xml:"PstCd,omitempty" json:",omitempty"`
+	TwnNm       *common.Max35Text        `xml:"TwnNm,omitempty" json:",omitempty"`
+	CtrySubDvsn *common.Max35Text        `xml:"CtrySubDvsn,omitempty" json:",omitempty"`
+	Ctry        *common.CountryCode      `xml:"Ctry,omitempty" json:",omitempty"`
+	AdrLine     []common.Max70Text       `xml:"AdrLine,omitempty" json:",omitempty"`
+}
+
+func (r PostalAddress6) Validate() error {
+	return utils.Validate(&r)
+}
+
+type Price2 struct {
+	Tp  YieldedOrValueType1Choice `xml:"Tp"`
+	Val PriceRateOrAmountChoice   `xml:"Val"`
+}
+
+func (r Price2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PriceRateOrAmountChoice struct {
+	Rate *float64                                    `xml:"Rate,omitempty" json:",omitempty"`
+	Amt  *ActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"Amt,omitempty" json:",omitempty"`
+}
+
+func (r PriceRateOrAmountChoice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type Product2 struct {
+	PdctCd       common.Max70Text                 `xml:"PdctCd"`
+	UnitOfMeasr  *UnitOfMeasure1Code              `xml:"UnitOfMeasr,omitempty" json:",omitempty"`
+	PdctQty      float64                          `xml:"PdctQty,omitempty" json:",omitempty"`
+	UnitPric     *common.ImpliedCurrencyAndAmount `xml:"UnitPric,omitempty" json:",omitempty"`
+	PdctAmt      *common.ImpliedCurrencyAndAmount `xml:"PdctAmt,omitempty" json:",omitempty"`
+	TaxTp        *common.Max35Text                `xml:"TaxTp,omitempty" json:",omitempty"`
+	AddtlPdctInf *common.Max35Text                `xml:"AddtlPdctInf,omitempty" json:",omitempty"`
+}
+
+func (r Product2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryAgent3 struct {
+	Tp  common.Max35Text                             `xml:"Tp"`
+	Agt BranchAndFinancialInstitutionIdentification5 `xml:"Agt"`
+}
+
+func (r ProprietaryAgent3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryBankTransactionCodeStructure1 struct {
+	Cd   common.Max35Text  `xml:"Cd"`
+	Issr *common.Max35Text `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r ProprietaryBankTransactionCodeStructure1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryDate2 struct {
+	Tp common.Max35Text      `xml:"Tp"`
+	Dt DateAndDateTimeChoice `xml:"Dt"`
+}
+
+func (r ProprietaryDate2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryParty3 struct {
+	Tp  common.Max35Text      `xml:"Tp"`
+	Pty PartyIdentification43 `xml:"Pty"`
+}
+
+func (r ProprietaryParty3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryPrice2 struct {
+	Tp   common.Max35Text                  `xml:"Tp"`
+	Pric ActiveOrHistoricCurrencyAndAmount `xml:"Pric"`
+}
+
+func (r ProprietaryPrice2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryQuantity1 struct {
+	Tp  common.Max35Text `xml:"Tp"`
+	Qty common.Max35Text `xml:"Qty"`
+}
+
+func (r ProprietaryQuantity1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ProprietaryReference1 struct {
+	Tp  common.Max35Text `xml:"Tp"`
+	Ref common.Max35Text `xml:"Ref"`
+}
+
+func (r ProprietaryReference1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type Purpose2Choice struct {
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r Purpose2Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type Rate3 struct {
+	Tp      RateType4Choice          `xml:"Tp"`
+	VldtyRg *CurrencyAndAmountRange2 `xml:"VldtyRg,omitempty" json:",omitempty"`
+}
+
+func (r Rate3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RateType4Choice struct {
+	Pctg *float64          `xml:"Pctg,omitempty" json:",omitempty"`
+	Othr *common.Max35Text `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r RateType4Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ReferredDocumentInformation3 struct {
+	Tp     *ReferredDocumentType2 `xml:"Tp,omitempty" json:",omitempty"`
+	Nb     *common.Max35Text      `xml:"Nb,omitempty" json:",omitempty"`
+	RltdDt *common.ISODate        `xml:"RltdDt,omitempty" json:",omitempty"`
+}
+
+func (r ReferredDocumentInformation3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ReferredDocumentType1Choice struct {
+	Cd    *DocumentType5Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ReferredDocumentType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ReferredDocumentType2 struct {
+	CdOrPrtry ReferredDocumentType1Choice `xml:"CdOrPrtry"`
+	Issr      *common.Max35Text           `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r ReferredDocumentType2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RemittanceAmount2 struct {
+	DuePyblAmt        *ActiveOrHistoricCurrencyAndAmount `xml:"DuePyblAmt,omitempty" json:",omitempty"`
+	DscntApldAmt      []DiscountAmountAndType1           `xml:"DscntApldAmt,omitempty" json:",omitempty"`
+	CdtNoteAmt        *ActiveOrHistoricCurrencyAndAmount `xml:"CdtNoteAmt,omitempty" json:",omitempty"`
+	TaxAmt            []TaxAmountAndType1                `xml:"TaxAmt,omitempty" json:",omitempty"`
+	AdjstmntAmtAndRsn []DocumentAdjustment1              `xml:"AdjstmntAmtAndRsn,omitempty" json:",omitempty"`
+	RmtdAmt           *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt,omitempty" json:",omitempty"`
+}
+
+func (r RemittanceAmount2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RemittanceInformation7 struct {
+	Ustrd []common.Max140Text                `xml:"Ustrd,omitempty" json:",omitempty"`
+	Strd  []StructuredRemittanceInformation9 `xml:"Strd,omitempty" json:",omitempty"`
+}
+
+func (r RemittanceInformation7) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RemittanceLocation2 struct {
+	RmtId             *common.Max35Text              `xml:"RmtId,omitempty" json:",omitempty"`
+	RmtLctnMtd        *RemittanceLocationMethod2Code `xml:"RmtLctnMtd,omitempty" json:",omitempty"`
+	RmtLctnElctrncAdr *common.Max2048Text            `xml:"RmtLctnElctrncAdr,omitempty" json:",omitempty"`
+	RmtLctnPstlAdr    *NameAndAddress10              `xml:"RmtLctnPstlAdr,omitempty" json:",omitempty"`
+}
+
+func (r RemittanceLocation2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ReportEntry4 struct {
+	NtryRef       *common.Max35Text                 `xml:"NtryRef,omitempty" json:",omitempty"`
+	Amt           ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd     common.CreditDebitCode            `xml:"CdtDbtInd"`
+	RvslInd       bool                              `xml:"RvslInd,omitempty" json:",omitempty"`
+	Sts           EntryStatus2Code                  `xml:"Sts"`
+	BookgDt       *DateAndDateTimeChoice            `xml:"BookgDt,omitempty" json:",omitempty"`
+	ValDt         *DateAndDateTimeChoice            `xml:"ValDt,omitempty" json:",omitempty"`
+	AcctSvcrRef   *common.Max35Text                 `xml:"AcctSvcrRef,omitempty" json:",omitempty"`
+	Avlbty        []CashBalanceAvailability2        `xml:"Avlbty,omitempty" json:",omitempty"`
+	BkTxCd        BankTransactionCodeStructure4     `xml:"BkTxCd"`
+	ComssnWvrInd  bool                              `xml:"ComssnWvrInd,omitempty" json:",omitempty"`
+	AddtlInfInd   *MessageIdentification2           `xml:"AddtlInfInd,omitempty" json:",omitempty"`
+	AmtDtls       *AmountAndCurrencyExchange3       `xml:"AmtDtls,omitempty" json:",omitempty"`
+	Chrgs         *Charges4                         `xml:"Chrgs,omitempty" json:",omitempty"`
+	TechInptChanl *TechnicalInputChannel1Choice     `xml:"TechInptChanl,omitempty" json:",omitempty"`
+	Intrst        *TransactionInterest3             `xml:"Intrst,omitempty" json:",omitempty"`
+	CardTx        *CardEntry1                       `xml:"CardTx,omitempty" json:",omitempty"`
+	NtryDtls      []EntryDetails3                   `xml:"NtryDtls,omitempty" json:",omitempty"`
+	AddtlNtryInf  *common.Max500Text                `xml:"AddtlNtryInf,omitempty" json:",omitempty"`
+}
+
+func (r ReportEntry4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ReportingSource1Choice struct {
+	Cd    *ExternalReportingSource1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ReportingSource1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ReturnReason5Choice struct {
+	Cd    *ExternalReturnReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ReturnReason5Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type SecuritiesAccount13 struct {
+	Id common.Max70Text         `xml:"Id"`
+	Tp *GenericIdentification20 `xml:"Tp,omitempty" json:",omitempty"`
+	Nm *common.Max70Text        `xml:"Nm,omitempty" json:",omitempty"`
+}
+
+func (r SecuritiesAccount13) Validate() error {
+	return utils.Validate(&r)
+}
+
+type SecurityIdentification14 struct {
+	ISIN   *ISINIdentifier        `xml:"ISIN,omitempty" json:",omitempty"`
+	OthrId []OtherIdentification1 `xml:"OthrId,omitempty" json:",omitempty"`
+	Desc   *common.Max140Text     `xml:"Desc,omitempty" json:",omitempty"`
+}
+
+func (r SecurityIdentification14) Validate() error {
+	return utils.Validate(&r)
+}
+
+type StructuredRemittanceInformation9 struct {
+	RfrdDocInf  []ReferredDocumentInformation3 `xml:"RfrdDocInf,omitempty" json:",omitempty"`
+	RfrdDocAmt  *RemittanceAmount2             `xml:"RfrdDocAmt,omitempty" json:",omitempty"`
+	CdtrRefInf  *CreditorReferenceInformation2 `xml:"CdtrRefInf,omitempty" json:",omitempty"`
+	Invcr       *PartyIdentification43         `xml:"Invcr,omitempty" json:",omitempty"`
+	Invcee      *PartyIdentification43         `xml:"Invcee,omitempty" json:",omitempty"`
+	AddtlRmtInf []common.Max140Text            `xml:"AddtlRmtInf,omitempty" json:",omitempty"`
+}
+
+func (r StructuredRemittanceInformation9) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxAmount1 struct {
+	Rate         float64                            `xml:"Rate,omitempty" json:",omitempty"`
+	TaxblBaseAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TaxblBaseAmt,omitempty" json:",omitempty"`
+	TtlAmt       *ActiveOrHistoricCurrencyAndAmount `xml:"TtlAmt,omitempty" json:",omitempty"`
+	Dtls         []TaxRecordDetails1                `xml:"Dtls,omitempty" json:",omitempty"`
+}
+
+func (r TaxAmount1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxAmountAndType1 struct {
+	Tp  *TaxAmountType1Choice             `xml:"Tp,omitempty" json:",omitempty"`
+	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+}
+
+func (r TaxAmountAndType1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxAmountType1Choice struct {
+	Cd    *ExternalTaxAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text           `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TaxAmountType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type TaxAuthorisation1 struct {
+	Titl *common.Max35Text  `xml:"Titl,omitempty" json:",omitempty"`
+	Nm   *common.Max140Text `xml:"Nm,omitempty" json:",omitempty"`
+}
+
+func (r TaxAuthorisation1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxCharges2 struct {
+	Id   *common.Max35Text                  `xml:"Id,omitempty" json:",omitempty"`
+	Rate float64                            `xml:"Rate,omitempty" json:",omitempty"`
+	Amt  *ActiveOrHistoricCurrencyAndAmount `xml:"Amt,omitempty" json:",omitempty"`
+}
+
+func (r TaxCharges2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxInformation3 struct {
+	Cdtr            *TaxParty1                         `xml:"Cdtr,omitempty" json:",omitempty"`
+	Dbtr            *TaxParty2                         `xml:"Dbtr,omitempty" json:",omitempty"`
+	AdmstnZn        *common.Max35Text                  `xml:"AdmstnZn,omitempty" json:",omitempty"`
+	RefNb           *common.Max140Text                 `xml:"RefNb,omitempty" json:",omitempty"`
+	Mtd             *common.Max35Text                  `xml:"Mtd,omitempty" json:",omitempty"`
+	TtlTaxblBaseAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlTaxblBaseAmt,omitempty" json:",omitempty"`
+	TtlTaxAmt       *ActiveOrHistoricCurrencyAndAmount `xml:"TtlTaxAmt,omitempty" json:",omitempty"`
+	Dt              *common.ISODate                    `xml:"Dt,omitempty" json:",omitempty"`
+	SeqNb           float64                            `xml:"SeqNb,omitempty" json:",omitempty"`
+	Rcrd            []TaxRecord1                       `xml:"Rcrd,omitempty" json:",omitempty"`
+}
+
+func (r TaxInformation3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxParty1 struct {
+	TaxId  *common.Max35Text `xml:"TaxId,omitempty" json:",omitempty"`
+	RegnId *common.Max35Text `xml:"RegnId,omitempty" json:",omitempty"`
+	TaxTp  *common.Max35Text `xml:"TaxTp,omitempty" json:",omitempty"`
+}
+
+func (r TaxParty1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxParty2 struct {
+	TaxId   *common.Max35Text  `xml:"TaxId,omitempty" json:",omitempty"`
+	RegnId  *common.Max35Text  `xml:"RegnId,omitempty" json:",omitempty"`
+	TaxTp   *common.Max35Text  `xml:"TaxTp,omitempty" json:",omitempty"`
+	Authstn *TaxAuthorisation1 `xml:"Authstn,omitempty" json:",omitempty"`
+}
+
+func (r TaxParty2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxPeriod1 struct {
+	Yr     *common.ISODate       `xml:"Yr,omitempty" json:",omitempty"`
+	Tp     *TaxRecordPeriod1Code `xml:"Tp,omitempty" json:",omitempty"`
+	FrToDt *DatePeriodDetails    `xml:"FrToDt,omitempty" json:",omitempty"`
+}
+
+func (r TaxPeriod1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxRecord1 struct {
+	Tp       *common.Max35Text  `xml:"Tp,omitempty" json:",omitempty"`
+	Ctgy     *common.Max35Text  `xml:"Ctgy,omitempty" json:",omitempty"`
+	CtgyDtls *common.Max35Text  `xml:"CtgyDtls,omitempty" json:",omitempty"`
+	DbtrSts  *common.Max35Text  `xml:"DbtrSts,omitempty" json:",omitempty"`
+	CertId   *common.Max35Text  `xml:"CertId,omitempty" json:",omitempty"`
+	FrmsCd   *common.Max35Text  `xml:"FrmsCd,omitempty" json:",omitempty"`
+	Prd      *TaxPeriod1        `xml:"Prd,omitempty" json:",omitempty"`
+	TaxAmt   *TaxAmount1        `xml:"TaxAmt,omitempty" json:",omitempty"`
+	AddtlInf *common.Max140Text `xml:"AddtlInf,omitempty" json:",omitempty"`
+}
+
+func (r TaxRecord1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxRecordDetails1 struct {
+	Prd *TaxPeriod1                       `xml:"Prd,omitempty" json:",omitempty"`
+	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+}
+
+func (r TaxRecordDetails1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TechnicalInputChannel1Choice struct {
+	Cd    *ExternalTechnicalInputChannel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TechnicalInputChannel1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type TotalTransactions4 struct {
+	TtlNtries          *NumberAndSumOfTransactions3    `xml:"TtlNtries,omitempty" json:",omitempty"`
+	TtlCdtNtries       *NumberAndSumOfTransactions1    `xml:"TtlCdtNtries,omitempty" json:",omitempty"`
+	TtlDbtNtries       *NumberAndSumOfTransactions1    `xml:"TtlDbtNtries,omitempty" json:",omitempty"`
+	TtlNtriesPerBkTxCd []TotalsPerBankTransactionCode3 `xml:"TtlNtriesPerBkTxCd,omitempty" json:",omitempty"`
+}
+
+func (r TotalTransactions4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TotalsPerBankTransactionCode3 struct {
+	NbOfNtries *common.Max15NumericText      `xml:"NbOfNtries,omitempty" json:",omitempty"`
+	Sum        *common.DecimalNumber         `xml:"Sum,omitempty" json:",omitempty"`
+	TtlNetNtry *AmountAndDirection35         `xml:"TtlNetNtry,omitempty" json:",omitempty"`
+	FcstInd    bool                          `xml:"FcstInd,omitempty" json:",omitempty"`
+	BkTxCd     BankTransactionCodeStructure4 `xml:"BkTxCd"`
+	Avlbty     []CashBalanceAvailability2    `xml:"Avlbty,omitempty" json:",omitempty"`
+	Dt         *DateAndDateTimeChoice        `xml:"Dt,omitempty" json:",omitempty"`
+}
+
+func (r TotalsPerBankTransactionCode3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TrackData1 struct {
+	TrckNb  *Exact1NumericText `xml:"TrckNb,omitempty" json:",omitempty"`
+	TrckVal common.Max140Text  `xml:"TrckVal"`
+}
+
+func (r TrackData1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionAgents3 struct {
+	DbtrAgt    *BranchAndFinancialInstitutionIdentification5 `xml:"DbtrAgt,omitempty" json:",omitempty"`
+	CdtrAgt    *BranchAndFinancialInstitutionIdentification5 `xml:"CdtrAgt,omitempty" json:",omitempty"`
+	IntrmyAgt1 *BranchAndFinancialInstitutionIdentification5 `xml:"IntrmyAgt1,omitempty" json:",omitempty"`
+	IntrmyAgt2 *BranchAndFinancialInstitutionIdentification5 `xml:"IntrmyAgt2,omitempty" json:",omitempty"`
+	IntrmyAgt3 *BranchAndFinancialInstitutionIdentification5 `xml:"IntrmyAgt3,omitempty" json:",omitempty"`
+	RcvgAgt    *BranchAndFinancialInstitutionIdentification5 `xml:"RcvgAgt,omitempty" json:",omitempty"`
+	DlvrgAgt   *BranchAndFinancialInstitutionIdentification5 `xml:"DlvrgAgt,omitempty" json:",omitempty"`
+	IssgAgt    *BranchAndFinancialInstitutionIdentification5 `xml:"IssgAgt,omitempty" json:",omitempty"`
+	SttlmPlc   *BranchAndFinancialInstitutionIdentification5 `xml:"SttlmPlc,omitempty" json:",omitempty"`
+	Prtry      []ProprietaryAgent3                           `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionAgents3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionDates2 struct {
+	AccptncDtTm             *common.ISODateTime `xml:"AccptncDtTm,omitempty" json:",omitempty"`
+	TradActvtyCtrctlSttlmDt *common.ISODate     `xml:"TradActvtyCtrctlSttlmDt,omitempty" json:",omitempty"`
+	TradDt                  *common.ISODate     `xml:"TradDt,omitempty" json:",omitempty"`
+	IntrBkSttlmDt           *common.ISODate     `xml:"IntrBkSttlmDt,omitempty" json:",omitempty"`
+	StartDt                 *common.ISODate     `xml:"StartDt,omitempty" json:",omitempty"`
+	EndDt                   *common.ISODate     `xml:"EndDt,omitempty" json:",omitempty"`
+	TxDtTm                  *common.ISODateTime `xml:"TxDtTm,omitempty" json:",omitempty"`
+	Prtry                   []ProprietaryDate2  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionDates2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionIdentifier1 struct {
+	TxDtTm common.ISODateTime `xml:"TxDtTm"`
+	TxRef  common.Max35Text   `xml:"TxRef"`
+}
+
+func (r TransactionIdentifier1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionInterest3 struct {
+	TtlIntrstAndTaxAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlIntrstAndTaxAmt,omitempty" json:",omitempty"`
+	Rcrd               []InterestRecord1                  `xml:"Rcrd,omitempty" json:",omitempty"`
+}
+
+func (r TransactionInterest3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionParties3 struct {
+	InitgPty  *PartyIdentification43 `xml:"InitgPty,omitempty" json:",omitempty"`
+	Dbtr      *PartyIdentification43 `xml:"Dbtr,omitempty" json:",omitempty"`
+	DbtrAcct  *CashAccount24         `xml:"DbtrAcct,omitempty" json:",omitempty"`
+	UltmtDbtr *PartyIdentification43 `xml:"UltmtDbtr,omitempty" json:",omitempty"`
+	Cdtr      *PartyIdentification43 `xml:"Cdtr,omitempty" json:",omitempty"`
+	CdtrAcct  *CashAccount24         `xml:"CdtrAcct,omitempty" json:",omitempty"`
+	UltmtCdtr *PartyIdentification43 `xml:"UltmtCdtr,omitempty" json:",omitempty"`
+	TradgPty  *PartyIdentification43 `xml:"TradgPty,omitempty" json:",omitempty"`
+	Prtry     []ProprietaryParty3    `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionParties3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TransactionPrice3Choice struct {
+	DealPric *Price2             `xml:"DealPric,omitempty" json:",omitempty"`
+	Prtry    []ProprietaryPrice2 `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionPrice3Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type TransactionQuantities2Choice struct {
+	Qty                *FinancialInstrumentQuantityChoice `xml:"Qty,omitempty" json:",omitempty"`
+	OrgnlAndCurFaceAmt *OriginalAndCurrentQuantities1     `xml:"OrgnlAndCurFaceAmt,omitempty" json:",omitempty"`
+	Prtry              *ProprietaryQuantity1              `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionQuantities2Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type TransactionReferences3 struct {
+	MsgId             *common.Max35Text       `xml:"MsgId,omitempty" json:",omitempty"`
+	AcctSvcrRef       *common.Max35Text       `xml:"AcctSvcrRef,omitempty" json:",omitempty"`
+	PmtInfId          *common.Max35Text       `xml:"PmtInfId,omitempty" json:",omitempty"`
+	InstrId           *common.Max35Text       `xml:"InstrId,omitempty" json:",omitempty"`
+	EndToEndId        *common.Max35Text       `xml:"EndToEndId,omitempty" json:",omitempty"`
+	TxId              *common.Max35Text       `xml:"TxId,omitempty" json:",omitempty"`
+	MndtId            *common.Max35Text       `xml:"MndtId,omitempty" json:",omitempty"`
+	ChqNb             *common.Max35Text       `xml:"ChqNb,omitempty" json:",omitempty"`
+	ClrSysRef         *common.Max35Text       `xml:"ClrSysRef,omitempty" json:",omitempty"`
+	AcctOwnrTxId      *common.Max35Text       `xml:"AcctOwnrTxId,omitempty" json:",omitempty"`
+	AcctSvcrTxId      *common.Max35Text       `xml:"AcctSvcrTxId,omitempty" json:",omitempty"`
+	MktInfrstrctrTxId *common.Max35Text       `xml:"MktInfrstrctrTxId,omitempty" json:",omitempty"`
+	PrcgId            *common.Max35Text       `xml:"PrcgId,omitempty" json:",omitempty"`
+	Prtry             []ProprietaryReference1 `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r TransactionReferences3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type YieldedOrValueType1Choice struct {
+	Yldd  *bool                `xml:"Yldd,omitempty" json:",omitempty"`
+	ValTp *PriceValueType1Code `xml:"ValTp,omitempty" json:",omitempty"`
+}
+
+func (r YieldedOrValueType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
@@ -14,6 +14,8 @@ func TestTypes(t *testing.T) {
 	var type1 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type1.Validate())
 	type1 = "test"
+	assert.NotNil(t, type1.Validate())
+	type1 = "USABA"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalEnquiryRequestType1Code
@@ -38,12 +40,12 @@ func TestTypes(t *testing.T) {
 
 	var type6 ExternalAccountIdentification1Code
 	assert.NotNil(t, type6.Validate())
-	type6 = "test"
+	type6 = "AIIN"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalCashAccountType1Code
 	assert.NotNil(t, type7.Validate())
-	type7 = "test"
+	type7 = "CACC"
 	assert.Nil(t, type7.Validate())
 
 	var type8 ExternalPaymentRole1Code
@@ -58,12 +60,12 @@ func TestTypes(t *testing.T) {
 
 	var type10 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type10.Validate())
-	type10 = "test"
+	type10 = "BANK"
 	assert.Nil(t, type10.Validate())
 
 	var type11 ExternalPersonIdentification1Code
 	assert.NotNil(t, type11.Validate())
-	type11 = "test"
+	type11 = "ARNU"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalSystemErrorHandling1Code
@@ -138,6 +140,222 @@ func TestTypes(t *testing.T) {
 	assert.NotNil(t, type22.Validate())
 	type22 = "PSTO"
 	assert.Nil(t, type22.Validate())
+
+	var type23 BalanceType12Code
+	assert.NotNil(t, type23.Validate())
+	type23 = "test"
+	assert.NotNil(t, type23.Validate())
+	type23 = "XPCD"
+	assert.Nil(t, type23.Validate())
+
+	var type24 CSCManagement1Code
+	assert.NotNil(t, type24.Validate())
+	type24 = "test"
+	assert.NotNil(t, type24.Validate())
+	type24 = "PRST"
+	assert.Nil(t, type24.Validate())
+
+	var type25 CardDataReading1Code
+	assert.NotNil(t, type25.Validate())
+	type25 = "test"
+	assert.NotNil(t, type25.Validate())
+	type25 = "TAGC"
+	assert.Nil(t, type25.Validate())
+
+	var type26 CardPaymentServiceType2Code
+	assert.NotNil(t, type26.Validate())
+	type26 = "test"
+	assert.NotNil(t, type26.Validate())
+	type26 = "AGGR"
+	assert.Nil(t, type26.Validate())
+
+	var type27 CardholderVerificationCapability1Code
+	assert.NotNil(t, type27.Validate())
+	type27 = "test"
+	assert.NotNil(t, type27.Validate())
+	type27 = "MNSG"
+	assert.Nil(t, type27.Validate())
+
+	var type28 ChargeBearerType1Code
+	assert.NotNil(t, type28.Validate())
+	type28 = "test"
+	assert.NotNil(t, type28.Validate())
+	type28 = "DEBT"
+	assert.Nil(t, type28.Validate())
+
+	var type29 DocumentType3Code
+	assert.NotNil(t, type29.Validate())
+	type29 = "test"
+	assert.NotNil(t, type29.Validate())
+	type29 = "RADM"
+	assert.Nil(t, type29.Validate())
+
+	var type30 DocumentType5Code
+	assert.NotNil(t, type30.Validate())
+	type30 = "test"
+	assert.NotNil(t, type30.Validate())
+	type30 = "MSIN"
+	assert.Nil(t, type30.Validate())
+
+	var type31 EntryStatus2Code
+	assert.NotNil(t, type31.Validate())
+	type31 = "test"
+	assert.NotNil(t, type31.Validate())
+	type31 = "BOOK"
+	assert.Nil(t, type31.Validate())
+
+	var type32 Exact1NumericText
+	assert.NotNil(t, type32.Validate())
+	type32 = "test"
+	assert.NotNil(t, type32.Validate())
+	type32 = "1"
+	assert.Nil(t, type32.Validate())
+
+	var type33 Exact3NumericText
+	assert.NotNil(t, type33.Validate())
+	type33 = "test"
+	assert.NotNil(t, type33.Validate())
+	type33 = "123"
+	assert.Nil(t, type33.Validate())
+
+	var type34 ExternalBalanceSubType1Code
+	assert.NotNil(t, type34.Validate())
+	type34 = "test"
+	assert.Nil(t, type34.Validate())
+
+	var type35 ExternalBankTransactionDomain1Code
+	assert.NotNil(t, type35.Validate())
+	type35 = "ACMT"
+	assert.Nil(t, type35.Validate())
+
+	var type36 ExternalBankTransactionFamily1Code
+	assert.NotNil(t, type36.Validate())
+	type36 = "test"
+	assert.Nil(t, type36.Validate())
+
+	var type37 ExternalBankTransactionSubFamily1Code
+	assert.NotNil(t, type37.Validate())
+	type37 = "test"
+	assert.Nil(t, type37.Validate())
+
+	var type38 ExternalCardTransactionCategory1Code
+	assert.NotNil(t, type38.Validate())
+	type38 = "test"
+	assert.Nil(t, type38.Validate())
+
+	var type39 ExternalChargeType1Code
+	assert.NotNil(t, type39.Validate())
+	type39 = "test"
+	assert.Nil(t, type39.Validate())
+
+	var type40 ExternalDiscountAmountType1Code
+	assert.NotNil(t, type40.Validate())
+	type40 = "APDS"
+	assert.Nil(t, type40.Validate())
+
+	var type41 ExternalFinancialInstrumentIdentificationType1Code
+	assert.NotNil(t, type41.Validate())
+	type41 = "test"
+	assert.Nil(t, type41.Validate())
+
+	var type42 ExternalPurpose1Code
+	assert.NotNil(t, type42.Validate())
+	type42 = "test"
+	assert.NotNil(t, type42.Validate())
+	type42 = "GDDS"
+	assert.Nil(t, type42.Validate())
+
+	var type43 ExternalReportingSource1Code
+	assert.NotNil(t, type43.Validate())
+	type43 = "test"
+	assert.Nil(t, type43.Validate())
+
+	var type44 ExternalReturnReason1Code
+	assert.NotNil(t, type44.Validate())
+	type44 = "test"
+	assert.NotNil(t, type44.Validate())
+	type44 = "AC04"
+	assert.Nil(t, type44.Validate())
+
+	var type45 ExternalTaxAmountType1Code
+	assert.NotNil(t, type45.Validate())
+	type45 = "CITY"
+	assert.Nil(t, type45.Validate())
+
+	var type46 ExternalTechnicalInputChannel1Code
+	assert.NotNil(t, type46.Validate())
+	type46 = "test"
+	assert.Nil(t, type46.Validate())
+
+	var type47 ISINIdentifier
+	assert.NotNil(t, type47.Validate())
+	type47 = "test"
+	assert.NotNil(t, type47.Validate())
+	type47 = "US0378331005"
+	assert.Nil(t, type47.Validate())
+
+	var type48 OnLineCapability1Code
+	assert.NotNil(t, type48.Validate())
+	type48 = "test"
+	assert.NotNil(t, type48.Validate())
+	type48 = "OFLN"
+	assert.Nil(t, type48.Validate())
+
+	var type49 POIComponentType1Code
+	assert.NotNil(t, type49.Validate())
+	type49 = "test"
+	assert.NotNil(t, type49.Validate())
+	type49 = "SOFT"
+	assert.Nil(t, type49.Validate())
+
+	var type50 PartyType3Code
+	assert.NotNil(t, type50.Validate())
+	type50 = "test"
+	assert.NotNil(t, type50.Validate())
+	type50 = "OPOI"
+	assert.Nil(t, type50.Validate())
+
+	var type51 PartyType4Code
+	assert.NotNil(t, type51.Validate())
+	type51 = "test"
+	assert.NotNil(t, type51.Validate())
+	type51 = "MERC"
+	assert.Nil(t, type51.Validate())
+
+	var type52 PriceValueType1Code
+	assert.NotNil(t, type52.Validate())
+	type52 = "test"
+	assert.NotNil(t, type52.Validate())
+	type52 = "DISC"
+	assert.Nil(t, type52.Validate())
+
+	var type53 RemittanceLocationMethod2Code
+	assert.NotNil(t, type53.Validate())
+	type53 = "test"
+	assert.NotNil(t, type53.Validate())
+	type53 = "FAXI"
+	assert.Nil(t, type53.Validate())
+
+	var type54 TaxRecordPeriod1Code
+	assert.NotNil(t, type54.Validate())
+	type54 = "test"
+	assert.NotNil(t, type54.Validate())
+	type54 = "MM01"
+	assert.Nil(t, type54.Validate())
+
+	var type55 UnitOfMeasure1Code
+	assert.NotNil(t, type55.Validate())
+	type55 = "test"
+	assert.NotNil(t, type55.Validate())
+	type55 = "PIEC"
+	assert.Nil(t, type55.Validate())
+
+	var type56 UserInterface2Code
+	assert.NotNil(t, type56.Validate())
+	type56 = "test"
+	assert.NotNil(t, type56.Validate())
+	type56 = "MDSP"
+	assert.Nil(t, type56.Validate())
 }
 
 func TestNestedTypes(t *testing.T) {
@@ -170,7 +388,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, GenericAccountIdentification1{}.Validate())
 	assert.NotNil(t, Member5{}.Validate())
 	assert.NotNil(t, MemberReport5{}.Validate())
-	assert.Nil(t, MemberReportOrError5Choice{}.Validate())
+	assert.NotNil(t, MemberReportOrError5Choice{}.Validate())
 	assert.NotNil(t, MemberReportOrError6Choice{}.Validate())
 	assert.NotNil(t, MessageHeader7{}.Validate())
 	assert.NotNil(t, OriginalBusinessQuery1{}.Validate())
@@ -193,7 +411,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, CurrencyExchange7{}.Validate())
 	assert.NotNil(t, CurrencyExchangeReport3{}.Validate())
 	assert.NotNil(t, CurrencySourceTarget1{}.Validate())
-	assert.Nil(t, ExchangeRateReportOrError1Choice{}.Validate())
+	assert.NotNil(t, ExchangeRateReportOrError1Choice{}.Validate())
 	assert.NotNil(t, ExchangeRateReportOrError2Choice{}.Validate())
 	assert.NotNil(t, ReturnCurrencyExchangeRateV04{}.Validate())
 	assert.Nil(t, BusinessInformationCriteria1{}.Validate())
@@ -220,8 +438,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PersonIdentification13{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
@@ -239,10 +457,146 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ReturnStandingOrderV04{}.Validate())
 	assert.NotNil(t, StandingOrder6{}.Validate())
 	assert.NotNil(t, StandingOrderIdentification4{}.Validate())
-	assert.Nil(t, StandingOrderOrError5Choice{}.Validate())
+	assert.NotNil(t, StandingOrderOrError5Choice{}.Validate())
 	assert.NotNil(t, StandingOrderOrError6Choice{}.Validate())
 	assert.NotNil(t, StandingOrderReport1{}.Validate())
 	assert.Nil(t, StandingOrderTotalAmount1{}.Validate())
 	assert.NotNil(t, StandingOrderType1Choice{}.Validate())
 	assert.Nil(t, TotalAmountAndCurrency1{}.Validate())
+	assert.Nil(t, AccountInterest3{}.Validate())
+	assert.NotNil(t, AccountNotification7{}.Validate())
+	assert.NotNil(t, AccountReport16{}.Validate())
+	assert.NotNil(t, AccountStatement4{}.Validate())
+	assert.NotNil(t, ActiveOrHistoricCurrencyAnd13DecimalAmount{}.Validate())
+	assert.NotNil(t, ActiveOrHistoricCurrencyAndAmount{}.Validate())
+	assert.Nil(t, AmountAndCurrencyExchange3{}.Validate())
+	assert.NotNil(t, AmountAndCurrencyExchangeDetails3{}.Validate())
+	assert.NotNil(t, AmountAndCurrencyExchangeDetails4{}.Validate())
+	assert.NotNil(t, AmountAndDirection35{}.Validate())
+	assert.Nil(t, AmountRangeBoundary1{}.Validate())
+	assert.NotNil(t, BalanceSubType1Choice{}.Validate())
+	assert.NotNil(t, BalanceType12{}.Validate())
+	assert.NotNil(t, BalanceType5Choice{}.Validate())
+	assert.NotNil(t, BankToCustomerAccountReportV04{}.Validate())
+	assert.NotNil(t, BankToCustomerDebitCreditNotificationV04{}.Validate())
+	assert.NotNil(t, BankToCustomerStatementV04{}.Validate())
+	assert.Nil(t, BankTransactionCodeStructure4{}.Validate())
+	assert.NotNil(t, BankTransactionCodeStructure5{}.Validate())
+	assert.NotNil(t, BankTransactionCodeStructure6{}.Validate())
+	assert.Nil(t, BatchInformation2{}.Validate())
+	assert.Nil(t, BranchAndFinancialInstitutionIdentification5{}.Validate())
+	assert.Nil(t, BranchData2{}.Validate())
+	assert.Nil(t, CardAggregated1{}.Validate())
+	assert.Nil(t, CardEntry1{}.Validate())
+	assert.Nil(t, CardIndividualTransaction1{}.Validate())
+	assert.NotNil(t, CardSecurityInformation1{}.Validate())
+	assert.Nil(t, CardSequenceNumberRange1{}.Validate())
+	assert.Nil(t, CardTransaction1{}.Validate())
+	assert.NotNil(t, CardTransaction1Choice{}.Validate())
+	assert.NotNil(t, CashAccount24{}.Validate())
+	assert.NotNil(t, CashAccount25{}.Validate())
+	assert.NotNil(t, CashBalance3{}.Validate())
+	assert.NotNil(t, CashBalanceAvailability2{}.Validate())
+	assert.NotNil(t, CashBalanceAvailabilityDate1{}.Validate())
+	assert.NotNil(t, CashDeposit1{}.Validate())
+	assert.NotNil(t, ChargeType3Choice{}.Validate())
+	assert.Nil(t, Charges4{}.Validate())
+	assert.NotNil(t, ChargesRecord2{}.Validate())
+	assert.Nil(t, ContactDetails2{}.Validate())
+	assert.NotNil(t, CorporateAction9{}.Validate())
+	assert.Nil(t, CreditLine2{}.Validate())
+	assert.Nil(t, CreditorReferenceInformation2{}.Validate())
+	assert.NotNil(t, CreditorReferenceType1Choice{}.Validate())
+	assert.NotNil(t, CreditorReferenceType2{}.Validate())
+	assert.NotNil(t, CurrencyAndAmountRange2{}.Validate())
+	assert.NotNil(t, CurrencyExchange5{}.Validate())
+	assert.NotNil(t, DateAndDateTimeChoice{}.Validate())
+	assert.NotNil(t, DateAndPlaceOfBirth{}.Validate())
+	assert.NotNil(t, DateOrDateTimePeriodChoice{}.Validate())
+	assert.Nil(t, DatePeriodDetails{}.Validate())
+	assert.Nil(t, DateTimePeriodDetails{}.Validate())
+	assert.NotNil(t, DiscountAmountAndType1{}.Validate())
+	assert.NotNil(t, DiscountAmountType1Choice{}.Validate())
+	assert.NotNil(t, DisplayCapabilities1{}.Validate())
+	assert.NotNil(t, DocumentAdjustment1{}.Validate())
+	assert.Nil(t, EntryDetails3{}.Validate())
+	assert.Nil(t, EntryTransaction4{}.Validate())
+	assert.Nil(t, FinancialInstitutionIdentification8{}.Validate())
+	assert.NotNil(t, FinancialInstrumentQuantityChoice{}.Validate())
+	assert.Nil(t, FromToAmountRange{}.Validate())
+	assert.NotNil(t, GenericIdentification20{}.Validate())
+	assert.NotNil(t, GenericIdentification3{}.Validate())
+	assert.NotNil(t, GenericIdentification32{}.Validate())
+	assert.NotNil(t, GroupHeader58{}.Validate())
+	assert.NotNil(t, IdentificationSource3Choice{}.Validate())
+	assert.NotNil(t, ImpliedCurrencyAmountRangeChoice{}.Validate())
+	assert.NotNil(t, InterestRecord1{}.Validate())
+	assert.NotNil(t, InterestType1Choice{}.Validate())
+	assert.Nil(t, MessageIdentification2{}.Validate())
+	assert.NotNil(t, NameAndAddress10{}.Validate())
+	assert.Nil(t, NumberAndSumOfTransactions1{}.Validate())
+	assert.Nil(t, NumberAndSumOfTransactions3{}.Validate())
+	assert.Nil(t, OrganisationIdentification8{}.Validate())
+	assert.Nil(t, OriginalAndCurrentQuantities1{}.Validate())
+	assert.NotNil(t, OtherIdentification1{}.Validate())
+	assert.NotNil(t, Pagination{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
+	assert.Nil(t, PartyIdentification43{}.Validate())
+	assert.Nil(t, PaymentCard4{}.Validate())
+	assert.Nil(t, PaymentReturnReason2{}.Validate())
+	assert.Nil(t, PersonIdentification5{}.Validate())
+	assert.NotNil(t, PlainCardData1{}.Validate())
+	assert.NotNil(t, PointOfInteraction1{}.Validate())
+	assert.Nil(t, PointOfInteractionCapabilities1{}.Validate())
+	assert.NotNil(t, PointOfInteractionComponent1{}.Validate())
+	assert.Nil(t, PostalAddress6{}.Validate())
+	assert.NotNil(t, Price2{}.Validate())
+	assert.NotNil(t, PriceRateOrAmountChoice{}.Validate())
+	assert.NotNil(t, Product2{}.Validate())
+	assert.NotNil(t, ProprietaryAgent3{}.Validate())
+	assert.NotNil(t, ProprietaryBankTransactionCodeStructure1{}.Validate())
+	assert.NotNil(t, ProprietaryDate2{}.Validate())
+	assert.NotNil(t, ProprietaryParty3{}.Validate())
+	assert.NotNil(t, ProprietaryPrice2{}.Validate())
+	assert.NotNil(t, ProprietaryQuantity1{}.Validate())
+	assert.NotNil(t, ProprietaryReference1{}.Validate())
+	assert.NotNil(t, Purpose2Choice{}.Validate())
+	assert.NotNil(t, Rate3{}.Validate())
+	assert.NotNil(t, RateType4Choice{}.Validate())
+	assert.Nil(t, ReferredDocumentInformation3{}.Validate())
+	assert.NotNil(t, ReferredDocumentType1Choice{}.Validate())
+	assert.NotNil(t, ReferredDocumentType2{}.Validate())
+	assert.Nil(t, RemittanceAmount2{}.Validate())
+	assert.Nil(t, RemittanceInformation7{}.Validate())
+	assert.Nil(t, RemittanceLocation2{}.Validate())
+	assert.NotNil(t, ReportEntry4{}.Validate())
+	assert.NotNil(t, ReportingSource1Choice{}.Validate())
+	assert.NotNil(t, ReturnReason5Choice{}.Validate())
+	assert.NotNil(t, SecuritiesAccount13{}.Validate())
+	assert.Nil(t, SecurityIdentification14{}.Validate())
+	assert.Nil(t, StructuredRemittanceInformation9{}.Validate())
+	assert.Nil(t, TaxAmount1{}.Validate())
+	assert.NotNil(t, TaxAmountAndType1{}.Validate())
+	assert.NotNil(t, TaxAmountType1Choice{}.Validate())
+	assert.Nil(t, TaxAuthorisation1{}.Validate())
+	assert.Nil(t, TaxCharges2{}.Validate())
+	assert.Nil(t, TaxInformation3{}.Validate())
+	assert.Nil(t, TaxParty1{}.Validate())
+	assert.Nil(t, TaxParty2{}.Validate())
+	assert.Nil(t, TaxPeriod1{}.Validate())
+	assert.Nil(t, TaxRecord1{}.Validate())
+	assert.NotNil(t, TaxRecordDetails1{}.Validate())
+	assert.NotNil(t, TechnicalInputChannel1Choice{}.Validate())
+	assert.Nil(t, TotalTransactions4{}.Validate())
+	assert.Nil(t, TotalsPerBankTransactionCode3{}.Validate())
+	assert.NotNil(t, TrackData1{}.Validate())
+	assert.Nil(t, TransactionAgents3{}.Validate())
+	assert.Nil(t, TransactionDates2{}.Validate())
+	assert.NotNil(t, TransactionIdentifier1{}.Validate())
+	assert.Nil(t, TransactionInterest3{}.Validate())
+	assert.Nil(t, TransactionParties3{}.Validate())
+	assert.NotNil(t, TransactionPrice3Choice{}.Validate())
+	assert.NotNil(t, TransactionQuantities2Choice{}.Validate())
+	assert.Nil(t, TransactionReferences3{}.Validate())
+	assert.NotNil(t, YieldedOrValueType1Choice{}.Validate())
 }
@@ -6,7 +6,9 @@ package camt_v04
 
 import (
 	"reflect"
+	"regexp"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -17,7 +19,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -27,7 +29,7 @@ func (r ExternalEnquiryRequestType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalEnquiryRequestType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalEnquiryRequestType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -37,7 +39,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -47,7 +49,7 @@ func (r ExternalPaymentControlRequestType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPaymentControlRequestType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPaymentControlRequestType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -57,7 +59,7 @@ func (r ExternalSystemMemberType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemMemberType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalSystemMemberType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -67,7 +69,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -77,7 +79,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -87,7 +89,7 @@ func (r ExternalPaymentRole1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPaymentRole1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPaymentRole1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -97,7 +99,7 @@ func (r ExternalProxyAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalProxyAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalProxyAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -107,7 +109,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -117,7 +119,7 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -127,7 +129,7 @@ func (r ExternalSystemErrorHandling1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemErrorHandling1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalSystemErrorHandling1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -137,7 +139,7 @@ func (r ExternalSystemEventType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemEventType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalSystemEventType1Code", string(r))
 }
 
 // May be one of ENBL, DSBL, DLTD, JOIN
@@ -265,3 +267,421 @@ func (r StandingOrderType1Code) Validate() error {
 	}
 	return utils.NewErrValueInvalid("StandingOrderType1Code")
 }
+
+// May be one of XPCD, OPAV, ITAV, CLAV, FWAV, CLBD, ITBD, OPBD, PRCD, INFO
+type BalanceType12Code string
+
+func (r BalanceType12Code) Validate() error {
+	for _, vv := range []string{
+		"XPCD", "OPAV", "ITAV", "CLAV", "FWAV", "CLBD", "ITBD", "OPBD", "PRCD", "INFO",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("BalanceType12Code")
+}
+
+// May be one of PRST, BYPS, UNRD, NCSC
+type CSCManagement1Code string
+
+func (r CSCManagement1Code) Validate() error {
+	for _, vv := range []string{
+		"PRST", "BYPS", "UNRD", "NCSC",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("CSCManagement1Code")
+}
+
+// May be one of TAGC, PHYS, BRCD, MGST, CICC, DFLE, CTLS, ECTL
+type CardDataReading1Code string
+
+func (r CardDataReading1Code) Validate() error {
+	for _, vv := range []string{
+		"TAGC", "PHYS", "BRCD", "MGST", "CICC", "DFLE", "CTLS", "ECTL",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("CardDataReading1Code")
+}
+
+// May be one of AGGR, DCCV, GRTT, INSP, LOYT, NRES, PUCO, RECP, SOAF, UNAF, VCAU
+type CardPaymentServiceType2Code string
+
+func (r CardPaymentServiceType2Code) Validate() error {
+	for _, vv := range []string{
+		"AGGR", "DCCV", "GRTT", "INSP", "LOYT", "NRES", "PUCO", "RECP", "SOAF", "UNAF", "VCAU",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("CardPaymentServiceType2Code")
+}
+
+// May be one of MNSG, NPIN, FCPN, FEPN, FDSG, FBIO, MNVR, FBIG, APKI, PKIS, CHDT, SCEC
+type CardholderVerificationCapability1Code string
+
+func (r CardholderVerificationCapability1Code) Validate() error {
+	for _, vv := range []string{
+		"MNSG", "NPIN", "FCPN", "FEPN", "FDSG", "FBIO", "MNVR", "FBIG", "APKI", "PKIS", "CHDT", "SCEC",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("CardholderVerificationCapability1Code")
+}
+
+// May be one of DEBT, CRED, SHAR, SLEV
+type ChargeBearerType1Code string
+
+func (r ChargeBearerType1Code) Validate() error {
+	for _, vv := range []string{
+		"DEBT", "CRED", "SHAR", "SLEV",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("ChargeBearerType1Code")
+}
+
+// May be one of RADM, RPIN, FXDR, DISP, PUOR, SCOR
+type DocumentType3Code string
+
+func (r DocumentType3Code) Validate() error {
+	for _, vv := range []string{
+		"RADM", "RPIN", "FXDR", "DISP", "PUOR", "SCOR",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("DocumentType3Code")
+}
+
+// May be one of MSIN, CNFA, DNFA, CINV, CREN, DEBN, HIRI, SBIN, CMCN, SOAC, DISP, BOLD, VCHR, AROI, TSUT
+type DocumentType5Code string
+
+func (r DocumentType5Code) Validate() error {
+	for _, vv := range []string{
+		"MSIN", "CNFA", "DNFA", "CINV", "CREN", "DEBN", "HIRI", "SBIN", "CMCN", "SOAC", "DISP", "BOLD", "VCHR", "AROI", "TSUT",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("DocumentType5Code")
+}
+
+// May be one of BOOK, PDNG, INFO
+type EntryStatus2Code string
+
+func (r EntryStatus2Code) Validate() error {
+	for _, vv := range []string{
+		"BOOK", "PDNG", "INFO",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("EntryStatus2Code")
+}
+
+// Must match the pattern [0-9]
+type Exact1NumericText string
+
+var exact1NumericTextRegexp = regexp.MustCompile(`^[0-9]$`)
+
+func (r Exact1NumericText) Validate() error {
+	if !exact1NumericTextRegexp.MatchString(string(r)) {
+		return utils.NewErrValueInvalid("Exact1NumericText")
+	}
+	return nil
+}
+
+// Must match the pattern [0-9]{3}
+type Exact3NumericText string
+
+var exact3NumericTextRegexp = regexp.MustCompile(`^[0-9]{3}$`)
+
+func (r Exact3NumericText) Validate() error {
+	if !exact3NumericTextRegexp.MatchString(string(r)) {
+		return utils.NewErrValueInvalid("Exact3NumericText")
+	}
+	return nil
+}
+
+// Must be at least 1 items long
+type ExternalBalanceSubType1Code string
+
+func (r ExternalBalanceSubType1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalBalanceSubType1Code", 1, 4)
+	}
+	return codes.Validate("ExternalBalanceSubType1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalBankTransactionDomain1Code string
+
+func (r ExternalBankTransactionDomain1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalBankTransactionDomain1Code", 1, 4)
+	}
+	return codes.Validate("ExternalBankTransactionDomain1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalBankTransactionFamily1Code string
+
+func (r ExternalBankTransactionFamily1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalBankTransactionFamily1Code", 1, 4)
+	}
+	return codes.Validate("ExternalBankTransactionFamily1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalBankTransactionSubFamily1Code string
+
+func (r ExternalBankTransactionSubFamily1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalBankTransactionSubFamily1Code", 1, 4)
+	}
+	return codes.Validate("ExternalBankTransactionSubFamily1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalCardTransactionCategory1Code string
+
+func (r ExternalCardTransactionCategory1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalCardTransactionCategory1Code", 1, 4)
+	}
+	return codes.Validate("ExternalCardTransactionCategory1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalChargeType1Code string
+
+func (r ExternalChargeType1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalChargeType1Code", 1, 4)
+	}
+	return codes.Validate("ExternalChargeType1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalDiscountAmountType1Code string
+
+func (r ExternalDiscountAmountType1Code) Validate() error {
+	if len(string(r)) < 1 {
+		return utils.NewErrTextLengthInvalid("ExternalDiscountAmountType1Code", 1, 0)
+	}
+	return codes.Validate("ExternalDiscountAmountType1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalFinancialInstrumentIdentificationType1Code string
+
+func (r ExternalFinancialInstrumentIdentificationType1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalFinancialInstrumentIdentificationType1Code", 1, 4)
+	}
+	return codes.Validate("ExternalFinancialInstrumentIdentificationType1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalPurpose1Code string
+
+func (r ExternalPurpose1Code) Validate() error {
+	if len(string(r)) < 1 {
+		return utils.NewErrTextLengthInvalid("ExternalPurpose1Code", 1, 0)
+	}
+	return codes.Validate("ExternalPurpose1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalReportingSource1Code string
+
+func (r ExternalReportingSource1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalReportingSource1Code", 1, 4)
+	}
+	return codes.Validate("ExternalReportingSource1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalReturnReason1Code string
+
+func (r ExternalReturnReason1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalReturnReason1Code", 1, 4)
+	}
+	return codes.Validate("ExternalReturnReason1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalTaxAmountType1Code string
+
+func (r ExternalTaxAmountType1Code) Validate() error {
+	if len(string(r)) < 1 {
+		return utils.NewErrTextLengthInvalid("ExternalTaxAmountType1Code", 1, 0)
+	}
+	return codes.Validate("ExternalTaxAmountType1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalTechnicalInputChannel1Code string
+
+func (r ExternalTechnicalInputChannel1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalTechnicalInputChannel1Code", 1, 4)
+	}
+	return codes.Validate("ExternalTechnicalInputChannel1Code", string(r))
+}
+
+// Must match the pattern [A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}
+type ISINIdentifier string
+
+var isinIdentifierRegexp = regexp.MustCompile(`^[A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}$`)
+
+func (r ISINIdentifier) Validate() error {
+	if !isinIdentifierRegexp.MatchString(string(r)) {
+		return utils.NewErrValueInvalid("ISINIdentifier")
+	}
+	return nil
+}
+
+// May be one of OFLN, ONLN, SMON
+type OnLineCapability1Code string
+
+func (r OnLineCapability1Code) Validate() error {
+	for _, vv := range []string{
+		"OFLN", "ONLN", "SMON",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("OnLineCapability1Code")
+}
+
+// May be one of SOFT, EMVK, EMVO, MRIT, CHIT, SECM, PEDV
+type POIComponentType1Code string
+
+func (r POIComponentType1Code) Validate() error {
+	for _, vv := range []string{
+		"SOFT", "EMVK", "EMVO", "MRIT", "CHIT", "SECM", "PEDV",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("POIComponentType1Code")
+}
+
+// May be one of OPOI, MERC, ACCP, ITAG, ACQR, CISS, DLIS
+type PartyType3Code string
+
+func (r PartyType3Code) Validate() error {
+	for _, vv := range []string{
+		"OPOI", "MERC", "ACCP", "ITAG", "ACQR", "CISS", "DLIS",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("PartyType3Code")
+}
+
+// May be one of MERC, ACCP, ITAG, ACQR, CISS, TAXH
+type PartyType4Code string
+
+func (r PartyType4Code) Validate() error {
+	for _, vv := range []string{
+		"MERC", "ACCP", "ITAG", "ACQR", "CISS", "TAXH",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("PartyType4Code")
+}
+
+// May be one of DISC, PREM, PARV
+type PriceValueType1Code string
+
+func (r PriceValueType1Code) Validate() error {
+	for _, vv := range []string{
+		"DISC", "PREM", "PARV",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("PriceValueType1Code")
+}
+
+// May be one of FAXI, EDIC, URID, EMAL, POST, SMSM
+type RemittanceLocationMethod2Code string
+
+func (r RemittanceLocationMethod2Code) Validate() error {
+	for _, vv := range []string{
+		"FAXI", "EDIC", "URID", "EMAL", "POST", "SMSM",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("RemittanceLocationMethod2Code")
+}
+
+// May be one of MM01, MM02, MM03, MM04, MM05, MM06, MM07, MM08, MM09, MM10, MM11, MM12, QTR1, QTR2, QTR3, QTR4, HLF1, HLF2
+type TaxRecordPeriod1Code string
+
+func (r TaxRecordPeriod1Code) Validate() error {
+	for _, vv := range []string{
+		"MM01", "MM02", "MM03", "MM04", "MM05", "MM06", "MM07", "MM08", "MM09", "MM10", "MM11", "MM12", "QTR1", "QTR2", "QTR3", "QTR4", "HLF1", "HLF2",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("TaxRecordPeriod1Code")
+}
+
+// May be one of PIEC, TONS, FOOT, GBGA, USGA, GRAM, INCH, KILO, PUND, METR, CMET, MMET, LITR, CELI, MILI, GBOU, USOU, GBQA, USQA, GBPI, USPI, MILE, KMET, YARD, SQKI, HECT, ARES, SMET, SCMT, SMIL, SQMI, SQYA, SQFO, SQIN, ACRE
+type UnitOfMeasure1Code string
+
+func (r UnitOfMeasure1Code) Validate() error {
+	for _, vv := range []string{
+		"PIEC", "TONS", "FOOT", "GBGA", "USGA", "GRAM", "INCH", "KILO", "PUND", "METR", "CMET", "MMET", "LITR", "CELI", "MILI", "GBOU", "USOU", "GBQA", "USQA", "GBPI", "USPI", "MILE", "KMET", "YARD", "SQKI", "HECT", "ARES", "SMET", "SCMT", "SMIL", "SQMI", "SQYA", "SQFO", "SQIN", "ACRE",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("UnitOfMeasure1Code")
+}
+
+// May be one of MDSP, CDSP
+type UserInterface2Code string
+
+func (r UserInterface2Code) Validate() error {
+	for _, vv := range []string{
+		"MDSP", "CDSP",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("UserInterface2Code")
+}
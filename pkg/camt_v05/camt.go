@@ -22,12 +22,12 @@ func (r BusinessDayCriteria2) Validate() error {
 }
 
 type BusinessDayCriteria3Choice struct {
-	QryNm   common.Max35Text     `xml:"QryNm"`
-	NewCrit BusinessDayCriteria2 `xml:"NewCrit"`
+	QryNm   *common.Max35Text     `xml:"QryNm,omitempty" json:",omitempty"`
+	NewCrit *BusinessDayCriteria2 `xml:"NewCrit,omitempty" json:",omitempty"`
 }
 
 func (r BusinessDayCriteria3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BusinessDayQuery2 struct {
@@ -75,13 +75,13 @@ func (r DateTimePeriod1) Validate() error {
 }
 
 type DateTimePeriod1Choice struct {
-	FrDtTm common.ISODateTime `xml:"FrDtTm"`
-	ToDtTm common.ISODateTime `xml:"ToDtTm"`
-	DtTmRg DateTimePeriod1    `xml:"DtTmRg"`
+	FrDtTm *common.ISODateTime `xml:"FrDtTm,omitempty" json:",omitempty"`
+	ToDtTm *common.ISODateTime `xml:"ToDtTm,omitempty" json:",omitempty"`
+	DtTmRg *DateTimePeriod1    `xml:"DtTmRg,omitempty" json:",omitempty"`
 }
 
 func (r DateTimePeriod1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericIdentification1 struct {
@@ -106,12 +106,12 @@ func (r GetBusinessDayInformationV05) Validate() error {
 }
 
 type MarketInfrastructureIdentification1Choice struct {
-	Cd    ExternalMarketInfrastructure1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalMarketInfrastructure1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MarketInfrastructureIdentification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MessageHeader9 struct {
@@ -125,13 +125,13 @@ func (r MessageHeader9) Validate() error {
 }
 
 type RequestType4Choice struct {
-	PmtCtrl ExternalPaymentControlRequestType1Code `xml:"PmtCtrl"`
-	Enqry   ExternalEnquiryRequestType1Code        `xml:"Enqry"`
-	Prtry   GenericIdentification1                 `xml:"Prtry"`
+	PmtCtrl *ExternalPaymentControlRequestType1Code `xml:"PmtCtrl,omitempty" json:",omitempty"`
+	Enqry   *ExternalEnquiryRequestType1Code        `xml:"Enqry,omitempty" json:",omitempty"`
+	Prtry   *GenericIdentification1                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r RequestType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SupplementaryData1 struct {
@@ -152,30 +152,30 @@ func (r SupplementaryDataEnvelope1) Validate() error {
 }
 
 type SystemEventType2Choice struct {
-	Cd    SystemEventType2Code   `xml:"Cd"`
-	Prtry GenericIdentification1 `xml:"Prtry"`
+	Cd    *SystemEventType2Code   `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification1 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r SystemEventType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SystemIdentification2Choice struct {
-	MktInfrstrctrId MarketInfrastructureIdentification1Choice `xml:"MktInfrstrctrId"`
-	Ctry            common.CountryCode                        `xml:"Ctry"`
+	MktInfrstrctrId *MarketInfrastructureIdentification1Choice `xml:"MktInfrstrctrId,omitempty" json:",omitempty"`
+	Ctry            *common.CountryCode                        `xml:"Ctry,omitempty" json:",omitempty"`
 }
 
 func (r SystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -199,12 +199,12 @@ func (r BranchData3) Validate() error {
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -217,12 +217,12 @@ func (r ClearingSystemMemberIdentification2) Validate() error {
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -261,7 +261,7 @@ func (r GenericIdentification30) Validate() error {
 type LongPaymentIdentification2 struct {
 	TxId           *common.Max35Text                            `xml:"TxId,omitempty" json:",omitempty"`
 	UETR           *common.UUIDv4Identifier                     `xml:"UETR,omitempty" json:",omitempty"`
-	IntrBkSttlmAmt float64                                      `xml:"IntrBkSttlmAmt"`
+	IntrBkSttlmAmt common.ImpliedCurrencyAndAmount              `xml:"IntrBkSttlmAmt"`
 	IntrBkSttlmDt  common.ISODate                               `xml:"IntrBkSttlmDt"`
 	PmtMtd         *PaymentOrigin1Choice                        `xml:"PmtMtd,omitempty" json:",omitempty"`
 	InstgAgt       BranchAndFinancialInstitutionIdentification6 `xml:"InstgAgt"`
@@ -285,26 +285,26 @@ func (r OriginalMessageAndIssuer1) Validate() error {
 }
 
 type PaymentIdentification6Choice struct {
-	TxId      common.Max35Text                `xml:"TxId"`
-	QId       QueueTransactionIdentification1 `xml:"QId"`
-	LngBizId  LongPaymentIdentification2      `xml:"LngBizId"`
-	ShrtBizId ShortPaymentIdentification2     `xml:"ShrtBizId"`
-	PrtryId   common.Max70Text                `xml:"PrtryId"`
+	TxId      *common.Max35Text                `xml:"TxId,omitempty" json:",omitempty"`
+	QId       *QueueTransactionIdentification1 `xml:"QId,omitempty" json:",omitempty"`
+	LngBizId  *LongPaymentIdentification2      `xml:"LngBizId,omitempty" json:",omitempty"`
+	ShrtBizId *ShortPaymentIdentification2     `xml:"ShrtBizId,omitempty" json:",omitempty"`
+	PrtryId   *common.Max70Text                `xml:"PrtryId,omitempty" json:",omitempty"`
 }
 
 func (r PaymentIdentification6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentOrigin1Choice struct {
-	FINMT    common.Max3NumericText `xml:"FINMT"`
-	XMLMsgNm common.Max35Text       `xml:"XMLMsgNm"`
-	Prtry    common.Max35Text       `xml:"Prtry"`
-	Instrm   PaymentInstrument1Code `xml:"Instrm"`
+	FINMT    *common.Max3NumericText `xml:"FINMT,omitempty" json:",omitempty"`
+	XMLMsgNm *common.Max35Text       `xml:"XMLMsgNm,omitempty" json:",omitempty"`
+	Prtry    *common.Max35Text       `xml:"Prtry,omitempty" json:",omitempty"`
+	Instrm   *PaymentInstrument1Code `xml:"Instrm,omitempty" json:",omitempty"`
 }
 
 func (r PaymentOrigin1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress24 struct {
@@ -482,12 +482,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OtherContact1 struct {
@@ -500,12 +500,12 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Party40Choice struct {
@@ -514,7 +514,7 @@ type Party40Choice struct {
 }
 
 func (r Party40Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification135 struct {
@@ -539,12 +539,12 @@ func (r PersonIdentification13) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReportHeader5 struct {
@@ -600,8 +600,8 @@ func (r SkipPayload) Validate() error {
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64                   `xml:",chardata"`
-	Ccy   common.ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -655,21 +655,21 @@ func (r CaseStatusReportV05) Validate() error {
 }
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericAccountIdentification1 struct {
@@ -694,12 +694,12 @@ func (r GetReservationV05) Validate() error {
 }
 
 type ReservationCriteria3Choice struct {
-	QryNm   common.Max35Text     `xml:"QryNm"`
-	NewCrit ReservationCriteria4 `xml:"NewCrit"`
+	QryNm   *common.Max35Text     `xml:"QryNm,omitempty" json:",omitempty"`
+	NewCrit *ReservationCriteria4 `xml:"NewCrit,omitempty" json:",omitempty"`
 }
 
 func (r ReservationCriteria3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReservationCriteria4 struct {
@@ -743,30 +743,30 @@ func (r ReservationSearchCriteria3) Validate() error {
 }
 
 type Amount2Choice struct {
-	AmtWthtCcy float64                 `xml:"AmtWthtCcy"`
-	AmtWthCcy  ActiveCurrencyAndAmount `xml:"AmtWthCcy"`
+	AmtWthtCcy *common.ImpliedCurrencyAndAmount `xml:"AmtWthtCcy,omitempty" json:",omitempty"`
+	AmtWthCcy  *ActiveCurrencyAndAmount         `xml:"AmtWthCcy,omitempty" json:",omitempty"`
 }
 
 func (r Amount2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CurrentOrDefaultReservation2Choice struct {
-	Cur  ReservationIdentification2 `xml:"Cur"`
-	Dflt ReservationIdentification2 `xml:"Dflt"`
+	Cur  *ReservationIdentification2 `xml:"Cur,omitempty" json:",omitempty"`
+	Dflt *ReservationIdentification2 `xml:"Dflt,omitempty" json:",omitempty"`
 }
 
 func (r CurrentOrDefaultReservation2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateAndDateTime2Choice struct {
-	Dt   common.ISODate     `xml:"Dt"`
-	DtTm common.ISODateTime `xml:"DtTm"`
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTime2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ModifyReservationV05 struct {
@@ -823,12 +823,12 @@ func (r ReservationIdentification2) Validate() error {
 }
 
 type ReservationType1Choice struct {
-	Cd    ReservationType2Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ReservationType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReservationType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CashAccount38 struct {
@@ -844,12 +844,12 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type LiquidityCreditTransfer2 struct {
@@ -898,12 +898,12 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type LiquidityDebitTransfer2 struct {
@@ -943,8 +943,8 @@ func (r AccountReportingRequestV05) Validate() error {
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
-	Value float64                             `xml:",chardata"`
-	Ccy   common.ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
@@ -952,21 +952,21 @@ func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
 }
 
 type BalanceSubType1Choice struct {
-	Cd    ExternalBalanceSubType1Code `xml:"Cd"`
-	Prtry common.Max35Text            `xml:"Prtry"`
+	Cd    *ExternalBalanceSubType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text            `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BalanceSubType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BalanceType10Choice struct {
-	Cd    ExternalBalanceType1Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *ExternalBalanceType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BalanceType10Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BalanceType13 struct {
@@ -988,12 +988,12 @@ func (r DatePeriodDetails1) Validate() error {
 }
 
 type EntryStatus1Choice struct {
-	Cd    ExternalEntryStatus1Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *ExternalEntryStatus1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r EntryStatus1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GroupHeader77 struct {
@@ -1051,15 +1051,15 @@ func (r SequenceRange1) Validate() error {
 }
 
 type SequenceRange1Choice struct {
-	FrSeq   common.Max35Text   `xml:"FrSeq"`
-	ToSeq   common.Max35Text   `xml:"ToSeq"`
+	FrSeq   *common.Max35Text  `xml:"FrSeq,omitempty" json:",omitempty"`
+	ToSeq   *common.Max35Text  `xml:"ToSeq,omitempty" json:",omitempty"`
 	FrToSeq []SequenceRange1   `xml:"FrToSeq" json:",omitempty"`
 	EQSeq   []common.Max35Text `xml:"EQSeq" json:",omitempty"`
 	NEQSeq  []common.Max35Text `xml:"NEQSeq" json:",omitempty"`
 }
 
 func (r SequenceRange1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TimePeriodDetails1 struct {
@@ -1100,12 +1100,12 @@ func (r AmendmentInformationDetails10) Validate() error {
 }
 
 type AmountType4Choice struct {
-	InstdAmt ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt"`
-	EqvtAmt  EquivalentAmount2                 `xml:"EqvtAmt"`
+	InstdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty" json:",omitempty"`
+	EqvtAmt  *EquivalentAmount2                 `xml:"EqvtAmt,omitempty" json:",omitempty"`
 }
 
 func (r AmountType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification5 struct {
@@ -1128,12 +1128,12 @@ func (r BranchData2) Validate() error {
 }
 
 type CancellationReason14Choice struct {
-	Cd    CancellationReason5Code `xml:"Cd"`
-	Prtry common.Max35Text        `xml:"Prtry"`
+	Cd    *CancellationReason5Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CancellationReason14Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Case3 struct {
@@ -1169,21 +1169,21 @@ func (r CashAccount24) Validate() error {
 }
 
 type CategoryPurpose1Choice struct {
-	Cd    ExternalCategoryPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCategoryPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CategoryPurpose1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification3Choice struct {
-	Cd    ExternalCashClearingSystem1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalCashClearingSystem1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ContactDetails2 struct {
@@ -1202,7 +1202,7 @@ func (r ContactDetails2) Validate() error {
 
 type ControlData1 struct {
 	NbOfTxs *common.Max15NumericText `xml:"NbOfTxs,omitempty" json:",omitempty"`
-	CtrlSum float64                  `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum *common.DecimalNumber    `xml:"CtrlSum,omitempty" json:",omitempty"`
 }
 
 func (r ControlData1) Validate() error {
@@ -1219,12 +1219,12 @@ func (r CreditorReferenceInformation2) Validate() error {
 }
 
 type CreditorReferenceType1Choice struct {
-	Cd    DocumentType3Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorReferenceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorReferenceType2 struct {
@@ -1266,12 +1266,12 @@ func (r DiscountAmountAndType1) Validate() error {
 }
 
 type DiscountAmountType1Choice struct {
-	Cd    ExternalDiscountAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalDiscountAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DiscountAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentAdjustment1 struct {
@@ -1315,12 +1315,12 @@ func (r DocumentLineType1) Validate() error {
 }
 
 type DocumentLineType1Choice struct {
-	Cd    ExternalDocumentLineType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalDocumentLineType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DocumentLineType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EquivalentAmount2 struct {
@@ -1358,12 +1358,12 @@ func (r FinancialInstitutionIdentification8) Validate() error {
 }
 
 type Frequency21Choice struct {
-	Tp  Frequency6Code   `xml:"Tp"`
-	Prd FrequencyPeriod1 `xml:"Prd"`
+	Tp  *Frequency6Code   `xml:"Tp,omitempty" json:",omitempty"`
+	Prd *FrequencyPeriod1 `xml:"Prd,omitempty" json:",omitempty"`
 }
 
 func (r Frequency21Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FrequencyPeriod1 struct {
@@ -1400,21 +1400,21 @@ func (r GarnishmentType1) Validate() error {
 }
 
 type GarnishmentType1Choice struct {
-	Cd    ExternalGarnishmentType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalGarnishmentType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r GarnishmentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type LocalInstrument2Choice struct {
-	Cd    ExternalLocalInstrument1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalLocalInstrument1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LocalInstrument2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedInformation10 struct {
@@ -1434,12 +1434,12 @@ func (r MandateRelatedInformation10) Validate() error {
 }
 
 type MandateSetupReason1Choice struct {
-	Cd    ExternalMandateSetupReason1Code `xml:"Cd"`
-	Prtry common.Max70Text                `xml:"Prtry"`
+	Cd    *ExternalMandateSetupReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max70Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateSetupReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OrganisationIdentification8 struct {
@@ -1458,7 +1458,7 @@ type OriginalGroupHeader4 struct {
 	OrgnlMsgNmId common.Max35Text             `xml:"OrgnlMsgNmId"`
 	OrgnlCreDtTm *common.ISODateTime          `xml:"OrgnlCreDtTm,omitempty" json:",omitempty"`
 	NbOfTxs      *common.Max15NumericText     `xml:"NbOfTxs,omitempty" json:",omitempty"`
-	CtrlSum      float64                      `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum      *common.DecimalNumber        `xml:"CtrlSum,omitempty" json:",omitempty"`
 	GrpCxl       bool                         `xml:"GrpCxl,omitempty" json:",omitempty"`
 	CxlRsnInf    []PaymentCancellationReason2 `xml:"CxlRsnInf,omitempty" json:",omitempty"`
 }
@@ -1506,21 +1506,21 @@ func (r OriginalTransactionReference22) Validate() error {
 }
 
 type Party11Choice struct {
-	OrgId  OrganisationIdentification8 `xml:"OrgId"`
-	PrvtId PersonIdentification5       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification8 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification5       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party11Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Party12Choice struct {
-	Pty PartyIdentification43                        `xml:"Pty"`
-	Agt BranchAndFinancialInstitutionIdentification5 `xml:"Agt"`
+	Pty *PartyIdentification43                        `xml:"Pty,omitempty" json:",omitempty"`
+	Agt *BranchAndFinancialInstitutionIdentification5 `xml:"Agt,omitempty" json:",omitempty"`
 }
 
 func (r Party12Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification43 struct {
@@ -1617,12 +1617,12 @@ func (r ReferredDocumentInformation7) Validate() error {
 }
 
 type ReferredDocumentType3Choice struct {
-	Cd    DocumentType6Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType6Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReferredDocumentType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentType4 struct {
@@ -1670,12 +1670,12 @@ func (r RemittanceInformation11) Validate() error {
 }
 
 type ServiceLevel8Choice struct {
-	Cd    ExternalServiceLevel1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalServiceLevel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ServiceLevel8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SettlementInstruction4 struct {
@@ -1730,12 +1730,12 @@ func (r TaxAmountAndType1) Validate() error {
 }
 
 type TaxAmountType1Choice struct {
-	Cd    ExternalTaxAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text           `xml:"Prtry"`
+	Cd    *ExternalTaxAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text           `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r TaxAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxAuthorisation1 struct {
@@ -1831,12 +1831,12 @@ func (r UnderlyingTransaction13) Validate() error {
 }
 
 type DateAndDateTimeChoice struct {
-	Dt   common.ISODate     `xml:"Dt"`
-	DtTm common.ISODateTime `xml:"DtTm"`
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTimeChoice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MissingOrIncorrectInformation3 struct {
@@ -1859,13 +1859,13 @@ func (r UnableToApplyIncorrect1) Validate() error {
 }
 
 type UnableToApplyJustification3Choice struct {
-	AnyInf            bool                           `xml:"AnyInf"`
-	MssngOrIncrrctInf MissingOrIncorrectInformation3 `xml:"MssngOrIncrrctInf"`
-	PssblDplctInstr   bool                           `xml:"PssblDplctInstr"`
+	AnyInf            *bool                           `xml:"AnyInf,omitempty" json:",omitempty"`
+	MssngOrIncrrctInf *MissingOrIncorrectInformation3 `xml:"MssngOrIncrrctInf,omitempty" json:",omitempty"`
+	PssblDplctInstr   *bool                           `xml:"PssblDplctInstr,omitempty" json:",omitempty"`
 }
 
 func (r UnableToApplyJustification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type UnableToApplyMissing1 struct {
@@ -1939,13 +1939,13 @@ func (r UnderlyingStatementEntry1) Validate() error {
 }
 
 type UnderlyingTransaction3Choice struct {
-	Initn    UnderlyingPaymentInstruction3 `xml:"Initn"`
-	IntrBk   UnderlyingPaymentTransaction2 `xml:"IntrBk"`
-	StmtNtry UnderlyingStatementEntry1     `xml:"StmtNtry"`
+	Initn    *UnderlyingPaymentInstruction3 `xml:"Initn,omitempty" json:",omitempty"`
+	IntrBk   *UnderlyingPaymentTransaction2 `xml:"IntrBk,omitempty" json:",omitempty"`
+	StmtNtry *UnderlyingStatementEntry1     `xml:"StmtNtry,omitempty" json:",omitempty"`
 }
 
 func (r UnderlyingTransaction3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AdditionalPaymentInformationV05 struct {
@@ -2021,12 +2021,12 @@ func (r PaymentComplementaryInformation4) Validate() error {
 }
 
 type Purpose2Choice struct {
-	Cd    ExternalPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Purpose2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentInformation6 struct {
@@ -2094,11 +2094,11 @@ func (r UnderlyingPaymentInstruction2) Validate() error {
 }
 
 type UnderlyingTransaction2Choice struct {
-	Initn    UnderlyingPaymentInstruction2 `xml:"Initn"`
-	IntrBk   UnderlyingPaymentTransaction2 `xml:"IntrBk"`
-	StmtNtry UnderlyingStatementEntry1     `xml:"StmtNtry"`
+	Initn    *UnderlyingPaymentInstruction2 `xml:"Initn,omitempty" json:",omitempty"`
+	IntrBk   *UnderlyingPaymentTransaction2 `xml:"IntrBk,omitempty" json:",omitempty"`
+	StmtNtry *UnderlyingStatementEntry1     `xml:"StmtNtry,omitempty" json:",omitempty"`
 }
 
 func (r UnderlyingTransaction2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
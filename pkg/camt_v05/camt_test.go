@@ -17,7 +17,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, BusinessDayReturnCriteria2{}.Validate())
 	assert.Nil(t, BusinessDaySearchCriteria2{}.Validate())
 	assert.Nil(t, DateTimePeriod1{}.Validate())
-	assert.Nil(t, DateTimePeriod1Choice{}.Validate())
+	assert.NotNil(t, DateTimePeriod1Choice{}.Validate())
 	assert.NotNil(t, GenericIdentification1{}.Validate())
 	assert.NotNil(t, GetBusinessDayInformationV05{}.Validate())
 	assert.NotNil(t, MarketInfrastructureIdentification1Choice{}.Validate())
@@ -57,8 +57,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PersonIdentification13{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
@@ -83,7 +83,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, ReservationSearchCriteria3{}.Validate())
 	assert.NotNil(t, Amount2Choice{}.Validate())
 	assert.NotNil(t, CurrentOrDefaultReservation2Choice{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, ModifyReservationV05{}.Validate())
 	assert.NotNil(t, Reservation4{}.Validate())
 	assert.NotNil(t, DeleteReservationV05{}.Validate())
@@ -153,8 +153,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OriginalGroupHeader4{}.Validate())
 	assert.NotNil(t, OriginalGroupInformation3{}.Validate())
 	assert.Nil(t, OriginalTransactionReference22{}.Validate())
-	assert.Nil(t, Party11Choice{}.Validate())
-	assert.Nil(t, Party12Choice{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
+	assert.NotNil(t, Party12Choice{}.Validate())
 	assert.Nil(t, PartyIdentification43{}.Validate())
 	assert.Nil(t, PaymentCancellationReason2{}.Validate())
 	assert.Nil(t, PaymentTransaction62{}.Validate())
@@ -181,10 +181,10 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, TaxRecord1{}.Validate())
 	assert.NotNil(t, TaxRecordDetails1{}.Validate())
 	assert.Nil(t, UnderlyingTransaction13{}.Validate())
-	assert.Nil(t, DateAndDateTimeChoice{}.Validate())
+	assert.NotNil(t, DateAndDateTimeChoice{}.Validate())
 	assert.Nil(t, MissingOrIncorrectInformation3{}.Validate())
 	assert.NotNil(t, UnableToApplyIncorrect1{}.Validate())
-	assert.Nil(t, UnableToApplyJustification3Choice{}.Validate())
+	assert.NotNil(t, UnableToApplyJustification3Choice{}.Validate())
 	assert.NotNil(t, UnableToApplyMissing1{}.Validate())
 	assert.NotNil(t, UnableToApplyV05{}.Validate())
 	assert.NotNil(t, UnderlyingGroupInformation1{}.Validate())
@@ -224,6 +224,8 @@ func TestTypes(t *testing.T) {
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalFinancialInstitutionIdentification1Code
@@ -233,22 +235,22 @@ func TestTypes(t *testing.T) {
 
 	var type6 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type6.Validate())
-	type6 = "test"
+	type6 = "BANK"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalPersonIdentification1Code
 	assert.NotNil(t, type7.Validate())
-	type7 = "test"
+	type7 = "ARNU"
 	assert.Nil(t, type7.Validate())
 
 	var type8 ExternalAccountIdentification1Code
 	assert.NotNil(t, type8.Validate())
-	type8 = "test"
+	type8 = "AIIN"
 	assert.Nil(t, type8.Validate())
 
 	var type9 ExternalCashAccountType1Code
 	assert.NotNil(t, type9.Validate())
-	type9 = "test"
+	type9 = "CACC"
 	assert.Nil(t, type9.Validate())
 
 	var type10 ExternalProxyAccountType1Code
@@ -273,7 +275,7 @@ func TestTypes(t *testing.T) {
 
 	var type14 ExternalEntryStatus1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "BOOK"
 	assert.Nil(t, type14.Validate())
 
 	var type15 EntryTypeIdentifier
@@ -281,6 +283,8 @@ func TestTypes(t *testing.T) {
 	type15 = "test"
 	assert.NotNil(t, type15.Validate())
 	type15 = "B00DUM"
+	assert.NotNil(t, type15.Validate())
+	type15 = "B00"
 	assert.Nil(t, type15.Validate())
 
 	var type17 QueryType2Code
@@ -384,6 +388,8 @@ func TestTypes(t *testing.T) {
 	var type31 ExternalServiceLevel1Code
 	assert.NotNil(t, type31.Validate())
 	type31 = "test"
+	assert.NotNil(t, type31.Validate())
+	type31 = "SEPA"
 	assert.Nil(t, type31.Validate())
 
 	var type32 SettlementMethod1Code
@@ -395,7 +401,7 @@ func TestTypes(t *testing.T) {
 
 	var type33 ExternalTaxAmountType1Code
 	assert.NotNil(t, type33.Validate())
-	type33 = "test"
+	type33 = "CITY"
 	assert.Nil(t, type33.Validate())
 
 	var type34 TaxRecordPeriod1Code
@@ -429,6 +435,8 @@ func TestTypes(t *testing.T) {
 	var type38 ExternalPurpose1Code
 	assert.NotNil(t, type38.Validate())
 	type38 = "test"
+	assert.NotNil(t, type38.Validate())
+	type38 = "GDDS"
 	assert.Nil(t, type38.Validate())
 
 	var type39 Instruction3Code
@@ -482,7 +490,7 @@ func TestTypes(t *testing.T) {
 
 	var type46 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type46.Validate())
-	type46 = "test"
+	type46 = "BONU"
 	assert.Nil(t, type46.Validate())
 
 	var type47 ExternalCashClearingSystem1Code
@@ -492,7 +500,7 @@ func TestTypes(t *testing.T) {
 
 	var type48 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type48.Validate())
-	type48 = "test"
+	type48 = "APDS"
 	assert.Nil(t, type48.Validate())
 
 	var type49 ExternalDocumentLineType1Code
@@ -516,12 +524,14 @@ func TestTypes(t *testing.T) {
 
 	var type52 ExternalGarnishmentType1Code
 	assert.NotNil(t, type52.Validate())
-	type52 = "test"
+	type52 = "GNCS"
 	assert.Nil(t, type52.Validate())
 
 	var type53 ExternalLocalInstrument1Code
 	assert.NotNil(t, type53.Validate())
 	type53 = "test"
+	assert.NotNil(t, type53.Validate())
+	type53 = "INST"
 	assert.Nil(t, type53.Validate())
 
 	var type54 ExternalMandateSetupReason1Code
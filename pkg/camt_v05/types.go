@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -18,7 +19,7 @@ func (r ExternalEnquiryRequestType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalEnquiryRequestType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalEnquiryRequestType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -28,7 +29,7 @@ func (r ExternalMarketInfrastructure1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 3 {
 		return utils.NewErrTextLengthInvalid("ExternalMarketInfrastructure1Code", 1, 3)
 	}
-	return nil
+	return codes.Validate("ExternalMarketInfrastructure1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -38,7 +39,7 @@ func (r ExternalPaymentControlRequestType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPaymentControlRequestType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPaymentControlRequestType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -48,7 +49,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -58,7 +59,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -68,7 +69,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -78,7 +79,7 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -88,7 +89,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -98,7 +99,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -108,7 +109,7 @@ func (r ExternalProxyAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalProxyAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalProxyAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -118,7 +119,7 @@ func (r ExternalBalanceSubType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBalanceSubType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBalanceSubType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -128,7 +129,7 @@ func (r ExternalBalanceType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBalanceType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBalanceType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -138,15 +139,16 @@ func (r ExternalEntryStatus1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalEntryStatus1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalEntryStatus1Code", string(r))
 }
 
 // Must match the pattern [BEOVW]{1,1}[0-9]{2,2}|DUM
 type EntryTypeIdentifier string
 
+var entryTypeIdentifierRegexp = regexp.MustCompile(`^(?:[BEOVW]{1,1}[0-9]{2,2}|DUM)$`)
+
 func (r EntryTypeIdentifier) Validate() error {
-	reg := regexp.MustCompile(`[BEOVW]{1,1}[0-9]{2,2}|DUM`)
-	if !reg.MatchString(string(r)) {
+	if !entryTypeIdentifierRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("EntryTypeIdentifier")
 	}
 	return nil
@@ -355,7 +357,7 @@ func (r ExternalServiceLevel1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalServiceLevel1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalServiceLevel1Code", string(r))
 }
 
 // May be one of INDA, INGA, COVE, CLRG
@@ -379,7 +381,7 @@ func (r ExternalTaxAmountType1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalTaxAmountType1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalTaxAmountType1Code", string(r))
 }
 
 // May be one of MM01, MM02, MM03, MM04, MM05, MM06, MM07, MM08, MM09, MM10, MM11, MM12, QTR1, QTR2, QTR3, QTR4, HLF1, HLF2
@@ -433,7 +435,7 @@ func (r ExternalPurpose1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalPurpose1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalPurpose1Code", string(r))
 }
 
 // May be one of DEBT, CRED, SHAR, SLEV
@@ -541,7 +543,7 @@ func (r ExternalCategoryPurpose1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalCategoryPurpose1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalCategoryPurpose1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -551,7 +553,7 @@ func (r ExternalCashClearingSystem1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalCashClearingSystem1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalCashClearingSystem1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -561,7 +563,7 @@ func (r ExternalDiscountAmountType1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalDiscountAmountType1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalDiscountAmountType1Code", string(r))
 }
 
 // May be one of CRDT, DBIT
@@ -585,7 +587,7 @@ func (r ExternalDocumentLineType1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalDocumentLineType1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalDocumentLineType1Code", string(r))
 }
 
 // May be one of YEAR, MNTH, QURT, MIAN, WEEK, DAIL, ADHO, INDA, FRTN
@@ -609,7 +611,7 @@ func (r ExternalGarnishmentType1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalGarnishmentType1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalGarnishmentType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -619,7 +621,7 @@ func (r ExternalLocalInstrument1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalLocalInstrument1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalLocalInstrument1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -630,5 +632,5 @@ func (r ExternalMandateSetupReason1Code) Validate() error {
 		return utils.NewErrTextLengthInvalid("ExternalMandateSetupReason1Code"+
 			"", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalMandateSetupReason1Code", string(r))
 }
@@ -12,12 +12,12 @@ import (
 )
 
 type ErrorHandling3Choice struct {
-	Cd    ExternalSystemErrorHandling1Code `xml:"Cd"`
-	Prtry common.Max35Text                 `xml:"Prtry"`
+	Cd    *ExternalSystemErrorHandling1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ErrorHandling3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ErrorHandling5 struct {
@@ -45,16 +45,16 @@ type GeneralBusinessOrError7Choice struct {
 }
 
 func (r GeneralBusinessOrError7Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GeneralBusinessOrError8Choice struct {
-	BizErr []ErrorHandling5            `xml:"BizErr" json:",omitempty"`
-	GnlBiz GeneralBusinessInformation1 `xml:"GnlBiz" json:",omitempty"`
+	BizErr []ErrorHandling5             `xml:"BizErr" json:",omitempty"`
+	GnlBiz *GeneralBusinessInformation1 `xml:"GnlBiz,omitempty" json:",omitempty"`
 }
 
 func (r GeneralBusinessOrError8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GeneralBusinessReport6 struct {
@@ -108,13 +108,13 @@ func (r OriginalBusinessQuery1) Validate() error {
 }
 
 type RequestType4Choice struct {
-	PmtCtrl ExternalPaymentControlRequestType1Code `xml:"PmtCtrl"`
-	Enqry   ExternalEnquiryRequestType1Code        `xml:"Enqry"`
-	Prtry   GenericIdentification1                 `xml:"Prtry"`
+	PmtCtrl *ExternalPaymentControlRequestType1Code `xml:"PmtCtrl,omitempty" json:",omitempty"`
+	Enqry   *ExternalEnquiryRequestType1Code        `xml:"Enqry,omitempty" json:",omitempty"`
+	Prtry   *GenericIdentification1                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r RequestType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReturnGeneralBusinessInformationV06 struct {
@@ -146,26 +146,26 @@ func (r SupplementaryDataEnvelope1) Validate() error {
 }
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64                   `xml:",chardata"`
-	Ccy   common.ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -173,21 +173,21 @@ func (r ActiveCurrencyAndAmount) Validate() error {
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Amount2Choice struct {
-	AmtWthtCcy float64                 `xml:"AmtWthtCcy"`
-	AmtWthCcy  ActiveCurrencyAndAmount `xml:"AmtWthCcy"`
+	AmtWthtCcy *common.ImpliedCurrencyAndAmount `xml:"AmtWthtCcy,omitempty" json:",omitempty"`
+	AmtWthCcy  *ActiveCurrencyAndAmount         `xml:"AmtWthCcy,omitempty" json:",omitempty"`
 }
 
 func (r Amount2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -223,21 +223,21 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -259,40 +259,40 @@ func (r DatePeriod2) Validate() error {
 }
 
 type DatePeriod2Choice struct {
-	FrDt   common.ISODate `xml:"FrDt"`
-	ToDt   common.ISODate `xml:"ToDt"`
-	FrToDt DatePeriod2    `xml:"FrToDt"`
+	FrDt   *common.ISODate `xml:"FrDt,omitempty" json:",omitempty"`
+	ToDt   *common.ISODate `xml:"ToDt,omitempty" json:",omitempty"`
+	FrToDt *DatePeriod2    `xml:"FrToDt,omitempty" json:",omitempty"`
 }
 
 func (r DatePeriod2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EventType1Choice struct {
-	Cd    ExternalSystemEventType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalSystemEventType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r EventType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ExecutionType1Choice struct {
-	Tm  common.ISOTime   `xml:"Tm"`
-	Evt EventType1Choice `xml:"Evt"`
+	Tm  *common.ISOTime   `xml:"Tm,omitempty" json:",omitempty"`
+	Evt *EventType1Choice `xml:"Evt,omitempty" json:",omitempty"`
 }
 
 func (r ExecutionType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -392,12 +392,12 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StandingOrder7 struct {
@@ -516,12 +516,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OtherContact1 struct {
@@ -534,21 +534,21 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Party40Choice struct {
-	Pty PartyIdentification135                       `xml:"Pty"`
-	Agt BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
+	Pty *PartyIdentification135                       `xml:"Pty,omitempty" json:",omitempty"`
+	Agt *BranchAndFinancialInstitutionIdentification6 `xml:"Agt,omitempty" json:",omitempty"`
 }
 
 func (r Party40Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification135 struct {
@@ -573,12 +573,12 @@ func (r PersonIdentification13) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type RejectInvestigationV06 struct {
@@ -651,21 +651,21 @@ func (r CurrentAndDefaultReservation4) Validate() error {
 }
 
 type DateAndDateTime2Choice struct {
-	Dt   common.ISODate     `xml:"Dt"`
-	DtTm common.ISODateTime `xml:"DtTm"`
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTime2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MarketInfrastructureIdentification1Choice struct {
-	Cd    ExternalMarketInfrastructure1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalMarketInfrastructure1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MarketInfrastructureIdentification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Reservation3 struct {
@@ -691,21 +691,21 @@ func (r ReservationIdentification2) Validate() error {
 }
 
 type ReservationOrError8Choice struct {
-	BizRpt  CurrentAndDefaultReservation4 `xml:"BizRpt"`
-	OprlErr []ErrorHandling5              `xml:"OprlErr" json:",omitempty"`
+	BizRpt  *CurrentAndDefaultReservation4 `xml:"BizRpt,omitempty" json:",omitempty"`
+	OprlErr []ErrorHandling5               `xml:"OprlErr" json:",omitempty"`
 }
 
 func (r ReservationOrError8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReservationOrError9Choice struct {
-	Rsvatn Reservation3     `xml:"Rsvatn"`
+	Rsvatn *Reservation3    `xml:"Rsvatn,omitempty" json:",omitempty"`
 	BizErr []ErrorHandling5 `xml:"BizErr" json:",omitempty"`
 }
 
 func (r ReservationOrError9Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReservationReport6 struct {
@@ -718,21 +718,21 @@ func (r ReservationReport6) Validate() error {
 }
 
 type ReservationStatus1Choice struct {
-	Cd    ReservationStatus1Code `xml:"Cd"`
-	Prtry common.Max35Text       `xml:"Prtry"`
+	Cd    *ReservationStatus1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text       `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReservationStatus1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReservationType1Choice struct {
-	Cd    ReservationType2Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ReservationType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReservationType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReturnReservationV06 struct {
@@ -747,12 +747,12 @@ func (r ReturnReservationV06) Validate() error {
 }
 
 type SystemIdentification2Choice struct {
-	MktInfrstrctrId MarketInfrastructureIdentification1Choice `xml:"MktInfrstrctrId"`
-	Ctry            common.CountryCode                        `xml:"Ctry"`
+	MktInfrstrctrId *MarketInfrastructureIdentification1Choice `xml:"MktInfrstrctrId,omitempty" json:",omitempty"`
+	Ctry            *common.CountryCode                        `xml:"Ctry,omitempty" json:",omitempty"`
 }
 
 func (r SystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountNotification16 struct {
@@ -807,12 +807,12 @@ func (r NotificationToReceiveV06) Validate() error {
 }
 
 type Purpose2Choice struct {
-	Cd    ExternalPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Purpose2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentInformation7 struct {
@@ -827,12 +827,12 @@ func (r ReferredDocumentInformation7) Validate() error {
 }
 
 type ReferredDocumentType3Choice struct {
-	Cd    DocumentType6Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType6Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReferredDocumentType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentType4 struct {
@@ -934,12 +934,12 @@ func (r TaxAmountAndType1) Validate() error {
 }
 
 type TaxAmountType1Choice struct {
-	Cd    ExternalTaxAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text           `xml:"Prtry"`
+	Cd    *ExternalTaxAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text           `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r TaxAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxAuthorisation1 struct {
@@ -1026,8 +1026,8 @@ func (r TaxRecordDetails2) Validate() error {
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
-	Value float64                             `xml:",chardata"`
-	Ccy   common.ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
@@ -1044,12 +1044,12 @@ func (r CreditorReferenceInformation2) Validate() error {
 }
 
 type CreditorReferenceType1Choice struct {
-	Cd    DocumentType3Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorReferenceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorReferenceType2 struct {
@@ -1071,12 +1071,12 @@ func (r DiscountAmountAndType1) Validate() error {
 }
 
 type DiscountAmountType1Choice struct {
-	Cd    ExternalDiscountAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalDiscountAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DiscountAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentAdjustment1 struct {
@@ -1120,12 +1120,12 @@ func (r DocumentLineType1) Validate() error {
 }
 
 type DocumentLineType1Choice struct {
-	Cd    ExternalDocumentLineType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalDocumentLineType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DocumentLineType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Garnishment3 struct {
@@ -1153,12 +1153,12 @@ func (r GarnishmentType1) Validate() error {
 }
 
 type GarnishmentType1Choice struct {
-	Cd    ExternalGarnishmentType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalGarnishmentType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r GarnishmentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GroupHeader77 struct {
@@ -1335,12 +1335,12 @@ func (r AmendmentInformationDetails10) Validate() error {
 }
 
 type AmountType4Choice struct {
-	InstdAmt ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt"`
-	EqvtAmt  EquivalentAmount2                 `xml:"EqvtAmt"`
+	InstdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty" json:",omitempty"`
+	EqvtAmt  *EquivalentAmount2                 `xml:"EqvtAmt,omitempty" json:",omitempty"`
 }
 
 func (r AmountType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification5 struct {
@@ -1373,12 +1373,12 @@ func (r CancellationStatusReason2) Validate() error {
 }
 
 type CancellationStatusReason2Choice struct {
-	Cd    PaymentCancellationRejection2Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *PaymentCancellationRejection2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CancellationStatusReason2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Case3 struct {
@@ -1414,21 +1414,21 @@ func (r CashAccount24) Validate() error {
 }
 
 type CategoryPurpose1Choice struct {
-	Cd    ExternalCategoryPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCategoryPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CategoryPurpose1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ChargeType3Choice struct {
-	Cd    ExternalChargeType1Code `xml:"Cd"`
-	Prtry GenericIdentification3  `xml:"Prtry"`
+	Cd    *ExternalChargeType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification3  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ChargeType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Charges3 struct {
@@ -1455,12 +1455,12 @@ func (r ChargesRecord1) Validate() error {
 }
 
 type ClearingSystemIdentification3Choice struct {
-	Cd    ExternalCashClearingSystem1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalCashClearingSystem1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ContactDetails2 struct {
@@ -1515,12 +1515,12 @@ func (r CorrectivePaymentInitiation1) Validate() error {
 }
 
 type CorrectiveTransaction1Choice struct {
-	Initn  CorrectivePaymentInitiation1    `xml:"Initn"`
-	IntrBk CorrectiveInterbankTransaction1 `xml:"IntrBk"`
+	Initn  *CorrectivePaymentInitiation1    `xml:"Initn,omitempty" json:",omitempty"`
+	IntrBk *CorrectiveInterbankTransaction1 `xml:"IntrBk,omitempty" json:",omitempty"`
 }
 
 func (r CorrectiveTransaction1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateAndPlaceOfBirth struct {
@@ -1565,12 +1565,12 @@ func (r FinancialInstitutionIdentification8) Validate() error {
 }
 
 type Frequency21Choice struct {
-	Tp  Frequency6Code   `xml:"Tp"`
-	Prd FrequencyPeriod1 `xml:"Prd"`
+	Tp  *Frequency6Code   `xml:"Tp,omitempty" json:",omitempty"`
+	Prd *FrequencyPeriod1 `xml:"Prd,omitempty" json:",omitempty"`
 }
 
 func (r Frequency21Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FrequencyPeriod1 struct {
@@ -1607,23 +1607,23 @@ func (r GenericIdentification3) Validate() error {
 }
 
 type InvestigationStatus3Choice struct {
-	Conf           InvestigationExecutionConfirmation3Code `xml:"Conf"`
-	RjctdMod       []ModificationRejection2Code            `xml:"RjctdMod"`
-	DplctOf        Case3                                   `xml:"DplctOf"`
-	AssgnmtCxlConf bool                                    `xml:"AssgnmtCxlConf"`
+	Conf           *InvestigationExecutionConfirmation3Code `xml:"Conf,omitempty" json:",omitempty"`
+	RjctdMod       []ModificationRejection2Code             `xml:"RjctdMod"`
+	DplctOf        *Case3                                   `xml:"DplctOf,omitempty" json:",omitempty"`
+	AssgnmtCxlConf *bool                                    `xml:"AssgnmtCxlConf,omitempty" json:",omitempty"`
 }
 
 func (r InvestigationStatus3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type LocalInstrument2Choice struct {
-	Cd    ExternalLocalInstrument1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalLocalInstrument1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LocalInstrument2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedInformation10 struct {
@@ -1643,18 +1643,18 @@ func (r MandateRelatedInformation10) Validate() error {
 }
 
 type MandateSetupReason1Choice struct {
-	Cd    ExternalMandateSetupReason1Code `xml:"Cd"`
-	Prtry common.Max70Text                `xml:"Prtry"`
+	Cd    *ExternalMandateSetupReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max70Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateSetupReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type NumberOfCancellationsPerStatus1 struct {
 	DtldNbOfTxs common.Max15NumericText           `xml:"DtldNbOfTxs"`
 	DtldSts     CancellationIndividualStatus1Code `xml:"DtldSts"`
-	DtldCtrlSum float64                           `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
+	DtldCtrlSum *common.DecimalNumber             `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
 }
 
 func (r NumberOfCancellationsPerStatus1) Validate() error {
@@ -1664,7 +1664,7 @@ func (r NumberOfCancellationsPerStatus1) Validate() error {
 type NumberOfTransactionsPerStatus1 struct {
 	DtldNbOfTxs common.Max15NumericText          `xml:"DtldNbOfTxs"`
 	DtldSts     TransactionIndividualStatus1Code `xml:"DtldSts"`
-	DtldCtrlSum float64                          `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
+	DtldCtrlSum *common.DecimalNumber            `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
 }
 
 func (r NumberOfTransactionsPerStatus1) Validate() error {
@@ -1687,7 +1687,7 @@ type OriginalGroupHeader5 struct {
 	OrgnlMsgNmId     common.Max35Text                 `xml:"OrgnlMsgNmId"`
 	OrgnlCreDtTm     *common.ISODateTime              `xml:"OrgnlCreDtTm,omitempty" json:",omitempty"`
 	OrgnlNbOfTxs     *common.Max15NumericText         `xml:"OrgnlNbOfTxs,omitempty" json:",omitempty"`
-	OrgnlCtrlSum     float64                          `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
+	OrgnlCtrlSum     *common.DecimalNumber            `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
 	GrpCxlSts        *GroupCancellationStatus1Code    `xml:"GrpCxlSts,omitempty" json:",omitempty"`
 	CxlStsRsnInf     []CancellationStatusReason2      `xml:"CxlStsRsnInf,omitempty" json:",omitempty"`
 	NbOfTxsPerCxlSts []NumberOfTransactionsPerStatus1 `xml:"NbOfTxsPerCxlSts,omitempty" json:",omitempty"`
@@ -1713,7 +1713,7 @@ type OriginalPaymentInstruction17 struct {
 	OrgnlPmtInfId    common.Max35Text                  `xml:"OrgnlPmtInfId"`
 	OrgnlGrpInf      *OriginalGroupInformation3        `xml:"OrgnlGrpInf,omitempty" json:",omitempty"`
 	OrgnlNbOfTxs     *common.Max15NumericText          `xml:"OrgnlNbOfTxs,omitempty" json:",omitempty"`
-	OrgnlCtrlSum     float64                           `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
+	OrgnlCtrlSum     *common.DecimalNumber             `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
 	PmtInfCxlSts     *GroupCancellationStatus1Code     `xml:"PmtInfCxlSts,omitempty" json:",omitempty"`
 	CxlStsRsnInf     []CancellationStatusReason2       `xml:"CxlStsRsnInf,omitempty" json:",omitempty"`
 	NbOfTxsPerCxlSts []NumberOfCancellationsPerStatus1 `xml:"NbOfTxsPerCxlSts,omitempty" json:",omitempty"`
@@ -1753,21 +1753,21 @@ func (r OriginalTransactionReference22) Validate() error {
 }
 
 type Party11Choice struct {
-	OrgId  OrganisationIdentification8 `xml:"OrgId"`
-	PrvtId PersonIdentification5       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification8 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification5       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party11Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Party12Choice struct {
-	Pty PartyIdentification43                        `xml:"Pty"`
-	Agt BranchAndFinancialInstitutionIdentification5 `xml:"Agt"`
+	Pty *PartyIdentification43                        `xml:"Pty,omitempty" json:",omitempty"`
+	Agt *BranchAndFinancialInstitutionIdentification5 `xml:"Agt,omitempty" json:",omitempty"`
 }
 
 func (r Party12Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification43 struct {
@@ -1896,12 +1896,12 @@ func (r ResolutionOfInvestigationV06) Validate() error {
 }
 
 type ServiceLevel8Choice struct {
-	Cd    ExternalServiceLevel1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalServiceLevel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ServiceLevel8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SettlementInstruction4 struct {
@@ -5,6 +5,7 @@
 package camt_v06
 
 import (
+	"encoding/xml"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,8 +15,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ErrorHandling3Choice{}.Validate())
 	assert.NotNil(t, ErrorHandling5{}.Validate())
 	assert.Nil(t, GeneralBusinessInformation1{}.Validate())
-	assert.Nil(t, GeneralBusinessOrError7Choice{}.Validate())
-	assert.Nil(t, GeneralBusinessOrError8Choice{}.Validate())
+	assert.NotNil(t, GeneralBusinessOrError7Choice{}.Validate())
+	assert.NotNil(t, GeneralBusinessOrError8Choice{}.Validate())
 	assert.NotNil(t, GeneralBusinessReport6{}.Validate())
 	assert.NotNil(t, GenericIdentification1{}.Validate())
 	assert.Nil(t, InformationQualifierType1{}.Validate())
@@ -37,7 +38,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
 	assert.NotNil(t, ClearingSystemMemberIdentification2{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
-	assert.Nil(t, DatePeriod2Choice{}.Validate())
+	assert.NotNil(t, DatePeriod2Choice{}.Validate())
 	assert.NotNil(t, EventType1Choice{}.Validate())
 	assert.NotNil(t, ExecutionType1Choice{}.Validate())
 	assert.NotNil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
@@ -62,8 +63,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PersonIdentification13{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
@@ -74,11 +75,11 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ProprietaryData7{}.Validate())
 	assert.Nil(t, SkipPayload{}.Validate())
 	assert.Nil(t, CurrentAndDefaultReservation4{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, MarketInfrastructureIdentification1Choice{}.Validate())
 	assert.NotNil(t, Reservation3{}.Validate())
 	assert.NotNil(t, ReservationIdentification2{}.Validate())
-	assert.Nil(t, ReservationOrError8Choice{}.Validate())
+	assert.NotNil(t, ReservationOrError8Choice{}.Validate())
 	assert.NotNil(t, ReservationOrError9Choice{}.Validate())
 	assert.NotNil(t, ReservationReport6{}.Validate())
 	assert.NotNil(t, ReservationStatus1Choice{}.Validate())
@@ -152,7 +153,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, CorrectiveGroupInformation1{}.Validate())
 	assert.Nil(t, CorrectiveInterbankTransaction1{}.Validate())
 	assert.Nil(t, CorrectivePaymentInitiation1{}.Validate())
-	assert.Nil(t, CorrectiveTransaction1Choice{}.Validate())
+	assert.NotNil(t, CorrectiveTransaction1Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth{}.Validate())
 	assert.Nil(t, DatePeriodDetails{}.Validate())
 	assert.NotNil(t, EquivalentAmount2{}.Validate())
@@ -172,8 +173,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OriginalGroupInformation3{}.Validate())
 	assert.NotNil(t, OriginalPaymentInstruction17{}.Validate())
 	assert.Nil(t, OriginalTransactionReference22{}.Validate())
-	assert.Nil(t, Party11Choice{}.Validate())
-	assert.Nil(t, Party12Choice{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
+	assert.NotNil(t, Party12Choice{}.Validate())
 	assert.Nil(t, PartyIdentification43{}.Validate())
 	assert.Nil(t, PaymentTransaction66{}.Validate())
 	assert.Nil(t, PaymentTransaction67{}.Validate())
@@ -214,17 +215,19 @@ func TestTypes(t *testing.T) {
 
 	var type4 ExternalAccountIdentification1Code
 	assert.NotNil(t, type4.Validate())
-	type4 = "test"
+	type4 = "AIIN"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalCashAccountType1Code
 	assert.NotNil(t, type5.Validate())
-	type5 = "test"
+	type5 = "CACC"
 	assert.Nil(t, type5.Validate())
 
 	var type6 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type6.Validate())
 	type6 = "test"
+	assert.NotNil(t, type6.Validate())
+	type6 = "USABA"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalFinancialInstitutionIdentification1Code
@@ -244,12 +247,12 @@ func TestTypes(t *testing.T) {
 
 	var type10 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type10.Validate())
-	type10 = "test"
+	type10 = "BANK"
 	assert.Nil(t, type10.Validate())
 
 	var type11 ExternalPersonIdentification1Code
 	assert.NotNil(t, type11.Validate())
-	type11 = "test"
+	type11 = "ARNU"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalMarketInfrastructure1Code
@@ -259,7 +262,7 @@ func TestTypes(t *testing.T) {
 
 	var type13 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type13.Validate())
-	type13 = "test"
+	type13 = "APDS"
 	assert.Nil(t, type13.Validate())
 
 	var type14 ExternalDocumentLineType1Code
@@ -269,17 +272,19 @@ func TestTypes(t *testing.T) {
 
 	var type15 ExternalGarnishmentType1Code
 	assert.NotNil(t, type15.Validate())
-	type15 = "test"
+	type15 = "GNCS"
 	assert.Nil(t, type15.Validate())
 
 	var type16 ExternalPurpose1Code
 	assert.NotNil(t, type16.Validate())
 	type16 = "test"
+	assert.NotNil(t, type16.Validate())
+	type16 = "GDDS"
 	assert.Nil(t, type16.Validate())
 
 	var type17 ExternalTaxAmountType1Code
 	assert.NotNil(t, type17.Validate())
-	type17 = "test"
+	type17 = "CITY"
 	assert.Nil(t, type17.Validate())
 
 	var type18 Priority1Code
@@ -367,6 +372,8 @@ func TestTypes(t *testing.T) {
 	var type30 ExternalServiceLevel1Code
 	assert.NotNil(t, type30.Validate())
 	type30 = "CLRG"
+	assert.NotNil(t, type30.Validate())
+	type30 = "SEPA"
 	assert.Nil(t, type30.Validate())
 
 	var type31 ClearingChannel2Code
@@ -412,6 +419,8 @@ func TestTypes(t *testing.T) {
 	var type39 ExternalLocalInstrument1Code
 	assert.NotNil(t, type39.Validate())
 	type39 = "ACTC"
+	assert.NotNil(t, type39.Validate())
+	type39 = "INST"
 	assert.Nil(t, type39.Validate())
 
 	var type40 PaymentCancellationRejection2Code
@@ -421,7 +430,7 @@ func TestTypes(t *testing.T) {
 
 	var type41 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type41.Validate())
-	type41 = "LEGL"
+	type41 = "BONU"
 	assert.Nil(t, type41.Validate())
 
 	var type42 ExternalChargeType1Code
@@ -459,3 +468,12 @@ func TestTypes(t *testing.T) {
 	type48 = "UM01"
 	assert.Nil(t, type48.Validate())
 }
+
+func TestGeneralBusinessOrErrorChoice(t *testing.T) {
+	choice := GeneralBusinessOrError8Choice{GnlBiz: &GeneralBusinessInformation1{}}
+	assert.Nil(t, choice.Validate())
+
+	buf, err := xml.Marshal(&GeneralBusinessOrError8Choice{BizErr: []ErrorHandling5{{}}})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(buf), "GnlBiz")
+}
@@ -7,6 +7,7 @@ package camt_v06
 import (
 	"reflect"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -17,7 +18,7 @@ func (r ExternalEnquiryRequestType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalEnquiryRequestType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalEnquiryRequestType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -27,7 +28,7 @@ func (r ExternalPaymentControlRequestType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPaymentControlRequestType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPaymentControlRequestType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -37,7 +38,7 @@ func (r ExternalSystemErrorHandling1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemErrorHandling1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalSystemErrorHandling1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -47,7 +48,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -57,7 +58,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -67,7 +68,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -77,7 +78,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -87,7 +88,7 @@ func (r ExternalProxyAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalProxyAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalProxyAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -97,7 +98,7 @@ func (r ExternalSystemEventType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemEventType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalSystemEventType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -107,7 +108,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -117,7 +118,7 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -127,7 +128,7 @@ func (r ExternalMarketInfrastructure1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 3 {
 		return utils.NewErrTextLengthInvalid("ExternalMarketInfrastructure1Code", 1, 3)
 	}
-	return nil
+	return codes.Validate("ExternalMarketInfrastructure1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -137,7 +138,7 @@ func (r ExternalDiscountAmountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDiscountAmountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDiscountAmountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -147,7 +148,7 @@ func (r ExternalDocumentLineType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDocumentLineType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDocumentLineType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -157,7 +158,7 @@ func (r ExternalGarnishmentType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalGarnishmentType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalGarnishmentType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -167,7 +168,7 @@ func (r ExternalPurpose1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPurpose1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPurpose1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -177,7 +178,7 @@ func (r ExternalTaxAmountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalTaxAmountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalTaxAmountType1Code", string(r))
 }
 
 // May be one of HIGH, NORM, LOWW
@@ -355,7 +356,7 @@ func (r ExternalServiceLevel1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalServiceLevel1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalServiceLevel1Code", string(r))
 }
 
 // May be one of RTGS, RTNS, MPNS, BOOK
@@ -463,7 +464,7 @@ func (r ExternalMandateSetupReason1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalMandateSetupReason1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalMandateSetupReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -473,7 +474,7 @@ func (r ExternalLocalInstrument1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalLocalInstrument1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalLocalInstrument1Code", string(r))
 }
 
 // May be one of LEGL, AGNT, CUST, ARDT, NOAS, NOOR, AC04, AM04
@@ -497,7 +498,7 @@ func (r ExternalCategoryPurpose1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalCategoryPurpose1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalCategoryPurpose1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -507,7 +508,7 @@ func (r ExternalChargeType1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalChargeType1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalChargeType1Code", string(r))
 }
 
 // May be one of DEBT, CRED, SHAR, SLEV
@@ -531,7 +532,7 @@ func (r ExternalCashClearingSystem1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalCashClearingSystem1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalCashClearingSystem1Code", string(r))
 }
 
 // May be one of YEAR, MNTH, QURT, MIAN, WEEK, DAIL, ADHO, INDA, FRTN
@@ -12,12 +12,12 @@ import (
 )
 
 type AccountCriteria3Choice struct {
-	QryNm   common.Max35Text `xml:"QryNm"`
-	NewCrit AccountCriteria7 `xml:"NewCrit"`
+	QryNm   *common.Max35Text `xml:"QryNm,omitempty" json:",omitempty"`
+	NewCrit *AccountCriteria7 `xml:"NewCrit,omitempty" json:",omitempty"`
 }
 
 func (r AccountCriteria3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountCriteria7 struct {
@@ -31,22 +31,22 @@ func (r AccountCriteria7) Validate() error {
 }
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountIdentificationSearchCriteria2Choice struct {
-	EQ     AccountIdentification4Choice `xml:"EQ"`
-	CTTxt  common.Max35Text             `xml:"CTTxt"`
-	NCTTxt common.Max35Text             `xml:"NCTTxt"`
+	EQ     *AccountIdentification4Choice `xml:"EQ,omitempty" json:",omitempty"`
+	CTTxt  *common.Max35Text             `xml:"CTTxt,omitempty" json:",omitempty"`
+	NCTTxt *common.Max35Text             `xml:"NCTTxt,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentificationSearchCriteria2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountQuery3 struct {
@@ -59,30 +59,30 @@ func (r AccountQuery3) Validate() error {
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BalanceType11Choice struct {
-	Cd    ExternalSystemBalanceType1Code `xml:"Cd"`
-	Prtry common.Max35Text               `xml:"Prtry"`
+	Cd    *ExternalSystemBalanceType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text               `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BalanceType11Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -136,12 +136,12 @@ func (r CashAccountSearchCriteria7) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CashBalance12 struct {
@@ -169,12 +169,12 @@ func (r CashBalanceReturnCriteria2) Validate() error {
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -206,12 +206,12 @@ func (r Contact4) Validate() error {
 }
 
 type DateAndDateTimeSearch4Choice struct {
-	DtTm DateTimeSearch2Choice   `xml:"DtTm"`
-	Dt   DatePeriodSearch1Choice `xml:"Dt"`
+	DtTm *DateTimeSearch2Choice   `xml:"DtTm,omitempty" json:",omitempty"`
+	Dt   *DatePeriodSearch1Choice `xml:"Dt,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTimeSearch4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateAndPlaceOfBirth1 struct {
@@ -235,15 +235,15 @@ func (r DatePeriod2) Validate() error {
 }
 
 type DatePeriodSearch1Choice struct {
-	FrDt   common.ISODate `xml:"FrDt"`
-	ToDt   common.ISODate `xml:"ToDt"`
-	FrToDt DatePeriod2    `xml:"FrToDt"`
-	EQDt   common.ISODate `xml:"EQDt"`
-	NEQDt  common.ISODate `xml:"NEQDt"`
+	FrDt   *common.ISODate `xml:"FrDt,omitempty" json:",omitempty"`
+	ToDt   *common.ISODate `xml:"ToDt,omitempty" json:",omitempty"`
+	FrToDt *DatePeriod2    `xml:"FrToDt,omitempty" json:",omitempty"`
+	EQDt   *common.ISODate `xml:"EQDt,omitempty" json:",omitempty"`
+	NEQDt  *common.ISODate `xml:"NEQDt,omitempty" json:",omitempty"`
 }
 
 func (r DatePeriodSearch1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateTimePeriod1 struct {
@@ -256,24 +256,24 @@ func (r DateTimePeriod1) Validate() error {
 }
 
 type DateTimeSearch2Choice struct {
-	FrDtTm   common.ISODateTime `xml:"FrDtTm"`
-	ToDtTm   common.ISODateTime `xml:"ToDtTm"`
-	FrToDtTm DateTimePeriod1    `xml:"FrToDtTm"`
-	EQDtTm   common.ISODateTime `xml:"EQDtTm"`
-	NEQDtTm  common.ISODateTime `xml:"NEQDtTm"`
+	FrDtTm   *common.ISODateTime `xml:"FrDtTm,omitempty" json:",omitempty"`
+	ToDtTm   *common.ISODateTime `xml:"ToDtTm,omitempty" json:",omitempty"`
+	FrToDtTm *DateTimePeriod1    `xml:"FrToDtTm,omitempty" json:",omitempty"`
+	EQDtTm   *common.ISODateTime `xml:"EQDtTm,omitempty" json:",omitempty"`
+	NEQDtTm  *common.ISODateTime `xml:"NEQDtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateTimeSearch2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -381,12 +381,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OtherContact1 struct {
@@ -399,12 +399,12 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification135 struct {
@@ -429,12 +429,12 @@ func (r PersonIdentification13) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress24 struct {
@@ -461,13 +461,13 @@ func (r PostalAddress24) Validate() error {
 }
 
 type RequestType4Choice struct {
-	PmtCtrl ExternalPaymentControlRequestType1Code `xml:"PmtCtrl"`
-	Enqry   ExternalEnquiryRequestType1Code        `xml:"Enqry"`
-	Prtry   GenericIdentification1                 `xml:"Prtry"`
+	PmtCtrl *ExternalPaymentControlRequestType1Code `xml:"PmtCtrl,omitempty" json:",omitempty"`
+	Enqry   *ExternalEnquiryRequestType1Code        `xml:"Enqry,omitempty" json:",omitempty"`
+	Prtry   *GenericIdentification1                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r RequestType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SupplementaryData1 struct {
@@ -488,12 +488,12 @@ func (r SupplementaryDataEnvelope1) Validate() error {
 }
 
 type ActiveAmountRange3Choice struct {
-	ImpldCcyAndAmtRg ImpliedCurrencyAndAmountRange1 `xml:"ImpldCcyAndAmtRg"`
-	CcyAndAmtRg      ActiveCurrencyAndAmountRange3  `xml:"CcyAndAmtRg"`
+	ImpldCcyAndAmtRg *ImpliedCurrencyAndAmountRange1 `xml:"ImpldCcyAndAmtRg,omitempty" json:",omitempty"`
+	CcyAndAmtRg      *ActiveCurrencyAndAmountRange3  `xml:"CcyAndAmtRg,omitempty" json:",omitempty"`
 }
 
 func (r ActiveAmountRange3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmountRange3 struct {
@@ -507,8 +507,8 @@ func (r ActiveCurrencyAndAmountRange3) Validate() error {
 }
 
 type AmountRangeBoundary1 struct {
-	BdryAmt float64 `xml:"BdryAmt"`
-	Incl    bool    `xml:"Incl"`
+	BdryAmt common.ImpliedCurrencyAndAmount `xml:"BdryAmt"`
+	Incl    bool                            `xml:"Incl"`
 }
 
 func (r AmountRangeBoundary1) Validate() error {
@@ -516,14 +516,14 @@ func (r AmountRangeBoundary1) Validate() error {
 }
 
 type DateAndPeriod2Choice struct {
-	Dt   common.ISODate `xml:"Dt"`
-	Prd  Period2        `xml:"Prd"`
-	FrDt common.ISODate `xml:"FrDt"`
-	ToDt common.ISODate `xml:"ToDt"`
+	Dt   *common.ISODate `xml:"Dt,omitempty" json:",omitempty"`
+	Prd  *Period2        `xml:"Prd,omitempty" json:",omitempty"`
+	FrDt *common.ISODate `xml:"FrDt,omitempty" json:",omitempty"`
+	ToDt *common.ISODate `xml:"ToDt,omitempty" json:",omitempty"`
 }
 
 func (r DateAndPeriod2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FromToAmountRange1 struct {
@@ -556,15 +556,15 @@ func (r GetLimitV07) Validate() error {
 }
 
 type ImpliedCurrencyAmountRange1Choice struct {
-	FrAmt   AmountRangeBoundary1 `xml:"FrAmt"`
-	ToAmt   AmountRangeBoundary1 `xml:"ToAmt"`
-	FrToAmt FromToAmountRange1   `xml:"FrToAmt"`
-	EQAmt   float64              `xml:"EQAmt"`
-	NEQAmt  float64              `xml:"NEQAmt"`
+	FrAmt   *AmountRangeBoundary1            `xml:"FrAmt,omitempty" json:",omitempty"`
+	ToAmt   *AmountRangeBoundary1            `xml:"ToAmt,omitempty" json:",omitempty"`
+	FrToAmt *FromToAmountRange1              `xml:"FrToAmt,omitempty" json:",omitempty"`
+	EQAmt   *common.ImpliedCurrencyAndAmount `xml:"EQAmt,omitempty" json:",omitempty"`
+	NEQAmt  *common.ImpliedCurrencyAndAmount `xml:"NEQAmt,omitempty" json:",omitempty"`
 }
 
 func (r ImpliedCurrencyAmountRange1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ImpliedCurrencyAndAmountRange1 struct {
@@ -587,12 +587,12 @@ func (r LimitCriteria6) Validate() error {
 }
 
 type LimitCriteria6Choice struct {
-	QryNm   common.Max35Text `xml:"QryNm"`
-	NewCrit LimitCriteria6   `xml:"NewCrit"`
+	QryNm   *common.Max35Text `xml:"QryNm,omitempty" json:",omitempty"`
+	NewCrit *LimitCriteria6   `xml:"NewCrit,omitempty" json:",omitempty"`
 }
 
 func (r LimitCriteria6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type LimitQuery4 struct {
@@ -634,33 +634,33 @@ func (r LimitSearchCriteria6) Validate() error {
 }
 
 type LimitType1Choice struct {
-	Cd    LimitType3Code   `xml:"Cd"`
-	Prtry common.Max35Text `xml:"Prtry"`
+	Cd    *LimitType3Code   `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LimitType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MarketInfrastructureIdentification1Choice struct {
-	Cd    ExternalMarketInfrastructure1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalMarketInfrastructure1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MarketInfrastructureIdentification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PercentageRange1Choice struct {
-	Fr   PercentageRangeBoundary1 `xml:"Fr"`
-	To   PercentageRangeBoundary1 `xml:"To"`
-	FrTo FromToPercentageRange1   `xml:"FrTo"`
-	EQ   float64                  `xml:"EQ"`
-	NEQ  float64                  `xml:"NEQ"`
+	Fr   *PercentageRangeBoundary1 `xml:"Fr,omitempty" json:",omitempty"`
+	To   *PercentageRangeBoundary1 `xml:"To,omitempty" json:",omitempty"`
+	FrTo *FromToPercentageRange1   `xml:"FrTo,omitempty" json:",omitempty"`
+	EQ   *float64                  `xml:"EQ,omitempty" json:",omitempty"`
+	NEQ  *float64                  `xml:"NEQ,omitempty" json:",omitempty"`
 }
 
 func (r PercentageRange1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PercentageRangeBoundary1 struct {
@@ -682,17 +682,17 @@ func (r Period2) Validate() error {
 }
 
 type SystemIdentification2Choice struct {
-	MktInfrstrctrId MarketInfrastructureIdentification1Choice `xml:"MktInfrstrctrId"`
-	Ctry            common.CountryCode                        `xml:"Ctry"`
+	MktInfrstrctrId *MarketInfrastructureIdentification1Choice `xml:"MktInfrstrctrId,omitempty" json:",omitempty"`
+	Ctry            *common.CountryCode                        `xml:"Ctry,omitempty" json:",omitempty"`
 }
 
 func (r SystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64                   `xml:",chardata"`
-	Ccy   common.ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -700,21 +700,21 @@ func (r ActiveCurrencyAndAmount) Validate() error {
 }
 
 type Amount2Choice struct {
-	AmtWthtCcy float64                 `xml:"AmtWthtCcy"`
-	AmtWthCcy  ActiveCurrencyAndAmount `xml:"AmtWthCcy"`
+	AmtWthtCcy *common.ImpliedCurrencyAndAmount `xml:"AmtWthtCcy,omitempty" json:",omitempty"`
+	AmtWthCcy  *ActiveCurrencyAndAmount         `xml:"AmtWthCcy,omitempty" json:",omitempty"`
 }
 
 func (r Amount2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateAndDateTime2Choice struct {
-	Dt   common.ISODate     `xml:"Dt"`
-	DtTm common.ISODateTime `xml:"DtTm"`
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTime2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Limit8 struct {
@@ -728,14 +728,14 @@ func (r Limit8) Validate() error {
 }
 
 type LimitIdentification2Choice struct {
-	Cur     LimitIdentification5 `xml:"Cur"`
-	Dflt    LimitIdentification5 `xml:"Dflt"`
-	AllCur  LimitIdentification6 `xml:"AllCur"`
-	AllDflt LimitIdentification6 `xml:"AllDflt"`
+	Cur     *LimitIdentification5 `xml:"Cur,omitempty" json:",omitempty"`
+	Dflt    *LimitIdentification5 `xml:"Dflt,omitempty" json:",omitempty"`
+	AllCur  *LimitIdentification6 `xml:"AllCur,omitempty" json:",omitempty"`
+	AllDflt *LimitIdentification6 `xml:"AllDflt,omitempty" json:",omitempty"`
 }
 
 func (r LimitIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type LimitIdentification5 struct {
@@ -802,12 +802,12 @@ func (r DeleteLimitV07) Validate() error {
 }
 
 type LimitStructure2Choice struct {
-	CurLmtId   LimitIdentification5 `xml:"CurLmtId"`
-	AllCurLmts LimitIdentification6 `xml:"AllCurLmts"`
+	CurLmtId   *LimitIdentification5 `xml:"CurLmtId,omitempty" json:",omitempty"`
+	AllCurLmts *LimitIdentification6 `xml:"AllCurLmts,omitempty" json:",omitempty"`
 }
 
 func (r LimitStructure2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BusinessDay8 struct {
@@ -830,12 +830,12 @@ func (r BusinessDay9) Validate() error {
 }
 
 type BusinessDayReportOrError10Choice struct {
-	BizDayInf BusinessDay9     `xml:"BizDayInf"`
+	BizDayInf *BusinessDay9    `xml:"BizDayInf,omitempty" json:",omitempty"`
 	BizErr    []ErrorHandling5 `xml:"BizErr" json:",omitempty"`
 }
 
 func (r BusinessDayReportOrError10Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BusinessDayReportOrError9Choice struct {
@@ -844,35 +844,35 @@ type BusinessDayReportOrError9Choice struct {
 }
 
 func (r BusinessDayReportOrError9Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClosureReason2Choice struct {
-	Cd    SystemClosureReason1Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *SystemClosureReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClosureReason2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateTimePeriod1Choice struct {
-	FrDtTm common.ISODateTime `xml:"FrDtTm"`
-	ToDtTm common.ISODateTime `xml:"ToDtTm"`
-	DtTmRg DateTimePeriod1    `xml:"DtTmRg"`
+	FrDtTm *common.ISODateTime `xml:"FrDtTm,omitempty" json:",omitempty"`
+	ToDtTm *common.ISODateTime `xml:"ToDtTm,omitempty" json:",omitempty"`
+	DtTmRg *DateTimePeriod1    `xml:"DtTmRg,omitempty" json:",omitempty"`
 }
 
 func (r DateTimePeriod1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ErrorHandling3Choice struct {
-	Cd    ExternalSystemErrorHandling1Code `xml:"Cd"`
-	Prtry common.Max35Text                 `xml:"Prtry"`
+	Cd    *ExternalSystemErrorHandling1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ErrorHandling3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ErrorHandling5 struct {
@@ -950,21 +950,21 @@ func (r SystemEvent3) Validate() error {
 }
 
 type SystemEventType4Choice struct {
-	Cd    ExternalSystemEventType1Code `xml:"Cd"`
-	Prtry GenericIdentification1       `xml:"Prtry"`
+	Cd    *ExternalSystemEventType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification1       `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r SystemEventType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SystemStatus2Choice struct {
-	Cd    SystemStatus2Code      `xml:"Cd"`
-	Prtry GenericIdentification1 `xml:"Prtry"`
+	Cd    *SystemStatus2Code      `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification1 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r SystemStatus2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SystemStatus3 struct {
@@ -1002,13 +1002,13 @@ func (r BackupPaymentV07) Validate() error {
 }
 
 type MemberIdentification3Choice struct {
-	BICFI       common.BICFIDec2014Identifier       `xml:"BICFI"`
-	ClrSysMmbId ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId"`
-	Othr        GenericFinancialIdentification1     `xml:"Othr"`
+	BICFI       *common.BICFIDec2014Identifier       `xml:"BICFI,omitempty" json:",omitempty"`
+	ClrSysMmbId *ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId,omitempty" json:",omitempty"`
+	Othr        *GenericFinancialIdentification1     `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r MemberIdentification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentInstruction13 struct {
@@ -1021,12 +1021,12 @@ func (r PaymentInstruction13) Validate() error {
 }
 
 type PaymentType4Choice struct {
-	Cd    PaymentType3Code `xml:"Cd"`
-	Prtry common.Max35Text `xml:"Prtry"`
+	Cd    *PaymentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PaymentType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SystemMember3 struct {
@@ -1039,8 +1039,8 @@ func (r SystemMember3) Validate() error {
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
-	Value float64                             `xml:",chardata"`
-	Ccy   common.ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
@@ -1067,12 +1067,12 @@ func (r AmendmentInformationDetails13) Validate() error {
 }
 
 type AmountType4Choice struct {
-	InstdAmt ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt"`
-	EqvtAmt  EquivalentAmount2                 `xml:"EqvtAmt"`
+	InstdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty" json:",omitempty"`
+	EqvtAmt  *EquivalentAmount2                 `xml:"EqvtAmt,omitempty" json:",omitempty"`
 }
 
 func (r AmountType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Case5 struct {
@@ -1109,21 +1109,21 @@ func (r CashAccount38) Validate() error {
 }
 
 type CategoryPurpose1Choice struct {
-	Cd    ExternalCategoryPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCategoryPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CategoryPurpose1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification3Choice struct {
-	Cd    ExternalCashClearingSystem1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalCashClearingSystem1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditTransferMandateData1 struct {
@@ -1152,12 +1152,12 @@ func (r CreditorReferenceInformation2) Validate() error {
 }
 
 type CreditorReferenceType1Choice struct {
-	Cd    DocumentType3Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorReferenceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorReferenceType2 struct {
@@ -1179,12 +1179,12 @@ func (r DiscountAmountAndType1) Validate() error {
 }
 
 type DiscountAmountType1Choice struct {
-	Cd    ExternalDiscountAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalDiscountAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DiscountAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentAdjustment1 struct {
@@ -1228,12 +1228,12 @@ func (r DocumentLineType1) Validate() error {
 }
 
 type DocumentLineType1Choice struct {
-	Cd    ExternalDocumentLineType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalDocumentLineType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DocumentLineType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EquivalentAmount2 struct {
@@ -1246,13 +1246,13 @@ func (r EquivalentAmount2) Validate() error {
 }
 
 type Frequency36Choice struct {
-	Tp     Frequency6Code      `xml:"Tp"`
-	Prd    FrequencyPeriod1    `xml:"Prd"`
-	PtInTm FrequencyAndMoment1 `xml:"PtInTm"`
+	Tp     *Frequency6Code      `xml:"Tp,omitempty" json:",omitempty"`
+	Prd    *FrequencyPeriod1    `xml:"Prd,omitempty" json:",omitempty"`
+	PtInTm *FrequencyAndMoment1 `xml:"PtInTm,omitempty" json:",omitempty"`
 }
 
 func (r Frequency36Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FrequencyAndMoment1 struct {
@@ -1298,12 +1298,12 @@ func (r GarnishmentType1) Validate() error {
 }
 
 type GarnishmentType1Choice struct {
-	Cd    ExternalGarnishmentType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalGarnishmentType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r GarnishmentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type InstructionForAssignee1 struct {
@@ -1334,21 +1334,21 @@ func (r InstructionForNextAgent1) Validate() error {
 }
 
 type LocalInstrument2Choice struct {
-	Cd    ExternalLocalInstrument1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalLocalInstrument1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LocalInstrument2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateClassification1Choice struct {
-	Cd    common.MandateClassification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *common.MandateClassification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateClassification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedData1Choice struct {
@@ -1357,7 +1357,7 @@ type MandateRelatedData1Choice struct {
 }
 
 func (r MandateRelatedData1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedInformation14 struct {
@@ -1378,12 +1378,12 @@ func (r MandateRelatedInformation14) Validate() error {
 }
 
 type MandateSetupReason1Choice struct {
-	Cd    ExternalMandateSetupReason1Code `xml:"Cd"`
-	Prtry common.Max70Text                `xml:"Prtry"`
+	Cd    *ExternalMandateSetupReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max70Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateSetupReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateTypeInformation2 struct {
@@ -1437,12 +1437,12 @@ func (r OriginalTransactionReference31) Validate() error {
 }
 
 type Party40Choice struct {
-	Pty PartyIdentification135                       `xml:"Pty"`
-	Agt BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
+	Pty *PartyIdentification135                       `xml:"Pty,omitempty" json:",omitempty"`
+	Agt *BranchAndFinancialInstitutionIdentification6 `xml:"Agt,omitempty" json:",omitempty"`
 }
 
 func (r Party40Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentTypeInformation27 struct {
@@ -1468,21 +1468,21 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Purpose2Choice struct {
-	Cd    ExternalPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Purpose2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentInformation7 struct {
@@ -1497,12 +1497,12 @@ func (r ReferredDocumentInformation7) Validate() error {
 }
 
 type ReferredDocumentType3Choice struct {
-	Cd    DocumentType6Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType6Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReferredDocumentType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentType4 struct {
@@ -1596,12 +1596,12 @@ func (r RequestedModification9) Validate() error {
 }
 
 type ServiceLevel8Choice struct {
-	Cd    ExternalServiceLevel1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalServiceLevel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ServiceLevel8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SettlementDateTimeIndication1 struct {
@@ -1676,12 +1676,12 @@ func (r TaxAmountAndType1) Validate() error {
 }
 
 type TaxAmountType1Choice struct {
-	Cd    ExternalTaxAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text           `xml:"Prtry"`
+	Cd    *ExternalTaxAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text           `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r TaxAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxAuthorisation1 struct {
@@ -1821,13 +1821,13 @@ func (r UnderlyingStatementEntry3) Validate() error {
 }
 
 type UnderlyingTransaction6Choice struct {
-	Initn    UnderlyingPaymentInstruction6 `xml:"Initn"`
-	IntrBk   UnderlyingPaymentTransaction5 `xml:"IntrBk"`
-	StmtNtry UnderlyingStatementEntry3     `xml:"StmtNtry"`
+	Initn    *UnderlyingPaymentInstruction6 `xml:"Initn,omitempty" json:",omitempty"`
+	IntrBk   *UnderlyingPaymentTransaction5 `xml:"IntrBk,omitempty" json:",omitempty"`
+	StmtNtry *UnderlyingStatementEntry3     `xml:"StmtNtry,omitempty" json:",omitempty"`
 }
 
 func (r UnderlyingTransaction6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MissingOrIncorrectInformation3 struct {
@@ -1879,13 +1879,13 @@ func (r UnableToApplyIncorrect1) Validate() error {
 }
 
 type UnableToApplyJustification3Choice struct {
-	AnyInf            bool                            `xml:"AnyInf,omitempty" json:",omitempty"`
+	AnyInf            *bool                           `xml:"AnyInf,omitempty" json:",omitempty"`
 	MssngOrIncrrctInf *MissingOrIncorrectInformation3 `xml:"MssngOrIncrrctInf,omitempty" json:",omitempty"`
-	PssblDplctInstr   bool                            `xml:"PssblDplctInstr,omitempty" json:",omitempty"`
+	PssblDplctInstr   *bool                           `xml:"PssblDplctInstr,omitempty" json:",omitempty"`
 }
 
 func (r UnableToApplyJustification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type UnableToApplyMissing1 struct {
@@ -1948,5 +1948,5 @@ type UnderlyingTransaction5Choice struct {
 }
 
 func (r UnderlyingTransaction5Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
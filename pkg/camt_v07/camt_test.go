@@ -5,6 +5,7 @@
 package camt_v07
 
 import (
+	"encoding/xml"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -29,12 +30,12 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
 	assert.NotNil(t, ClearingSystemMemberIdentification2{}.Validate())
 	assert.Nil(t, Contact4{}.Validate())
-	assert.Nil(t, DateAndDateTimeSearch4Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTimeSearch4Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth1{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
-	assert.Nil(t, DatePeriodSearch1Choice{}.Validate())
+	assert.NotNil(t, DatePeriodSearch1Choice{}.Validate())
 	assert.Nil(t, DateTimePeriod1{}.Validate())
-	assert.Nil(t, DateTimeSearch2Choice{}.Validate())
+	assert.NotNil(t, DateTimeSearch2Choice{}.Validate())
 	assert.NotNil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
 	assert.Nil(t, FinancialInstitutionIdentification18{}.Validate())
 	assert.NotNil(t, GenericAccountIdentification1{}.Validate())
@@ -48,7 +49,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PersonIdentification13{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
@@ -59,12 +60,12 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ActiveAmountRange3Choice{}.Validate())
 	assert.NotNil(t, ActiveCurrencyAndAmountRange3{}.Validate())
 	assert.Nil(t, AmountRangeBoundary1{}.Validate())
-	assert.Nil(t, DateAndPeriod2Choice{}.Validate())
+	assert.NotNil(t, DateAndPeriod2Choice{}.Validate())
 	assert.Nil(t, FromToAmountRange1{}.Validate())
 	assert.Nil(t, FromToPercentageRange1{}.Validate())
 	assert.NotNil(t, GetLimitV07{}.Validate())
-	assert.Nil(t, ImpliedCurrencyAmountRange1Choice{}.Validate())
-	assert.Nil(t, ImpliedCurrencyAndAmountRange1{}.Validate())
+	assert.NotNil(t, ImpliedCurrencyAmountRange1Choice{}.Validate())
+	assert.NotNil(t, ImpliedCurrencyAndAmountRange1{}.Validate())
 	assert.Nil(t, LimitCriteria6{}.Validate())
 	assert.NotNil(t, LimitCriteria6Choice{}.Validate())
 	assert.Nil(t, LimitQuery4{}.Validate())
@@ -72,13 +73,13 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, LimitSearchCriteria6{}.Validate())
 	assert.NotNil(t, LimitType1Choice{}.Validate())
 	assert.NotNil(t, MarketInfrastructureIdentification1Choice{}.Validate())
-	assert.Nil(t, PercentageRange1Choice{}.Validate())
+	assert.NotNil(t, PercentageRange1Choice{}.Validate())
 	assert.Nil(t, PercentageRangeBoundary1{}.Validate())
 	assert.Nil(t, Period2{}.Validate())
 	assert.NotNil(t, SystemIdentification2Choice{}.Validate())
 	assert.NotNil(t, ActiveCurrencyAndAmount{}.Validate())
 	assert.NotNil(t, Amount2Choice{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, Limit8{}.Validate())
 	assert.NotNil(t, LimitIdentification2Choice{}.Validate())
 	assert.NotNil(t, LimitIdentification5{}.Validate())
@@ -88,12 +89,12 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ModifyLimitV07{}.Validate())
 	assert.NotNil(t, DeleteLimitV07{}.Validate())
 	assert.NotNil(t, LimitStructure2Choice{}.Validate())
-	assert.Nil(t, BusinessDay8{}.Validate())
+	assert.NotNil(t, BusinessDay8{}.Validate())
 	assert.Nil(t, BusinessDay9{}.Validate())
-	assert.Nil(t, BusinessDayReportOrError10Choice{}.Validate())
-	assert.Nil(t, BusinessDayReportOrError9Choice{}.Validate())
+	assert.NotNil(t, BusinessDayReportOrError10Choice{}.Validate())
+	assert.NotNil(t, BusinessDayReportOrError9Choice{}.Validate())
 	assert.NotNil(t, ClosureReason2Choice{}.Validate())
-	assert.Nil(t, DateTimePeriod1Choice{}.Validate())
+	assert.NotNil(t, DateTimePeriod1Choice{}.Validate())
 	assert.NotNil(t, ErrorHandling3Choice{}.Validate())
 	assert.NotNil(t, ErrorHandling5{}.Validate())
 	assert.NotNil(t, MessageHeader7{}.Validate())
@@ -142,13 +143,13 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, InstructionForNextAgent1{}.Validate())
 	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
 	assert.NotNil(t, MandateClassification1Choice{}.Validate())
-	assert.Nil(t, MandateRelatedData1Choice{}.Validate())
+	assert.NotNil(t, MandateRelatedData1Choice{}.Validate())
 	assert.Nil(t, MandateRelatedInformation14{}.Validate())
 	assert.NotNil(t, MandateSetupReason1Choice{}.Validate())
 	assert.Nil(t, MandateTypeInformation2{}.Validate())
 	assert.NotNil(t, OriginalGroupInformation29{}.Validate())
 	assert.Nil(t, OriginalTransactionReference31{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.Nil(t, PaymentTypeInformation27{}.Validate())
 	assert.NotNil(t, ProxyAccountIdentification1{}.Validate())
 	assert.NotNil(t, ProxyAccountType1Choice{}.Validate())
@@ -186,17 +187,19 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type3.Validate())
 	type3 = "test"
+	assert.NotNil(t, type3.Validate())
+	type3 = "USABA"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalEnquiryRequestType1Code
@@ -211,7 +214,7 @@ func TestTypes(t *testing.T) {
 
 	var type6 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type6.Validate())
-	type6 = "test"
+	type6 = "BANK"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalPaymentControlRequestType1Code
@@ -221,7 +224,7 @@ func TestTypes(t *testing.T) {
 
 	var type8 ExternalPersonIdentification1Code
 	assert.NotNil(t, type8.Validate())
-	type8 = "test"
+	type8 = "ARNU"
 	assert.Nil(t, type8.Validate())
 
 	var type9 ExternalSystemBalanceType1Code
@@ -256,7 +259,7 @@ func TestTypes(t *testing.T) {
 
 	var type15 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type15.Validate())
-	type15 = "test"
+	type15 = "BONU"
 	assert.Nil(t, type15.Validate())
 
 	var type16 ExternalCreditorAgentInstruction1Code
@@ -266,7 +269,7 @@ func TestTypes(t *testing.T) {
 
 	var type17 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type17.Validate())
-	type17 = "test"
+	type17 = "APDS"
 	assert.Nil(t, type17.Validate())
 
 	var type18 ExternalDocumentLineType1Code
@@ -276,12 +279,14 @@ func TestTypes(t *testing.T) {
 
 	var type19 ExternalGarnishmentType1Code
 	assert.NotNil(t, type19.Validate())
-	type19 = "test"
+	type19 = "GNCS"
 	assert.Nil(t, type19.Validate())
 
 	var type20 ExternalLocalInstrument1Code
 	assert.NotNil(t, type20.Validate())
 	type20 = "test"
+	assert.NotNil(t, type20.Validate())
+	type20 = "INST"
 	assert.Nil(t, type20.Validate())
 
 	var type21 ExternalMandateSetupReason1Code
@@ -297,16 +302,20 @@ func TestTypes(t *testing.T) {
 	var type23 ExternalPurpose1Code
 	assert.NotNil(t, type23.Validate())
 	type23 = "test"
+	assert.NotNil(t, type23.Validate())
+	type23 = "GDDS"
 	assert.Nil(t, type23.Validate())
 
 	var type24 ExternalServiceLevel1Code
 	assert.NotNil(t, type24.Validate())
 	type24 = "test"
+	assert.NotNil(t, type24.Validate())
+	type24 = "SEPA"
 	assert.Nil(t, type24.Validate())
 
 	var type25 ExternalTaxAmountType1Code
 	assert.NotNil(t, type25.Validate())
-	type25 = "test"
+	type25 = "CITY"
 	assert.Nil(t, type25.Validate())
 
 	var type26 PreferredContactMethod1Code
@@ -435,3 +444,18 @@ func TestTypes(t *testing.T) {
 	type43 = "MM01"
 	assert.Nil(t, type43.Validate())
 }
+
+func TestUnableToApplyJustificationChoice(t *testing.T) {
+	anyInf, duplicate := false, true
+	assert.Nil(t, UnableToApplyJustification3Choice{AnyInf: &anyInf}.Validate())
+	assert.Nil(t, UnableToApplyJustification3Choice{PssblDplctInstr: &duplicate}.Validate())
+	assert.NotNil(t, UnableToApplyJustification3Choice{AnyInf: &anyInf, PssblDplctInstr: &duplicate}.Validate())
+
+	buf, err := xml.Marshal(&UnableToApplyJustification3Choice{AnyInf: &anyInf})
+	assert.Nil(t, err)
+	assert.Equal(t, "<UnableToApplyJustification3Choice><AnyInf>false</AnyInf></UnableToApplyJustification3Choice>", string(buf))
+
+	var choice UnableToApplyJustification3Choice
+	assert.Nil(t, xml.Unmarshal(buf, &choice))
+	assert.Nil(t, choice.Validate())
+}
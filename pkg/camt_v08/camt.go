@@ -12,21 +12,21 @@ import (
 )
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountOrBusinessError4Choice struct {
-	Acct   CashAccount37    `xml:"Acct"`
+	Acct   *CashAccount37   `xml:"Acct,omitempty" json:",omitempty"`
 	BizErr []ErrorHandling5 `xml:"BizErr" json:",omitempty"`
 }
 
 func (r AccountOrBusinessError4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountOrOperationalError4Choice struct {
@@ -35,7 +35,7 @@ type AccountOrOperationalError4Choice struct {
 }
 
 func (r AccountOrOperationalError4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountReport24 struct {
@@ -48,17 +48,17 @@ func (r AccountReport24) Validate() error {
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64                   `xml:",chardata"`
-	Ccy   common.ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -66,21 +66,21 @@ func (r ActiveCurrencyAndAmount) Validate() error {
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Amount2Choice struct {
-	AmtWthtCcy float64                 `xml:"AmtWthtCcy"`
-	AmtWthCcy  ActiveCurrencyAndAmount `xml:"AmtWthCcy"`
+	AmtWthtCcy *common.ImpliedCurrencyAndAmount `xml:"AmtWthtCcy,omitempty" json:",omitempty"`
+	AmtWthCcy  *ActiveCurrencyAndAmount         `xml:"AmtWthCcy,omitempty" json:",omitempty"`
 }
 
 func (r Amount2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BalanceRestrictionType1 struct {
@@ -94,21 +94,21 @@ func (r BalanceRestrictionType1) Validate() error {
 }
 
 type BalanceType11Choice struct {
-	Cd    ExternalSystemBalanceType1Code `xml:"Cd"`
-	Prtry common.Max35Text               `xml:"Prtry"`
+	Cd    *ExternalSystemBalanceType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text               `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BalanceType11Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BalanceType9Choice struct {
-	Cd    SystemBalanceType2Code `xml:"Cd"`
-	Prtry common.Max35Text       `xml:"Prtry"`
+	Cd    *SystemBalanceType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text       `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BalanceType9Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BilateralLimit3 struct {
@@ -172,21 +172,21 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CashBalance11 struct {
-	Amt       float64                 `xml:"Amt"`
-	CdtDbtInd common.CreditDebitCode  `xml:"CdtDbtInd"`
-	Tp        *BalanceType9Choice     `xml:"Tp,omitempty" json:",omitempty"`
-	Sts       *BalanceStatus1Code     `xml:"Sts,omitempty" json:",omitempty"`
-	ValDt     *DateAndDateTime2Choice `xml:"ValDt,omitempty" json:",omitempty"`
-	NbOfPmts  float64                 `xml:"NbOfPmts,omitempty" json:",omitempty"`
+	Amt       common.ImpliedCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd common.CreditDebitCode          `xml:"CdtDbtInd"`
+	Tp        *BalanceType9Choice             `xml:"Tp,omitempty" json:",omitempty"`
+	Sts       *BalanceStatus1Code             `xml:"Sts,omitempty" json:",omitempty"`
+	ValDt     *DateAndDateTime2Choice         `xml:"ValDt,omitempty" json:",omitempty"`
+	NbOfPmts  float64                         `xml:"NbOfPmts,omitempty" json:",omitempty"`
 }
 
 func (r CashBalance11) Validate() error {
@@ -194,14 +194,14 @@ func (r CashBalance11) Validate() error {
 }
 
 type CashBalance13 struct {
-	Amt       float64                  `xml:"Amt"`
-	CdtDbtInd common.CreditDebitCode   `xml:"CdtDbtInd"`
-	Tp        *BalanceType11Choice     `xml:"Tp,omitempty" json:",omitempty"`
-	Sts       *BalanceStatus1Code      `xml:"Sts,omitempty" json:",omitempty"`
-	ValDt     *DateAndDateTime2Choice  `xml:"ValDt,omitempty" json:",omitempty"`
-	PrcgDt    *DateAndDateTime2Choice  `xml:"PrcgDt,omitempty" json:",omitempty"`
-	NbOfPmts  float64                  `xml:"NbOfPmts,omitempty" json:",omitempty"`
-	RstrctnTp *BalanceRestrictionType1 `xml:"RstrctnTp,omitempty" json:",omitempty"`
+	Amt       common.ImpliedCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd common.CreditDebitCode          `xml:"CdtDbtInd"`
+	Tp        *BalanceType11Choice            `xml:"Tp,omitempty" json:",omitempty"`
+	Sts       *BalanceStatus1Code             `xml:"Sts,omitempty" json:",omitempty"`
+	ValDt     *DateAndDateTime2Choice         `xml:"ValDt,omitempty" json:",omitempty"`
+	PrcgDt    *DateAndDateTime2Choice         `xml:"PrcgDt,omitempty" json:",omitempty"`
+	NbOfPmts  float64                         `xml:"NbOfPmts,omitempty" json:",omitempty"`
+	RstrctnTp *BalanceRestrictionType1        `xml:"RstrctnTp,omitempty" json:",omitempty"`
 }
 
 func (r CashBalance13) Validate() error {
@@ -209,12 +209,12 @@ func (r CashBalance13) Validate() error {
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -246,12 +246,12 @@ func (r Contact4) Validate() error {
 }
 
 type DateAndDateTime2Choice struct {
-	Dt   common.ISODate     `xml:"Dt"`
-	DtTm common.ISODateTime `xml:"DtTm"`
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTime2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateAndPlaceOfBirth1 struct {
@@ -275,12 +275,12 @@ func (r DatePeriodDetails1) Validate() error {
 }
 
 type ErrorHandling3Choice struct {
-	Cd    ExternalSystemErrorHandling1Code `xml:"Cd"`
-	Prtry common.Max35Text                 `xml:"Prtry"`
+	Cd    *ExternalSystemErrorHandling1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ErrorHandling3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ErrorHandling5 struct {
@@ -293,30 +293,30 @@ func (r ErrorHandling5) Validate() error {
 }
 
 type EventType1Choice struct {
-	Cd    ExternalSystemEventType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalSystemEventType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r EventType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ExecutionType1Choice struct {
-	Tm  common.ISOTime   `xml:"Tm"`
-	Evt EventType1Choice `xml:"Evt"`
+	Tm  *common.ISOTime   `xml:"Tm,omitempty" json:",omitempty"`
+	Evt *EventType1Choice `xml:"Evt,omitempty" json:",omitempty"`
 }
 
 func (r ExecutionType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -424,12 +424,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OriginalBusinessQuery1 struct {
@@ -452,12 +452,12 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification135 struct {
@@ -482,12 +482,12 @@ func (r PersonIdentification13) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress24 struct {
@@ -514,12 +514,12 @@ func (r PostalAddress24) Validate() error {
 }
 
 type ProcessingType1Choice struct {
-	Cd    ProcessingType1Code `xml:"Cd"`
-	Prtry common.Max35Text    `xml:"Prtry"`
+	Cd    *ProcessingType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text    `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProcessingType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ProxyAccountIdentification1 struct {
@@ -532,22 +532,22 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type RequestType4Choice struct {
-	PmtCtrl ExternalPaymentControlRequestType1Code `xml:"PmtCtrl"`
-	Enqry   ExternalEnquiryRequestType1Code        `xml:"Enqry"`
-	Prtry   GenericIdentification1                 `xml:"Prtry"`
+	PmtCtrl *ExternalPaymentControlRequestType1Code `xml:"PmtCtrl,omitempty" json:",omitempty"`
+	Enqry   *ExternalEnquiryRequestType1Code        `xml:"Enqry,omitempty" json:",omitempty"`
+	Prtry   *GenericIdentification1                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r RequestType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReturnAccountV08 struct {
@@ -600,12 +600,12 @@ func (r StandingOrderTotalAmount1) Validate() error {
 }
 
 type StandingOrderType1Choice struct {
-	Cd    StandingOrderType1Code `xml:"Cd"`
-	Prtry GenericIdentification1 `xml:"Prtry"`
+	Cd    *StandingOrderType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification1 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r StandingOrderType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SupplementaryData1 struct {
@@ -626,9 +626,9 @@ func (r SupplementaryDataEnvelope1) Validate() error {
 }
 
 type TotalAmountAndCurrency1 struct {
-	TtlAmt    float64                    `xml:"TtlAmt"`
-	CdtDbtInd *common.CreditDebitCode    `xml:"CdtDbtInd,omitempty" json:",omitempty"`
-	Ccy       *common.ActiveCurrencyCode `xml:"Ccy,omitempty" json:",omitempty"`
+	TtlAmt    common.ImpliedCurrencyAndAmount `xml:"TtlAmt"`
+	CdtDbtInd *common.CreditDebitCode         `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	Ccy       *common.ActiveCurrencyCode      `xml:"Ccy,omitempty" json:",omitempty"`
 }
 
 func (r TotalAmountAndCurrency1) Validate() error {
@@ -651,22 +651,22 @@ func (r AccountCashEntryReturnCriteria3) Validate() error {
 }
 
 type AccountIdentificationSearchCriteria2Choice struct {
-	EQ     AccountIdentification4Choice `xml:"EQ"`
-	CTTxt  common.Max35Text             `xml:"CTTxt"`
-	NCTTxt common.Max35Text             `xml:"NCTTxt"`
+	EQ     *AccountIdentification4Choice `xml:"EQ,omitempty" json:",omitempty"`
+	CTTxt  *common.Max35Text             `xml:"CTTxt,omitempty" json:",omitempty"`
+	NCTTxt *common.Max35Text             `xml:"NCTTxt,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentificationSearchCriteria2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveAmountRange3Choice struct {
-	ImpldCcyAndAmtRg ImpliedCurrencyAndAmountRange1 `xml:"ImpldCcyAndAmtRg"`
-	CcyAndAmtRg      ActiveCurrencyAndAmountRange3  `xml:"CcyAndAmtRg"`
+	ImpldCcyAndAmtRg *ImpliedCurrencyAndAmountRange1 `xml:"ImpldCcyAndAmtRg,omitempty" json:",omitempty"`
+	CcyAndAmtRg      *ActiveCurrencyAndAmountRange3  `xml:"CcyAndAmtRg,omitempty" json:",omitempty"`
 }
 
 func (r ActiveAmountRange3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmountRange3 struct {
@@ -680,12 +680,12 @@ func (r ActiveCurrencyAndAmountRange3) Validate() error {
 }
 
 type ActiveOrHistoricAmountRange2Choice struct {
-	ImpldCcyAndAmtRg ImpliedCurrencyAndAmountRange1          `xml:"ImpldCcyAndAmtRg"`
-	CcyAndAmtRg      ActiveOrHistoricCurrencyAndAmountRange2 `xml:"CcyAndAmtRg"`
+	ImpldCcyAndAmtRg *ImpliedCurrencyAndAmountRange1          `xml:"ImpldCcyAndAmtRg,omitempty" json:",omitempty"`
+	CcyAndAmtRg      *ActiveOrHistoricCurrencyAndAmountRange2 `xml:"CcyAndAmtRg,omitempty" json:",omitempty"`
 }
 
 func (r ActiveOrHistoricAmountRange2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveOrHistoricCurrencyAndAmountRange2 struct {
@@ -699,8 +699,8 @@ func (r ActiveOrHistoricCurrencyAndAmountRange2) Validate() error {
 }
 
 type AmountRangeBoundary1 struct {
-	BdryAmt float64 `xml:"BdryAmt"`
-	Incl    bool    `xml:"Incl"`
+	BdryAmt common.ImpliedCurrencyAndAmount `xml:"BdryAmt"`
+	Incl    bool                            `xml:"Incl"`
 }
 
 func (r AmountRangeBoundary1) Validate() error {
@@ -723,21 +723,21 @@ func (r CashAccountEntrySearch6) Validate() error {
 }
 
 type ClearingSystemIdentification3Choice struct {
-	Cd    ExternalCashClearingSystem1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalCashClearingSystem1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateAndDateTimeSearch3Choice struct {
-	DtTmSch DateTimePeriod1Choice   `xml:"DtTmSch"`
-	DtSch   DatePeriodSearch1Choice `xml:"DtSch"`
+	DtTmSch *DateTimePeriod1Choice   `xml:"DtTmSch,omitempty" json:",omitempty"`
+	DtSch   *DatePeriodSearch1Choice `xml:"DtSch,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTimeSearch3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DatePeriod2 struct {
@@ -750,15 +750,15 @@ func (r DatePeriod2) Validate() error {
 }
 
 type DatePeriodSearch1Choice struct {
-	FrDt   common.ISODate `xml:"FrDt"`
-	ToDt   common.ISODate `xml:"ToDt"`
-	FrToDt DatePeriod2    `xml:"FrToDt"`
-	EQDt   common.ISODate `xml:"EQDt"`
-	NEQDt  common.ISODate `xml:"NEQDt"`
+	FrDt   *common.ISODate `xml:"FrDt,omitempty" json:",omitempty"`
+	ToDt   *common.ISODate `xml:"ToDt,omitempty" json:",omitempty"`
+	FrToDt *DatePeriod2    `xml:"FrToDt,omitempty" json:",omitempty"`
+	EQDt   *common.ISODate `xml:"EQDt,omitempty" json:",omitempty"`
+	NEQDt  *common.ISODate `xml:"NEQDt,omitempty" json:",omitempty"`
 }
 
 func (r DatePeriodSearch1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateTimePeriod1 struct {
@@ -771,13 +771,13 @@ func (r DateTimePeriod1) Validate() error {
 }
 
 type DateTimePeriod1Choice struct {
-	FrDtTm common.ISODateTime `xml:"FrDtTm"`
-	ToDtTm common.ISODateTime `xml:"ToDtTm"`
-	DtTmRg DateTimePeriod1    `xml:"DtTmRg"`
+	FrDtTm *common.ISODateTime `xml:"FrDtTm,omitempty" json:",omitempty"`
+	ToDtTm *common.ISODateTime `xml:"ToDtTm,omitempty" json:",omitempty"`
+	DtTmRg *DateTimePeriod1    `xml:"DtTmRg,omitempty" json:",omitempty"`
 }
 
 func (r DateTimePeriod1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FromToAmountRange1 struct {
@@ -801,15 +801,15 @@ func (r GetTransactionV08) Validate() error {
 }
 
 type ImpliedCurrencyAmountRange1Choice struct {
-	FrAmt   AmountRangeBoundary1 `xml:"FrAmt"`
-	ToAmt   AmountRangeBoundary1 `xml:"ToAmt"`
-	FrToAmt FromToAmountRange1   `xml:"FrToAmt"`
-	EQAmt   float64              `xml:"EQAmt"`
-	NEQAmt  float64              `xml:"NEQAmt"`
+	FrAmt   *AmountRangeBoundary1            `xml:"FrAmt,omitempty" json:",omitempty"`
+	ToAmt   *AmountRangeBoundary1            `xml:"ToAmt,omitempty" json:",omitempty"`
+	FrToAmt *FromToAmountRange1              `xml:"FrToAmt,omitempty" json:",omitempty"`
+	EQAmt   *common.ImpliedCurrencyAndAmount `xml:"EQAmt,omitempty" json:",omitempty"`
+	NEQAmt  *common.ImpliedCurrencyAndAmount `xml:"NEQAmt,omitempty" json:",omitempty"`
 }
 
 func (r ImpliedCurrencyAmountRange1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ImpliedCurrencyAndAmountRange1 struct {
@@ -844,7 +844,7 @@ func (r InstructionStatusSearch5) Validate() error {
 type LongPaymentIdentification2 struct {
 	TxId           *common.Max35Text                            `xml:"TxId,omitempty" json:",omitempty"`
 	UETR           *common.UUIDv4Identifier                     `xml:"UETR,omitempty" json:",omitempty"`
-	IntrBkSttlmAmt float64                                      `xml:"IntrBkSttlmAmt"`
+	IntrBkSttlmAmt common.ImpliedCurrencyAndAmount              `xml:"IntrBkSttlmAmt"`
 	IntrBkSttlmDt  common.ISODate                               `xml:"IntrBkSttlmDt"`
 	PmtMtd         *PaymentOrigin1Choice                        `xml:"PmtMtd,omitempty" json:",omitempty"`
 	InstgAgt       BranchAndFinancialInstitutionIdentification6 `xml:"InstgAgt"`
@@ -873,30 +873,30 @@ type Party40Choice struct {
 }
 
 func (r Party40Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentIdentification6Choice struct {
-	TxId      common.Max35Text                `xml:"TxId"`
-	QId       QueueTransactionIdentification1 `xml:"QId"`
-	LngBizId  LongPaymentIdentification2      `xml:"LngBizId"`
-	ShrtBizId ShortPaymentIdentification2     `xml:"ShrtBizId"`
-	PrtryId   common.Max70Text                `xml:"PrtryId"`
+	TxId      *common.Max35Text                `xml:"TxId,omitempty" json:",omitempty"`
+	QId       *QueueTransactionIdentification1 `xml:"QId,omitempty" json:",omitempty"`
+	LngBizId  *LongPaymentIdentification2      `xml:"LngBizId,omitempty" json:",omitempty"`
+	ShrtBizId *ShortPaymentIdentification2     `xml:"ShrtBizId,omitempty" json:",omitempty"`
+	PrtryId   *common.Max70Text                `xml:"PrtryId,omitempty" json:",omitempty"`
 }
 
 func (r PaymentIdentification6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentOrigin1Choice struct {
-	FINMT    common.Max3NumericText `xml:"FINMT"`
-	XMLMsgNm common.Max35Text       `xml:"XMLMsgNm"`
-	Prtry    common.Max35Text       `xml:"Prtry"`
-	Instrm   PaymentInstrument1Code `xml:"Instrm"`
+	FINMT    *common.Max3NumericText `xml:"FINMT,omitempty" json:",omitempty"`
+	XMLMsgNm *common.Max35Text       `xml:"XMLMsgNm,omitempty" json:",omitempty"`
+	Prtry    *common.Max35Text       `xml:"Prtry,omitempty" json:",omitempty"`
+	Instrm   *PaymentInstrument1Code `xml:"Instrm,omitempty" json:",omitempty"`
 }
 
 func (r PaymentOrigin1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentReturnCriteria4 struct {
@@ -956,13 +956,13 @@ func (r PaymentSearch8) Validate() error {
 }
 
 type PaymentStatusCodeSearch2Choice struct {
-	PdgSts       PendingStatus4Code     `xml:"PdgSts"`
-	FnlSts       FinalStatusCode        `xml:"FnlSts"`
-	PdgAndFnlSts CashPaymentStatus2Code `xml:"PdgAndFnlSts"`
+	PdgSts       *PendingStatus4Code     `xml:"PdgSts,omitempty" json:",omitempty"`
+	FnlSts       *FinalStatusCode        `xml:"FnlSts,omitempty" json:",omitempty"`
+	PdgAndFnlSts *CashPaymentStatus2Code `xml:"PdgAndFnlSts,omitempty" json:",omitempty"`
 }
 
 func (r PaymentStatusCodeSearch2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentTransactionParty3 struct {
@@ -986,21 +986,21 @@ func (r PaymentTransactionParty3) Validate() error {
 }
 
 type PaymentType4Choice struct {
-	Cd    PaymentType3Code `xml:"Cd"`
-	Prtry common.Max35Text `xml:"Prtry"`
+	Cd    *PaymentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PaymentType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Priority1Choice struct {
-	Cd    Priority5Code    `xml:"Cd"`
-	Prtry common.Max35Text `xml:"Prtry"`
+	Cd    *Priority5Code    `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Priority1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type QueueTransactionIdentification1 struct {
@@ -1045,12 +1045,12 @@ func (r SystemSearch4) Validate() error {
 }
 
 type TransactionCriteria5Choice struct {
-	QryNm   common.Max35Text     `xml:"QryNm"`
-	NewCrit TransactionCriteria8 `xml:"NewCrit"`
+	QryNm   *common.Max35Text     `xml:"QryNm,omitempty" json:",omitempty"`
+	NewCrit *TransactionCriteria8 `xml:"NewCrit,omitempty" json:",omitempty"`
 }
 
 func (r TransactionCriteria5Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TransactionCriteria8 struct {
@@ -1096,8 +1096,8 @@ func (r TransactionSearchCriteria8) Validate() error {
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
-	Value float64                             `xml:",chardata"`
-	Ccy   common.ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
@@ -1105,12 +1105,12 @@ func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
 }
 
 type Amount3Choice struct {
-	AmtWthCcy  ActiveOrHistoricCurrencyAndAmount `xml:"AmtWthCcy"`
-	AmtWthtCcy float64                           `xml:"AmtWthtCcy"`
+	AmtWthCcy  *ActiveOrHistoricCurrencyAndAmount `xml:"AmtWthCcy,omitempty" json:",omitempty"`
+	AmtWthtCcy *common.ImpliedCurrencyAndAmount   `xml:"AmtWthtCcy,omitempty" json:",omitempty"`
 }
 
 func (r Amount3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CashAccount39 struct {
@@ -1151,12 +1151,12 @@ func (r CashEntry2) Validate() error {
 }
 
 type MarketInfrastructureIdentification1Choice struct {
-	Cd    ExternalMarketInfrastructure1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalMarketInfrastructure1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MarketInfrastructureIdentification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MessageHeader8 struct {
@@ -1174,8 +1174,8 @@ func (r MessageHeader8) Validate() error {
 
 type NumberAndSumOfTransactions2 struct {
 	NbOfNtries    *common.Max15NumericText `xml:"NbOfNtries,omitempty" json:",omitempty"`
-	Sum           float64                  `xml:"Sum,omitempty" json:",omitempty"`
-	TtlNetNtryAmt float64                  `xml:"TtlNetNtryAmt,omitempty" json:",omitempty"`
+	Sum           *common.DecimalNumber    `xml:"Sum,omitempty" json:",omitempty"`
+	TtlNetNtryAmt *common.DecimalNumber    `xml:"TtlNetNtryAmt,omitempty" json:",omitempty"`
 	CdtDbtInd     *common.CreditDebitCode  `xml:"CdtDbtInd,omitempty" json:",omitempty"`
 }
 
@@ -1240,29 +1240,29 @@ func (r PaymentStatus6) Validate() error {
 }
 
 type PaymentStatusCode6Choice struct {
-	Pdg   PendingStatus4Code          `xml:"Pdg"`
-	Fnl   FinalStatus1Code            `xml:"Fnl"`
-	RTGS  common.Max4AlphaNumericText `xml:"RTGS"`
-	Sttlm common.Max4AlphaNumericText `xml:"Sttlm"`
-	Prtry common.Max35Text            `xml:"Prtry"`
+	Pdg   *PendingStatus4Code          `xml:"Pdg,omitempty" json:",omitempty"`
+	Fnl   *FinalStatus1Code            `xml:"Fnl,omitempty" json:",omitempty"`
+	RTGS  *common.Max4AlphaNumericText `xml:"RTGS,omitempty" json:",omitempty"`
+	Sttlm *common.Max4AlphaNumericText `xml:"Sttlm,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text            `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PaymentStatusCode6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentStatusReason1Choice struct {
-	Umtchd       UnmatchedStatusReason1Code      `xml:"Umtchd"`
-	Canc         CancelledStatusReason1Code      `xml:"Canc"`
-	Sspd         SuspendedStatusReason1Code      `xml:"Sspd"`
-	PdgFlngSttlm PendingFailingSettlement1Code   `xml:"PdgFlngSttlm"`
-	PdgSttlm     PendingSettlement2Code          `xml:"PdgSttlm"`
-	PrtryRjctn   ProprietaryStatusJustification2 `xml:"PrtryRjctn"`
-	Prtry        common.Max35Text                `xml:"Prtry"`
+	Umtchd       *UnmatchedStatusReason1Code      `xml:"Umtchd,omitempty" json:",omitempty"`
+	Canc         *CancelledStatusReason1Code      `xml:"Canc,omitempty" json:",omitempty"`
+	Sspd         *SuspendedStatusReason1Code      `xml:"Sspd,omitempty" json:",omitempty"`
+	PdgFlngSttlm *PendingFailingSettlement1Code   `xml:"PdgFlngSttlm,omitempty" json:",omitempty"`
+	PdgSttlm     *PendingSettlement2Code          `xml:"PdgSttlm,omitempty" json:",omitempty"`
+	PrtryRjctn   *ProprietaryStatusJustification2 `xml:"PrtryRjctn,omitempty" json:",omitempty"`
+	Prtry        *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PaymentStatusReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ProprietaryStatusJustification2 struct {
@@ -1321,12 +1321,12 @@ func (r Transaction66) Validate() error {
 }
 
 type TransactionOrError4Choice struct {
-	Tx     Transaction66    `xml:"Tx"`
+	Tx     *Transaction66   `xml:"Tx,omitempty" json:",omitempty"`
 	BizErr []ErrorHandling5 `xml:"BizErr" json:",omitempty"`
 }
 
 func (r TransactionOrError4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TransactionReport5 struct {
@@ -1339,12 +1339,12 @@ func (r TransactionReport5) Validate() error {
 }
 
 type TransactionReportOrError4Choice struct {
-	BizRpt  Transactions8    `xml:"BizRpt"`
+	BizRpt  *Transactions8   `xml:"BizRpt,omitempty" json:",omitempty"`
 	OprlErr []ErrorHandling5 `xml:"OprlErr" json:",omitempty"`
 }
 
 func (r TransactionReportOrError4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Transactions8 struct {
@@ -1416,7 +1416,7 @@ type CancellationReason33Choice struct {
 }
 
 func (r CancellationReason33Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PaymentCancellationReason5 struct {
@@ -1457,12 +1457,12 @@ func (r LimitIdentification5) Validate() error {
 }
 
 type LimitOrError4Choice struct {
-	Lmt    Limit7           `xml:"Lmt"`
+	Lmt    *Limit7          `xml:"Lmt,omitempty" json:",omitempty"`
 	BizErr []ErrorHandling5 `xml:"BizErr" json:",omitempty"`
 }
 
 func (r LimitOrError4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type LimitReport7 struct {
@@ -1475,21 +1475,21 @@ func (r LimitReport7) Validate() error {
 }
 
 type LimitReportOrError4Choice struct {
-	BizRpt  Limits7          `xml:"BizRpt"`
+	BizRpt  *Limits7         `xml:"BizRpt,omitempty" json:",omitempty"`
 	OprlErr []ErrorHandling5 `xml:"OprlErr" json:",omitempty"`
 }
 
 func (r LimitReportOrError4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type LimitType1Choice struct {
-	Cd    LimitType3Code   `xml:"Cd"`
-	Prtry common.Max35Text `xml:"Prtry"`
+	Cd    *LimitType3Code   `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LimitType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Limits7 struct {
@@ -1513,12 +1513,12 @@ func (r ReturnLimitV08) Validate() error {
 }
 
 type SystemIdentification2Choice struct {
-	MktInfrstrctrId MarketInfrastructureIdentification1Choice `xml:"MktInfrstrctrId"`
-	Ctry            common.CountryCode                        `xml:"Ctry"`
+	MktInfrstrctrId *MarketInfrastructureIdentification1Choice `xml:"MktInfrstrctrId,omitempty" json:",omitempty"`
+	Ctry            *common.CountryCode                        `xml:"Ctry,omitempty" json:",omitempty"`
 }
 
 func (r SystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AmendmentInformationDetails13 struct {
@@ -1541,12 +1541,12 @@ func (r AmendmentInformationDetails13) Validate() error {
 }
 
 type AmountType4Choice struct {
-	InstdAmt ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt"`
-	EqvtAmt  EquivalentAmount2                 `xml:"EqvtAmt"`
+	InstdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty" json:",omitempty"`
+	EqvtAmt  *EquivalentAmount2                 `xml:"EqvtAmt,omitempty" json:",omitempty"`
 }
 
 func (r AmountType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Case5 struct {
@@ -1571,12 +1571,12 @@ func (r CaseAssignment5) Validate() error {
 }
 
 type CategoryPurpose1Choice struct {
-	Cd    ExternalCategoryPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCategoryPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CategoryPurpose1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditTransferMandateData1 struct {
@@ -1605,12 +1605,12 @@ func (r CreditorReferenceInformation2) Validate() error {
 }
 
 type CreditorReferenceType1Choice struct {
-	Cd    DocumentType3Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorReferenceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorReferenceType2 struct {
@@ -1632,12 +1632,12 @@ func (r DiscountAmountAndType1) Validate() error {
 }
 
 type DiscountAmountType1Choice struct {
-	Cd    ExternalDiscountAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalDiscountAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DiscountAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentAdjustment1 struct {
@@ -1681,12 +1681,12 @@ func (r DocumentLineType1) Validate() error {
 }
 
 type DocumentLineType1Choice struct {
-	Cd    ExternalDocumentLineType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalDocumentLineType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DocumentLineType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EquivalentAmount2 struct {
@@ -1699,13 +1699,13 @@ func (r EquivalentAmount2) Validate() error {
 }
 
 type Frequency36Choice struct {
-	Tp     Frequency6Code      `xml:"Tp"`
-	Prd    FrequencyPeriod1    `xml:"Prd"`
-	PtInTm FrequencyAndMoment1 `xml:"PtInTm"`
+	Tp     *Frequency6Code      `xml:"Tp,omitempty" json:",omitempty"`
+	Prd    *FrequencyPeriod1    `xml:"Prd,omitempty" json:",omitempty"`
+	PtInTm *FrequencyAndMoment1 `xml:"PtInTm,omitempty" json:",omitempty"`
 }
 
 func (r Frequency36Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FrequencyAndMoment1 struct {
@@ -1751,30 +1751,30 @@ func (r GarnishmentType1) Validate() error {
 }
 
 type GarnishmentType1Choice struct {
-	Cd    ExternalGarnishmentType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalGarnishmentType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r GarnishmentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type LocalInstrument2Choice struct {
-	Cd    ExternalLocalInstrument1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalLocalInstrument1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LocalInstrument2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateClassification1Choice struct {
-	Cd    common.MandateClassification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *common.MandateClassification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateClassification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedData1Choice struct {
@@ -1783,7 +1783,7 @@ type MandateRelatedData1Choice struct {
 }
 
 func (r MandateRelatedData1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedInformation14 struct {
@@ -1804,12 +1804,12 @@ func (r MandateRelatedInformation14) Validate() error {
 }
 
 type MandateSetupReason1Choice struct {
-	Cd    ExternalMandateSetupReason1Code `xml:"Cd"`
-	Prtry common.Max70Text                `xml:"Prtry"`
+	Cd    *ExternalMandateSetupReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max70Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateSetupReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateTypeInformation2 struct {
@@ -1886,12 +1886,12 @@ func (r PaymentTypeInformation27) Validate() error {
 }
 
 type Purpose2Choice struct {
-	Cd    ExternalPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Purpose2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentInformation7 struct {
@@ -1906,12 +1906,12 @@ func (r ReferredDocumentInformation7) Validate() error {
 }
 
 type ReferredDocumentType3Choice struct {
-	Cd    DocumentType6Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType6Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReferredDocumentType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentType4 struct {
@@ -1959,12 +1959,12 @@ func (r RemittanceInformation16) Validate() error {
 }
 
 type ServiceLevel8Choice struct {
-	Cd    ExternalServiceLevel1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalServiceLevel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ServiceLevel8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SettlementInstruction7 struct {
@@ -2003,12 +2003,12 @@ func (r TaxAmountAndType1) Validate() error {
 }
 
 type TaxAmountType1Choice struct {
-	Cd    ExternalTaxAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text           `xml:"Prtry"`
+	Cd    *ExternalTaxAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text           `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r TaxAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxAuthorisation1 struct {
@@ -2104,13 +2104,13 @@ func (r UnableToApplyIncorrect1) Validate() error {
 }
 
 type UnableToApplyJustification3Choice struct {
-	AnyInf            bool                           `xml:"AnyInf"`
-	MssngOrIncrrctInf MissingOrIncorrectInformation3 `xml:"MssngOrIncrrctInf"`
-	PssblDplctInstr   bool                           `xml:"PssblDplctInstr"`
+	AnyInf            *bool                           `xml:"AnyInf,omitempty" json:",omitempty"`
+	MssngOrIncrrctInf *MissingOrIncorrectInformation3 `xml:"MssngOrIncrrctInf,omitempty" json:",omitempty"`
+	PssblDplctInstr   *bool                           `xml:"PssblDplctInstr,omitempty" json:",omitempty"`
 }
 
 func (r UnableToApplyJustification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type UnableToApplyMissing1 struct {
@@ -2189,13 +2189,13 @@ func (r UnderlyingStatementEntry3) Validate() error {
 }
 
 type UnderlyingTransaction6Choice struct {
-	Initn    UnderlyingPaymentInstruction6 `xml:"Initn"`
-	IntrBk   UnderlyingPaymentTransaction5 `xml:"IntrBk"`
-	StmtNtry UnderlyingStatementEntry3     `xml:"StmtNtry"`
+	Initn    *UnderlyingPaymentInstruction6 `xml:"Initn,omitempty" json:",omitempty"`
+	IntrBk   *UnderlyingPaymentTransaction5 `xml:"IntrBk,omitempty" json:",omitempty"`
+	StmtNtry *UnderlyingStatementEntry3     `xml:"StmtNtry,omitempty" json:",omitempty"`
 }
 
 func (r UnderlyingTransaction6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClaimNonReceiptV08 struct {
@@ -2316,8 +2316,8 @@ func (r AccountReport25) Validate() error {
 }
 
 type ActiveOrHistoricCurrencyAnd13DecimalAmount struct {
-	Value float64                             `xml:",chardata"`
-	Ccy   common.ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                         `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAnd13DecimalAmount) Validate() error {
@@ -2356,8 +2356,8 @@ func (r AmountAndCurrencyExchangeDetails4) Validate() error {
 }
 
 type AmountAndDirection35 struct {
-	Amt       float64                `xml:"Amt"`
-	CdtDbtInd common.CreditDebitCode `xml:"CdtDbtInd"`
+	Amt       common.NonNegativeDecimalNumber `xml:"Amt"`
+	CdtDbtInd common.CreditDebitCode          `xml:"CdtDbtInd"`
 }
 
 func (r AmountAndDirection35) Validate() error {
@@ -2365,21 +2365,21 @@ func (r AmountAndDirection35) Validate() error {
 }
 
 type BalanceSubType1Choice struct {
-	Cd    ExternalBalanceSubType1Code `xml:"Cd"`
-	Prtry common.Max35Text            `xml:"Prtry"`
+	Cd    *ExternalBalanceSubType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text            `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BalanceSubType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BalanceType10Choice struct {
-	Cd    ExternalBalanceType1Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *ExternalBalanceType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r BalanceType10Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BalanceType13 struct {
@@ -2513,12 +2513,12 @@ func (r CardTransaction17) Validate() error {
 }
 
 type CardTransaction3Choice struct {
-	Aggtd CardAggregated2            `xml:"Aggtd"`
-	Indv  CardIndividualTransaction2 `xml:"Indv"`
+	Aggtd *CardAggregated2            `xml:"Aggtd,omitempty" json:",omitempty"`
+	Indv  *CardIndividualTransaction2 `xml:"Indv,omitempty" json:",omitempty"`
 }
 
 func (r CardTransaction3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CardholderAuthentication2 struct {
@@ -2541,12 +2541,12 @@ func (r CashAvailability1) Validate() error {
 }
 
 type CashAvailabilityDate1Choice struct {
-	NbOfDays common.Max15PlusSignedNumericText `xml:"NbOfDays"`
-	ActlDt   common.ISODate                    `xml:"ActlDt"`
+	NbOfDays *common.Max15PlusSignedNumericText `xml:"NbOfDays,omitempty" json:",omitempty"`
+	ActlDt   *common.ISODate                    `xml:"ActlDt,omitempty" json:",omitempty"`
 }
 
 func (r CashAvailabilityDate1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CashBalance8 struct {
@@ -2573,12 +2573,12 @@ func (r CashDeposit1) Validate() error {
 }
 
 type ChargeType3Choice struct {
-	Cd    ExternalChargeType1Code `xml:"Cd"`
-	Prtry GenericIdentification3  `xml:"Prtry"`
+	Cd    *ExternalChargeType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification3  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ChargeType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Charges6 struct {
@@ -2626,12 +2626,12 @@ func (r CreditLine3) Validate() error {
 }
 
 type CreditLineType1Choice struct {
-	Cd    ExternalCreditLineType1Code `xml:"Cd"`
-	Prtry common.Max35Text            `xml:"Prtry"`
+	Cd    *ExternalCreditLineType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text            `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditLineType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CurrencyExchange5 struct {
@@ -2648,12 +2648,12 @@ func (r CurrencyExchange5) Validate() error {
 }
 
 type DateOrDateTimePeriod1Choice struct {
-	Dt   DatePeriod2     `xml:"Dt"`
-	DtTm DateTimePeriod1 `xml:"DtTm"`
+	Dt   *DatePeriod2     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *DateTimePeriod1 `xml:"DtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateOrDateTimePeriod1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DisplayCapabilities1 struct {
@@ -2676,12 +2676,12 @@ func (r EntryDetails9) Validate() error {
 }
 
 type EntryStatus1Choice struct {
-	Cd    ExternalEntryStatus1Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *ExternalEntryStatus1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r EntryStatus1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EntryTransaction10 struct {
@@ -2718,13 +2718,13 @@ func (r EntryTransaction10) Validate() error {
 }
 
 type FinancialInstrumentQuantity1Choice struct {
-	Unit     float64 `xml:"Unit"`
-	FaceAmt  float64 `xml:"FaceAmt"`
-	AmtsdVal float64 `xml:"AmtsdVal"`
+	Unit     *float64                         `xml:"Unit,omitempty" json:",omitempty"`
+	FaceAmt  *common.ImpliedCurrencyAndAmount `xml:"FaceAmt,omitempty" json:",omitempty"`
+	AmtsdVal *common.ImpliedCurrencyAndAmount `xml:"AmtsdVal,omitempty" json:",omitempty"`
 }
 
 func (r FinancialInstrumentQuantity1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericIdentification3 struct {
@@ -2761,12 +2761,12 @@ func (r GroupHeader81) Validate() error {
 }
 
 type IdentificationSource3Choice struct {
-	Cd    ExternalFinancialInstrumentIdentificationType1Code `xml:"Cd"`
-	Prtry common.Max35Text                                   `xml:"Prtry"`
+	Cd    *ExternalFinancialInstrumentIdentificationType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r IdentificationSource3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type InterestRecord2 struct {
@@ -2784,12 +2784,12 @@ func (r InterestRecord2) Validate() error {
 }
 
 type InterestType1Choice struct {
-	Cd    common.InterestType1Code `xml:"Cd"`
-	Prtry common.Max35Text         `xml:"Prtry"`
+	Cd    *common.InterestType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r InterestType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MessageIdentification2 struct {
@@ -2812,7 +2812,7 @@ func (r NameAndAddress16) Validate() error {
 
 type NumberAndSumOfTransactions1 struct {
 	NbOfNtries *common.Max15NumericText `xml:"NbOfNtries,omitempty" json:",omitempty"`
-	Sum        float64                  `xml:"Sum,omitempty" json:",omitempty"`
+	Sum        *common.DecimalNumber    `xml:"Sum,omitempty" json:",omitempty"`
 }
 
 func (r NumberAndSumOfTransactions1) Validate() error {
@@ -2821,7 +2821,7 @@ func (r NumberAndSumOfTransactions1) Validate() error {
 
 type NumberAndSumOfTransactions4 struct {
 	NbOfNtries *common.Max15NumericText `xml:"NbOfNtries,omitempty" json:",omitempty"`
-	Sum        float64                  `xml:"Sum,omitempty" json:",omitempty"`
+	Sum        *common.DecimalNumber    `xml:"Sum,omitempty" json:",omitempty"`
 	TtlNetNtry *AmountAndDirection35    `xml:"TtlNetNtry,omitempty" json:",omitempty"`
 }
 
@@ -2830,8 +2830,8 @@ func (r NumberAndSumOfTransactions4) Validate() error {
 }
 
 type OriginalAndCurrentQuantities1 struct {
-	FaceAmt  float64 `xml:"FaceAmt"`
-	AmtsdVal float64 `xml:"AmtsdVal"`
+	FaceAmt  common.ImpliedCurrencyAndAmount `xml:"FaceAmt"`
+	AmtsdVal common.ImpliedCurrencyAndAmount `xml:"AmtsdVal"`
 }
 
 func (r OriginalAndCurrentQuantities1) Validate() error {
@@ -2949,22 +2949,22 @@ func (r Price7) Validate() error {
 }
 
 type PriceRateOrAmount3Choice struct {
-	Rate float64                                    `xml:"Rate"`
-	Amt  ActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"Amt"`
+	Rate *float64                                    `xml:"Rate,omitempty" json:",omitempty"`
+	Amt  *ActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"Amt,omitempty" json:",omitempty"`
 }
 
 func (r PriceRateOrAmount3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Product2 struct {
-	PdctCd       common.Max70Text    `xml:"PdctCd"`
-	UnitOfMeasr  *UnitOfMeasure1Code `xml:"UnitOfMeasr,omitempty" json:",omitempty"`
-	PdctQty      float64             `xml:"PdctQty,omitempty" json:",omitempty"`
-	UnitPric     float64             `xml:"UnitPric,omitempty" json:",omitempty"`
-	PdctAmt      float64             `xml:"PdctAmt,omitempty" json:",omitempty"`
-	TaxTp        *common.Max35Text   `xml:"TaxTp,omitempty" json:",omitempty"`
-	AddtlPdctInf *common.Max35Text   `xml:"AddtlPdctInf,omitempty" json:",omitempty"`
+	PdctCd       common.Max70Text                 `xml:"PdctCd"`
+	UnitOfMeasr  *UnitOfMeasure1Code              `xml:"UnitOfMeasr,omitempty" json:",omitempty"`
+	PdctQty      float64                          `xml:"PdctQty,omitempty" json:",omitempty"`
+	UnitPric     *common.ImpliedCurrencyAndAmount `xml:"UnitPric,omitempty" json:",omitempty"`
+	PdctAmt      *common.ImpliedCurrencyAndAmount `xml:"PdctAmt,omitempty" json:",omitempty"`
+	TaxTp        *common.Max35Text                `xml:"TaxTp,omitempty" json:",omitempty"`
+	AddtlPdctInf *common.Max35Text                `xml:"AddtlPdctInf,omitempty" json:",omitempty"`
 }
 
 func (r Product2) Validate() error {
@@ -3044,12 +3044,12 @@ func (r Rate4) Validate() error {
 }
 
 type RateType4Choice struct {
-	Pctg float64          `xml:"Pctg"`
-	Othr common.Max35Text `xml:"Othr"`
+	Pctg *float64          `xml:"Pctg,omitempty" json:",omitempty"`
+	Othr *common.Max35Text `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r RateType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type RemittanceLocation7 struct {
@@ -3098,21 +3098,21 @@ func (r ReportEntry10) Validate() error {
 }
 
 type ReportingSource1Choice struct {
-	Cd    ExternalReportingSource1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalReportingSource1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReportingSource1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReturnReason5Choice struct {
-	Cd    ExternalReturnReason1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalReturnReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReturnReason5Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SecuritiesAccount19 struct {
@@ -3145,15 +3145,15 @@ func (r SequenceRange1) Validate() error {
 }
 
 type SequenceRange1Choice struct {
-	FrSeq   common.Max35Text   `xml:"FrSeq"`
-	ToSeq   common.Max35Text   `xml:"ToSeq"`
+	FrSeq   *common.Max35Text  `xml:"FrSeq,omitempty" json:",omitempty"`
+	ToSeq   *common.Max35Text  `xml:"ToSeq,omitempty" json:",omitempty"`
 	FrToSeq []SequenceRange1   `xml:"FrToSeq" json:",omitempty"`
 	EQSeq   []common.Max35Text `xml:"EQSeq" json:",omitempty"`
 	NEQSeq  []common.Max35Text `xml:"NEQSeq" json:",omitempty"`
 }
 
 func (r SequenceRange1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxCharges2 struct {
@@ -3184,12 +3184,12 @@ func (r TaxInformation8) Validate() error {
 }
 
 type TechnicalInputChannel1Choice struct {
-	Cd    ExternalTechnicalInputChannel1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalTechnicalInputChannel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r TechnicalInputChannel1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TotalTransactions6 struct {
@@ -3205,7 +3205,7 @@ func (r TotalTransactions6) Validate() error {
 
 type TotalsPerBankTransactionCode5 struct {
 	NbOfNtries *common.Max15NumericText      `xml:"NbOfNtries,omitempty" json:",omitempty"`
-	Sum        float64                       `xml:"Sum,omitempty" json:",omitempty"`
+	Sum        *common.DecimalNumber         `xml:"Sum,omitempty" json:",omitempty"`
 	TtlNetNtry *AmountAndDirection35         `xml:"TtlNetNtry,omitempty" json:",omitempty"`
 	CdtNtries  *NumberAndSumOfTransactions1  `xml:"CdtNtries,omitempty" json:",omitempty"`
 	DbtNtries  *NumberAndSumOfTransactions1  `xml:"DbtNtries,omitempty" json:",omitempty"`
@@ -3297,22 +3297,22 @@ func (r TransactionParties6) Validate() error {
 }
 
 type TransactionPrice4Choice struct {
-	DealPric Price7              `xml:"DealPric"`
+	DealPric *Price7             `xml:"DealPric,omitempty" json:",omitempty"`
 	Prtry    []ProprietaryPrice2 `xml:"Prtry" json:",omitempty"`
 }
 
 func (r TransactionPrice4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TransactionQuantities3Choice struct {
-	Qty                FinancialInstrumentQuantity1Choice `xml:"Qty"`
-	OrgnlAndCurFaceAmt OriginalAndCurrentQuantities1      `xml:"OrgnlAndCurFaceAmt"`
-	Prtry              ProprietaryQuantity1               `xml:"Prtry"`
+	Qty                *FinancialInstrumentQuantity1Choice `xml:"Qty,omitempty" json:",omitempty"`
+	OrgnlAndCurFaceAmt *OriginalAndCurrentQuantities1      `xml:"OrgnlAndCurFaceAmt,omitempty" json:",omitempty"`
+	Prtry              *ProprietaryQuantity1               `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r TransactionQuantities3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TransactionReferences6 struct {
@@ -3338,12 +3338,12 @@ func (r TransactionReferences6) Validate() error {
 }
 
 type YieldedOrValueType1Choice struct {
-	Yldd  bool                `xml:"Yldd"`
-	ValTp PriceValueType1Code `xml:"ValTp"`
+	Yldd  *bool                `xml:"Yldd,omitempty" json:",omitempty"`
+	ValTp *PriceValueType1Code `xml:"ValTp,omitempty" json:",omitempty"`
 }
 
 func (r YieldedOrValueType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountStatement9 struct {
@@ -3415,7 +3415,7 @@ func (r BankToCustomerDebitCreditNotificationV08) Validate() error {
 
 type ControlData1 struct {
 	NbOfTxs *common.Max15NumericText `xml:"NbOfTxs,omitempty" json:",omitempty"`
-	CtrlSum float64                  `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum *common.DecimalNumber    `xml:"CtrlSum,omitempty" json:",omitempty"`
 }
 
 func (r ControlData1) Validate() error {
@@ -3442,7 +3442,7 @@ type OriginalGroupHeader15 struct {
 	OrgnlMsgNmId common.Max35Text             `xml:"OrgnlMsgNmId"`
 	OrgnlCreDtTm *common.ISODateTime          `xml:"OrgnlCreDtTm,omitempty" json:",omitempty"`
 	NbOfTxs      *common.Max15NumericText     `xml:"NbOfTxs,omitempty" json:",omitempty"`
-	CtrlSum      float64                      `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum      *common.DecimalNumber        `xml:"CtrlSum,omitempty" json:",omitempty"`
 	GrpCxl       bool                         `xml:"GrpCxl,omitempty" json:",omitempty"`
 	CxlRsnInf    []PaymentCancellationReason5 `xml:"CxlRsnInf,omitempty" json:",omitempty"`
 }
@@ -12,8 +12,8 @@ import (
 
 func Test1NestedTypes(t *testing.T) {
 	assert.NotNil(t, AccountIdentification4Choice{}.Validate())
-	assert.Nil(t, AccountOrBusinessError4Choice{}.Validate())
-	assert.Nil(t, AccountOrOperationalError4Choice{}.Validate())
+	assert.NotNil(t, AccountOrBusinessError4Choice{}.Validate())
+	assert.NotNil(t, AccountOrOperationalError4Choice{}.Validate())
 	assert.NotNil(t, AccountReport24{}.Validate())
 	assert.NotNil(t, AccountSchemeName1Choice{}.Validate())
 	assert.NotNil(t, ActiveCurrencyAndAmount{}.Validate())
@@ -33,7 +33,7 @@ func Test1NestedTypes(t *testing.T) {
 	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
 	assert.NotNil(t, ClearingSystemMemberIdentification2{}.Validate())
 	assert.Nil(t, Contact4{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth1{}.Validate())
 	assert.Nil(t, DatePeriodDetails1{}.Validate())
 	assert.NotNil(t, ErrorHandling3Choice{}.Validate())
@@ -54,7 +54,7 @@ func Test1NestedTypes(t *testing.T) {
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OriginalBusinessQuery1{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PersonIdentification13{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
@@ -79,20 +79,20 @@ func Test1NestedTypes(t *testing.T) {
 	assert.Nil(t, AmountRangeBoundary1{}.Validate())
 	assert.Nil(t, CashAccountEntrySearch6{}.Validate())
 	assert.NotNil(t, ClearingSystemIdentification3Choice{}.Validate())
-	assert.Nil(t, DateAndDateTimeSearch3Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTimeSearch3Choice{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
-	assert.Nil(t, DatePeriodSearch1Choice{}.Validate())
+	assert.NotNil(t, DatePeriodSearch1Choice{}.Validate())
 	assert.Nil(t, DateTimePeriod1{}.Validate())
-	assert.Nil(t, DateTimePeriod1Choice{}.Validate())
+	assert.NotNil(t, DateTimePeriod1Choice{}.Validate())
 	assert.Nil(t, FromToAmountRange1{}.Validate())
 	assert.NotNil(t, GetTransactionV08{}.Validate())
-	assert.Nil(t, ImpliedCurrencyAmountRange1Choice{}.Validate())
-	assert.Nil(t, ImpliedCurrencyAndAmountRange1{}.Validate())
+	assert.NotNil(t, ImpliedCurrencyAmountRange1Choice{}.Validate())
+	assert.NotNil(t, ImpliedCurrencyAndAmountRange1{}.Validate())
 	assert.Nil(t, InstructionStatusReturnCriteria1{}.Validate())
 	assert.Nil(t, InstructionStatusSearch5{}.Validate())
 	assert.Nil(t, LongPaymentIdentification2{}.Validate())
 	assert.NotNil(t, MessageHeader9{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.NotNil(t, PaymentIdentification6Choice{}.Validate())
 	assert.NotNil(t, PaymentOrigin1Choice{}.Validate())
 	assert.Nil(t, PaymentReturnCriteria4{}.Validate())
@@ -129,16 +129,16 @@ func Test1NestedTypes(t *testing.T) {
 	assert.Nil(t, SecuritiesTransactionReferences1{}.Validate())
 	assert.Nil(t, System2{}.Validate())
 	assert.Nil(t, Transaction66{}.Validate())
-	assert.Nil(t, TransactionOrError4Choice{}.Validate())
+	assert.NotNil(t, TransactionOrError4Choice{}.Validate())
 	assert.NotNil(t, TransactionReport5{}.Validate())
-	assert.Nil(t, TransactionReportOrError4Choice{}.Validate())
+	assert.NotNil(t, TransactionReportOrError4Choice{}.Validate())
 	assert.Nil(t, Transactions8{}.Validate())
 	assert.NotNil(t, MessageHeader1{}.Validate())
 	assert.NotNil(t, ModifyTransactionV08{}.Validate())
 	assert.Nil(t, PaymentInstruction33{}.Validate())
 	assert.NotNil(t, TransactionModification5{}.Validate())
 	assert.NotNil(t, CancelTransactionV08{}.Validate())
-	assert.Nil(t, CancellationReason33Choice{}.Validate())
+	assert.NotNil(t, CancellationReason33Choice{}.Validate())
 	assert.Nil(t, PaymentCancellationReason5{}.Validate())
 	assert.NotNil(t, Limit7{}.Validate())
 	assert.NotNil(t, LimitIdentification5{}.Validate())
@@ -147,7 +147,7 @@ func Test1NestedTypes(t *testing.T) {
 }
 
 func Test2NestedTypes(t *testing.T) {
-	assert.Nil(t, LimitReportOrError4Choice{}.Validate())
+	assert.NotNil(t, LimitReportOrError4Choice{}.Validate())
 	assert.NotNil(t, LimitType1Choice{}.Validate())
 	assert.Nil(t, Limits7{}.Validate())
 	assert.NotNil(t, ReturnLimitV08{}.Validate())
@@ -177,7 +177,7 @@ func Test2NestedTypes(t *testing.T) {
 	assert.NotNil(t, GarnishmentType1Choice{}.Validate())
 	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
 	assert.NotNil(t, MandateClassification1Choice{}.Validate())
-	assert.Nil(t, MandateRelatedData1Choice{}.Validate())
+	assert.NotNil(t, MandateRelatedData1Choice{}.Validate())
 	assert.Nil(t, MandateRelatedInformation14{}.Validate())
 	assert.NotNil(t, MandateSetupReason1Choice{}.Validate())
 	assert.Nil(t, MandateTypeInformation2{}.Validate())
@@ -203,13 +203,13 @@ func Test2NestedTypes(t *testing.T) {
 	assert.Nil(t, PaymentInstruction33{}.Validate())
 	assert.NotNil(t, TransactionModification5{}.Validate())
 	assert.NotNil(t, CancelTransactionV08{}.Validate())
-	assert.Nil(t, CancellationReason33Choice{}.Validate())
+	assert.NotNil(t, CancellationReason33Choice{}.Validate())
 	assert.Nil(t, PaymentCancellationReason5{}.Validate())
 	assert.NotNil(t, Limit7{}.Validate())
 	assert.NotNil(t, LimitIdentification5{}.Validate())
 	assert.NotNil(t, LimitOrError4Choice{}.Validate())
 	assert.NotNil(t, LimitReport7{}.Validate())
-	assert.Nil(t, LimitReportOrError4Choice{}.Validate())
+	assert.NotNil(t, LimitReportOrError4Choice{}.Validate())
 	assert.NotNil(t, LimitType1Choice{}.Validate())
 	assert.Nil(t, Limits7{}.Validate())
 	assert.NotNil(t, ReturnLimitV08{}.Validate())
@@ -242,7 +242,7 @@ func Test3NestedTypes(t *testing.T) {
 	assert.NotNil(t, GarnishmentType1Choice{}.Validate())
 	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
 	assert.NotNil(t, MandateClassification1Choice{}.Validate())
-	assert.Nil(t, MandateRelatedData1Choice{}.Validate())
+	assert.NotNil(t, MandateRelatedData1Choice{}.Validate())
 	assert.Nil(t, MandateRelatedInformation14{}.Validate())
 	assert.NotNil(t, MandateSetupReason1Choice{}.Validate())
 	assert.Nil(t, MandateTypeInformation2{}.Validate())
@@ -270,7 +270,7 @@ func Test3NestedTypes(t *testing.T) {
 	assert.Nil(t, TaxRecord2{}.Validate())
 	assert.NotNil(t, TaxRecordDetails2{}.Validate())
 	assert.NotNil(t, UnableToApplyIncorrect1{}.Validate())
-	assert.Nil(t, UnableToApplyJustification3Choice{}.Validate())
+	assert.NotNil(t, UnableToApplyJustification3Choice{}.Validate())
 	assert.NotNil(t, UnableToApplyMissing1{}.Validate())
 	assert.NotNil(t, UnableToApplyV08{}.Validate())
 	assert.NotNil(t, UnderlyingGroupInformation1{}.Validate())
@@ -282,7 +282,7 @@ func Test3NestedTypes(t *testing.T) {
 	assert.Nil(t, InstructionForAssignee1{}.Validate())
 	assert.Nil(t, MissingCover4{}.Validate())
 	assert.Nil(t, SettlementInstruction6{}.Validate())
-	assert.Nil(t, DebitAuthorisation2{}.Validate())
+	assert.NotNil(t, DebitAuthorisation2{}.Validate())
 	assert.NotNil(t, DebitAuthorisationRequestV08{}.Validate())
 	assert.Nil(t, StructuredRemittanceInformation16{}.Validate())
 	assert.Nil(t, AccountInterest4{}.Validate())
@@ -306,7 +306,7 @@ func Test3NestedTypes(t *testing.T) {
 	assert.NotNil(t, CardSecurityInformation1{}.Validate())
 	assert.Nil(t, CardSequenceNumberRange1{}.Validate())
 	assert.Nil(t, CardTransaction17{}.Validate())
-	assert.Nil(t, CardTransaction3Choice{}.Validate())
+	assert.NotNil(t, CardTransaction3Choice{}.Validate())
 	assert.NotNil(t, CardholderAuthentication2{}.Validate())
 	assert.NotNil(t, CashAvailability1{}.Validate())
 	assert.NotNil(t, CashAvailabilityDate1Choice{}.Validate())
@@ -319,12 +319,12 @@ func Test3NestedTypes(t *testing.T) {
 	assert.Nil(t, CreditLine3{}.Validate())
 	assert.NotNil(t, CreditLineType1Choice{}.Validate())
 	assert.NotNil(t, CurrencyExchange5{}.Validate())
-	assert.Nil(t, DateOrDateTimePeriod1Choice{}.Validate())
+	assert.NotNil(t, DateOrDateTimePeriod1Choice{}.Validate())
 	assert.NotNil(t, DisplayCapabilities1{}.Validate())
 	assert.Nil(t, EntryDetails9{}.Validate())
 	assert.NotNil(t, EntryStatus1Choice{}.Validate())
 	assert.Nil(t, EntryTransaction10{}.Validate())
-	assert.Nil(t, FinancialInstrumentQuantity1Choice{}.Validate())
+	assert.NotNil(t, FinancialInstrumentQuantity1Choice{}.Validate())
 	assert.NotNil(t, GenericIdentification3{}.Validate())
 	assert.NotNil(t, GenericIdentification32{}.Validate())
 	assert.NotNil(t, GroupHeader81{}.Validate())
@@ -389,17 +389,19 @@ func Test3NestedTypes(t *testing.T) {
 func Test1Types(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type3.Validate())
 	type3 = "test"
+	assert.NotNil(t, type3.Validate())
+	type3 = "USABA"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalEnquiryRequestType1Code
@@ -414,7 +416,7 @@ func Test1Types(t *testing.T) {
 
 	var type6 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type6.Validate())
-	type6 = "test"
+	type6 = "BANK"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalPaymentControlRequestType1Code
@@ -424,7 +426,7 @@ func Test1Types(t *testing.T) {
 
 	var type8 ExternalPersonIdentification1Code
 	assert.NotNil(t, type8.Validate())
-	type8 = "test"
+	type8 = "ARNU"
 	assert.Nil(t, type8.Validate())
 
 	var type9 ExternalProxyAccountType1Code
@@ -460,16 +462,18 @@ func Test1Types(t *testing.T) {
 	var type15 ExternalCancellationReason1Code
 	assert.NotNil(t, type15.Validate())
 	type15 = "test"
+	assert.NotNil(t, type15.Validate())
+	type15 = "DUPL"
 	assert.Nil(t, type15.Validate())
 
 	var type16 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type16.Validate())
-	type16 = "test"
+	type16 = "BONU"
 	assert.Nil(t, type16.Validate())
 
 	var type17 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type17.Validate())
-	type17 = "test"
+	type17 = "APDS"
 	assert.Nil(t, type17.Validate())
 
 	var type18 ExternalDocumentLineType1Code
@@ -479,12 +483,14 @@ func Test1Types(t *testing.T) {
 
 	var type19 ExternalGarnishmentType1Code
 	assert.NotNil(t, type19.Validate())
-	type19 = "test"
+	type19 = "GNCS"
 	assert.Nil(t, type19.Validate())
 
 	var type20 ExternalLocalInstrument1Code
 	assert.NotNil(t, type20.Validate())
 	type20 = "test"
+	assert.NotNil(t, type20.Validate())
+	type20 = "INST"
 	assert.Nil(t, type20.Validate())
 
 	var type21 ExternalMandateSetupReason1Code
@@ -495,16 +501,20 @@ func Test1Types(t *testing.T) {
 	var type22 ExternalPurpose1Code
 	assert.NotNil(t, type22.Validate())
 	type22 = "test"
+	assert.NotNil(t, type22.Validate())
+	type22 = "GDDS"
 	assert.Nil(t, type22.Validate())
 
 	var type23 ExternalServiceLevel1Code
 	assert.NotNil(t, type23.Validate())
 	type23 = "test"
+	assert.NotNil(t, type23.Validate())
+	type23 = "SEPA"
 	assert.Nil(t, type23.Validate())
 
 	var type24 ExternalTaxAmountType1Code
 	assert.NotNil(t, type24.Validate())
-	type24 = "test"
+	type24 = "CITY"
 	assert.Nil(t, type24.Validate())
 
 	var type25 ExternalAgentInstruction1Code
@@ -524,7 +534,7 @@ func Test1Types(t *testing.T) {
 
 	var type28 ExternalBankTransactionDomain1Code
 	assert.NotNil(t, type28.Validate())
-	type28 = "test"
+	type28 = "ACMT"
 	assert.Nil(t, type28.Validate())
 
 	var type29 ExternalBankTransactionFamily1Code
@@ -556,7 +566,7 @@ func Test2Types(t *testing.T) {
 
 	var type2 ExternalEntryStatus1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "BOOK"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalFinancialInstrumentIdentificationType1Code
@@ -577,6 +587,8 @@ func Test2Types(t *testing.T) {
 	var type6 ExternalReturnReason1Code
 	assert.NotNil(t, type6.Validate())
 	type6 = "test"
+	assert.NotNil(t, type6.Validate())
+	type6 = "AC04"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalTechnicalInputChannel1Code
@@ -962,6 +974,8 @@ func Test3Types(t *testing.T) {
 	type31 = "test"
 	assert.NotNil(t, type31.Validate())
 	type31 = "000"
+	assert.NotNil(t, type31.Validate())
+	type31 = "0"
 	assert.Nil(t, type31.Validate())
 
 	var type32 Exact3NumericText
@@ -990,5 +1004,7 @@ func Test3Types(t *testing.T) {
 	type35 = "test"
 	assert.NotNil(t, type35.Validate())
 	type35 = "B00DUM"
+	assert.NotNil(t, type35.Validate())
+	type35 = "DUM"
 	assert.Nil(t, type35.Validate())
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -18,7 +19,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -28,7 +29,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -38,7 +39,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -48,7 +49,7 @@ func (r ExternalEnquiryRequestType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalEnquiryRequestType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalEnquiryRequestType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -58,7 +59,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -68,7 +69,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -78,7 +79,7 @@ func (r ExternalPaymentControlRequestType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPaymentControlRequestType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPaymentControlRequestType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -88,7 +89,7 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -98,7 +99,7 @@ func (r ExternalProxyAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalProxyAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalProxyAccountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -108,7 +109,7 @@ func (r ExternalSystemBalanceType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemBalanceType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalSystemBalanceType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -118,7 +119,7 @@ func (r ExternalSystemErrorHandling1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemErrorHandling1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalSystemErrorHandling1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -128,7 +129,7 @@ func (r ExternalSystemEventType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalSystemEventType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalSystemEventType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -138,7 +139,7 @@ func (r ExternalCashClearingSystem1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 3 {
 		return utils.NewErrTextLengthInvalid("ExternalCashClearingSystem1Code", 1, 3)
 	}
-	return nil
+	return codes.Validate("ExternalCashClearingSystem1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -148,7 +149,7 @@ func (r ExternalMarketInfrastructure1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 3 {
 		return utils.NewErrTextLengthInvalid("ExternalMarketInfrastructure1Code", 1, 3)
 	}
-	return nil
+	return codes.Validate("ExternalMarketInfrastructure1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -158,7 +159,7 @@ func (r ExternalCancellationReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCancellationReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCancellationReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -168,7 +169,7 @@ func (r ExternalCategoryPurpose1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCategoryPurpose1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCategoryPurpose1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -178,7 +179,7 @@ func (r ExternalDiscountAmountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDiscountAmountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDiscountAmountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -188,7 +189,7 @@ func (r ExternalDocumentLineType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDocumentLineType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDocumentLineType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -198,7 +199,7 @@ func (r ExternalGarnishmentType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalGarnishmentType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalGarnishmentType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -208,7 +209,7 @@ func (r ExternalLocalInstrument1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 35 {
 		return utils.NewErrTextLengthInvalid("ExternalLocalInstrument1Code", 1, 35)
 	}
-	return nil
+	return codes.Validate("ExternalLocalInstrument1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -218,7 +219,7 @@ func (r ExternalMandateSetupReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalMandateSetupReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalMandateSetupReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -228,7 +229,7 @@ func (r ExternalPurpose1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPurpose1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPurpose1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -238,7 +239,7 @@ func (r ExternalServiceLevel1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalServiceLevel1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalServiceLevel1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -248,7 +249,7 @@ func (r ExternalTaxAmountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalTaxAmountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalTaxAmountType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -258,7 +259,7 @@ func (r ExternalAgentInstruction1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAgentInstruction1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAgentInstruction1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -268,7 +269,7 @@ func (r ExternalBalanceSubType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBalanceSubType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBalanceSubType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -278,7 +279,7 @@ func (r ExternalBalanceType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBalanceType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBalanceType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -288,7 +289,7 @@ func (r ExternalBankTransactionDomain1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBankTransactionDomain1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBankTransactionDomain1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -298,7 +299,7 @@ func (r ExternalBankTransactionFamily1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBankTransactionFamily1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBankTransactionFamily1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -308,7 +309,7 @@ func (r ExternalBankTransactionSubFamily1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalBankTransactionSubFamily1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalBankTransactionSubFamily1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -318,7 +319,7 @@ func (r ExternalCardTransactionCategory1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCardTransactionCategory1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCardTransactionCategory1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -328,7 +329,7 @@ func (r ExternalChargeType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalChargeType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalChargeType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -338,7 +339,7 @@ func (r ExternalCreditLineType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCreditLineType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCreditLineType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -348,7 +349,7 @@ func (r ExternalEntryStatus1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalEntryStatus1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalEntryStatus1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -358,7 +359,7 @@ func (r ExternalFinancialInstrumentIdentificationType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstrumentIdentificationType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstrumentIdentificationType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -368,7 +369,7 @@ func (r ExternalRePresentmentReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalRePresentmentReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalRePresentmentReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -378,7 +379,7 @@ func (r ExternalReportingSource1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalReportingSource1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalReportingSource1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -388,7 +389,7 @@ func (r ExternalReturnReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalReturnReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalReturnReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -398,7 +399,7 @@ func (r ExternalTechnicalInputChannel1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalTechnicalInputChannel1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalTechnicalInputChannel1Code", string(r))
 }
 
 // May be one of PDNG, STLD
@@ -1146,9 +1147,10 @@ func (r UserInterface2Code) Validate() error {
 // Must match the pattern [0-9]
 type Exact1NumericText string
 
+var exact1NumericTextRegexp = regexp.MustCompile(`^[0-9]$`)
+
 func (r Exact1NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]`)
-	if !reg.MatchString(string(r)) {
+	if !exact1NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Exact1NumericText")
 	}
 	return nil
@@ -1157,9 +1159,10 @@ func (r Exact1NumericText) Validate() error {
 // Must match the pattern [0-9]{3}
 type Exact3NumericText string
 
+var exact3NumericTextRegexp = regexp.MustCompile(`^[0-9]{3}$`)
+
 func (r Exact3NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{3}`)
-	if !reg.MatchString(string(r)) {
+	if !exact3NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Exact3NumericText")
 	}
 	return nil
@@ -1168,9 +1171,10 @@ func (r Exact3NumericText) Validate() error {
 // Must match the pattern [A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}
 type ISINOct2015Identifier string
 
+var isinOct2015IdentifierRegexp = regexp.MustCompile(`^[A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}$`)
+
 func (r ISINOct2015Identifier) Validate() error {
-	reg := regexp.MustCompile(`[A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1}`)
-	if !reg.MatchString(string(r)) {
+	if !isinOct2015IdentifierRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("ISINOct2015Identifier")
 	}
 	return nil
@@ -1179,9 +1183,10 @@ func (r ISINOct2015Identifier) Validate() error {
 // Must match the pattern [a-z]{2,2}
 type ISO2ALanguageCode string
 
+var iso2ALanguageCodeRegexp = regexp.MustCompile(`^[a-z]{2,2}$`)
+
 func (r ISO2ALanguageCode) Validate() error {
-	reg := regexp.MustCompile(`[a-z]{2,2}`)
-	if !reg.MatchString(string(r)) {
+	if !iso2ALanguageCodeRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("ISO2ALanguageCode")
 	}
 	return nil
@@ -1190,9 +1195,10 @@ func (r ISO2ALanguageCode) Validate() error {
 // Must match the pattern [BEOVW]{1,1}[0-9]{2,2}|DUM
 type EntryTypeIdentifier string
 
+var entryTypeIdentifierRegexp = regexp.MustCompile(`^(?:[BEOVW]{1,1}[0-9]{2,2}|DUM)$`)
+
 func (r EntryTypeIdentifier) Validate() error {
-	reg := regexp.MustCompile(`[BEOVW]{1,1}[0-9]{2,2}|DUM`)
-	if !reg.MatchString(string(r)) {
+	if !entryTypeIdentifierRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("EntryTypeIdentifier")
 	}
 	return nil
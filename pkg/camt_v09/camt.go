@@ -12,26 +12,26 @@ import (
 )
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
-	Value float64                             `xml:",chardata"`
-	Ccy   common.ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
@@ -39,12 +39,12 @@ func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AmendmentInformationDetails13 struct {
@@ -67,12 +67,12 @@ func (r AmendmentInformationDetails13) Validate() error {
 }
 
 type AmountType4Choice struct {
-	InstdAmt ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt"`
-	EqvtAmt  EquivalentAmount2                 `xml:"EqvtAmt"`
+	InstdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty" json:",omitempty"`
+	EqvtAmt  *EquivalentAmount2                 `xml:"EqvtAmt,omitempty" json:",omitempty"`
 }
 
 func (r AmountType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -96,12 +96,12 @@ func (r BranchData3) Validate() error {
 }
 
 type CancellationReason33Choice struct {
-	Cd    ExternalCancellationReason1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalCancellationReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CancellationReason33Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Case5 struct {
@@ -138,39 +138,39 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CategoryPurpose1Choice struct {
-	Cd    ExternalCategoryPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCategoryPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CategoryPurpose1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification3Choice struct {
-	Cd    ExternalCashClearingSystem1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalCashClearingSystem1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -203,7 +203,7 @@ func (r Contact4) Validate() error {
 
 type ControlData1 struct {
 	NbOfTxs *common.Max15NumericText `xml:"NbOfTxs,omitempty" json:",omitempty"`
-	CtrlSum float64                  `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum *common.DecimalNumber    `xml:"CtrlSum,omitempty" json:",omitempty"`
 }
 
 func (r ControlData1) Validate() error {
@@ -236,12 +236,12 @@ func (r CreditorReferenceInformation2) Validate() error {
 }
 
 type CreditorReferenceType1Choice struct {
-	Cd    DocumentType3Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorReferenceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorReferenceType2 struct {
@@ -272,7 +272,7 @@ type DateAndDateTime2Choice struct {
 }
 
 func (r DateAndDateTime2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateAndPlaceOfBirth1 struct {
@@ -305,12 +305,12 @@ func (r DiscountAmountAndType1) Validate() error {
 }
 
 type DiscountAmountType1Choice struct {
-	Cd    ExternalDiscountAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalDiscountAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DiscountAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentLineIdentification1 struct {
@@ -343,12 +343,12 @@ func (r DocumentLineType1) Validate() error {
 }
 
 type DocumentLineType1Choice struct {
-	Cd    ExternalDocumentLineType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalDocumentLineType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DocumentLineType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EquivalentAmount2 struct {
@@ -361,12 +361,12 @@ func (r EquivalentAmount2) Validate() error {
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -383,13 +383,13 @@ func (r FinancialInstitutionIdentification18) Validate() error {
 }
 
 type Frequency36Choice struct {
-	Tp     Frequency6Code      `xml:"Tp"`
-	Prd    FrequencyPeriod1    `xml:"Prd"`
-	PtInTm FrequencyAndMoment1 `xml:"PtInTm"`
+	Tp     *Frequency6Code      `xml:"Tp,omitempty" json:",omitempty"`
+	Prd    *FrequencyPeriod1    `xml:"Prd,omitempty" json:",omitempty"`
+	PtInTm *FrequencyAndMoment1 `xml:"PtInTm,omitempty" json:",omitempty"`
 }
 
 func (r Frequency36Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FrequencyAndMoment1 struct {
@@ -435,12 +435,12 @@ func (r GarnishmentType1) Validate() error {
 }
 
 type GarnishmentType1Choice struct {
-	Cd    ExternalGarnishmentType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalGarnishmentType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r GarnishmentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericAccountIdentification1 struct {
@@ -494,21 +494,21 @@ func (r GenericPersonIdentification1) Validate() error {
 }
 
 type LocalInstrument2Choice struct {
-	Cd    ExternalLocalInstrument1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalLocalInstrument1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LocalInstrument2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateClassification1Choice struct {
-	Cd    common.MandateClassification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *common.MandateClassification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateClassification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedData1Choice struct {
@@ -517,7 +517,7 @@ type MandateRelatedData1Choice struct {
 }
 
 func (r MandateRelatedData1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedInformation14 struct {
@@ -538,12 +538,12 @@ func (r MandateRelatedInformation14) Validate() error {
 }
 
 type MandateSetupReason1Choice struct {
-	Cd    ExternalMandateSetupReason1Code `xml:"Cd"`
-	Prtry common.Max70Text                `xml:"Prtry"`
+	Cd    *ExternalMandateSetupReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max70Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateSetupReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateTypeInformation2 struct {
@@ -568,12 +568,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OriginalGroupHeader15 struct {
@@ -583,7 +583,7 @@ type OriginalGroupHeader15 struct {
 	OrgnlMsgNmId common.Max35Text             `xml:"OrgnlMsgNmId"`
 	OrgnlCreDtTm *common.ISODateTime          `xml:"OrgnlCreDtTm,omitempty" json:",omitempty"`
 	NbOfTxs      *common.Max15NumericText     `xml:"NbOfTxs,omitempty" json:",omitempty"`
-	CtrlSum      float64                      `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum      *common.DecimalNumber        `xml:"CtrlSum,omitempty" json:",omitempty"`
 	GrpCxl       bool                         `xml:"GrpCxl,omitempty" json:",omitempty"`
 	CxlRsnInf    []PaymentCancellationReason5 `xml:"CxlRsnInf,omitempty" json:",omitempty"`
 }
@@ -608,7 +608,7 @@ type OriginalPaymentInstruction36 struct {
 	OrgnlPmtInfId common.Max35Text             `xml:"OrgnlPmtInfId"`
 	OrgnlGrpInf   *OriginalGroupInformation29  `xml:"OrgnlGrpInf,omitempty" json:",omitempty"`
 	NbOfTxs       *common.Max15NumericText     `xml:"NbOfTxs,omitempty" json:",omitempty"`
-	CtrlSum       float64                      `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum       *common.DecimalNumber        `xml:"CtrlSum,omitempty" json:",omitempty"`
 	PmtInfCxl     bool                         `xml:"PmtInfCxl,omitempty" json:",omitempty"`
 	CxlRsnInf     []PaymentCancellationReason5 `xml:"CxlRsnInf,omitempty" json:",omitempty"`
 	TxInf         []PaymentTransaction124      `xml:"TxInf,omitempty" json:",omitempty"`
@@ -657,12 +657,12 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Party40Choice struct {
@@ -671,7 +671,7 @@ type Party40Choice struct {
 }
 
 func (r Party40Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification135 struct {
@@ -737,12 +737,12 @@ func (r PersonIdentification13) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress24 struct {
@@ -778,21 +778,21 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Purpose2Choice struct {
-	Cd    ExternalPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Purpose2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentInformation7 struct {
@@ -807,12 +807,12 @@ func (r ReferredDocumentInformation7) Validate() error {
 }
 
 type ReferredDocumentType3Choice struct {
-	Cd    DocumentType6Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType6Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReferredDocumentType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentType4 struct {
@@ -860,12 +860,12 @@ func (r RemittanceInformation16) Validate() error {
 }
 
 type ServiceLevel8Choice struct {
-	Cd    ExternalServiceLevel1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalServiceLevel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ServiceLevel8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SettlementInstruction7 struct {
@@ -937,12 +937,12 @@ func (r TaxAmountAndType1) Validate() error {
 }
 
 type TaxAmountType1Choice struct {
-	Cd    ExternalTaxAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text           `xml:"Prtry"`
+	Cd    *ExternalTaxAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text           `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r TaxAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxAuthorisation1 struct {
@@ -1228,12 +1228,12 @@ type UnderlyingTransaction5Choice struct {
 }
 
 func (r UnderlyingTransaction5Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64                   `xml:",chardata"`
-	Ccy   common.ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -1246,7 +1246,7 @@ type CancellationStatusReason3Choice struct {
 }
 
 func (r CancellationStatusReason3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CancellationStatusReason4 struct {
@@ -1265,7 +1265,7 @@ type ChargeType3Choice struct {
 }
 
 func (r ChargeType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Charges6 struct {
@@ -1316,7 +1316,7 @@ type ClaimNonReceipt2Choice struct {
 }
 
 func (r ClaimNonReceipt2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClaimNonReceiptRejectReason1Choice struct {
@@ -1325,7 +1325,7 @@ type ClaimNonReceiptRejectReason1Choice struct {
 }
 
 func (r ClaimNonReceiptRejectReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Compensation2 struct {
@@ -1345,7 +1345,7 @@ type CompensationReason1Choice struct {
 }
 
 func (r CompensationReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CorrectiveGroupInformation1 struct {
@@ -1393,7 +1393,7 @@ type CorrectiveTransaction4Choice struct {
 }
 
 func (r CorrectiveTransaction4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericIdentification3 struct {
@@ -1409,11 +1409,11 @@ type InvestigationStatus5Choice struct {
 	Conf           *ExternalInvestigationExecutionConfirmation1Code `xml:"Conf,omitempty" json:",omitempty"`
 	RjctdMod       []ModificationStatusReason1Choice                `xml:"RjctdMod,omitempty" json:",omitempty"`
 	DplctOf        *Case5                                           `xml:"DplctOf,omitempty" json:",omitempty"`
-	AssgnmtCxlConf bool                                             `xml:"AssgnmtCxlConf,omitempty" json:",omitempty"`
+	AssgnmtCxlConf *bool                                            `xml:"AssgnmtCxlConf,omitempty" json:",omitempty"`
 }
 
 func (r InvestigationStatus5Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ModificationStatusReason1Choice struct {
@@ -1422,7 +1422,7 @@ type ModificationStatusReason1Choice struct {
 }
 
 func (r ModificationStatusReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ModificationStatusReason2 struct {
@@ -1438,7 +1438,7 @@ func (r ModificationStatusReason2) Validate() error {
 type NumberOfCancellationsPerStatus1 struct {
 	DtldNbOfTxs common.Max15NumericText           `xml:"DtldNbOfTxs"`
 	DtldSts     CancellationIndividualStatus1Code `xml:"DtldSts"`
-	DtldCtrlSum float64                           `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
+	DtldCtrlSum *common.DecimalNumber             `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
 }
 
 func (r NumberOfCancellationsPerStatus1) Validate() error {
@@ -1448,7 +1448,7 @@ func (r NumberOfCancellationsPerStatus1) Validate() error {
 type NumberOfTransactionsPerStatus1 struct {
 	DtldNbOfTxs common.Max15NumericText          `xml:"DtldNbOfTxs"`
 	DtldSts     TransactionIndividualStatus1Code `xml:"DtldSts"`
-	DtldCtrlSum float64                          `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
+	DtldCtrlSum *common.DecimalNumber            `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
 }
 
 func (r NumberOfTransactionsPerStatus1) Validate() error {
@@ -1462,7 +1462,7 @@ type OriginalGroupHeader14 struct {
 	OrgnlMsgNmId     common.Max35Text                 `xml:"OrgnlMsgNmId"`
 	OrgnlCreDtTm     *common.ISODateTime              `xml:"OrgnlCreDtTm,omitempty" json:",omitempty"`
 	OrgnlNbOfTxs     *common.Max15NumericText         `xml:"OrgnlNbOfTxs,omitempty" json:",omitempty"`
-	OrgnlCtrlSum     float64                          `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
+	OrgnlCtrlSum     *common.DecimalNumber            `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
 	GrpCxlSts        *GroupCancellationStatus1Code    `xml:"GrpCxlSts,omitempty" json:",omitempty"`
 	CxlStsRsnInf     []CancellationStatusReason4      `xml:"CxlStsRsnInf,omitempty" json:",omitempty"`
 	NbOfTxsPerCxlSts []NumberOfTransactionsPerStatus1 `xml:"NbOfTxsPerCxlSts,omitempty" json:",omitempty"`
@@ -1478,7 +1478,7 @@ type OriginalPaymentInstruction30 struct {
 	OrgnlPmtInfId    common.Max35Text                  `xml:"OrgnlPmtInfId"`
 	OrgnlGrpInf      *OriginalGroupInformation29       `xml:"OrgnlGrpInf,omitempty" json:",omitempty"`
 	OrgnlNbOfTxs     *common.Max15NumericText          `xml:"OrgnlNbOfTxs,omitempty" json:",omitempty"`
-	OrgnlCtrlSum     float64                           `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
+	OrgnlCtrlSum     *common.DecimalNumber             `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
 	PmtInfCxlSts     *GroupCancellationStatus1Code     `xml:"PmtInfCxlSts,omitempty" json:",omitempty"`
 	CxlStsRsnInf     []CancellationStatusReason4       `xml:"CxlStsRsnInf,omitempty" json:",omitempty"`
 	NbOfTxsPerCxlSts []NumberOfCancellationsPerStatus1 `xml:"NbOfTxsPerCxlSts,omitempty" json:",omitempty"`
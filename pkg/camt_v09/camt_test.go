@@ -5,6 +5,7 @@
 package camt_v09
 
 import (
+	"encoding/xml"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -35,7 +36,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, CreditorReferenceType1Choice{}.Validate())
 	assert.NotNil(t, CreditorReferenceType2{}.Validate())
 	assert.NotNil(t, CustomerPaymentCancellationRequestV09{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth1{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
 	assert.NotNil(t, DiscountAmountAndType1{}.Validate())
@@ -60,7 +61,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, GenericPersonIdentification1{}.Validate())
 	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
 	assert.NotNil(t, MandateClassification1Choice{}.Validate())
-	assert.Nil(t, MandateRelatedData1Choice{}.Validate())
+	assert.NotNil(t, MandateRelatedData1Choice{}.Validate())
 	assert.Nil(t, MandateRelatedInformation14{}.Validate())
 	assert.NotNil(t, MandateSetupReason1Choice{}.Validate())
 	assert.Nil(t, MandateTypeInformation2{}.Validate())
@@ -71,8 +72,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OriginalPaymentInstruction36{}.Validate())
 	assert.Nil(t, OriginalTransactionReference31{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PaymentCancellationReason5{}.Validate())
 	assert.Nil(t, PaymentTransaction124{}.Validate())
@@ -114,17 +115,19 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCancellationReason1Code
 	assert.NotNil(t, type2.Validate())
 	type2 = "test"
+	assert.NotNil(t, type2.Validate())
+	type2 = "DUPL"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCashAccountType1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "CACC"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalCashClearingSystem1Code
@@ -134,17 +137,19 @@ func TestTypes(t *testing.T) {
 
 	var type5 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type5.Validate())
-	type5 = "test"
+	type5 = "BONU"
 	assert.Nil(t, type5.Validate())
 
 	var type6 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type6.Validate())
 	type6 = "test"
+	assert.NotNil(t, type6.Validate())
+	type6 = "USABA"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type7.Validate())
-	type7 = "test"
+	type7 = "APDS"
 	assert.Nil(t, type7.Validate())
 
 	var type8 ExternalDocumentLineType1Code
@@ -159,12 +164,14 @@ func TestTypes(t *testing.T) {
 
 	var type10 ExternalGarnishmentType1Code
 	assert.NotNil(t, type10.Validate())
-	type10 = "test"
+	type10 = "GNCS"
 	assert.Nil(t, type10.Validate())
 
 	var type11 ExternalLocalInstrument1Code
 	assert.NotNil(t, type11.Validate())
 	type11 = "test"
+	assert.NotNil(t, type11.Validate())
+	type11 = "INST"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalMandateSetupReason1Code
@@ -174,12 +181,12 @@ func TestTypes(t *testing.T) {
 
 	var type13 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type13.Validate())
-	type13 = "test"
+	type13 = "BANK"
 	assert.Nil(t, type13.Validate())
 
 	var type14 ExternalPersonIdentification1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "ARNU"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalProxyAccountType1Code
@@ -190,16 +197,20 @@ func TestTypes(t *testing.T) {
 	var type16 ExternalPurpose1Code
 	assert.NotNil(t, type16.Validate())
 	type16 = "test"
+	assert.NotNil(t, type16.Validate())
+	type16 = "GDDS"
 	assert.Nil(t, type16.Validate())
 
 	var type17 ExternalServiceLevel1Code
 	assert.NotNil(t, type17.Validate())
 	type17 = "test"
+	assert.NotNil(t, type17.Validate())
+	type17 = "SEPA"
 	assert.Nil(t, type17.Validate())
 
 	var type18 ExternalTaxAmountType1Code
 	assert.NotNil(t, type18.Validate())
-	type18 = "test"
+	type18 = "CITY"
 	assert.Nil(t, type18.Validate())
 
 	var type19 ClearingChannel2Code
@@ -272,3 +283,16 @@ func TestTypes(t *testing.T) {
 	type28 = "MM01"
 	assert.Nil(t, type28.Validate())
 }
+
+func TestInvestigationStatusChoice(t *testing.T) {
+	confirmed := false
+	assert.Nil(t, InvestigationStatus5Choice{AssgnmtCxlConf: &confirmed}.Validate())
+
+	buf, err := xml.Marshal(&InvestigationStatus5Choice{AssgnmtCxlConf: &confirmed})
+	assert.Nil(t, err)
+	assert.Equal(t, "<InvestigationStatus5Choice><AssgnmtCxlConf>false</AssgnmtCxlConf></InvestigationStatus5Choice>", string(buf))
+
+	var choice InvestigationStatus5Choice
+	assert.Nil(t, xml.Unmarshal(buf, &choice))
+	assert.Nil(t, choice.Validate())
+}
@@ -12,26 +12,26 @@ import (
 )
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
-	Value float64                             `xml:",chardata"`
-	Ccy   common.ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
@@ -52,12 +52,12 @@ func (r AdditionalPaymentInformationV10) Validate() error {
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AmendmentInformationDetails13 struct {
@@ -80,12 +80,12 @@ func (r AmendmentInformationDetails13) Validate() error {
 }
 
 type AmountType4Choice struct {
-	InstdAmt ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt"`
-	EqvtAmt  EquivalentAmount2                 `xml:"EqvtAmt"`
+	InstdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty" json:",omitempty"`
+	EqvtAmt  *EquivalentAmount2                 `xml:"EqvtAmt,omitempty" json:",omitempty"`
 }
 
 func (r AmountType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -142,39 +142,39 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CategoryPurpose1Choice struct {
-	Cd    ExternalCategoryPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCategoryPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CategoryPurpose1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification3Choice struct {
-	Cd    ExternalCashClearingSystem1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalCashClearingSystem1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -240,12 +240,12 @@ func (r CreditorReferenceType2) Validate() error {
 }
 
 type DateAndDateTime2Choice struct {
-	Dt   common.ISODate     `xml:"Dt"`
-	DtTm common.ISODateTime `xml:"DtTm"`
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTime2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateAndPlaceOfBirth1 struct {
@@ -278,12 +278,12 @@ func (r DiscountAmountAndType1) Validate() error {
 }
 
 type DiscountAmountType1Choice struct {
-	Cd    ExternalDiscountAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalDiscountAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DiscountAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentAdjustment1 struct {
@@ -327,12 +327,12 @@ func (r DocumentLineType1) Validate() error {
 }
 
 type DocumentLineType1Choice struct {
-	Cd    ExternalDocumentLineType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalDocumentLineType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DocumentLineType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EquivalentAmount2 struct {
@@ -345,12 +345,12 @@ func (r EquivalentAmount2) Validate() error {
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -367,13 +367,13 @@ func (r FinancialInstitutionIdentification18) Validate() error {
 }
 
 type Frequency36Choice struct {
-	Tp     Frequency6Code      `xml:"Tp"`
-	Prd    FrequencyPeriod1    `xml:"Prd"`
-	PtInTm FrequencyAndMoment1 `xml:"PtInTm"`
+	Tp     *Frequency6Code      `xml:"Tp,omitempty" json:",omitempty"`
+	Prd    *FrequencyPeriod1    `xml:"Prd,omitempty" json:",omitempty"`
+	PtInTm *FrequencyAndMoment1 `xml:"PtInTm,omitempty" json:",omitempty"`
 }
 
 func (r Frequency36Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FrequencyAndMoment1 struct {
@@ -419,12 +419,12 @@ func (r GarnishmentType1) Validate() error {
 }
 
 type GarnishmentType1Choice struct {
-	Cd    ExternalGarnishmentType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalGarnishmentType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r GarnishmentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericAccountIdentification1 struct {
@@ -496,21 +496,21 @@ func (r InstructionForNextAgent1) Validate() error {
 }
 
 type LocalInstrument2Choice struct {
-	Cd    ExternalLocalInstrument1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalLocalInstrument1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LocalInstrument2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateClassification1Choice struct {
-	Cd    common.MandateClassification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *common.MandateClassification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateClassification1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedData1Choice struct {
@@ -519,7 +519,7 @@ type MandateRelatedData1Choice struct {
 }
 
 func (r MandateRelatedData1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedInformation14 struct {
@@ -540,12 +540,12 @@ func (r MandateRelatedInformation14) Validate() error {
 }
 
 type MandateSetupReason1Choice struct {
-	Cd    ExternalMandateSetupReason1Code `xml:"Cd"`
-	Prtry common.Max70Text                `xml:"Prtry"`
+	Cd    *ExternalMandateSetupReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max70Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateSetupReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateTypeInformation2 struct {
@@ -570,12 +570,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OriginalGroupInformation29 struct {
@@ -627,21 +627,21 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Party40Choice struct {
-	Pty PartyIdentification135                       `xml:"Pty"`
-	Agt BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
+	Pty *PartyIdentification135                       `xml:"Pty,omitempty" json:",omitempty"`
+	Agt *BranchAndFinancialInstitutionIdentification6 `xml:"Agt,omitempty" json:",omitempty"`
 }
 
 func (r Party40Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification135 struct {
@@ -724,12 +724,12 @@ func (r PersonIdentification13) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress24 struct {
@@ -765,21 +765,21 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Purpose2Choice struct {
-	Cd    ExternalPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Purpose2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentInformation7 struct {
@@ -794,12 +794,12 @@ func (r ReferredDocumentInformation7) Validate() error {
 }
 
 type ReferredDocumentType3Choice struct {
-	Cd    DocumentType6Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType6Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReferredDocumentType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentType4 struct {
@@ -847,12 +847,12 @@ func (r RemittanceInformation16) Validate() error {
 }
 
 type ServiceLevel8Choice struct {
-	Cd    ExternalServiceLevel1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalServiceLevel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ServiceLevel8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SettlementInstruction7 struct {
@@ -924,12 +924,12 @@ func (r TaxAmountAndType1) Validate() error {
 }
 
 type TaxAmountType1Choice struct {
-	Cd    ExternalTaxAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text           `xml:"Prtry"`
+	Cd    *ExternalTaxAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text           `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r TaxAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxAuthorisation1 struct {
@@ -1069,18 +1069,18 @@ func (r UnderlyingStatementEntry3) Validate() error {
 }
 
 type UnderlyingTransaction6Choice struct {
-	Initn    UnderlyingPaymentInstruction6 `xml:"Initn"`
-	IntrBk   UnderlyingPaymentTransaction5 `xml:"IntrBk"`
-	StmtNtry UnderlyingStatementEntry3     `xml:"StmtNtry"`
+	Initn    *UnderlyingPaymentInstruction6 `xml:"Initn,omitempty" json:",omitempty"`
+	IntrBk   *UnderlyingPaymentTransaction5 `xml:"IntrBk,omitempty" json:",omitempty"`
+	StmtNtry *UnderlyingStatementEntry3     `xml:"StmtNtry,omitempty" json:",omitempty"`
 }
 
 func (r UnderlyingTransaction6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64                   `xml:",chardata"`
-	Ccy   common.ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -1088,12 +1088,12 @@ func (r ActiveCurrencyAndAmount) Validate() error {
 }
 
 type CancellationStatusReason3Choice struct {
-	Cd    ExternalPaymentCancellationRejection1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalPaymentCancellationRejection1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CancellationStatusReason3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CancellationStatusReason4 struct {
@@ -1107,12 +1107,12 @@ func (r CancellationStatusReason4) Validate() error {
 }
 
 type ChargeType3Choice struct {
-	Cd    ExternalChargeType1Code `xml:"Cd"`
-	Prtry GenericIdentification3  `xml:"Prtry"`
+	Cd    *ExternalChargeType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification3  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ChargeType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Charges6 struct {
@@ -1159,21 +1159,21 @@ func (r ClaimNonReceipt2) Validate() error {
 }
 
 type ClaimNonReceipt2Choice struct {
-	Accptd ClaimNonReceipt2                   `xml:"Accptd"`
-	Rjctd  ClaimNonReceiptRejectReason1Choice `xml:"Rjctd"`
+	Accptd *ClaimNonReceipt2                   `xml:"Accptd,omitempty" json:",omitempty"`
+	Rjctd  *ClaimNonReceiptRejectReason1Choice `xml:"Rjctd,omitempty" json:",omitempty"`
 }
 
 func (r ClaimNonReceipt2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClaimNonReceiptRejectReason1Choice struct {
-	Cd    ExternalClaimNonReceiptRejection1Code `xml:"Cd"`
-	Prtry common.Max35Text                      `xml:"Prtry"`
+	Cd    *ExternalClaimNonReceiptRejection1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                      `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClaimNonReceiptRejectReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Compensation3 struct {
@@ -1190,12 +1190,12 @@ func (r Compensation3) Validate() error {
 }
 
 type CompensationReason1Choice struct {
-	Cd    ExternalPaymentCompensationReason1Code `xml:"Cd"`
-	Prtry common.Max35Text                       `xml:"Prtry"`
+	Cd    *ExternalPaymentCompensationReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                       `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CompensationReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CorrectiveGroupInformation1 struct {
@@ -1238,21 +1238,21 @@ func (r CorrectivePaymentInitiation4) Validate() error {
 }
 
 type CorrectiveTransaction4Choice struct {
-	Initn  CorrectivePaymentInitiation4    `xml:"Initn"`
-	IntrBk CorrectiveInterbankTransaction2 `xml:"IntrBk"`
+	Initn  *CorrectivePaymentInitiation4    `xml:"Initn,omitempty" json:",omitempty"`
+	IntrBk *CorrectiveInterbankTransaction2 `xml:"IntrBk,omitempty" json:",omitempty"`
 }
 
 func (r CorrectiveTransaction4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorReferenceType1Choice struct {
-	Cd    DocumentType3Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorReferenceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericIdentification3 struct {
@@ -1265,23 +1265,23 @@ func (r GenericIdentification3) Validate() error {
 }
 
 type InvestigationStatus5Choice struct {
-	Conf           ExternalInvestigationExecutionConfirmation1Code `xml:"Conf"`
-	RjctdMod       []ModificationStatusReason1Choice               `xml:"RjctdMod" json:",omitempty"`
-	DplctOf        Case5                                           `xml:"DplctOf"`
-	AssgnmtCxlConf bool                                            `xml:"AssgnmtCxlConf"`
+	Conf           *ExternalInvestigationExecutionConfirmation1Code `xml:"Conf,omitempty" json:",omitempty"`
+	RjctdMod       []ModificationStatusReason1Choice                `xml:"RjctdMod" json:",omitempty"`
+	DplctOf        *Case5                                           `xml:"DplctOf,omitempty" json:",omitempty"`
+	AssgnmtCxlConf *bool                                            `xml:"AssgnmtCxlConf,omitempty" json:",omitempty"`
 }
 
 func (r InvestigationStatus5Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ModificationStatusReason1Choice struct {
-	Cd    ExternalPaymentModificationRejection1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalPaymentModificationRejection1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ModificationStatusReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ModificationStatusReason2 struct {
@@ -1297,7 +1297,7 @@ func (r ModificationStatusReason2) Validate() error {
 type NumberOfCancellationsPerStatus1 struct {
 	DtldNbOfTxs common.Max15NumericText           `xml:"DtldNbOfTxs"`
 	DtldSts     CancellationIndividualStatus1Code `xml:"DtldSts"`
-	DtldCtrlSum float64                           `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
+	DtldCtrlSum *common.DecimalNumber             `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
 }
 
 func (r NumberOfCancellationsPerStatus1) Validate() error {
@@ -1307,7 +1307,7 @@ func (r NumberOfCancellationsPerStatus1) Validate() error {
 type NumberOfTransactionsPerStatus1 struct {
 	DtldNbOfTxs common.Max15NumericText          `xml:"DtldNbOfTxs"`
 	DtldSts     TransactionIndividualStatus1Code `xml:"DtldSts"`
-	DtldCtrlSum float64                          `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
+	DtldCtrlSum *common.DecimalNumber            `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
 }
 
 func (r NumberOfTransactionsPerStatus1) Validate() error {
@@ -1321,7 +1321,7 @@ type OriginalGroupHeader14 struct {
 	OrgnlMsgNmId     *common.Max35Text                `xml:"OrgnlMsgNmId"`
 	OrgnlCreDtTm     *common.ISODateTime              `xml:"OrgnlCreDtTm,omitempty" json:",omitempty"`
 	OrgnlNbOfTxs     *common.Max15NumericText         `xml:"OrgnlNbOfTxs,omitempty" json:",omitempty"`
-	OrgnlCtrlSum     float64                          `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
+	OrgnlCtrlSum     *common.DecimalNumber            `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
 	GrpCxlSts        *GroupCancellationStatus1Code    `xml:"GrpCxlSts,omitempty" json:",omitempty"`
 	CxlStsRsnInf     []CancellationStatusReason4      `xml:"CxlStsRsnInf,omitempty" json:",omitempty"`
 	NbOfTxsPerCxlSts []NumberOfTransactionsPerStatus1 `xml:"NbOfTxsPerCxlSts,omitempty" json:",omitempty"`
@@ -1337,7 +1337,7 @@ type OriginalPaymentInstruction35 struct {
 	OrgnlPmtInfId    *common.Max35Text                 `xml:"OrgnlPmtInfId"`
 	OrgnlGrpInf      *OriginalGroupInformation29       `xml:"OrgnlGrpInf,omitempty" json:",omitempty"`
 	OrgnlNbOfTxs     *common.Max15NumericText          `xml:"OrgnlNbOfTxs,omitempty" json:",omitempty"`
-	OrgnlCtrlSum     float64                           `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
+	OrgnlCtrlSum     *common.DecimalNumber             `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
 	PmtInfCxlSts     *GroupCancellationStatus1Code     `xml:"PmtInfCxlSts,omitempty" json:",omitempty"`
 	CxlStsRsnInf     []CancellationStatusReason4       `xml:"CxlStsRsnInf,omitempty" json:",omitempty"`
 	NbOfTxsPerCxlSts []NumberOfCancellationsPerStatus1 `xml:"NbOfTxsPerCxlSts,omitempty" json:",omitempty"`
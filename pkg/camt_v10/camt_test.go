@@ -32,7 +32,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, CreditTransferMandateData1{}.Validate())
 	assert.Nil(t, CreditorReferenceInformation2{}.Validate())
 	assert.NotNil(t, CreditorReferenceType2{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth1{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
 	assert.NotNil(t, DiscountAmountAndType1{}.Validate())
@@ -60,7 +60,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, InstructionForNextAgent1{}.Validate())
 	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
 	assert.NotNil(t, MandateClassification1Choice{}.Validate())
-	assert.Nil(t, MandateRelatedData1Choice{}.Validate())
+	assert.NotNil(t, MandateRelatedData1Choice{}.Validate())
 	assert.Nil(t, MandateRelatedInformation14{}.Validate())
 	assert.NotNil(t, MandateSetupReason1Choice{}.Validate())
 	assert.Nil(t, MandateTypeInformation2{}.Validate())
@@ -69,8 +69,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OriginalGroupInformation29{}.Validate())
 	assert.Nil(t, OriginalTransactionReference31{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PaymentComplementaryInformation9{}.Validate())
 	assert.Nil(t, PaymentTypeInformation27{}.Validate())
@@ -144,12 +144,12 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCashClearingSystem1Code
@@ -159,12 +159,14 @@ func TestTypes(t *testing.T) {
 
 	var type4 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type4.Validate())
-	type4 = "test"
+	type4 = "BONU"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type5.Validate())
 	type5 = "test"
+	assert.NotNil(t, type5.Validate())
+	type5 = "USABA"
 	assert.Nil(t, type5.Validate())
 
 	var type6 ExternalCreditorAgentInstruction1Code
@@ -174,7 +176,7 @@ func TestTypes(t *testing.T) {
 
 	var type7 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type7.Validate())
-	type7 = "test"
+	type7 = "APDS"
 	assert.Nil(t, type7.Validate())
 
 	var type8 ExternalDocumentLineType1Code
@@ -189,12 +191,14 @@ func TestTypes(t *testing.T) {
 
 	var type10 ExternalGarnishmentType1Code
 	assert.NotNil(t, type10.Validate())
-	type10 = "test"
+	type10 = "GNCS"
 	assert.Nil(t, type10.Validate())
 
 	var type11 ExternalLocalInstrument1Code
 	assert.NotNil(t, type11.Validate())
 	type11 = "test"
+	assert.NotNil(t, type11.Validate())
+	type11 = "INST"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalMandateSetupReason1Code
@@ -204,12 +208,12 @@ func TestTypes(t *testing.T) {
 
 	var type13 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type13.Validate())
-	type13 = "test"
+	type13 = "BANK"
 	assert.Nil(t, type13.Validate())
 
 	var type14 ExternalPersonIdentification1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "ARNU"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalProxyAccountType1Code
@@ -220,16 +224,20 @@ func TestTypes(t *testing.T) {
 	var type16 ExternalPurpose1Code
 	assert.NotNil(t, type16.Validate())
 	type16 = "test"
+	assert.NotNil(t, type16.Validate())
+	type16 = "GDDS"
 	assert.Nil(t, type16.Validate())
 
 	var type17 ExternalServiceLevel1Code
 	assert.NotNil(t, type17.Validate())
 	type17 = "test"
+	assert.NotNil(t, type17.Validate())
+	type17 = "SEPA"
 	assert.Nil(t, type17.Validate())
 
 	var type18 ExternalTaxAmountType1Code
 	assert.NotNil(t, type18.Validate())
-	type18 = "test"
+	type18 = "CITY"
 	assert.Nil(t, type18.Validate())
 
 	var type19 ExternalChargeType1Code
@@ -0,0 +1,173 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package codes holds the ISO 20022 External Code Sets used to validate the External*Code types of the messages.
+//
+// The code sets bundled with the package are replaced at runtime by Load or LoadFile, so a newer publication of
+// the code sets is picked up without a new release. The value of a type without a loaded code set is only checked
+// for its length.
+package codes
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Code is an entry of an external code set
+type Code struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	Definition string `json:"definition,omitempty"`
+}
+
+// CodeSet is the list of codes allowed by an External*Code type, e.g. ExternalCategoryPurpose1Code
+type CodeSet struct {
+	Name  string `json:"name"`
+	Codes []Code `json:"codes"`
+}
+
+// File is the format read by Load, the format of the bundled external_code_sets.json
+type File struct {
+	Version  string    `json:"version"`
+	CodeSets []CodeSet `json:"codeSets"`
+}
+
+//go:embed external_code_sets.json
+var bundled []byte
+
+var (
+	mu      sync.RWMutex
+	version string
+	sets    map[string]CodeSet
+	lookup  map[string]map[string]Code
+)
+
+func init() {
+	if err := Reset(); err != nil {
+		panic(err)
+	}
+}
+
+// Reset restores the bundled code sets
+func Reset() error {
+	file, err := readFile(bytes.NewReader(bundled))
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	version, sets, lookup = "", map[string]CodeSet{}, map[string]map[string]Code{}
+	apply(file)
+	return nil
+}
+
+// Load reads a code set file, its code sets replace the loaded code sets of the same name and the other ones are kept
+func Load(r io.Reader) error {
+	file, err := readFile(r)
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	apply(file)
+	return nil
+}
+
+// LoadFile loads the code set file at path like Load
+func LoadFile(path string) error {
+	fd, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+	return Load(fd)
+}
+
+func readFile(r io.Reader) (File, error) {
+	var file File
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return File{}, err
+	}
+	for _, set := range file.CodeSets {
+		if set.Name == "" {
+			return File{}, utils.NewErrInvalidCodeSet("without name")
+		}
+		for _, code := range set.Codes {
+			if code.Code == "" {
+				return File{}, utils.NewErrInvalidCodeSet(set.Name)
+			}
+		}
+	}
+	return file, nil
+}
+
+func apply(file File) {
+	if file.Version != "" {
+		version = file.Version
+	}
+	for _, set := range file.CodeSets {
+		entries := make(map[string]Code, len(set.Codes))
+		for _, code := range set.Codes {
+			entries[code.Code] = code
+		}
+		sets[set.Name] = set
+		lookup[set.Name] = entries
+	}
+}
+
+// Version returns the version of the last loaded code set file
+func Version() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return version
+}
+
+// Sets returns the names of the loaded code sets in alphabetical order
+func Sets() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(sets))
+	for name := range sets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupSet returns the code set of a External*Code type
+func LookupSet(name string) (CodeSet, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	set, found := sets[name]
+	return set, found
+}
+
+// Lookup returns the name and definition of a code of the code set
+func Lookup(set, code string) (Code, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	entry, found := lookup[set][code]
+	return entry, found
+}
+
+// Validate checks that code belongs to the code set, a code set which isn't loaded accepts every code
+func Validate(set, code string) error {
+	mu.RLock()
+	defer mu.RUnlock()
+	entries, found := lookup[set]
+	if !found {
+		return nil
+	}
+	if _, found := entries[code]; !found {
+		return utils.NewErrExternalCodeInvalid(set)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package codes
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundledCodeSets(t *testing.T) {
+	assert.Equal(t, "bundled", Version())
+	assert.Contains(t, Sets(), "ExternalCategoryPurpose1Code")
+	assert.Contains(t, Sets(), "ExternalPaymentTransactionStatus1Code")
+
+	code, found := Lookup("ExternalCategoryPurpose1Code", "SALA")
+	assert.True(t, found)
+	assert.Equal(t, "SalaryPayment", code.Name)
+	assert.Equal(t, "Transaction is the payment of salaries.", code.Definition)
+
+	_, found = Lookup("ExternalCategoryPurpose1Code", "XYZ1")
+	assert.False(t, found)
+
+	set, found := LookupSet("ExternalAccountIdentification1Code")
+	assert.True(t, found)
+	assert.Len(t, set.Codes, 4)
+
+	assert.Nil(t, Validate("ExternalCategoryPurpose1Code", "SALA"))
+	assert.EqualError(t, Validate("ExternalCategoryPurpose1Code", "XYZ1"), "The value of ExternalCategoryPurpose1Code has invalid external code")
+	// a code set which isn't loaded accepts every code
+	assert.Nil(t, Validate("ExternalUnknown1Code", "XYZ1"))
+}
+
+func TestBundledCodeSetsRejectUnknownCodes(t *testing.T) {
+	valid := map[string]string{
+		"ExternalAccountIdentification1Code":        "BBAN",
+		"ExternalAuthorisation1Code":                "AUTH",
+		"ExternalBankTransactionDomain1Code":        "PMNT",
+		"ExternalCancellationReason1Code":           "DUPL",
+		"ExternalCashAccountType1Code":              "CACC",
+		"ExternalClearingSystemIdentification1Code": "USABA",
+		"ExternalDiscountAmountType1Code":           "APDS",
+		"ExternalEntryStatus1Code":                  "BOOK",
+		"ExternalGarnishmentType1Code":              "GNCS",
+		"ExternalLocalInstrument1Code":              "INST",
+		"ExternalOrganisationIdentification1Code":   "DUNS",
+		"ExternalPaymentGroupStatus1Code":           "ACCP",
+		"ExternalPaymentTransactionStatus1Code":     "ACSC",
+		"ExternalPersonIdentification1Code":         "NIDN",
+		"ExternalPurpose1Code":                      "GDDS",
+		"ExternalReturnReason1Code":                 "AC04",
+		"ExternalServiceLevel1Code":                 "SEPA",
+		"ExternalStatusReason1Code":                 "AC01",
+		"ExternalTaxAmountType1Code":                "CITY",
+	}
+	for name, code := range valid {
+		assert.Nil(t, Validate(name, code), name)
+		assert.EqualError(t, Validate(name, "XYZ1"), "The value of "+name+" has invalid external code")
+	}
+}
+
+func TestLoadCodeSets(t *testing.T) {
+	defer Reset()
+
+	err := Load(strings.NewReader(`{"version": "2023-11", "codeSets": [
+		{"name": "ExternalCategoryPurpose1Code", "codes": [{"code": "XYZ1", "name": "NewCode", "definition": "A code of a newer release."}]},
+		{"name": "ExternalLocalInstrument1Code", "codes": [{"code": "INST", "name": "InstantCreditTransfer"}]}
+	]}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "2023-11", Version())
+
+	// the sets of the file replace the loaded ones, the others are kept
+	assert.Nil(t, Validate("ExternalCategoryPurpose1Code", "XYZ1"))
+	assert.NotNil(t, Validate("ExternalCategoryPurpose1Code", "SALA"))
+	assert.NotNil(t, Validate("ExternalLocalInstrument1Code", "CORE"))
+	assert.Nil(t, Validate("ExternalAccountIdentification1Code", "BBAN"))
+
+	assert.Nil(t, Reset())
+	assert.Equal(t, "bundled", Version())
+	assert.Nil(t, Validate("ExternalCategoryPurpose1Code", "SALA"))
+	assert.Nil(t, Validate("ExternalLocalInstrument1Code", "CORE"))
+
+	path := filepath.Join(t.TempDir(), "codes.json")
+	assert.Nil(t, os.WriteFile(path, []byte(`{"codeSets": [{"name": "ExternalPurpose1Code", "codes": [{"code": "SALA"}]}]}`), 0600))
+	assert.Nil(t, LoadFile(path))
+	assert.Equal(t, "bundled", Version())
+	assert.NotNil(t, Validate("ExternalPurpose1Code", "GDDS"))
+}
+
+func TestLoadCodeSetsErrors(t *testing.T) {
+	defer Reset()
+
+	assert.Error(t, Load(strings.NewReader(`{"codeSets": [`)))
+	assert.EqualError(t, Load(strings.NewReader(`{"codeSets": [{"codes": []}]}`)), "The code set without name is invalid")
+	assert.EqualError(t, Load(strings.NewReader(`{"codeSets": [{"name": "ExternalPurpose1Code", "codes": [{"name": "Empty"}]}]}`)),
+		"The code set ExternalPurpose1Code is invalid")
+	assert.Error(t, LoadFile(filepath.Join(t.TempDir(), "missing.json")))
+
+	// a failed load keeps the loaded code sets
+	assert.Nil(t, Validate("ExternalPurpose1Code", "GDDS"))
+	assert.Equal(t, "bundled", Version())
+}
@@ -0,0 +1,438 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var (
+	bigTen = big.NewInt(10)
+
+	errDecimalSyntax = errors.New("invalid decimal syntax")
+)
+
+// Decimal is an exact xs:decimal value.
+//
+// The digits and the number of fraction digits of the parsed text are kept, so
+// "100.50" is marshaled back as "100.50" and never in exponent notation.
+// The zero value is 0. Decimal values are immutable, arithmetic returns new values.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+// NewDecimal returns unscaled * 10^-scale
+func NewDecimal(unscaled int64, scale int) Decimal {
+	if scale < 0 {
+		unscaled *= pow10(-scale).Int64()
+		scale = 0
+	}
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+// ParseDecimal parses the lexical representation of xs:decimal, e.g. "-1234.50"
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return Decimal{}, errDecimalSyntax
+	}
+
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	intPart, fracPart := s, ""
+	if idx := strings.IndexByte(s, '.'); idx >= 0 {
+		intPart, fracPart = s[:idx], s[idx+1:]
+	}
+	if len(intPart)+len(fracPart) == 0 {
+		return Decimal{}, errDecimalSyntax
+	}
+	for _, part := range []string{intPart, fracPart} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return Decimal{}, errDecimalSyntax
+			}
+		}
+	}
+
+	unscaled, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return Decimal{}, errDecimalSyntax
+	}
+	if neg {
+		unscaled.Neg(unscaled)
+	}
+	return Decimal{unscaled: unscaled, scale: len(fracPart)}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if the value cannot be parsed
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// SumDecimals returns the exact sum of the values
+func SumDecimals(values ...Decimal) Decimal {
+	var sum Decimal
+	for _, v := range values {
+		sum = sum.Add(v)
+	}
+	return sum
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// rescale returns the unscaled value of d expressed with the given (larger) scale
+func (d Decimal) rescale(scale int) *big.Int {
+	if scale <= d.scale {
+		return new(big.Int).Set(d.int())
+	}
+	return new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+}
+
+// Scale returns the number of fraction digits kept by the value, including trailing zeros
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the value
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero reports whether the value is zero
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares the numeric values and returns -1, 0 or +1
+func (d Decimal) Cmp(other Decimal) int {
+	scale := d.scale
+	if other.scale > scale {
+		scale = other.scale
+	}
+	return d.rescale(scale).Cmp(other.rescale(scale))
+}
+
+// Equal reports whether both values are numerically equal, "1.0" equals "1.00"
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Add returns d + other, the scale of the result is the larger of both scales
+func (d Decimal) Add(other Decimal) Decimal {
+	scale := d.scale
+	if other.scale > scale {
+		scale = other.scale
+	}
+	return Decimal{unscaled: new(big.Int).Add(d.rescale(scale), other.rescale(scale)), scale: scale}
+}
+
+// Sub returns d - other, the scale of the result is the larger of both scales
+func (d Decimal) Sub(other Decimal) Decimal {
+	return d.Add(other.Neg())
+}
+
+// Mul returns d * other, the scale of the result is the sum of both scales
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), other.int()), scale: d.scale + other.scale}
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs returns |d|
+func (d Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Round returns d rounded half away from zero to the given number of fraction digits
+func (d Decimal) Round(places int) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if places >= d.scale {
+		return Decimal{unscaled: d.rescale(places), scale: places}
+	}
+
+	divisor := pow10(d.scale - places)
+	quo, rem := new(big.Int).QuoRem(d.int(), divisor, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(divisor) >= 0 {
+		if d.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	return Decimal{unscaled: quo, scale: places}
+}
+
+// Float64 returns the nearest float64 value, only meant for display purposes
+func (d Decimal) Float64() float64 {
+	f, _ := new(big.Float).SetPrec(64).Quo(new(big.Float).SetInt(d.int()), new(big.Float).SetInt(pow10(d.scale))).Float64()
+	return f
+}
+
+// canonical returns the unscaled value and scale without trailing fraction zeros
+func (d Decimal) canonical() (*big.Int, int) {
+	unscaled, scale := new(big.Int).Set(d.int()), d.scale
+	rem := new(big.Int)
+	for scale > 0 {
+		quo, _ := new(big.Int).QuoRem(unscaled, bigTen, rem)
+		if rem.Sign() != 0 {
+			break
+		}
+		unscaled, scale = quo, scale-1
+	}
+	return unscaled, scale
+}
+
+// TotalDigits returns the number of significant digits as defined by the xs:totalDigits facet
+func (d Decimal) TotalDigits() int {
+	unscaled, scale := d.canonical()
+	digits := len(new(big.Int).Abs(unscaled).String())
+	if unscaled.Sign() == 0 {
+		digits = 1
+	}
+	if digits < scale {
+		// leading zeros of the fraction, e.g. 0.001
+		return scale
+	}
+	return digits
+}
+
+// FractionDigits returns the number of fraction digits as defined by the xs:fractionDigits facet
+func (d Decimal) FractionDigits() int {
+	_, scale := d.canonical()
+	return scale
+}
+
+// String returns the decimal representation without exponent
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+	if d.scale > 0 {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+	if d.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) (err error) {
+	*d, err = ParseDecimal(string(text))
+	return
+}
+
+// MarshalJSON writes the value as a JSON number with all of its digits
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a string holding a decimal
+func (d *Decimal) UnmarshalJSON(data []byte) (err error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err = json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*d, err = ParseDecimal(s)
+		return err
+	}
+	var num json.Number
+	if err = json.Unmarshal(data, &num); err != nil {
+		return err
+	}
+	if strings.ContainsAny(string(num), "eE") {
+		f, ok := new(big.Float).SetPrec(256).SetString(string(num))
+		if !ok {
+			return errDecimalSyntax
+		}
+		*d, err = ParseDecimal(f.Text('f', -1))
+		return err
+	}
+	*d, err = ParseDecimal(string(num))
+	return err
+}
+
+func validateDecimal(d Decimal, typeStr string, totalDigits, fractionDigits int) error {
+	if d.Sign() < 0 || d.TotalDigits() > totalDigits || d.FractionDigits() > fractionDigits {
+		return utils.NewErrValueInvalid(typeStr)
+	}
+	return nil
+}
+
+// Must be at least 0 with at most 18 total digits and 5 fraction digits
+type ActiveCurrencyAndAmountSimpleType Decimal
+
+func (r ActiveCurrencyAndAmountSimpleType) Validate() error {
+	return validateDecimal(Decimal(r), "ActiveCurrencyAndAmount", 18, 5)
+}
+func (r ActiveCurrencyAndAmountSimpleType) String() string {
+	return Decimal(r).String()
+}
+func (r ActiveCurrencyAndAmountSimpleType) MarshalText() ([]byte, error) {
+	return Decimal(r).MarshalText()
+}
+func (r *ActiveCurrencyAndAmountSimpleType) UnmarshalText(text []byte) error {
+	return (*Decimal)(r).UnmarshalText(text)
+}
+func (r ActiveCurrencyAndAmountSimpleType) MarshalJSON() ([]byte, error) {
+	return Decimal(r).MarshalJSON()
+}
+func (r *ActiveCurrencyAndAmountSimpleType) UnmarshalJSON(data []byte) error {
+	return (*Decimal)(r).UnmarshalJSON(data)
+}
+
+// Must be at least 0 with at most 18 total digits and 5 fraction digits
+type ActiveOrHistoricCurrencyAndAmountSimpleType Decimal
+
+func (r ActiveOrHistoricCurrencyAndAmountSimpleType) Validate() error {
+	return validateDecimal(Decimal(r), "ActiveOrHistoricCurrencyAndAmount", 18, 5)
+}
+func (r ActiveOrHistoricCurrencyAndAmountSimpleType) String() string {
+	return Decimal(r).String()
+}
+func (r ActiveOrHistoricCurrencyAndAmountSimpleType) MarshalText() ([]byte, error) {
+	return Decimal(r).MarshalText()
+}
+func (r *ActiveOrHistoricCurrencyAndAmountSimpleType) UnmarshalText(text []byte) error {
+	return (*Decimal)(r).UnmarshalText(text)
+}
+func (r ActiveOrHistoricCurrencyAndAmountSimpleType) MarshalJSON() ([]byte, error) {
+	return Decimal(r).MarshalJSON()
+}
+func (r *ActiveOrHistoricCurrencyAndAmountSimpleType) UnmarshalJSON(data []byte) error {
+	return (*Decimal)(r).UnmarshalJSON(data)
+}
+
+// Must be at least 0 with at most 18 total digits and 13 fraction digits
+type ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType Decimal
+
+func (r ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType) Validate() error {
+	return validateDecimal(Decimal(r), "ActiveOrHistoricCurrencyAnd13DecimalAmount", 18, 13)
+}
+func (r ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType) String() string {
+	return Decimal(r).String()
+}
+func (r ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType) MarshalText() ([]byte, error) {
+	return Decimal(r).MarshalText()
+}
+func (r *ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType) UnmarshalText(text []byte) error {
+	return (*Decimal)(r).UnmarshalText(text)
+}
+func (r ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType) MarshalJSON() ([]byte, error) {
+	return Decimal(r).MarshalJSON()
+}
+func (r *ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType) UnmarshalJSON(data []byte) error {
+	return (*Decimal)(r).UnmarshalJSON(data)
+}
+
+// May be negative, with at most 18 total digits and 17 fraction digits
+type DecimalNumber Decimal
+
+func (r DecimalNumber) Validate() error {
+	if d := Decimal(r); d.TotalDigits() > 18 || d.FractionDigits() > 17 {
+		return utils.NewErrValueInvalid("DecimalNumber")
+	}
+	return nil
+}
+func (r DecimalNumber) String() string {
+	return Decimal(r).String()
+}
+func (r DecimalNumber) MarshalText() ([]byte, error) {
+	return Decimal(r).MarshalText()
+}
+func (r *DecimalNumber) UnmarshalText(text []byte) error {
+	return (*Decimal)(r).UnmarshalText(text)
+}
+func (r DecimalNumber) MarshalJSON() ([]byte, error) {
+	return Decimal(r).MarshalJSON()
+}
+func (r *DecimalNumber) UnmarshalJSON(data []byte) error {
+	return (*Decimal)(r).UnmarshalJSON(data)
+}
+
+// Must be at least 0 with at most 18 total digits and 5 fraction digits, the currency is implied by the context
+type ImpliedCurrencyAndAmount Decimal
+
+func (r ImpliedCurrencyAndAmount) Validate() error {
+	return validateDecimal(Decimal(r), "ImpliedCurrencyAndAmount", 18, 5)
+}
+func (r ImpliedCurrencyAndAmount) String() string {
+	return Decimal(r).String()
+}
+func (r ImpliedCurrencyAndAmount) MarshalText() ([]byte, error) {
+	return Decimal(r).MarshalText()
+}
+func (r *ImpliedCurrencyAndAmount) UnmarshalText(text []byte) error {
+	return (*Decimal)(r).UnmarshalText(text)
+}
+func (r ImpliedCurrencyAndAmount) MarshalJSON() ([]byte, error) {
+	return Decimal(r).MarshalJSON()
+}
+func (r *ImpliedCurrencyAndAmount) UnmarshalJSON(data []byte) error {
+	return (*Decimal)(r).UnmarshalJSON(data)
+}
+
+// Must be at least 0 with at most 18 total digits and 17 fraction digits
+type NonNegativeDecimalNumber Decimal
+
+func (r NonNegativeDecimalNumber) Validate() error {
+	return validateDecimal(Decimal(r), "NonNegativeDecimalNumber", 18, 17)
+}
+func (r NonNegativeDecimalNumber) String() string {
+	return Decimal(r).String()
+}
+func (r NonNegativeDecimalNumber) MarshalText() ([]byte, error) {
+	return Decimal(r).MarshalText()
+}
+func (r *NonNegativeDecimalNumber) UnmarshalText(text []byte) error {
+	return (*Decimal)(r).UnmarshalText(text)
+}
+func (r NonNegativeDecimalNumber) MarshalJSON() ([]byte, error) {
+	return Decimal(r).MarshalJSON()
+}
+func (r *NonNegativeDecimalNumber) UnmarshalJSON(data []byte) error {
+	return (*Decimal)(r).UnmarshalJSON(data)
+}
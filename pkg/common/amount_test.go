@@ -0,0 +1,170 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal(t *testing.T) {
+	for input, expected := range map[string]string{
+		"0":                  "0",
+		"100.50":             "100.50",
+		"+1.5":               "1.5",
+		"-0.01":              "-0.01",
+		".5":                 "0.5",
+		"5.":                 "5",
+		"1234567890123.45":   "1234567890123.45",
+		" 12.300 ":           "12.300",
+		"0000000000000001.1": "1.1",
+	} {
+		d, err := ParseDecimal(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, expected, d.String(), input)
+	}
+
+	for _, input := range []string{"", "-", ".", "1e5", "1,5", "12a", "1.2.3"} {
+		_, err := ParseDecimal(input)
+		assert.NotNil(t, err, input)
+	}
+
+	assert.Panics(t, func() { MustParseDecimal("abc") })
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.Equal(t, "1.23", NewDecimal(123, 2).String())
+	assert.Equal(t, "1200", NewDecimal(12, -2).String())
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := MustParseDecimal("1234567890123.45")
+	b := MustParseDecimal("0.1")
+	c := MustParseDecimal("0.2")
+
+	assert.Equal(t, "0.3", b.Add(c).String())
+	assert.True(t, b.Add(c).Equal(MustParseDecimal("0.30")))
+	assert.Equal(t, "1234567890123.55", a.Add(b).String())
+	assert.Equal(t, "-0.1", b.Sub(c).String())
+	assert.Equal(t, "0.1", b.Sub(c).Abs().String())
+	assert.Equal(t, "0.02", b.Mul(c).String())
+	assert.Equal(t, "1234567890123.75", SumDecimals(a, b, c).String())
+	assert.Equal(t, "0", SumDecimals().String())
+
+	assert.Equal(t, -1, b.Cmp(c))
+	assert.Equal(t, 1, c.Cmp(b))
+	assert.Equal(t, 0, b.Cmp(MustParseDecimal("0.100")))
+	assert.Equal(t, -1, b.Neg().Sign())
+	assert.True(t, Decimal{}.IsZero())
+
+	assert.Equal(t, "2.35", MustParseDecimal("2.345").Round(2).String())
+	assert.Equal(t, "-2.35", MustParseDecimal("-2.345").Round(2).String())
+	assert.Equal(t, "2.34", MustParseDecimal("2.3449").Round(2).String())
+	assert.Equal(t, "2.300", MustParseDecimal("2.3").Round(3).String())
+	assert.Equal(t, 0.3, b.Add(c).Float64())
+}
+
+func TestDecimalFacets(t *testing.T) {
+	assert.Equal(t, 15, MustParseDecimal("1234567890123.45").TotalDigits())
+	assert.Equal(t, 2, MustParseDecimal("1234567890123.45").FractionDigits())
+	assert.Equal(t, 1, MustParseDecimal("1.500000").FractionDigits())
+	assert.Equal(t, 3, MustParseDecimal("0.001").TotalDigits())
+	assert.Equal(t, 1, MustParseDecimal("0").TotalDigits())
+
+	assert.Nil(t, ActiveCurrencyAndAmountSimpleType(MustParseDecimal("999999999999.99999")).Validate())
+	assert.Nil(t, ActiveCurrencyAndAmountSimpleType(MustParseDecimal("1.1000000")).Validate())
+	assert.NotNil(t, ActiveCurrencyAndAmountSimpleType(MustParseDecimal("99999999999999.99999")).Validate())
+	assert.NotNil(t, ActiveCurrencyAndAmountSimpleType(MustParseDecimal("1.123456")).Validate())
+	assert.NotNil(t, ActiveCurrencyAndAmountSimpleType(MustParseDecimal("-1")).Validate())
+	assert.NotNil(t, ActiveOrHistoricCurrencyAndAmountSimpleType(MustParseDecimal("-1")).Validate())
+	assert.Nil(t, ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType(MustParseDecimal("1.1234567890123")).Validate())
+	assert.NotNil(t, ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType(MustParseDecimal("1.12345678901234")).Validate())
+}
+
+func TestDecimalMarshal(t *testing.T) {
+	type amount struct {
+		XMLName xml.Name                          `xml:"Amt"`
+		Value   ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+		Ccy     string                            `xml:"Ccy,attr"`
+	}
+
+	var amt amount
+	assert.Nil(t, xml.Unmarshal([]byte(`<Amt Ccy="EUR">1234567890123.45</Amt>`), &amt))
+	assert.Equal(t, "1234567890123.45", amt.Value.String())
+
+	buf, err := xml.Marshal(&amt)
+	assert.Nil(t, err)
+	assert.Equal(t, `<Amt Ccy="EUR">1234567890123.45</Amt>`, string(buf))
+
+	buf, err = json.Marshal(&amt)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"XMLName":{"Space":"","Local":"Amt"},"Value":1234567890123.45,"Ccy":"EUR"}`, string(buf))
+
+	var fromJson amount
+	assert.Nil(t, json.Unmarshal(buf, &fromJson))
+	assert.Equal(t, "1234567890123.45", fromJson.Value.String())
+	assert.Nil(t, json.Unmarshal([]byte(`{"Value":"10.10"}`), &fromJson))
+	assert.Equal(t, "10.10", fromJson.Value.String())
+	assert.Nil(t, json.Unmarshal([]byte(`{"Value":1.5e2}`), &fromJson))
+	assert.Equal(t, "150", fromJson.Value.String())
+
+	assert.NotNil(t, xml.Unmarshal([]byte(`<Amt Ccy="EUR">1.2.3</Amt>`), &amt))
+}
+
+func TestDecimalNumber(t *testing.T) {
+	assert.Nil(t, DecimalNumber(MustParseDecimal("-1234567.89")).Validate())
+	assert.Nil(t, DecimalNumber(MustParseDecimal("1.12345678901234567")).Validate())
+	assert.NotNil(t, DecimalNumber(MustParseDecimal("1234567890123456789")).Validate())
+	assert.NotNil(t, DecimalNumber(MustParseDecimal("0.123456789012345678")).Validate())
+
+	type group struct {
+		XMLName xml.Name       `xml:"GrpHdr"`
+		CtrlSum *DecimalNumber `xml:"CtrlSum,omitempty" json:",omitempty"`
+	}
+
+	sum := DecimalNumber(MustParseDecimal("1234567.89"))
+	buf, err := xml.Marshal(&group{CtrlSum: &sum})
+	assert.Nil(t, err)
+	assert.Equal(t, `<GrpHdr><CtrlSum>1234567.89</CtrlSum></GrpHdr>`, string(buf))
+
+	buf, err = xml.Marshal(&group{})
+	assert.Nil(t, err)
+	assert.Equal(t, `<GrpHdr></GrpHdr>`, string(buf))
+
+	var grp group
+	assert.Nil(t, xml.Unmarshal([]byte(`<GrpHdr><CtrlSum>1000000.00</CtrlSum></GrpHdr>`), &grp))
+	assert.Equal(t, "1000000.00", grp.CtrlSum.String())
+
+	buf, err = json.Marshal(&grp)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"XMLName":{"Space":"","Local":"GrpHdr"},"CtrlSum":1000000.00}`, string(buf))
+}
+
+func TestImpliedCurrencyAmounts(t *testing.T) {
+	assert.Nil(t, ImpliedCurrencyAndAmount(MustParseDecimal("1234567.12345")).Validate())
+	assert.NotNil(t, ImpliedCurrencyAndAmount(MustParseDecimal("-1")).Validate())
+	assert.NotNil(t, ImpliedCurrencyAndAmount(MustParseDecimal("1.123456")).Validate())
+	assert.Nil(t, NonNegativeDecimalNumber(MustParseDecimal("1.12345678901234567")).Validate())
+	assert.NotNil(t, NonNegativeDecimalNumber(MustParseDecimal("-0.01")).Validate())
+
+	type balance struct {
+		XMLName xml.Name                  `xml:"CshBal"`
+		Amt     ImpliedCurrencyAndAmount  `xml:"Amt"`
+		Net     *NonNegativeDecimalNumber `xml:"Net,omitempty" json:",omitempty"`
+	}
+
+	var bal balance
+	assert.Nil(t, xml.Unmarshal([]byte(`<CshBal><Amt>98765432101234.50</Amt></CshBal>`), &bal))
+	assert.Equal(t, "98765432101234.50", bal.Amt.String())
+	assert.Nil(t, bal.Net)
+
+	buf, err := xml.Marshal(&bal)
+	assert.Nil(t, err)
+	assert.Equal(t, `<CshBal><Amt>98765432101234.50</Amt></CshBal>`, string(buf))
+	buf, err = json.Marshal(&bal)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"XMLName":{"Space":"","Local":"CshBal"},"Amt":98765432101234.50}`, string(buf))
+}
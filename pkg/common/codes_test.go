@@ -0,0 +1,52 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifierChecksums(t *testing.T) {
+	for _, iban := range []string{"DE89370400440532013000", "GB82WEST12345698765432", "CH9300762011623852957", "XK051212012345678906"} {
+		assert.Nil(t, IBAN2007Identifier(iban).Validate(), iban)
+	}
+	for _, iban := range []string{"DE89370400440532013001", "ZZ89370400440532013000", "de89370400440532013000", " DE89370400440532013000"} {
+		assert.NotNil(t, IBAN2007Identifier(iban).Validate(), iban)
+	}
+
+	for _, lei := range []string{"5493001KJTIIGC8Y1R12", "7H6GLXDRUGQFU57RNE97"} {
+		assert.Nil(t, LEIIdentifier(lei).Validate(), lei)
+	}
+	for _, lei := range []string{"5493001KJTIIGC8Y1R13", "5493001KJTIIGC8Y1R1", "5493001KJTIIGC8Y1R12X"} {
+		assert.NotNil(t, LEIIdentifier(lei).Validate(), lei)
+	}
+}
+
+func TestCodeTables(t *testing.T) {
+	assert.Nil(t, CountryCode("US").Validate())
+	assert.Nil(t, CountryCode("XK").Validate())
+	assert.NotNil(t, CountryCode("AA").Validate())
+	assert.NotNil(t, CountryCode("USA").Validate())
+
+	assert.Nil(t, ActiveCurrencyCode("EUR").Validate())
+	assert.NotNil(t, ActiveCurrencyCode("DEM").Validate())
+	assert.NotNil(t, ActiveCurrencyCode("ABC").Validate())
+	assert.NotNil(t, ActiveCurrencyCode("XXUSD123").Validate())
+
+	assert.Nil(t, ActiveOrHistoricCurrencyCode("EUR").Validate())
+	assert.Nil(t, ActiveOrHistoricCurrencyCode("DEM").Validate())
+	assert.NotNil(t, ActiveOrHistoricCurrencyCode("ABC").Validate())
+}
+
+func TestAnchoredPatterns(t *testing.T) {
+	assert.Nil(t, BICFIDec2014Identifier("DEUTDEFF").Validate())
+	assert.Nil(t, BICFIDec2014Identifier("DEUTDEFF500").Validate())
+	assert.NotNil(t, BICFIDec2014Identifier("xxDEUTDEFFxx").Validate())
+	assert.NotNil(t, BICFIDec2014Identifier("DEUTDEFF5").Validate())
+	assert.Nil(t, BICIdentifier("UBSWCHZH80A").Validate())
+	assert.NotNil(t, BICIdentifier("xxUBSWCHZHxx").Validate())
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package common
+
+// OptionalMax35Text returns the text of an optional element, nil when it's empty so that the element is omitted
+func OptionalMax35Text(value string) *Max35Text {
+	if value == "" {
+		return nil
+	}
+	text := Max35Text(value)
+	return &text
+}
+
+// OptionalMax140Text returns the text of an optional element, nil when it's empty so that the element is omitted
+func OptionalMax140Text(value string) *Max140Text {
+	if value == "" {
+		return nil
+	}
+	text := Max140Text(value)
+	return &text
+}
+
+// OptionalMax15NumericText returns the number of an optional element, nil when it's empty
+func OptionalMax15NumericText(value string) *Max15NumericText {
+	if value == "" {
+		return nil
+	}
+	number := Max15NumericText(value)
+	return &number
+}
+
+// OptionalBICFIDec2014Identifier returns the BIC of an optional element, nil when it's empty
+func OptionalBICFIDec2014Identifier(value string) *BICFIDec2014Identifier {
+	if value == "" {
+		return nil
+	}
+	bic := BICFIDec2014Identifier(value)
+	return &bic
+}
+
+// OptionalUUIDv4Identifier returns the UETR of an optional element, nil when it's empty
+func OptionalUUIDv4Identifier(value string) *UUIDv4Identifier {
+	if value == "" {
+		return nil
+	}
+	uetr := UUIDv4Identifier(value)
+	return &uetr
+}
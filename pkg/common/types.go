@@ -268,9 +268,10 @@ func (r Max105Text) Validate() error {
 // Must match the pattern \+[0-9]{1,3}-[0-9()+\-]{1,30}
 type PhoneNumber string
 
+var phoneNumberRegexp = regexp.MustCompile(`^\+[0-9]{1,3}-[0-9()+\-]{1,30}$`)
+
 func (r PhoneNumber) Validate() error {
-	reg := regexp.MustCompile(`\+[0-9]{1,3}-[0-9()+\-]{1,30}`)
-	if !reg.MatchString(string(r)) {
+	if !phoneNumberRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("PhoneNumber")
 	}
 	return nil
@@ -290,12 +291,13 @@ func (r AddressType2Code) Validate() error {
 	return utils.NewErrValueInvalid("AddressType2Code")
 }
 
-// Must match the pattern [A-Z]{2,2}
+// Must match the pattern [A-Z]{2,2} and be an ISO 3166-1 alpha-2 code
 type CountryCode string
 
+var countryCodeRegexp = regexp.MustCompile(`^[A-Z]{2,2}$`)
+
 func (r CountryCode) Validate() error {
-	reg := regexp.MustCompile(`[A-Z]{2,2}`)
-	if !reg.MatchString(string(r)) {
+	if !countryCodeRegexp.MatchString(string(r)) || !isCountryCode(string(r)) {
 		return utils.NewErrValueInvalid("CountryCode")
 	}
 	return nil
@@ -329,23 +331,25 @@ func (r CopyDuplicate1Code) Validate() error {
 	return utils.NewErrValueInvalid("CopyDuplicate1Code")
 }
 
-// Must match the pattern [A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30}
+// Must match the pattern [A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30} and pass the mod-97 check
 type IBAN2007Identifier string
 
+var iban2007IdentifierRegexp = regexp.MustCompile(`^[A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30}$`)
+
 func (r IBAN2007Identifier) Validate() error {
-	reg := regexp.MustCompile(`[A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30}`)
-	if !reg.MatchString(string(r)) {
+	if !iban2007IdentifierRegexp.MatchString(string(r)) || !validIBAN(string(r)) {
 		return utils.NewErrValueInvalid("IBAN2007Identifier")
 	}
 	return nil
 }
 
-// Must match the pattern [A-Z0-9]{18,18}[0-9]{2,2}
+// Must match the pattern [A-Z0-9]{18,18}[0-9]{2,2} and pass the mod-97 check
 type LEIIdentifier string
 
+var leiIdentifierRegexp = regexp.MustCompile(`^[A-Z0-9]{18,18}[0-9]{2,2}$`)
+
 func (r LEIIdentifier) Validate() error {
-	reg := regexp.MustCompile(`[A-Z0-9]{18,18}[0-9]{2,2}`)
-	if !reg.MatchString(string(r)) {
+	if !leiIdentifierRegexp.MatchString(string(r)) || !validLEI(string(r)) {
 		return utils.NewErrValueInvalid("LEIIdentifier")
 	}
 	return nil
@@ -368,9 +372,10 @@ func (r NamePrefix2Code) Validate() error {
 // Must match the pattern [0-9]{1,15}
 type Max15NumericText string
 
+var max15NumericTextRegexp = regexp.MustCompile(`^[0-9]{1,15}$`)
+
 func (r Max15NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{1,15}`)
-	if !reg.MatchString(string(r)) {
+	if !max15NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Max15NumericText")
 	}
 	return nil
@@ -379,9 +384,10 @@ func (r Max15NumericText) Validate() error {
 // Must match the pattern [0-9]{1,3}
 type Max3NumericText string
 
+var max3NumericTextRegexp = regexp.MustCompile(`^[0-9]{1,3}$`)
+
 func (r Max3NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{1,3}`)
-	if !reg.MatchString(string(r)) {
+	if !max3NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Max3NumericText")
 	}
 	return nil
@@ -390,9 +396,10 @@ func (r Max3NumericText) Validate() error {
 // Must match the pattern [0-9]{4,4}
 type MerchantCategoryCodeIdentifier string
 
+var merchantCategoryCodeIdentifierRegexp = regexp.MustCompile(`^[0-9]{4,4}$`)
+
 func (r MerchantCategoryCodeIdentifier) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{4,4}`)
-	if !reg.MatchString(string(r)) {
+	if !merchantCategoryCodeIdentifierRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("MerchantCategoryCodeIdentifier")
 	}
 	return nil
@@ -429,9 +436,10 @@ func (r InterestType1Code) Validate() error {
 // Must match the pattern [\+]{0,1}[0-9]{1,15}
 type Max15PlusSignedNumericText string
 
+var max15PlusSignedNumericTextRegexp = regexp.MustCompile(`^[\+]{0,1}[0-9]{1,15}$`)
+
 func (r Max15PlusSignedNumericText) Validate() error {
-	reg := regexp.MustCompile(`[\+]{0,1}[0-9]{1,15}`)
-	if !reg.MatchString(string(r)) {
+	if !max15PlusSignedNumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Max15PlusSignedNumericText")
 	}
 	return nil
@@ -440,9 +448,10 @@ func (r Max15PlusSignedNumericText) Validate() error {
 // Must match the pattern [a-zA-Z0-9]{1,4}
 type Max4AlphaNumericText string
 
+var max4AlphaNumericTextRegexp = regexp.MustCompile(`^[a-zA-Z0-9]{1,4}$`)
+
 func (r Max4AlphaNumericText) Validate() error {
-	reg := regexp.MustCompile(`[a-zA-Z0-9]{1,4}`)
-	if !reg.MatchString(string(r)) {
+	if !max4AlphaNumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Max4AlphaNumericText")
 	}
 	return nil
@@ -451,9 +460,10 @@ func (r Max4AlphaNumericText) Validate() error {
 // Must match the pattern [0-9]{1,5}
 type Max5NumericText string
 
+var max5NumericTextRegexp = regexp.MustCompile(`^[0-9]{1,5}$`)
+
 func (r Max5NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{1,5}`)
-	if !reg.MatchString(string(r)) {
+	if !max5NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Max5NumericText")
 	}
 	return nil
@@ -462,9 +472,10 @@ func (r Max5NumericText) Validate() error {
 // Must match the pattern [0-9]{2,3}
 type Min2Max3NumericText string
 
+var min2Max3NumericTextRegexp = regexp.MustCompile(`^[0-9]{2,3}$`)
+
 func (r Min2Max3NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{2,3}`)
-	if !reg.MatchString(string(r)) {
+	if !min2Max3NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Min2Max3NumericText")
 	}
 	return nil
@@ -473,9 +484,10 @@ func (r Min2Max3NumericText) Validate() error {
 // Must match the pattern [0-9]{3,4}
 type Min3Max4NumericText string
 
+var min3Max4NumericTextRegexp = regexp.MustCompile(`^[0-9]{3,4}$`)
+
 func (r Min3Max4NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{3,4}`)
-	if !reg.MatchString(string(r)) {
+	if !min3Max4NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Min3Max4NumericText")
 	}
 	return nil
@@ -484,9 +496,10 @@ func (r Min3Max4NumericText) Validate() error {
 // Must match the pattern [0-9]{8,28}
 type Min8Max28NumericText string
 
+var min8Max28NumericTextRegexp = regexp.MustCompile(`^[0-9]{8,28}$`)
+
 func (r Min8Max28NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{8,28}`)
-	if !reg.MatchString(string(r)) {
+	if !min8Max28NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Min8Max28NumericText")
 	}
 	return nil
@@ -506,23 +519,26 @@ func (r Authorisation1Code) Validate() error {
 	return utils.NewErrValueInvalid("Authorisation1Code")
 }
 
-// Must match the pattern [A-Z]{3,3}
+// Must match the pattern [A-Z]{3,3} and be an active ISO 4217 code
 type ActiveCurrencyCode string
 
+var activeCurrencyCodeRegexp = regexp.MustCompile(`^[A-Z]{3,3}$`)
+
 func (r ActiveCurrencyCode) Validate() error {
-	reg := regexp.MustCompile(`[A-Z]{3,3}`)
-	if !reg.MatchString(string(r)) {
+	if !activeCurrencyCodeRegexp.MatchString(string(r)) || !isActiveCurrencyCode(string(r)) {
 		return utils.NewErrValueInvalid("ActiveCurrencyCode")
 	}
 	return nil
 }
 
-// Must match the pattern [A-Z]{3,3}
+// Must match the pattern [A-Z]{3,3} and be an active or historic ISO 4217 code
 type ActiveOrHistoricCurrencyCode string
 
+var activeOrHistoricCurrencyCodeRegexp = regexp.MustCompile(`^[A-Z]{3,3}$`)
+
 func (r ActiveOrHistoricCurrencyCode) Validate() error {
-	reg := regexp.MustCompile(`[A-Z]{3,3}`)
-	if !reg.MatchString(string(r)) {
+	code := string(r)
+	if !activeOrHistoricCurrencyCodeRegexp.MatchString(code) || !(isActiveCurrencyCode(code) || isHistoricCurrencyCode(code)) {
 		return utils.NewErrValueInvalid("ActiveOrHistoricCurrencyCode")
 	}
 	return nil
@@ -531,9 +547,10 @@ func (r ActiveOrHistoricCurrencyCode) Validate() error {
 // Must match the pattern [A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}
 type AnyBICIdentifier string
 
+var anyBICIdentifierRegexp = regexp.MustCompile(`^[A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}$`)
+
 func (r AnyBICIdentifier) Validate() error {
-	reg := regexp.MustCompile(`[A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}`)
-	if !reg.MatchString(string(r)) {
+	if !anyBICIdentifierRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("AnyBICIdentifier")
 	}
 	return nil
@@ -542,20 +559,34 @@ func (r AnyBICIdentifier) Validate() error {
 // Must match the pattern [A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}
 type BICFIIdentifier string
 
+var bicfiIdentifierRegexp = regexp.MustCompile(`^[A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}$`)
+
 func (r BICFIIdentifier) Validate() error {
-	reg := regexp.MustCompile(`[A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}`)
-	if !reg.MatchString(string(r)) {
+	if !bicfiIdentifierRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("BICFIIdentifier")
 	}
 	return nil
 }
 
+// Must match the pattern [A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}
+type BICIdentifier string
+
+var bicIdentifierRegexp = regexp.MustCompile(`^[A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}$`)
+
+func (r BICIdentifier) Validate() error {
+	if !bicIdentifierRegexp.MatchString(string(r)) {
+		return utils.NewErrValueInvalid("BICIdentifier")
+	}
+	return nil
+}
+
 // Must match the pattern [A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1}
 type AnyBICDec2014Identifier string
 
+var anyBICDec2014IdentifierRegexp = regexp.MustCompile(`^[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1}$`)
+
 func (r AnyBICDec2014Identifier) Validate() error {
-	reg := regexp.MustCompile(`[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1}`)
-	if !reg.MatchString(string(r)) {
+	if !anyBICDec2014IdentifierRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("AnyBICDec2014Identifier")
 	}
 	return nil
@@ -564,9 +595,10 @@ func (r AnyBICDec2014Identifier) Validate() error {
 // Must match the pattern [A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1}
 type BICFIDec2014Identifier string
 
+var bicfiDec2014IdentifierRegexp = regexp.MustCompile(`^[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1}$`)
+
 func (r BICFIDec2014Identifier) Validate() error {
-	reg := regexp.MustCompile(`[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1}`)
-	if !reg.MatchString(string(r)) {
+	if !bicfiDec2014IdentifierRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("BICFIDec2014Identifier")
 	}
 	return nil
@@ -603,9 +635,10 @@ func (r AccountStatus3Code) Validate() error {
 // Must match the pattern [a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12}
 type UUIDv4Identifier string
 
+var uuidv4IdentifierRegexp = regexp.MustCompile(`^[a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12}$`)
+
 func (r UUIDv4Identifier) Validate() error {
-	reg := regexp.MustCompile(`[a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12}`)
-	if !reg.MatchString(string(r)) {
+	if !uuidv4IdentifierRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("UUIDv4Identifier")
 	}
 	return nil
@@ -614,9 +647,10 @@ func (r UUIDv4Identifier) Validate() error {
 // Must match the pattern [a-zA-Z0-9]{4}
 type Exact4AlphaNumericText string
 
+var exact4AlphaNumericTextRegexp = regexp.MustCompile(`^[a-zA-Z0-9]{4}$`)
+
 func (r Exact4AlphaNumericText) Validate() error {
-	reg := regexp.MustCompile(`[a-zA-Z0-9]{4}`)
-	if !reg.MatchString(string(r)) {
+	if !exact4AlphaNumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Exact4AlphaNumericText")
 	}
 	return nil
@@ -625,9 +659,10 @@ func (r Exact4AlphaNumericText) Validate() error {
 // Must match the pattern [0-9]{2}
 type Exact2NumericText string
 
+var exact2NumericTextRegexp = regexp.MustCompile(`^[0-9]{2}$`)
+
 func (r Exact2NumericText) Validate() error {
-	reg := regexp.MustCompile(`[0-9]{2}`)
-	if !reg.MatchString(string(r)) {
+	if !exact2NumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Exact2NumericText")
 	}
 	return nil
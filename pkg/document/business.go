@@ -0,0 +1,398 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/head_v01"
+	"github.com/moov-io/iso20022/pkg/head_v02"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// DefaultBusinessMessageElement is the envelope element used when a business message doesn't name one
+	DefaultBusinessMessageElement = "BizMsg"
+
+	appHdrElement   = "AppHdr"
+	documentElement = "Document"
+	nameSpacePrefix = "urn:iso:std:iso:20022:tech:xsd:"
+)
+
+var headerNameSpaces = map[string]bool{
+	utils.DocumentHead00100101NameSpace: true,
+	utils.DocumentHead00100102NameSpace: true,
+}
+
+// BusinessMessage is a Business Application Header (AppHdr) and the Document it describes,
+// carried together inside an envelope element such as <BizMsg>, <Message> or <RequestPayload>
+type BusinessMessage struct {
+	XMLName     xml.Name
+	Attrs       []xml.Attr `json:",omitempty"`
+	AppHdrAttrs []xml.Attr `json:",omitempty"`
+	// AppHdr is a *head_v01.BusinessApplicationHeaderV01 or a *head_v02.BusinessApplicationHeaderV02
+	AppHdr   Iso20022Message
+	Document *Iso20022DocumentObject
+}
+
+// NewBusinessMessage wraps the header and the document in a <BizMsg> envelope
+func NewBusinessMessage(header Iso20022Message, doc *Iso20022DocumentObject) (*BusinessMessage, error) {
+	namespace := headerNameSpace(header)
+	if namespace == "" {
+		return nil, fmt.Errorf("%T is not a business application header", header)
+	}
+	return &BusinessMessage{
+		XMLName:     xml.Name{Local: DefaultBusinessMessageElement},
+		AppHdrAttrs: canonicalAttrs(namespace),
+		AppHdr:      header,
+		Document:    doc,
+	}, nil
+}
+
+func headerNameSpace(header Iso20022Message) string {
+	switch header.(type) {
+	case *head_v01.BusinessApplicationHeaderV01:
+		return utils.DocumentHead00100101NameSpace
+	case *head_v02.BusinessApplicationHeaderV02:
+		return utils.DocumentHead00100102NameSpace
+	}
+	return ""
+}
+
+func newHeader(namespace string) (Iso20022Message, error) {
+	constructor := lookupConstructor(namespace)
+	if !headerNameSpaces[namespace] || constructor == nil {
+		return nil, utils.NewErrUnsupportedNameSpace()
+	}
+	return constructor(), nil
+}
+
+func newDocumentObject(namespace string) (*Iso20022DocumentObject, error) {
+	if namespace == "" {
+		return nil, utils.NewErrOmittedNameSpace()
+	}
+	constructor := lookupConstructor(namespace)
+	if constructor == nil || headerNameSpaces[namespace] {
+		return nil, utils.NewErrUnsupportedNameSpace()
+	}
+	return &Iso20022DocumentObject{Message: constructor()}, nil
+}
+
+// isBusinessMessage reports whether the root element of the input is an envelope rather than a Document.
+// Envelopes may be in a namespace of their own, but never in the namespace of a registered message.
+func isBusinessMessage(dummy documentDummy) bool {
+	return dummy.XMLName.Local != "" && dummy.XMLName.Local != documentElement && lookupConstructor(dummy.NameSpace()) == nil
+}
+
+// parseBusinessMessage reads an envelope and checks the raw Document against its schema when asked to
+func parseBusinessMessage(buf []byte, bType string, options parseOptions) (*BusinessMessage, error) {
+	msg := &BusinessMessage{}
+	if bType == utils.DocumentTypeJson {
+		if err := json.Unmarshal(buf, msg); err != nil {
+			return nil, err
+		}
+		return msg, msg.complete()
+	}
+
+	if options.schemas != nil {
+		if err := validateEnvelopeSchema(buf, options); err != nil {
+			return nil, err
+		}
+	}
+	if err := xml.Unmarshal(buf, msg); err != nil {
+		return nil, err
+	}
+	return msg, msg.complete()
+}
+
+// validateEnvelopeSchema validates the raw Document element of the envelope
+func validateEnvelopeSchema(buf []byte, options parseOptions) error {
+	decoder := xml.NewDecoder(bytes.NewReader(buf))
+	depth := 0
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if depth == 1 && t.Name.Local == documentElement {
+				if err = decoder.Skip(); err != nil {
+					return err
+				}
+				return options.schemas.Validate(buf[offset:decoder.InputOffset()])
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
+
+func (msg *BusinessMessage) complete() error {
+	if msg.AppHdr == nil {
+		return errors.New("the business message has no AppHdr")
+	}
+	if msg.Document == nil {
+		return errors.New("the business message has no Document")
+	}
+	return nil
+}
+
+func (msg *BusinessMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	msg.XMLName = start.Name
+	msg.Attrs = start.Attr
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case appHdrElement:
+				header, err := newHeader(t.Name.Space)
+				if err != nil {
+					return err
+				}
+				if err = d.DecodeElement(header, &t); err != nil {
+					return err
+				}
+				msg.AppHdr = header
+				msg.AppHdrAttrs = t.Attr
+			case documentElement:
+				doc, err := newDocumentObject(t.Name.Space)
+				if err != nil {
+					return err
+				}
+				if err = d.DecodeElement(doc, &t); err != nil {
+					return err
+				}
+				msg.Document = doc
+			default:
+				if err = d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// MarshalXML writes the envelope, the header and the document with the namespace prefixes and declarations they were read with
+func (msg BusinessMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	name := msg.XMLName
+	if name.Local == "" {
+		name.Local = DefaultBusinessMessageElement
+	}
+	start, _ = qualifiedStartElement(name, msg.Attrs, nil)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if msg.AppHdr != nil {
+		namespace := headerNameSpace(msg.AppHdr)
+		hdr, prefix := qualifiedStartElement(xml.Name{Space: namespace, Local: appHdrElement}, msg.AppHdrAttrs, msg.Attrs)
+		if err := encodeQualified(e, hdr, prefix, namespace, msg.AppHdr); err != nil {
+			return err
+		}
+	}
+	if msg.Document != nil {
+		if err := msg.Document.encode(e, msg.Attrs); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+func (msg *BusinessMessage) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		XMLName     xml.Name
+		Attrs       []xml.Attr
+		AppHdrAttrs []xml.Attr
+		AppHdr      json.RawMessage
+		Document    json.RawMessage
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	msg.XMLName = raw.XMLName
+	msg.Attrs = raw.Attrs
+	msg.AppHdrAttrs = raw.AppHdrAttrs
+
+	if len(raw.AppHdr) > 0 {
+		header, err := newHeader(resolveNameSpace(xml.Name{}, raw.AppHdrAttrs))
+		if err != nil {
+			return err
+		}
+		if err = json.Unmarshal(raw.AppHdr, header); err != nil {
+			return err
+		}
+		msg.AppHdr = header
+	}
+
+	if len(raw.Document) > 0 {
+		var dummy documentDummy
+		if err := json.Unmarshal(raw.Document, &dummy); err != nil {
+			return err
+		}
+		doc, err := newDocumentObject(dummy.NameSpace())
+		if err != nil {
+			return err
+		}
+		if err = json.Unmarshal(raw.Document, doc); err != nil {
+			return err
+		}
+		msg.Document = doc
+	}
+	return nil
+}
+
+// Validate checks the header and the document, and then that they describe the same message:
+// MsgDefIdr matches the document namespace, BizMsgIdr matches the message identification of the
+// document and the header isn't created before the document.
+func (msg BusinessMessage) Validate() error {
+	if err := msg.complete(); err != nil {
+		return err
+	}
+
+	root := "/" + msg.XMLName.Local
+	if msg.XMLName.Local == "" {
+		root = "/" + DefaultBusinessMessageElement
+	}
+
+	errs := utils.ValidateAll(msg.AppHdr, root+"/"+appHdrElement)
+	if err := msg.Document.Validate(); err != nil {
+		var docErrs utils.ValidationErrors
+		if !errors.As(err, &docErrs) {
+			return err
+		}
+		for _, docErr := range docErrs {
+			docErr.Path = root + docErr.Path
+			errs = append(errs, docErr)
+		}
+	}
+	errs = append(errs, msg.crossCheck(root)...)
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (msg BusinessMessage) crossCheck(root string) utils.ValidationErrors {
+	var errs utils.ValidationErrors
+	header := reflect.Indirect(reflect.ValueOf(msg.AppHdr))
+	headerType := header.Type().Name()
+	path := root + "/" + appHdrElement
+
+	namespace := msg.Document.NameSpace()
+	msgDefIdr := header.FieldByName("MsgDefIdr").String()
+	if expected := strings.TrimPrefix(namespace, nameSpacePrefix); msgDefIdr != expected {
+		errs = append(errs, utils.ValidationError{
+			Path:  path + "/MsgDefIdr",
+			Type:  headerType,
+			Rule:  fmt.Sprintf("consistency (must match the document namespace %s)", namespace),
+			Value: msgDefIdr,
+		})
+	}
+
+	grpHdr := groupHeader(msg.Document.Message)
+	if !grpHdr.IsValid() {
+		return errs
+	}
+
+	bizMsgIdr := header.FieldByName("BizMsgIdr").String()
+	if msgId := grpHdr.FieldByName("MsgId"); msgId.IsValid() && msgId.Kind() == reflect.String && msgId.String() != bizMsgIdr {
+		errs = append(errs, utils.ValidationError{
+			Path:  path + "/BizMsgIdr",
+			Type:  headerType,
+			Rule:  fmt.Sprintf("consistency (must match the message identification %s)", msgId.String()),
+			Value: bizMsgIdr,
+		})
+	}
+
+	creDt, ok := timeValue(header.FieldByName("CreDt"))
+	docCreDtTm, docOk := timeValue(grpHdr.FieldByName("CreDtTm"))
+	if ok && docOk && creDt.Before(docCreDtTm) {
+		errs = append(errs, utils.ValidationError{
+			Path:  path + "/CreDt",
+			Type:  headerType,
+			Rule:  fmt.Sprintf("consistency (must not precede the document creation date time %s)", docCreDtTm.Format(time.RFC3339)),
+			Value: creDt.Format(time.RFC3339),
+		})
+	}
+
+	return errs
+}
+
+// groupHeader returns the header block of a message holding its MsgId and CreDtTm
+func groupHeader(message Iso20022Message) reflect.Value {
+	value := reflect.Indirect(reflect.ValueOf(message))
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	for _, name := range []string{"GrpHdr", "Assgnmt", "MsgHdr", "Hdr"} {
+		field := reflect.Indirect(value.FieldByName(name))
+		if field.IsValid() && field.Kind() == reflect.Struct && field.FieldByName("MsgId").IsValid() {
+			return field
+		}
+	}
+	return reflect.Value{}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func timeValue(value reflect.Value) (time.Time, bool) {
+	value = reflect.Indirect(value)
+	if !value.IsValid() || !value.Type().ConvertibleTo(timeType) {
+		return time.Time{}, false
+	}
+	t := value.Convert(timeType).Interface().(time.Time)
+	return t, !t.IsZero()
+}
+
+// Canonicalize writes the envelope, the header and the document without namespace prefixes,
+// keeping the default namespace declarations only
+func (msg *BusinessMessage) Canonicalize() {
+	msg.Attrs = canonicalAttrs(resolveNameSpace(msg.XMLName, msg.Attrs))
+	if msg.AppHdr != nil {
+		msg.AppHdrAttrs = canonicalAttrs(headerNameSpace(msg.AppHdr))
+	}
+	if msg.Document != nil {
+		msg.Document.Canonicalize()
+	}
+}
+
+func (msg BusinessMessage) NameSpace() string {
+	if msg.Document == nil {
+		return ""
+	}
+	return msg.Document.NameSpace()
+}
+
+func (msg *BusinessMessage) GetXmlName() *xml.Name {
+	return &msg.XMLName
+}
+
+func (msg *BusinessMessage) GetAttrs() []xml.Attr {
+	return msg.Attrs
+}
+
+func (msg *BusinessMessage) InspectMessage() Iso20022Message {
+	if msg.Document == nil {
+		return nil
+	}
+	return msg.Document.Message
+}
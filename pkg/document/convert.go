@@ -0,0 +1,463 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// LossKind tells what happened to an element which couldn't be converted as it was
+type LossKind string
+
+const (
+	// LossDropped is an element the target version hasn't, or whose value the target version doesn't allow
+	LossDropped LossKind = "dropped"
+	// LossTruncated is a text longer than the target version allows, it's cut to the maximum length
+	LossTruncated LossKind = "truncated"
+	// LossDefaulted is an element required by the target version which the source version hasn't,
+	// it's left empty and has to be completed before the message is valid
+	LossDefaulted LossKind = "defaulted"
+	// LossTransliterated is a text with characters the target character set lacks, they are replaced
+	// by similar characters (used by the translation of SWIFT MT messages)
+	LossTransliterated LossKind = "transliterated"
+)
+
+// Loss is an element of the source message which isn't carried unchanged into the target message
+type Loss struct {
+	// Path is the location of the element in the source message, e.g. /Document/FIToFIPmtStsRpt/GrpHdr/MsgId
+	Path string   `json:"path"`
+	Kind LossKind `json:"kind"`
+	// Value is the original value of a simple element, complex elements have no value
+	Value string `json:"value,omitempty"`
+}
+
+func (loss Loss) String() string {
+	if loss.Value == "" {
+		return fmt.Sprintf("%s %s", loss.Path, loss.Kind)
+	}
+	return fmt.Sprintf("%s %s (value: %q)", loss.Path, loss.Kind, loss.Value)
+}
+
+// ConversionReport lists the losses of a conversion from a message version into another
+type ConversionReport struct {
+	// From and To are the message identifiers, e.g. pacs.002.001.07 and pacs.002.001.11
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Losses []Loss `json:"losses,omitempty"`
+}
+
+// Lossless is true when every element of the source message is carried unchanged into the target message
+func (report ConversionReport) Lossless() bool {
+	return len(report.Losses) == 0
+}
+
+// ConvertVersion maps the message of doc into another registered version of the same message,
+// e.g. pacs.002.001.07 into pacs.002.001.11. The elements are matched by their XML names; the report
+// lists every element dropped, truncated or defaulted because the target version lacks it.
+// A business message keeps its header, MsgDefIdr is changed to the target message.
+// doc isn't changed, the converted document isn't validated.
+func ConvertVersion(doc Iso20022Document, namespace string) (Iso20022Document, ConversionReport, error) {
+	switch source := doc.(type) {
+	case *Iso20022DocumentObject:
+		return convertDocument(source, namespace, "")
+	case *BusinessMessage:
+		return convertBusinessMessage(source, namespace)
+	}
+	return nil, ConversionReport{}, utils.NewErrInvalidFileType()
+}
+
+// CopyElements copies the elements of src into the elements of dst with the same XML names like ConvertVersion,
+// e.g. a pacs.008 transaction into the original transaction reference of a pacs.004. dst is a pointer to a
+// component. The losses are the elements of src which dst hasn't, with paths relative to src.
+func CopyElements(dst, src interface{}) []Loss {
+	target := reflect.ValueOf(dst)
+	if target.Kind() != reflect.Ptr || target.IsNil() || src == nil {
+		return nil
+	}
+	c := converter{}
+	c.assign(target.Elem(), reflect.ValueOf(src), "")
+	return c.losses
+}
+
+func convertDocument(doc *Iso20022DocumentObject, namespace, path string) (*Iso20022DocumentObject, ConversionReport, error) {
+	from, found := LookupMessage(doc.NameSpace())
+	if !found {
+		return nil, ConversionReport{}, utils.NewErrUnsupportedNameSpace()
+	}
+	to, found := LookupMessage(namespace)
+	if !found {
+		return nil, ConversionReport{}, utils.NewErrUnsupportedNameSpace()
+	}
+	report := ConversionReport{From: messageName(from), To: messageName(to)}
+	if from.BusinessArea == "" || from.BusinessArea != to.BusinessArea || from.MessageId != to.MessageId {
+		return nil, report, utils.NewErrIncompatibleMessages(report.From, report.To)
+	}
+
+	root := doc.XMLName.Local
+	if root == "" {
+		root = documentElement
+	}
+	target := &Iso20022DocumentObject{
+		XMLName: doc.XMLName,
+		Attrs:   renameNameSpace(doc.Attrs, from, to),
+		Message: to.New(),
+	}
+	if target.XMLName.Space != "" {
+		target.XMLName.Space = to.NameSpace
+	}
+
+	c := converter{}
+	if doc.Message != nil {
+		source := reflect.Indirect(reflect.ValueOf(doc.Message))
+		c.convertStruct(reflect.ValueOf(target.Message).Elem(), source, path+"/"+root+"/"+messageElement(doc.Message))
+	}
+	report.Losses = c.losses
+	return target, report, nil
+}
+
+func convertBusinessMessage(msg *BusinessMessage, namespace string) (*BusinessMessage, ConversionReport, error) {
+	if msg.Document == nil {
+		return nil, ConversionReport{}, utils.NewErrOmittedNameSpace()
+	}
+	root := msg.XMLName.Local
+	if root == "" {
+		root = DefaultBusinessMessageElement
+	}
+	doc, report, err := convertDocument(msg.Document, namespace, "/"+root)
+	if err != nil {
+		return nil, report, err
+	}
+
+	target := *msg
+	target.Document = doc
+	if header := reflect.ValueOf(msg.AppHdr); header.Kind() == reflect.Ptr && !header.IsNil() {
+		copied := reflect.New(header.Elem().Type())
+		copied.Elem().Set(header.Elem())
+		if identifier := copied.Elem().FieldByName("MsgDefIdr"); identifier.Kind() == reflect.String {
+			identifier.SetString(strings.TrimPrefix(namespace, nameSpacePrefix))
+		}
+		target.AppHdr = copied.Interface().(Iso20022Message)
+	}
+	return &target, report, nil
+}
+
+// messageName returns the message identifier, or the namespace when it hasn't one
+func messageName(info MessageInfo) string {
+	if identifier := info.Identifier(); identifier != "" {
+		return identifier
+	}
+	return info.NameSpace
+}
+
+// renameNameSpace changes the namespace declarations, and the schema location, to the target message
+func renameNameSpace(attrs []xml.Attr, from, to MessageInfo) []xml.Attr {
+	var renamed []xml.Attr
+	for _, attr := range attrs {
+		if attr.Value == from.NameSpace {
+			attr.Value = to.NameSpace
+		} else {
+			attr.Value = strings.ReplaceAll(attr.Value, from.Identifier(), to.Identifier())
+		}
+		renamed = append(renamed, attr)
+	}
+	return renamed
+}
+
+var maxTextRegexp = regexp.MustCompile(`^Max([0-9]+)Text$`)
+
+type converter struct {
+	losses []Loss
+}
+
+func (c *converter) lose(path string, kind LossKind, value reflect.Value) {
+	c.losses = append(c.losses, Loss{Path: path, Kind: kind, Value: SimpleValue(value)})
+}
+
+// convertStruct copies the fields of src into the fields of dst with the same element name
+func (c *converter) convertStruct(dst, src reflect.Value, path string) {
+	fields := map[string]int{}
+	for i := 0; i < dst.NumField(); i++ {
+		if name, _ := fieldElement(dst.Type().Field(i)); name != "" {
+			fields[name] = i
+		}
+	}
+
+	known := map[string]bool{}
+	for i := 0; i < src.NumField(); i++ {
+		name, _ := fieldElement(src.Type().Field(i))
+		if name == "" {
+			continue
+		}
+		known[name] = true
+		value := src.Field(i)
+		if isEmptyValue(value) {
+			continue
+		}
+		if j, found := fields[name]; found {
+			c.assign(dst.Field(j), value, elementPath(path, name))
+		} else {
+			c.lose(elementPath(path, name), LossDropped, value)
+		}
+	}
+
+	for i := 0; i < dst.NumField(); i++ {
+		name, required := fieldElement(dst.Type().Field(i))
+		if name != "" && required && !known[name] && isEmptyValue(dst.Field(i)) {
+			c.lose(elementPath(path, name), LossDefaulted, reflect.Value{})
+		}
+	}
+}
+
+// assign converts src into dst, src is an element of the source message
+func (c *converter) assign(dst, src reflect.Value, path string) {
+	for src.Kind() == reflect.Ptr || src.Kind() == reflect.Interface {
+		src = src.Elem()
+	}
+	if isEmptyValue(src) {
+		return
+	}
+
+	switch {
+	case dst.Kind() == reflect.Ptr:
+		elem := reflect.New(dst.Type().Elem())
+		c.assign(elem.Elem(), src, path)
+		if !isEmptyValue(elem.Elem()) {
+			dst.Set(elem)
+		}
+		return
+	case isList(dst.Type()):
+		items := []reflect.Value{src}
+		paths := []string{path}
+		if isList(src.Type()) {
+			items, paths = nil, nil
+			for i := 0; i < src.Len(); i++ {
+				items = append(items, src.Index(i))
+				paths = append(paths, fmt.Sprintf("%s[%d]", path, i+1))
+			}
+		}
+		for i, item := range items {
+			elem := reflect.New(dst.Type().Elem()).Elem()
+			c.assign(elem, item, paths[i])
+			if !isEmptyValue(elem) {
+				dst.Set(reflect.Append(dst, elem))
+			}
+		}
+		return
+	case isList(src.Type()):
+		// the target version has a single element, the first one is kept
+		for i := 1; i < src.Len(); i++ {
+			c.lose(fmt.Sprintf("%s[%d]", path, i+1), LossDropped, src.Index(i))
+		}
+		c.assign(dst, src.Index(0), path+"[1]")
+		return
+	}
+
+	switch {
+	case src.Type() == dst.Type():
+		dst.Set(src)
+	case src.Kind() == reflect.Struct && dst.Kind() == reflect.Struct && hasElements(src.Type()) && hasElements(dst.Type()):
+		if sharedElements(src.Type(), dst.Type()) == 0 && c.rewrap(dst, src, path) {
+			return
+		}
+		c.convertStruct(dst, src, path)
+	case src.Kind() == dst.Kind() && src.Type().ConvertibleTo(dst.Type()):
+		c.assignSimple(dst, src, path)
+	default:
+		c.lose(path, LossDropped, src)
+	}
+}
+
+// rewrap handles a component which a version puts into a choice, e.g. the party of a pacs.002 transaction
+// reference which is a PartyIdentification43 in v07 and a Party40Choice with the branches Pty and Agt in v11
+func (c *converter) rewrap(dst, src reflect.Value, path string) bool {
+	// the target wraps the source into the branch with the most elements in common
+	best, shared := -1, 0
+	for j := 0; j < dst.NumField(); j++ {
+		if name, _ := fieldElement(dst.Type().Field(j)); name == "" {
+			continue
+		}
+		if n := sharedElements(src.Type(), elementType(dst.Type().Field(j).Type)); n > shared {
+			best, shared = j, n
+		}
+	}
+	if best >= 0 {
+		c.assign(dst.Field(best), src, path)
+		return true
+	}
+
+	// the source wraps the target, the branches which aren't like the target are dropped
+	unwrapped := false
+	for i := 0; i < src.NumField(); i++ {
+		name, _ := fieldElement(src.Type().Field(i))
+		if name == "" || isEmptyValue(src.Field(i)) {
+			continue
+		}
+		if !unwrapped && sharedElements(elementType(src.Type().Field(i).Type), dst.Type()) > 0 {
+			c.assign(dst, src.Field(i), elementPath(path, name))
+			unwrapped = true
+		} else {
+			c.lose(elementPath(path, name), LossDropped, src.Field(i))
+		}
+	}
+	return unwrapped
+}
+
+// assignSimple converts a simple value, a text is truncated to the maximum length of the target type and
+// a value which isn't allowed by the target type, like a code missing from its enumeration, is dropped
+func (c *converter) assignSimple(dst, src reflect.Value, path string) {
+	value := src.Convert(dst.Type())
+	truncated := false
+	if match := maxTextRegexp.FindStringSubmatch(dst.Type().Name()); match != nil && value.Kind() == reflect.String {
+		max, _ := strconv.Atoi(match[1])
+		if text := value.String(); len(text) > max {
+			text = text[:max]
+			for !utf8.ValidString(text) {
+				text = text[:len(text)-1]
+			}
+			value = reflect.ValueOf(text).Convert(dst.Type())
+			truncated = true
+		}
+	}
+
+	if validateValue(src) == nil && validateValue(value) != nil {
+		c.lose(path, LossDropped, src)
+		return
+	}
+	if truncated {
+		c.lose(path, LossTruncated, src)
+	}
+	dst.Set(value)
+}
+
+func validateValue(value reflect.Value) error {
+	if validator, ok := value.Interface().(interface{ Validate() error }); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// fieldElement returns the element name of a struct field, @name for attributes and #chardata for the
+// value of a simple content, and whether the element is required
+func fieldElement(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" || field.Name == "XMLName" {
+		return "", false
+	}
+	tag := field.Tag.Get("xml")
+	if tag == "-" {
+		return "", false
+	}
+	options := strings.Split(tag, ",")
+	name := options[0]
+	name = name[strings.LastIndex(name, " ")+1:]
+	if name == "" {
+		name = field.Name
+	}
+	required := true
+	for _, option := range options[1:] {
+		switch option {
+		case "chardata":
+			name = "#chardata"
+		case "attr":
+			name = "@" + name
+		case "omitempty":
+			required = false
+		}
+	}
+	return name, required
+}
+
+func elementPath(path, name string) string {
+	if name == "#chardata" {
+		return path
+	}
+	return path + "/" + name
+}
+
+// sharedElements counts the element names of the complex type a which the complex type b has too
+func sharedElements(a, b reflect.Type) int {
+	if a.Kind() != reflect.Struct || b.Kind() != reflect.Struct {
+		return 0
+	}
+	names := map[string]bool{}
+	for i := 0; i < b.NumField(); i++ {
+		if name, _ := fieldElement(b.Field(i)); name != "" {
+			names[name] = true
+		}
+	}
+	count := 0
+	for i := 0; i < a.NumField(); i++ {
+		if name, _ := fieldElement(a.Field(i)); name != "" && names[name] {
+			count++
+		}
+	}
+	return count
+}
+
+// elementType returns the type of the elements of a field, without pointer and list
+func elementType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || isList(t) {
+		t = t.Elem()
+	}
+	return t
+}
+
+func isList(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8) || t.Kind() == reflect.Array
+}
+
+// hasElements is true for complex types, false for simple types based on a struct like ISODateTime
+func hasElements(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if name, _ := fieldElement(t.Field(i)); name != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func isEmptyValue(value reflect.Value) bool {
+	if !value.IsValid() {
+		return true
+	}
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return value.Len() == 0
+	}
+	return value.IsZero()
+}
+
+// SimpleValue returns the text of a simple value, complex values have none
+func SimpleValue(value reflect.Value) string {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return ""
+	}
+	if marshaler, ok := value.Interface().(encoding.TextMarshaler); ok {
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return fmt.Sprint(value.Interface())
+	}
+	return ""
+}
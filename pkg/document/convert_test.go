@@ -0,0 +1,189 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/head_v02"
+	"github.com/moov-io/iso20022/pkg/pacs_v07"
+	"github.com/moov-io/iso20022/pkg/pacs_v09"
+	"github.com/moov-io/iso20022/pkg/pacs_v11"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestConvertVersion(t *testing.T) {
+	text := func(value string) *common.Max35Text {
+		text := common.Max35Text(value)
+		return &text
+	}
+	name := common.Max140Text("Debtor Name")
+	status := pacs_v11.ExternalPaymentTransactionStatus1Code("ACCC")
+	uetr := common.UUIDv4Identifier("eb6305c9-1f7f-49de-aed0-16487c27b42d")
+	message := &pacs_v11.FIToFIPaymentStatusReportV11{
+		GrpHdr: pacs_v11.GroupHeader91{
+			MsgId:   "MsgId",
+			CreDtTm: common.ISODateTime(time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)),
+		},
+		TxInfAndSts: []pacs_v11.PaymentTransaction123{{
+			OrgnlEndToEndId: text("E2E-1"),
+			OrgnlUETR:       &uetr,
+			TxSts:           &status,
+			OrgnlTxRef: &pacs_v11.OriginalTransactionReference31{
+				Dbtr: &pacs_v11.Party40Choice{Pty: &pacs_v11.PartyIdentification135{Nm: &name}},
+			},
+		}},
+	}
+	doc := &Iso20022DocumentObject{
+		XMLName: xml.Name{Local: "Document"},
+		Attrs:   canonicalAttrs(utils.DocumentPacs00200111NameSpace),
+		Message: message,
+	}
+	assert.Nil(t, doc.Validate())
+
+	converted, report, err := ConvertVersion(doc, utils.DocumentPacs00200107NameSpace)
+	assert.Nil(t, err)
+	assert.Equal(t, "pacs.002.001.11", report.From)
+	assert.Equal(t, "pacs.002.001.07", report.To)
+	assert.False(t, report.Lossless())
+	assert.Equal(t, []Loss{
+		{Path: "/Document/FIToFIPmtStsRpt/TxInfAndSts[1]/OrgnlUETR", Kind: LossDropped, Value: string(uetr)},
+		{Path: "/Document/FIToFIPmtStsRpt/TxInfAndSts[1]/TxSts", Kind: LossDropped, Value: "ACCC"},
+	}, report.Losses)
+	assert.Equal(t, "/Document/FIToFIPmtStsRpt/TxInfAndSts[1]/TxSts dropped (value: \"ACCC\")", report.Losses[1].String())
+
+	// the source is unchanged, the target is valid and declares the target namespace
+	assert.Equal(t, utils.DocumentPacs00200111NameSpace, doc.NameSpace())
+	assert.Equal(t, utils.DocumentPacs00200107NameSpace, converted.NameSpace())
+	assert.Nil(t, converted.Validate())
+	v07 := converted.InspectMessage().(*pacs_v07.FIToFIPaymentStatusReportV07)
+	assert.Equal(t, common.Max35Text("MsgId"), v07.GrpHdr.MsgId)
+	assert.Equal(t, message.GrpHdr.CreDtTm, v07.GrpHdr.CreDtTm)
+	assert.Equal(t, common.Max35Text("E2E-1"), *v07.TxInfAndSts[0].OrgnlEndToEndId)
+	assert.Nil(t, v07.TxInfAndSts[0].TxSts)
+	// the party of v11 is a choice, the one of v07 isn't
+	assert.Equal(t, name, *v07.TxInfAndSts[0].OrgnlTxRef.Dbtr.Nm)
+
+	output, err := xml.Marshal(converted)
+	assert.Nil(t, err)
+	assert.Contains(t, string(output), `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.07"><FIToFIPmtStsRpt>`)
+
+	// every element of v07 is carried into v11
+	upgraded, report, err := ConvertVersion(converted, utils.DocumentPacs00200111NameSpace)
+	assert.Nil(t, err)
+	assert.True(t, report.Lossless())
+	assert.Nil(t, upgraded.Validate())
+	v11 := upgraded.InspectMessage().(*pacs_v11.FIToFIPaymentStatusReportV11)
+	assert.Equal(t, name, *v11.TxInfAndSts[0].OrgnlTxRef.Dbtr.Pty.Nm)
+}
+
+func TestConvertVersionOfBusinessMessage(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_bah_pacs_v08.xml"))
+	assert.Nil(t, err)
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+
+	converted, report, err := ConvertVersion(doc, utils.DocumentPacs00800109NameSpace)
+	assert.Nil(t, err)
+	assert.Equal(t, "pacs.008.001.09", report.To)
+	for _, loss := range report.Losses {
+		assert.Regexp(t, `^/BizMsg/Document/FIToFICstmrCdtTrf/`, loss.Path)
+	}
+
+	msg := converted.(*BusinessMessage)
+	assert.Equal(t, utils.DocumentPacs00800109NameSpace, msg.Document.NameSpace())
+	assert.IsType(t, &pacs_v09.FIToFICustomerCreditTransferV09{}, msg.InspectMessage())
+	assert.Equal(t, common.Max35Text("pacs.008.001.09"), msg.AppHdr.(*head_v02.BusinessApplicationHeaderV02).MsgDefIdr)
+	assert.Equal(t, common.Max35Text("pacs.008.001.08"), doc.(*BusinessMessage).AppHdr.(*head_v02.BusinessApplicationHeaderV02).MsgDefIdr)
+}
+
+func TestConvertVersionErrors(t *testing.T) {
+	doc := &Iso20022DocumentObject{
+		XMLName: xml.Name{Local: "Document"},
+		Attrs:   canonicalAttrs(utils.DocumentPacs00200111NameSpace),
+		Message: &pacs_v11.FIToFIPaymentStatusReportV11{},
+	}
+
+	_, _, err := ConvertVersion(doc, utils.DocumentPacs00800109NameSpace)
+	assert.EqualError(t, err, "The message of pacs.002.001.11 can't be converted into pacs.008.001.09")
+
+	_, _, err = ConvertVersion(doc, "urn:iso:std:iso:20022:tech:xsd:pacs.002.001.99")
+	assert.EqualError(t, err, utils.NewErrUnsupportedNameSpace().Error())
+}
+
+func TestConverterLosses(t *testing.T) {
+	type code string
+	type source struct {
+		Nm    common.Max140Text           `xml:"Nm"`
+		Adr   []common.Max70Text          `xml:"Adr,omitempty"`
+		Ccy   string                      `xml:"Ccy,attr"`
+		Value common.Max140Text           `xml:",chardata"`
+		Extra *common.Max35Text           `xml:"Extra,omitempty"`
+		Cd    common.Max4AlphaNumericText `xml:"Cd"`
+	}
+	type target struct {
+		Nm    common.Max35Text                     `xml:"Nm"`
+		Adr   *common.Max70Text                    `xml:"Adr,omitempty"`
+		Ccy   code                                 `xml:"Ccy,attr"`
+		Value common.Max35Text                     `xml:",chardata"`
+		Id    common.Max35Text                     `xml:"Id"`
+		Cd    pacs_v07.TransactionGroupStatus3Code `xml:"Cd"`
+	}
+	extra := common.Max35Text("extra")
+	src := source{
+		Nm:    "A name which is longer than 35 characters",
+		Adr:   []common.Max70Text{"Line 1", "Line 2"},
+		Ccy:   "EUR",
+		Value: common.Max140Text(strings.Repeat("é", 20)),
+		Extra: &extra,
+		Cd:    "ACCC",
+	}
+
+	var dst target
+	c := converter{}
+	c.convertStruct(reflect.ValueOf(&dst).Elem(), reflect.ValueOf(src), "/Src")
+	assert.Equal(t, []Loss{
+		{Path: "/Src/Nm", Kind: LossTruncated, Value: string(src.Nm)},
+		{Path: "/Src/Adr[2]", Kind: LossDropped, Value: "Line 2"},
+		{Path: "/Src", Kind: LossTruncated, Value: string(src.Value)},
+		{Path: "/Src/Extra", Kind: LossDropped, Value: "extra"},
+		{Path: "/Src/Cd", Kind: LossDropped, Value: "ACCC"},
+		{Path: "/Src/Id", Kind: LossDefaulted},
+	}, c.losses)
+	assert.Equal(t, common.Max35Text("A name which is longer than 35 char"), dst.Nm)
+	assert.Equal(t, common.Max70Text("Line 1"), *dst.Adr)
+	assert.Equal(t, code("EUR"), dst.Ccy)
+	// the limit is counted in bytes, the text is cut before the rune which doesn't fit
+	assert.Equal(t, common.Max35Text(strings.Repeat("é", 17)), dst.Value)
+	assert.Nil(t, dst.Value.Validate())
+}
+
+func TestCopyElements(t *testing.T) {
+	type source struct {
+		Nm    common.Max140Text `xml:"Nm"`
+		Extra *common.Max35Text `xml:"Extra,omitempty"`
+	}
+	type target struct {
+		Nm *common.Max35Text `xml:"Nm,omitempty"`
+	}
+	extra := common.Max35Text("extra")
+
+	var dst target
+	losses := CopyElements(&dst, source{Nm: "Name", Extra: &extra})
+	assert.Equal(t, []Loss{{Path: "/Extra", Kind: LossDropped, Value: "extra"}}, losses)
+	assert.Equal(t, common.Max35Text("Name"), *dst.Nm)
+
+	assert.Nil(t, CopyElements(dst, source{Nm: "Name"}))
+	assert.Nil(t, CopyElements(&dst, nil))
+}
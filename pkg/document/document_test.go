@@ -5,15 +5,31 @@
 package document
 
 import (
+	"bytes"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
+	"github.com/moov-io/iso20022/pkg/camt_v02"
+	"github.com/moov-io/iso20022/pkg/camt_v04"
+	"github.com/moov-io/iso20022/pkg/codes"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/head_v02"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/pacs_v11"
+	"github.com/moov-io/iso20022/pkg/pain_v03"
+	"github.com/moov-io/iso20022/pkg/pain_v09"
+	"github.com/moov-io/iso20022/pkg/schema"
 	"github.com/moov-io/iso20022/pkg/utils"
 	"io/ioutil"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
 )
 
 func TestJsonXmlWithDocumentCamt05500109(t *testing.T) {
@@ -164,6 +180,208 @@ func TestJsonXmlWithDocumentPacs00200111(t *testing.T) {
 	assert.Equal(t, expectJson, string(buf))
 }
 
+func TestJsonXmlWithDocumentPain00100109(t *testing.T) {
+	inputXml, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pain_v09.xml"))
+	assert.Equal(t, nil, err)
+
+	inputJson, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pain_v09.json"))
+	assert.Equal(t, nil, err)
+
+	doc, err := NewDocument(utils.DocumentPain00100109NameSpace)
+	assert.Equal(t, nil, err)
+	err = xml.Unmarshal(inputXml, doc)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, doc.Validate())
+
+	expectXml := strings.ReplaceAll(string(inputXml), "\r\n", "\n")
+	expectJson := strings.ReplaceAll(string(inputJson), "\r\n", "\n")
+
+	buf, err := xml.MarshalIndent(doc, "", "\t")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, expectXml, string(buf))
+	buf, err = json.MarshalIndent(doc, "", "\t")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, expectJson, string(buf))
+
+	doc, err = NewDocument(utils.DocumentPain00100109NameSpace)
+	assert.Equal(t, nil, err)
+	err = json.Unmarshal(inputJson, doc)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, doc.Validate())
+
+	buf, err = xml.MarshalIndent(doc, "", "\t")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, expectXml, string(buf))
+	buf, err = json.MarshalIndent(doc, "", "\t")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, expectJson, string(buf))
+}
+
+func TestRoundTripWithDocumentPain00100103(t *testing.T) {
+	files := map[string]int{
+		"musterfile_pain.001_Nov2020.xml":        8,
+		"gistfile1.xml":                          3,
+		"International_payment_RUB_naujas_1.xml": 1,
+		"International_payment_USD_naujas_1.xml": 1,
+		"sepa_payment_naujas_1.xml":              1,
+	}
+
+	for fileName, nbOfTxs := range files {
+		input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", fileName))
+		assert.Nil(t, err)
+
+		doc, err := ParseIso20022Document(input)
+		assert.Nil(t, err, fileName)
+		message, ok := doc.InspectMessage().(*pain_v03.CustomerCreditTransferInitiationV03)
+		assert.True(t, ok, fileName)
+
+		count := 0
+		for _, pmtInf := range message.PmtInf {
+			count += len(pmtInf.CdtTrfTxInf)
+		}
+		assert.Equal(t, nbOfTxs, count, fileName)
+
+		// the sample accounts of the files don't have valid IBAN check digits, the gist
+		// uses the CINV document type as purpose, and the group header of the musterfile
+		// counts 7 of its 8 transactions
+		var verrs utils.ValidationErrors
+		if err = doc.Validate(); err != nil {
+			assert.True(t, errors.As(err, &verrs), fileName)
+		}
+		for _, verr := range verrs {
+			if verr.Path == "/Document/CstmrCdtTrfInitn/GrpHdr/NbOfTxs" && fileName == "musterfile_pain.001_Nov2020.xml" {
+				assert.Equal(t, "7", verr.Value)
+				continue
+			}
+			if strings.HasSuffix(verr.Path, "/Purp/Cd") {
+				assert.Equal(t, "CINV", verr.Value)
+				continue
+			}
+			assert.True(t, strings.HasSuffix(verr.Path, "/IBAN"), verr.Error())
+		}
+
+		buf, err := xml.MarshalIndent(doc, "", "\t")
+		assert.Nil(t, err)
+		reparsed, err := ParseIso20022Document(buf)
+		assert.Nil(t, err, fileName)
+		assert.Equal(t, doc.NameSpace(), reparsed.NameSpace())
+		assert.Equal(t, message, reparsed.InspectMessage(), fileName)
+
+		buf, err = json.MarshalIndent(doc, "", "\t")
+		assert.Nil(t, err)
+		reparsed, err = ParseIso20022Document(buf)
+		assert.Nil(t, err, fileName)
+		assert.Equal(t, message, reparsed.InspectMessage(), fileName)
+	}
+
+	info, found := LookupMessage(utils.DocumentPain00100103CH02NameSpace)
+	assert.True(t, found)
+	assert.Equal(t, "pain.001.001.03", info.Identifier())
+}
+
+func countEntries(message Iso20022Message) int {
+	count := 0
+	switch m := message.(type) {
+	case *camt_v02.BankToCustomerAccountReportV02:
+		for _, rpt := range m.Rpt {
+			count += len(rpt.Ntry)
+		}
+	case *camt_v02.BankToCustomerStatementV02:
+		for _, stmt := range m.Stmt {
+			count += len(stmt.Ntry)
+		}
+	case *camt_v02.BankToCustomerDebitCreditNotificationV02:
+		for _, ntfctn := range m.Ntfctn {
+			count += len(ntfctn.Ntry)
+		}
+	case *camt_v04.BankToCustomerAccountReportV04:
+		for _, rpt := range m.Rpt {
+			count += len(rpt.Ntry)
+		}
+	case *camt_v04.BankToCustomerStatementV04:
+		for _, stmt := range m.Stmt {
+			count += len(stmt.Ntry)
+		}
+	case *camt_v04.BankToCustomerDebitCreditNotificationV04:
+		for _, ntfctn := range m.Ntfctn {
+			count += len(ntfctn.Ntry)
+		}
+	}
+	return count
+}
+
+func TestRoundTripWithLegacyBankToCustomerMessages(t *testing.T) {
+	files := map[string]struct {
+		identifier string
+		entries    int
+	}{
+		"200519_camt.052_P_CH2909000000250094239_1110092686_0_2019042416072347.xml":        {"camt.052.001.04", 2},
+		"200519_camt.054-Credit_P_CH2909000000250094239_1110092691_0_2019042421291293.xml": {"camt.054.001.04", 1},
+		"200519_camt.054-Debit_P_CH2909000000250094239_1110092692_0_2019042401501580.xml":  {"camt.054.001.04", 1},
+		"200519_camt.054_P_CH2909000000250094239_1111091335_0_2020061900081727.xml":        {"camt.054.001.04", 1},
+		"200519_camt054-ESR-ASR_P_CH2909000000250094239_1110092704_0_2019042500372179.xml": {"camt.054.001.04", 2},
+		"200519_camt054-chdd_p_ch2909000000250094239_1110097484_0_20190520700381159.xml":   {"camt.054.001.04", 1},
+		"200519_camt054-epo_p_ch5109000000250092291_1110097605_0_2019052003522556.xml":     {"camt.054.001.04", 1},
+		"200519_camt054-epo_p_ch5109000000250092291_1110097605_0_2019052103322231.xml":     {"camt.054.001.04", 1},
+		"200519_camt054-returns_p_ch2909000000250094239_1109800798_0_2019052023472022.xml": {"camt.054.001.04", 1},
+		"200924_camt.054_P_CH2909000000250094239_1110092703_0_2019042423412214.xml":        {"camt.054.001.04", 1},
+		"200924_camt.054_P_CH2909000000250094239_1111091335_0_2020061900081727.xml":        {"camt.054.001.04", 1},
+		"FI_camt_052_sample.xml.xml":                                                {"camt.052.001.02", 2},
+		"FI_camt_053_sample.xml.xml":                                                {"camt.053.001.02", 10},
+		"FI_camt_054_sample.xml.xml":                                                {"camt.054.001.02", 6},
+		"camt.053_P_CH2909000000250094239_1110092698_0_2020112503071366.xml":        {"camt.053.001.04", 5},
+		"camt054-returns_p_ch5109000000250092291_1110097606_0_2020112500512470.xml": {"camt.054.001.04", 1},
+		"statement_1.xml":                                                           {"camt.053.001.02", 4},
+	}
+
+	for fileName, expected := range files {
+		input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", fileName))
+		assert.Nil(t, err)
+
+		doc, err := ParseIso20022Document(input)
+		assert.Nil(t, err, fileName)
+		info, found := LookupMessage(doc.NameSpace())
+		assert.True(t, found, fileName)
+		assert.Equal(t, expected.identifier, info.Identifier(), fileName)
+		message := doc.InspectMessage()
+		assert.Equal(t, expected.entries, countEntries(message), fileName)
+
+		// the bank samples contain test accounts, the "UK" country code, empty issuers
+		// and the misspelled JPGZN clearing system
+		var verrs utils.ValidationErrors
+		if err = doc.Validate(); err != nil {
+			assert.True(t, errors.As(err, &verrs), fileName)
+		}
+		for _, verr := range verrs {
+			if strings.HasSuffix(verr.Path, "/ClrSysId/Cd") {
+				assert.Equal(t, "JPGZN", verr.Value)
+				continue
+			}
+			assert.Regexp(t, "/(IBAN|Ctry|Issr)$", verr.Path, verr.Error())
+		}
+
+		// date times are written without their zone offset, so the messages are compared after a first round trip
+		buf, err := xml.MarshalIndent(doc, "", "\t")
+		assert.Nil(t, err)
+		reparsed, err := ParseIso20022Document(buf)
+		assert.Nil(t, err, fileName)
+		message = reparsed.InspectMessage()
+		assert.Equal(t, expected.entries, countEntries(message), fileName)
+
+		buf, err = xml.MarshalIndent(reparsed, "", "\t")
+		assert.Nil(t, err)
+		reparsed, err = ParseIso20022Document(buf)
+		assert.Nil(t, err, fileName)
+		assert.Equal(t, message, reparsed.InspectMessage(), fileName)
+
+		buf, err = json.MarshalIndent(reparsed, "", "\t")
+		assert.Nil(t, err)
+		reparsed, err = ParseIso20022Document(buf)
+		assert.Nil(t, err, fileName)
+		assert.Equal(t, message, reparsed.InspectMessage(), fileName)
+	}
+}
+
 func TestJsonXmlWithDocumentPain00200111(t *testing.T) {
 	inputXml, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pain_v11.xml"))
 	assert.Equal(t, nil, err)
@@ -300,7 +518,9 @@ func TestJsonXmlWithFiles(t *testing.T) {
 		"valid_acmt_v03.xml",
 		"valid_auth_v02.xml",
 		"valid_camt_v09.xml",
+		"valid_pacs_v08_prefixed.xml",
 		"valid_pacs_v11.xml",
+		"valid_pain_v09.xml",
 		"valid_pain_v11.xml",
 		"valid_reda_v01.xml",
 		"valid_remt_v04.xml",
@@ -308,6 +528,7 @@ func TestJsonXmlWithFiles(t *testing.T) {
 		"valid_auth_v02.json",
 		"valid_camt_v09.json",
 		"valid_pacs_v11.json",
+		"valid_pain_v09.json",
 		"valid_pain_v11.json",
 		"valid_reda_v01.json",
 		"valid_remt_v04.json",
@@ -324,34 +545,12 @@ func TestJsonXmlWithFiles(t *testing.T) {
 	}
 
 	unsupportedFileList := []string{
-		"FI_camt_052_sample.xml.xml",
-		"FI_camt_053_sample.xml.xml",
-		"FI_camt_054_sample.xml.xml",
-		"200519_camt.054-Debit_P_CH2909000000250094239_1110092692_0_2019042401501580.xml",
-		"200519_camt.054-Credit_P_CH2909000000250094239_1110092691_0_2019042421291293.xml",
-		"200519_camt.052_P_CH2909000000250094239_1110092686_0_2019042416072347.xml",
-		"200519_camt.054_P_CH2909000000250094239_1111091335_0_2020061900081727.xml",
-		"200924_camt.054_P_CH2909000000250094239_1111091335_0_2020061900081727.xml",
-		"200924_camt.054_P_CH2909000000250094239_1110092703_0_2019042423412214.xml",
-		"200519_camt054-ESR-ASR_P_CH2909000000250094239_1110092704_0_2019042500372179.xml",
-		"camt.053_P_CH2909000000250094239_1110092698_0_2020112503071366.xml",
-		"200519_camt054-chdd_p_ch2909000000250094239_1110097484_0_20190520700381159.xml",
 		"pain002-chdd-cor1_p_ch2909000000250094239_1110097483_0_2018031317221082.xml",
 		"pain008-musterfile.xml",
-		"camt054-returns_p_ch5109000000250092291_1110097606_0_2020112500512470.xml",
-		"200519_camt054-returns_p_ch2909000000250094239_1109800798_0_2019052023472022.xml",
-		"200519_camt054-epo_p_ch5109000000250092291_1110097605_0_2019052003522556.xml",
-		"200519_camt054-epo_p_ch5109000000250092291_1110097605_0_2019052103322231.xml",
 		"pain002-epo_p_0_0_0_2018032614401842.xml",
 		"pain002-epo_p_0_0_0_2018031510491259.xml",
 		"pain002-epo_p_ch5109000000250092291_1110097605_0_2018031511252307.xml",
 		"pain002-epo_p_ch2909000000250094239_1109800799_0_2018032612092784.xml",
-		"musterfile_pain.001_Nov2020.xml",
-		"gistfile1.xml",
-		"statement_1.xml",
-		"International_payment_RUB_naujas_1.xml",
-		"International_payment_USD_naujas_1.xml",
-		"sepa_payment_naujas_1.xml",
 	}
 
 	for _, fileName := range unsupportedFileList {
@@ -391,3 +590,285 @@ func TestJsonXmlWithFiles(t *testing.T) {
 		assert.Equal(t, "The type of file is invalid", err.Error())
 	}
 }
+
+func TestValidateCollectsAllErrors(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "invalid_pacs_v08.xml"))
+	assert.Nil(t, err)
+
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+
+	err = doc.Validate()
+	assert.NotNil(t, err)
+
+	var verrs utils.ValidationErrors
+	assert.True(t, errors.As(err, &verrs))
+
+	paths := make(map[string]utils.ValidationError)
+	for _, verr := range verrs {
+		paths[verr.Path] = verr
+	}
+	assert.Len(t, verrs, 3)
+	assert.Contains(t, paths, "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[2]/IntrBkSttlmAmt")
+	assert.Contains(t, paths, "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[2]/ChrgBr")
+	assert.Contains(t, paths, "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[2]/Dbtr/Nm")
+
+	nm := paths["/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[2]/Dbtr/Nm"]
+	assert.Equal(t, "Max140Text", nm.Type)
+	assert.Equal(t, "length (minLength:1, maxLength:140)", nm.Rule)
+	assert.Equal(t, "", nm.Value)
+
+	chrgBr := paths["/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[2]/ChrgBr"]
+	assert.Equal(t, "ChargeBearerType1Code", chrgBr.Type)
+	assert.Equal(t, "value", chrgBr.Rule)
+	assert.Equal(t, "XXXX", chrgBr.Value)
+
+	assert.Equal(t, "10.123456", paths["/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[2]/IntrBkSttlmAmt"].Value)
+}
+
+func TestValidateExternalCodes(t *testing.T) {
+	defer codes.Reset()
+
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08_prefixed.xml"))
+	assert.Nil(t, err)
+
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+
+	purpose := pacs_v08.ExternalCategoryPurpose1Code("XYZ1")
+	message := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	message.CdtTrfTxInf[0].PmtTpInf = &pacs_v08.PaymentTypeInformation28{
+		CtgyPurp: &pacs_v08.CategoryPurpose1Choice{Cd: &purpose},
+	}
+
+	var verrs utils.ValidationErrors
+	assert.True(t, errors.As(doc.Validate(), &verrs))
+	assert.Len(t, verrs, 1)
+	assert.Equal(t, "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/PmtTpInf/CtgyPurp/Cd", verrs[0].Path)
+	assert.Equal(t, "ExternalCategoryPurpose1Code", verrs[0].Type)
+	assert.Equal(t, "external code", verrs[0].Rule)
+	assert.Equal(t, "XYZ1", verrs[0].Value)
+
+	// the code is accepted once a code set file listing it is loaded
+	err = codes.Load(strings.NewReader(`{"codeSets": [{"name": "ExternalCategoryPurpose1Code", "codes": [{"code": "XYZ1"}]}]}`))
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+}
+
+func TestParseWithSchemaValidation(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v11.xml"))
+	assert.Nil(t, err)
+
+	doc, err := ParseIso20022Document(input, WithSchemaValidation(nil))
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPacs00200111NameSpace, doc.NameSpace())
+
+	// struct unmarshalling silently ignores unknown elements and element order
+	invalid := bytes.Replace(input, []byte("<MsgId>MsgId</MsgId>"), []byte("<Unknown/><MsgId>MsgId</MsgId>"), 1)
+	invalid = bytes.Replace(invalid, []byte("<MsgId>MsgId</MsgId>\n\t\t\t<CreDtTm>2014-11-12T11:45:26.371</CreDtTm>"), []byte("<CreDtTm>2014-11-12T11:45:26.371</CreDtTm><MsgId>MsgId</MsgId>"), 1)
+	_, err = ParseIso20022Document(invalid)
+	assert.Nil(t, err)
+
+	_, err = ParseIso20022Document(invalid, WithSchemaValidation(nil))
+	var verrs utils.ValidationErrors
+	assert.True(t, errors.As(err, &verrs))
+	assert.Len(t, verrs, 2)
+	assert.Equal(t, "/Document/FIToFIPmtStsRpt/GrpHdr/Unknown", verrs[0].Path)
+	assert.Equal(t, "content (unexpected element)", verrs[0].Rule)
+	assert.Equal(t, "/Document/FIToFIPmtStsRpt/GrpHdr/MsgId", verrs[1].Path)
+	assert.Equal(t, "element order (MsgId must precede CreDtTm)", verrs[1].Rule)
+	assert.Equal(t, 4, verrs[0].Line)
+
+	// json input has no element order
+	input, err = ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v11.json"))
+	assert.Nil(t, err)
+	_, err = ParseIso20022Document(input, WithSchemaValidation(schema.NewSet()))
+	assert.Nil(t, err)
+
+	// the schema of pacs.008.001.08 isn't shipped
+	input, err = ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "invalid_pacs_v08.xml"))
+	assert.Nil(t, err)
+	_, err = ParseIso20022Document(input, WithSchemaValidation(nil))
+	assert.NotNil(t, err)
+}
+
+func TestBusinessMessage(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_bah_pacs_v08.xml"))
+	assert.Nil(t, err)
+
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+	assert.Equal(t, utils.DocumentPacs00800108NameSpace, doc.NameSpace())
+	assert.Equal(t, "BizMsg", doc.GetXmlName().Local)
+	assert.IsType(t, &pacs_v08.FIToFICustomerCreditTransferV08{}, doc.InspectMessage())
+
+	msg := doc.(*BusinessMessage)
+	header := msg.AppHdr.(*head_v02.BusinessApplicationHeaderV02)
+	assert.Equal(t, "MSG-1", string(header.BizMsgIdr))
+
+	// xml and json round trips
+	buf, err := xml.MarshalIndent(msg, "", "\t")
+	assert.Nil(t, err)
+	reparsed, err := ParseIso20022Document(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, msg.AppHdr, reparsed.(*BusinessMessage).AppHdr)
+	assert.Equal(t, msg.Document.Message, reparsed.(*BusinessMessage).Document.Message)
+
+	buf, err = json.Marshal(msg)
+	assert.Nil(t, err)
+	reparsed, err = ParseIso20022Document(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, msg.AppHdr, reparsed.(*BusinessMessage).AppHdr)
+	assert.Nil(t, reparsed.Validate())
+
+	// cross checks between the header and the document
+	header.MsgDefIdr = "pacs.008.001.09"
+	header.BizMsgIdr = "OTHER"
+	header.CreDt = common.ISODateTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	err = msg.Validate()
+	var verrs utils.ValidationErrors
+	assert.True(t, errors.As(err, &verrs))
+	assert.Len(t, verrs, 3)
+	assert.Equal(t, "/BizMsg/AppHdr/MsgDefIdr", verrs[0].Path)
+	assert.Equal(t, "/BizMsg/AppHdr/BizMsgIdr", verrs[1].Path)
+	assert.Equal(t, "/BizMsg/AppHdr/CreDt", verrs[2].Path)
+
+	// errors of the document are reported below the envelope
+	msg.Document.Message.(*pacs_v08.FIToFICustomerCreditTransferV08).CdtTrfTxInf[0].ChrgBr = "XXXX"
+	err = msg.Validate()
+	assert.True(t, errors.As(err, &verrs))
+	assert.Equal(t, "/BizMsg/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/ChrgBr", verrs[0].Path)
+
+	created, err := NewBusinessMessage(header, msg.Document)
+	assert.Nil(t, err)
+	buf, err = xml.Marshal(created)
+	assert.Nil(t, err)
+	assert.True(t, bytes.HasPrefix(buf, []byte(`<BizMsg><AppHdr xmlns="urn:iso:std:iso:20022:tech:xsd:head.001.001.02">`)))
+	_, err = NewBusinessMessage(&pacs_v08.FIToFICustomerCreditTransferV08{}, msg.Document)
+	assert.NotNil(t, err)
+
+	// the envelope needs both parts
+	_, err = ParseIso20022Document([]byte(`<BizMsg><AppHdr xmlns="urn:iso:std:iso:20022:tech:xsd:head.001.001.02"></AppHdr></BizMsg>`))
+	assert.NotNil(t, err)
+	_, err = ParseIso20022Document([]byte(`<BizMsg><AppHdr xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"></AppHdr></BizMsg>`))
+	assert.NotNil(t, err)
+
+	// the document of the envelope is checked against its schema
+	_, err = ParseIso20022Document(input, WithSchemaValidation(nil))
+	assert.NotNil(t, err)
+}
+
+func TestParseWithCharsets(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pain_v09.xml"))
+	assert.Nil(t, err)
+	debtor := func(doc Iso20022Document) string {
+		return string(*doc.InspectMessage().(*pain_v09.CustomerCreditTransferInitiationV09).GrpHdr.InitgPty.Nm)
+	}
+	withName := func(name, declaration string) string {
+		return declaration + strings.Replace(string(input), "<Nm>ABC Corporation</Nm>", "<Nm>"+name+"</Nm>", 1)
+	}
+	encode := func(enc encoding.Encoding, text string) []byte {
+		buf, err := enc.NewEncoder().Bytes([]byte(text))
+		assert.Nil(t, err)
+		return buf
+	}
+	utf16LE := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM)
+	utf16BE := unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+
+	inputs := map[string]struct {
+		buf  []byte
+		name string
+	}{
+		"latin-1":           {encode(charmap.ISO8859_1, withName("Müller Straße", `<?xml version="1.0" encoding="ISO-8859-1"?>`+"\n")), "Müller Straße"},
+		"windows-1252":      {encode(charmap.Windows1252, withName("Šilas € Ltd", `<?xml version='1.0' encoding='windows-1252' standalone='yes'?>`)), "Šilas € Ltd"},
+		"baltic":            {encode(charmap.Windows1257, withName("Žalgiris ąčęėįšųūž", `<?xml version="1.0" encoding="windows-1257"?>`)), "Žalgiris ąčęėįšųūž"},
+		"utf-8 bom":         {append([]byte{0xEF, 0xBB, 0xBF}, withName("Grüße", `<?xml version="1.0" encoding="UTF-8"?>`)...), "Grüße"},
+		"utf-8 bom wins":    {append([]byte{0xEF, 0xBB, 0xBF}, withName("Grüße", `<?xml version="1.0" encoding="ISO-8859-1"?>`)...), "Grüße"},
+		"utf-16 bom":        {encode(utf16LE, withName("Łódź", `<?xml version="1.0" encoding="UTF-16"?>`)), "Łódź"},
+		"utf-16 no bom":     {encode(utf16BE, withName("Łódź", "")), "Łódź"},
+		"utf-16 bom wins":   {encode(utf16LE, withName("Łódź", `<?xml version="1.0" encoding="ISO-8859-1"?>`)), "Łódź"},
+		"ascii declaration": {[]byte(withName("ABC", `<?xml version="1.0" encoding="US-ASCII"?>`)), "ABC"},
+	}
+	for name, input := range inputs {
+		doc, err := ParseIso20022Document(input.buf)
+		assert.Nil(t, err, name)
+		assert.Equal(t, input.name, debtor(doc), name)
+		assert.Nil(t, doc.Validate(), name)
+	}
+
+	_, err = ParseIso20022Document([]byte(withName("ABC", `<?xml version="1.0" encoding="X-UNKNOWN"?>`)))
+	assert.Equal(t, "The charset X-UNKNOWN is unsupported", err.Error())
+
+	// json in UTF-16 with and without a byte order mark
+	doc, err := ParseIso20022Document([]byte(withName("Müller Straße", "")))
+	assert.Nil(t, err)
+	buf, err := json.Marshal(doc)
+	assert.Nil(t, err)
+	for _, enc := range []encoding.Encoding{utf16LE, utf16BE} {
+		fromJson, err := ParseIso20022Document(encode(enc, string(buf)))
+		assert.Nil(t, err)
+		assert.Equal(t, "Müller Straße", debtor(fromJson))
+	}
+
+	// the schema is checked after the conversion
+	input, err = ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v11.xml"))
+	assert.Nil(t, err)
+	latin1 := encode(charmap.ISO8859_1, `<?xml version="1.0" encoding="ISO-8859-1"?>`+strings.Replace(string(input), "<MsgId>MsgId</MsgId>", "<MsgId>Überweisung</MsgId>", 1))
+	doc, err = ParseIso20022Document(latin1, WithSchemaValidation(nil))
+	assert.Nil(t, err)
+	assert.Equal(t, "Überweisung", string(doc.InspectMessage().(*pacs_v11.FIToFIPaymentStatusReportV11).GrpHdr.MsgId))
+}
+
+func TestWriteWithCharsets(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pain_v09.xml"))
+	assert.Nil(t, err)
+	doc, err := ParseIso20022Document([]byte(strings.Replace(string(input), "<Nm>ABC Corporation</Nm>", "<Nm>Müller € Łódź</Nm>", 1)))
+	assert.Nil(t, err)
+
+	buf, err := xml.MarshalIndent(doc, "", "\t")
+	assert.Nil(t, err)
+
+	// characters the charset lacks are written as character references
+	latin1, err := utils.EncodeFromUTF8(buf, "ISO-8859-1")
+	assert.Nil(t, err)
+	assert.True(t, bytes.HasPrefix(latin1, []byte(`<?xml version="1.0" encoding="ISO-8859-1"?>`+"\n<Document")))
+	assert.Contains(t, string(latin1), "<Nm>M\xfcller &#8364; &#321;\xf3d&#378;</Nm>")
+	reparsed, err := ParseIso20022Document(latin1)
+	assert.Nil(t, err)
+	assert.Equal(t, doc.InspectMessage(), reparsed.InspectMessage())
+
+	// an existing declaration is updated
+	declared, err := utils.EncodeFromUTF8(append([]byte(xml.Header), buf...), "windows-1252")
+	assert.Nil(t, err)
+	assert.True(t, bytes.HasPrefix(declared, []byte(`<?xml version="1.0" encoding="windows-1252"?>`+"\n<Document")))
+	assert.Contains(t, string(declared), "<Nm>M\xfcller \x80 &#321;\xf3d&#378;</Nm>")
+	reparsed, err = ParseIso20022Document(declared)
+	assert.Nil(t, err)
+	assert.Equal(t, doc.InspectMessage(), reparsed.InspectMessage())
+
+	utf16, err := utils.EncodeFromUTF8(buf, "UTF-16")
+	assert.Nil(t, err)
+	assert.True(t, bytes.HasPrefix(utf16, []byte{0xFE, 0xFF, 0, '<', 0, '?'}))
+	reparsed, err = ParseIso20022Document(utf16)
+	assert.Nil(t, err)
+	assert.Equal(t, doc.InspectMessage(), reparsed.InspectMessage())
+
+	// json is written in unicode only
+	buf, err = json.Marshal(doc)
+	assert.Nil(t, err)
+	_, err = utils.EncodeFromUTF8(buf, "ISO-8859-1")
+	assert.NotNil(t, err)
+	utf16, err = utils.EncodeFromUTF8(buf, "UTF-16LE")
+	assert.Nil(t, err)
+	reparsed, err = ParseIso20022Document(utf16)
+	assert.Nil(t, err)
+	assert.Equal(t, doc.InspectMessage(), reparsed.InspectMessage())
+
+	output, err := utils.EncodeFromUTF8(buf, "utf-8")
+	assert.Nil(t, err)
+	assert.Equal(t, buf, output)
+	_, err = utils.EncodeFromUTF8(buf, "X-UNKNOWN")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,192 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	xsiNameSpace = "http://www.w3.org/2001/XMLSchema-instance"
+	xsiPrefix    = "xsi"
+)
+
+// declaredNameSpaces returns the namespaces declared by the attributes keyed by their prefix,
+// the default namespace (xmlns="...") is keyed by the empty prefix
+func declaredNameSpaces(attrs []xml.Attr) map[string]string {
+	declared := make(map[string]string)
+	for _, attr := range attrs {
+		switch {
+		case attr.Name.Space == "" && attr.Name.Local == utils.XmlDefaultNamespace:
+			declared[""] = attr.Value
+		case attr.Name.Space == utils.XmlDefaultNamespace:
+			declared[attr.Name.Local] = attr.Value
+		}
+	}
+	return declared
+}
+
+// resolveNameSpace returns the namespace of an element.
+// The decoder resolves prefixes and inherited default namespaces into the element name, so the name wins.
+// Names without a namespace (JSON input, documents built in code) fall back to the default namespace
+// declaration and then to the only prefixed declaration other than xsi.
+func resolveNameSpace(name xml.Name, attrs []xml.Attr) string {
+	if name.Space != "" {
+		return name.Space
+	}
+
+	declared := declaredNameSpaces(attrs)
+	if namespace, found := declared[""]; found {
+		return namespace
+	}
+
+	var candidates []string
+	for _, namespace := range declared {
+		if namespace != xsiNameSpace {
+			candidates = append(candidates, namespace)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	return ""
+}
+
+// elementPrefix returns the prefix the element was written with, the empty prefix is preferred.
+// Later declarations override earlier ones like the declarations of an element override those of its ancestors.
+func elementPrefix(namespace string, attrs []xml.Attr) string {
+	declared := declaredNameSpaces(attrs)
+	if declared[""] == namespace {
+		return ""
+	}
+	for p, value := range declared {
+		if p != "" && value == namespace {
+			return p
+		}
+	}
+	return ""
+}
+
+func qualifiedName(prefix, local string) string {
+	if prefix == "" {
+		return local
+	}
+	return prefix + ":" + local
+}
+
+// qualifiedStartElement builds the start element as the sender wrote it, scope are the declarations of its ancestors.
+// encoding/xml invents prefixes such as _xmlns for attributes in a namespace, so namespace declarations
+// and attributes like xsi:schemaLocation are written with their literal prefixed names instead.
+func qualifiedStartElement(name xml.Name, attrs []xml.Attr, scope []xml.Attr) (xml.StartElement, string) {
+	namespace := resolveNameSpace(name, attrs)
+	visible := append(append([]xml.Attr{}, scope...), attrs...)
+	declared := declaredNameSpaces(visible)
+	prefix := elementPrefix(namespace, visible)
+
+	prefixes := make(map[string]string)
+	for p, ns := range declared {
+		if p != "" {
+			prefixes[ns] = p
+		}
+	}
+
+	var start xml.StartElement
+	start.Name.Local = qualifiedName(prefix, name.Local)
+	if namespace != "" && declared[prefix] != namespace {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: utils.XmlDefaultNamespace}, Value: namespace})
+	}
+
+	for _, attr := range attrs {
+		switch attr.Name.Space {
+		case "":
+			start.Attr = append(start.Attr, attr)
+		case utils.XmlDefaultNamespace:
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: qualifiedName(utils.XmlDefaultNamespace, attr.Name.Local)}, Value: attr.Value})
+		default:
+			p, found := prefixes[attr.Name.Space]
+			if !found && attr.Name.Space == xsiNameSpace {
+				p = xsiPrefix
+				prefixes[xsiNameSpace] = p
+				start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: qualifiedName(utils.XmlDefaultNamespace, p)}, Value: xsiNameSpace})
+			}
+			if p == "" {
+				start.Attr = append(start.Attr, attr)
+				continue
+			}
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: qualifiedName(p, attr.Name.Local)}, Value: attr.Value})
+		}
+	}
+
+	return start, prefix
+}
+
+// encodeQualified writes value inside start like xml.Encoder.EncodeElement. When the element is written with
+// a namespace prefix, every element of value is written with the same prefix like JAXB and other senders do.
+func encodeQualified(e *xml.Encoder, start xml.StartElement, prefix, namespace string, value interface{}) error {
+	if prefix == "" {
+		return e.EncodeElement(value, start)
+	}
+
+	var buf bytes.Buffer
+	if err := xml.NewEncoder(&buf).EncodeElement(value, xml.StartElement{Name: xml.Name{Local: start.Name.Local}}); err != nil {
+		return err
+	}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	decoder := xml.NewDecoder(&buf)
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 1 {
+				continue
+			}
+			element := xml.StartElement{Name: xml.Name{Local: qualifiedName(prefix, t.Name.Local)}}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "" && attr.Name.Local == utils.XmlDefaultNamespace && attr.Value == namespace {
+					continue
+				}
+				element.Attr = append(element.Attr, attr)
+			}
+			err = e.EncodeToken(element)
+		case xml.EndElement:
+			depth--
+			if depth == 0 {
+				continue
+			}
+			err = e.EncodeToken(xml.EndElement{Name: xml.Name{Local: qualifiedName(prefix, t.Name.Local)}})
+		case xml.CharData:
+			if depth > 1 {
+				err = e.EncodeToken(t)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// canonicalAttrs declares namespace as the default namespace and nothing else
+func canonicalAttrs(namespace string) []xml.Attr {
+	if namespace == "" {
+		return nil
+	}
+	return []xml.Attr{{Name: xml.Name{Local: utils.XmlDefaultNamespace}, Value: namespace}}
+}
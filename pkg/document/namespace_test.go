@@ -0,0 +1,184 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/head_v02"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/pacs_v11"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestPrefixedDocument(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08_prefixed.xml"))
+	assert.Nil(t, err)
+
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+	assert.Equal(t, utils.DocumentPacs00800108NameSpace, doc.NameSpace())
+	message := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	assert.Equal(t, "MSG-1", string(message.GrpHdr.MsgId))
+	assert.Equal(t, "USD", string(message.CdtTrfTxInf[0].IntrBkSttlmAmt.Ccy))
+
+	// the prefix, the declarations and the schema location of the sender are kept
+	buf, err := xml.MarshalIndent(doc, "", "\t")
+	assert.Nil(t, err)
+	output := string(buf)
+	assert.True(t, strings.HasPrefix(output, `<ns2:Document xmlns:ns2="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:schemaLocation="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08 pacs.008.001.08.xsd">`))
+	assert.Contains(t, output, "\n\t\t\t<ns2:MsgId>MSG-1</ns2:MsgId>\n")
+	assert.Contains(t, output, `<ns2:IntrBkSttlmAmt Ccy="USD">1500.25</ns2:IntrBkSttlmAmt>`)
+	assert.False(t, regexp.MustCompile(`<[A-Za-z]+[ >]`).MatchString(output))
+	assert.NotContains(t, output, "_xmlns")
+
+	reparsed, err := ParseIso20022Document(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, doc.InspectMessage(), reparsed.InspectMessage())
+	again, err := xml.MarshalIndent(reparsed, "", "\t")
+	assert.Nil(t, err)
+	assert.Equal(t, output, string(again))
+
+	// json keeps the declarations as well
+	buf, err = json.Marshal(doc)
+	assert.Nil(t, err)
+	fromJson, err := ParseIso20022Document(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentPacs00800108NameSpace, fromJson.NameSpace())
+	again, err = xml.MarshalIndent(fromJson, "", "\t")
+	assert.Nil(t, err)
+	assert.Equal(t, output, string(again))
+
+	// implementations without a Canonicalize method are left as they are
+	attrs := doc.GetAttrs()
+	Canonicalize(struct{ Iso20022Document }{doc})
+	assert.Equal(t, attrs, doc.GetAttrs())
+
+	// the canonical form has no prefix and declares the default namespace only
+	Canonicalize(doc)
+	buf, err = xml.MarshalIndent(doc, "", "\t")
+	assert.Nil(t, err)
+	output = string(buf)
+	assert.True(t, strings.HasPrefix(output, `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08">`+"\n\t<FIToFICstmrCdtTrf>"))
+	assert.NotContains(t, output, "ns2:")
+	assert.NotContains(t, output, "schemaLocation")
+	assert.Nil(t, doc.Validate())
+	reparsed, err = ParseIso20022Document(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, doc.InspectMessage(), reparsed.InspectMessage())
+}
+
+func TestDocumentNameSpaceResolution(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v11.xml"))
+	assert.Nil(t, err)
+	body := string(input)
+	body = body[strings.Index(body, "<FIToFIPmtStsRpt>"):strings.Index(body, "</Document>")]
+	prefixed := regexp.MustCompile(`<(/?)(\w+)`).ReplaceAllString(body, "<${1}p:${2}")
+
+	inputs := map[string]string{
+		"schema location": `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.11" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:schemaLocation="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.11 pacs.002.001.11.xsd">` + body + `</Document>`,
+		"prefix":          `<p:Document xmlns:p="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.11">` + prefixed + `</p:Document>`,
+		"several namespaces": `<p:Document xmlns="urn:example:other" xmlns:p="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.11" xmlns:ds="http://www.w3.org/2000/09/xmldsig#">` +
+			prefixed + `</p:Document>`,
+	}
+	for name, input := range inputs {
+		doc, err := ParseIso20022Document([]byte(input), WithSchemaValidation(nil))
+		assert.Nil(t, err, name)
+		assert.Equal(t, utils.DocumentPacs00200111NameSpace, doc.NameSpace(), name)
+		assert.Nil(t, doc.Validate(), name)
+		assert.Equal(t, "MsgId", string(doc.InspectMessage().(*pacs_v11.FIToFIPaymentStatusReportV11).GrpHdr.MsgId), name)
+
+		buf, err := xml.Marshal(doc)
+		assert.Nil(t, err, name)
+		reparsed, err := ParseIso20022Document(buf, WithSchemaValidation(nil))
+		assert.Nil(t, err, name)
+		assert.Equal(t, doc.InspectMessage(), reparsed.InspectMessage(), name)
+	}
+
+	// a prefix is preferred over the default namespace only when it's bound to the namespace of the document
+	doc, err := ParseIso20022Document([]byte(inputs["several namespaces"]))
+	assert.Nil(t, err)
+	buf, err := xml.Marshal(doc)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(buf), `<p:Document xmlns="urn:example:other" xmlns:p="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.11" xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><p:FIToFIPmtStsRpt>`))
+
+	// the namespace declared by the document must be its own
+	obj := doc.(*Iso20022DocumentObject)
+	obj.XMLName.Space = utils.DocumentPacs00800108NameSpace
+	assert.NotNil(t, obj.Validate())
+
+	// a prefixed document without a declaration has no namespace
+	_, err = ParseIso20022Document([]byte(`<Document>` + prefixed + `</Document>`))
+	assert.NotNil(t, err)
+	assert.Equal(t, "The namespace of document is omitted", err.Error())
+
+	// documents built in code are written with their namespace
+	created := &Iso20022DocumentObject{
+		XMLName: xml.Name{Space: utils.DocumentPacs00200111NameSpace},
+		Message: &pacs_v11.FIToFIPaymentStatusReportV11{},
+	}
+	buf, err = xml.Marshal(created)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(buf), `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.11"><FIToFIPmtStsRpt>`), string(buf))
+}
+
+func TestPrefixedBusinessMessage(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_bah_pacs_v08.xml"))
+	assert.Nil(t, err)
+	original, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+
+	prefix := func(body, p string) string {
+		return regexp.MustCompile(`<(/?)(\w+)`).ReplaceAllString(body, "<${1}"+p+":${2}")
+	}
+	body := string(input)
+	header := body[strings.Index(body, "<AppHdr"):strings.Index(body, "<Document")]
+	header = header[strings.Index(header, ">")+1:]
+	document := body[strings.Index(body, "<Document"):strings.Index(body, "</BizMsg>")]
+	document = document[strings.Index(document, ">")+1:]
+
+	// the envelope is in a namespace of its own and declares the prefixes of both parts
+	enveloped := `<env:RequestPayload xmlns:env="urn:example:envelope" xmlns:h="urn:iso:std:iso:20022:tech:xsd:head.001.001.02" xmlns:d="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08">` +
+		`<h:AppHdr>` + prefix(header, "h") + prefix(document, "d") + `</env:RequestPayload>`
+	enveloped = strings.Replace(enveloped, "</h:AppHdr>", "</h:AppHdr><d:Document>", 1)
+
+	doc, err := ParseIso20022Document([]byte(enveloped))
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+	assert.Equal(t, utils.DocumentPacs00800108NameSpace, doc.NameSpace())
+	msg := doc.(*BusinessMessage)
+	assert.Equal(t, "MSG-1", string(msg.AppHdr.(*head_v02.BusinessApplicationHeaderV02).BizMsgIdr))
+	assert.Equal(t, original.InspectMessage(), doc.InspectMessage())
+
+	buf, err := xml.Marshal(doc)
+	assert.Nil(t, err)
+	output := string(buf)
+	assert.True(t, strings.HasPrefix(output, `<env:RequestPayload xmlns:env="urn:example:envelope" xmlns:h="urn:iso:std:iso:20022:tech:xsd:head.001.001.02" xmlns:d="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08">`))
+	assert.NotContains(t, output, "_xmlns")
+	reparsed, err := ParseIso20022Document(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, msg.AppHdr, reparsed.(*BusinessMessage).AppHdr, output)
+	assert.Equal(t, doc.InspectMessage(), reparsed.InspectMessage())
+
+	Canonicalize(doc)
+	buf, err = xml.Marshal(doc)
+	assert.Nil(t, err)
+	output = string(buf)
+	assert.True(t, strings.HasPrefix(output, `<RequestPayload xmlns="urn:example:envelope"><AppHdr xmlns="urn:iso:std:iso:20022:tech:xsd:head.001.001.02"><Fr>`))
+	assert.Contains(t, output, `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"><FIToFICstmrCdtTrf>`)
+	reparsed, err = ParseIso20022Document(buf)
+	assert.Nil(t, err)
+	assert.Nil(t, reparsed.Validate())
+	assert.Equal(t, msg.AppHdr, reparsed.(*BusinessMessage).AppHdr)
+}
@@ -0,0 +1,404 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Profile restricts messages to the usage guidelines of a market practice such as SEPA or CBPR+.
+// A message can be valid against the schema and still be rejected by a scheme, so a profile checks its rules
+// on top of Validate. Like business rules, the rules of a profile are kept per message named like pacs.008
+// and apply to every version of the message.
+type Profile struct {
+	// Name selects the profile, e.g. with validator --profile
+	Name string
+	// Description names the scheme and the guidelines the profile implements
+	Description string
+	// BusinessHeader requires the document to be exchanged with a business application header
+	BusinessHeader bool
+
+	rules map[string][]BusinessRule
+}
+
+// NewProfile returns a profile without rules
+func NewProfile(name, description string) *Profile {
+	return &Profile{Name: name, Description: description, rules: map[string][]BusinessRule{}}
+}
+
+// AddRule adds a rule checked for every version of a message named like pacs.008. Messages without rules are
+// rejected by the profile.
+func (p *Profile) AddRule(message string, rules ...BusinessRule) *Profile {
+	p.rules[message] = append(p.rules[message], rules...)
+	return p
+}
+
+// Messages returns the messages supported by the profile
+func (p *Profile) Messages() []string {
+	var messages []string
+	for message := range p.rules {
+		messages = append(messages, message)
+	}
+	sort.Strings(messages)
+	return messages
+}
+
+// Validate checks the document with its own Validate and then the rules of the profile for its message.
+// All violations are returned together as utils.ValidationErrors.
+func (p *Profile) Validate(doc Iso20022Document) error {
+	if doc == nil {
+		return utils.NewErrOmittedNameSpace()
+	}
+	var errs utils.ValidationErrors
+	if err := doc.Validate(); err != nil && !errors.As(err, &errs) {
+		return err
+	}
+
+	namespace := doc.NameSpace()
+	match := messageIdentifierRegexp.FindStringSubmatch(namespace)
+	if match == nil {
+		return utils.NewErrUnsupportedNameSpace()
+	}
+	rules, found := p.rules[match[1]+"."+match[2]]
+	if !found {
+		return utils.NewErrUnsupportedProfileMessage(p.Name, namespace)
+	}
+
+	root := documentRoot(doc)
+	if _, ok := doc.(*BusinessMessage); p.BusinessHeader && !ok {
+		errs = append(errs, utils.ValidationError{
+			Path: "/" + appHdrElement,
+			Type: "BusinessApplicationHeader",
+			Rule: p.rule("(required)"),
+		})
+	}
+
+	message := doc.InspectMessage()
+	path := root
+	if element := messageElement(message); element != "" {
+		path = path + "/" + element
+	}
+	for _, rule := range rules {
+		for _, err := range rule(message, path) {
+			err.Rule = p.rule(err.Rule)
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (p *Profile) rule(rule string) string {
+	return fmt.Sprintf("profile %s %s", p.Name, rule)
+}
+
+// documentRoot returns the path of the document element, below the envelope of a business message
+func documentRoot(doc Iso20022Document) string {
+	if msg, ok := doc.(*BusinessMessage); ok {
+		root := "/" + msg.XMLName.Local
+		if msg.XMLName.Local == "" {
+			root = "/" + DefaultBusinessMessageElement
+		}
+		if msg.Document != nil && msg.Document.XMLName.Local != "" {
+			return root + "/" + msg.Document.XMLName.Local
+		}
+		return root + "/" + documentElement
+	}
+	if name := doc.GetXmlName(); name != nil && name.Local != "" {
+		return "/" + name.Local
+	}
+	return "/" + documentElement
+}
+
+var profiles = struct {
+	sync.RWMutex
+	profiles map[string]*Profile
+}{profiles: map[string]*Profile{}}
+
+// RegisterProfile makes a profile available to LookupProfile by its name
+func RegisterProfile(profile *Profile) error {
+	profiles.Lock()
+	defer profiles.Unlock()
+
+	name := strings.ToLower(profile.Name)
+	if _, found := profiles.profiles[name]; found {
+		return utils.NewErrRegisteredProfile(profile.Name)
+	}
+	profiles.profiles[name] = profile
+	return nil
+}
+
+// LookupProfile returns the registered profile with the name, ignoring its case
+func LookupProfile(name string) (*Profile, error) {
+	profiles.RLock()
+	defer profiles.RUnlock()
+
+	profile, found := profiles.profiles[strings.ToLower(name)]
+	if !found {
+		return nil, utils.NewErrUnknownProfile(name)
+	}
+	return profile, nil
+}
+
+// Profiles returns the registered profiles sorted by name
+func Profiles() []*Profile {
+	profiles.RLock()
+	defer profiles.RUnlock()
+
+	var list []*Profile
+	for _, profile := range profiles.profiles {
+		list = append(list, profile)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+var (
+	// sepaCharacterRegexp is the Latin character set of the EPC implementation guidelines
+	sepaCharacterRegexp = regexp.MustCompile(`^[A-Za-z0-9/\-?:().,'+ ]*$`)
+	xmlNameType         = reflect.TypeOf(xml.Name{})
+)
+
+func init() {
+	sepaText := CharacterSetRule(sepaCharacterRegexp, "the SEPA character set")
+	sepa := NewProfile("sepa", "SEPA credit transfer and direct debit (EPC implementation guidelines)").
+		AddRule("pacs.008",
+			CodeRule("GrpHdr/TtlIntrBkSttlmAmt/Ccy", "EUR"),
+			CodeRule("CdtTrfTxInf/IntrBkSttlmAmt/Ccy", "EUR"),
+			CodeRule("CdtTrfTxInf/ChrgBr", "SLEV"),
+			MaxOccursRule("CdtTrfTxInf/RmtInf", "Ustrd", 1),
+			sepaText).
+		AddRule("pain.001",
+			CodeRule("PmtInf/CdtTrfTxInf/Amt/InstdAmt/Ccy", "EUR"),
+			CodeRule("PmtInf/ChrgBr", "SLEV"),
+			CodeRule("PmtInf/CdtTrfTxInf/ChrgBr", "SLEV"),
+			MaxOccursRule("PmtInf/CdtTrfTxInf/RmtInf", "Ustrd", 1),
+			sepaText).
+		AddRule("pain.008",
+			CodeRule("PmtInf/DrctDbtTxInf/InstdAmt/Ccy", "EUR"),
+			CodeRule("PmtInf/ChrgBr", "SLEV"),
+			CodeRule("PmtInf/DrctDbtTxInf/ChrgBr", "SLEV"),
+			MaxOccursRule("PmtInf/DrctDbtTxInf/RmtInf", "Ustrd", 1),
+			sepaText).
+		AddRule("pacs.002", sepaText)
+
+	cbpr := NewProfile("cbpr+", "Cross-border payments and reporting plus (SWIFT CBPR+ usage guidelines)")
+	cbpr.BusinessHeader = true
+	hvps := NewProfile("hvps+", "High value payment systems plus (HVPS+ usage guidelines)")
+	hvps.BusinessHeader = true
+	for _, profile := range []*Profile{cbpr, hvps} {
+		for _, message := range []string{"pacs.008", "pacs.009"} {
+			profile.AddRule(message,
+				MaxOccursRule("", "CdtTrfTxInf", 1),
+				RequiredRule("CdtTrfTxInf/PmtId/UETR"),
+				RequiredRule("CdtTrfTxInf/InstgAgt"),
+				RequiredRule("CdtTrfTxInf/InstdAgt"),
+				MaxOccursRule("CdtTrfTxInf/RmtInf", "Ustrd", 1))
+		}
+		profile.AddRule("pacs.002",
+			MaxOccursRule("", "TxInfAndSts", 1),
+			RequiredRule("TxInfAndSts/OrgnlUETR"))
+	}
+	cbpr.AddRule("pacs.008", CodeRule("GrpHdr/SttlmInf/SttlmMtd", "INDA", "INGA", "COVE"))
+	cbpr.AddRule("pacs.009", CodeRule("GrpHdr/SttlmInf/SttlmMtd", "INDA", "INGA", "COVE"))
+	hvps.AddRule("pacs.008", CodeRule("GrpHdr/SttlmInf/SttlmMtd", "CLRG"))
+	hvps.AddRule("pacs.009", CodeRule("GrpHdr/SttlmInf/SttlmMtd", "CLRG"))
+
+	fednow := NewProfile("fednow", "FedNow instant payments (FedNow ISO 20022 message specifications)")
+	for _, message := range []string{"pacs.008", "pacs.009"} {
+		fednow.AddRule(message,
+			MaxOccursRule("", "CdtTrfTxInf", 1),
+			CodeRule("GrpHdr/NbOfTxs", "1"),
+			CodeRule("GrpHdr/SttlmInf/SttlmMtd", "CLRG"),
+			CodeRule("CdtTrfTxInf/IntrBkSttlmAmt/Ccy", "USD"),
+			RequiredRule("CdtTrfTxInf/InstgAgt/FinInstnId/ClrSysMmbId/MmbId"),
+			RequiredRule("CdtTrfTxInf/InstdAgt/FinInstnId/ClrSysMmbId/MmbId"))
+	}
+	fednow.AddRule("pacs.008", CodeRule("CdtTrfTxInf/ChrgBr", "SLEV"))
+	fednow.AddRule("pacs.002", MaxOccursRule("", "TxInfAndSts", 1))
+
+	for _, profile := range []*Profile{sepa, cbpr, fednow, hvps} {
+		if err := RegisterProfile(profile); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// CodeRule restricts the values of an element at a path like CdtTrfTxInf/ChrgBr below the message,
+// omitted elements are skipped
+func CodeRule(path string, values ...string) BusinessRule {
+	return func(message Iso20022Message, root string) utils.ValidationErrors {
+		var errs utils.ValidationErrors
+		selectElements(reflect.ValueOf(message), root, splitPath(path), func(value reflect.Value, parent reflect.Value, path string) {
+			if value = reflect.Indirect(value); value.Kind() != reflect.String || value.String() == "" {
+				return
+			}
+			for _, allowed := range values {
+				if value.String() == allowed {
+					return
+				}
+			}
+			errs = append(errs, utils.ValidationError{
+				Path:  attributePath(path),
+				Type:  typeName(parent),
+				Rule:  fmt.Sprintf("(must be %s)", strings.Join(values, " or ")),
+				Value: value.String(),
+			})
+		})
+		return errs
+	}
+}
+
+// RequiredRule requires an optional element at a path like CdtTrfTxInf/PmtId/UETR below the message
+func RequiredRule(path string) BusinessRule {
+	return func(message Iso20022Message, root string) utils.ValidationErrors {
+		var errs utils.ValidationErrors
+		selectElements(reflect.ValueOf(message), root, splitPath(path), func(value reflect.Value, parent reflect.Value, path string) {
+			if value = reflect.Indirect(value); value.IsValid() && !value.IsZero() {
+				return
+			}
+			errs = append(errs, utils.ValidationError{
+				Path: path,
+				Type: typeName(parent),
+				Rule: "(required)",
+			})
+		})
+		return errs
+	}
+}
+
+// MaxOccursRule limits the occurrences of the list element name inside the elements at path
+func MaxOccursRule(path, name string, max int) BusinessRule {
+	return func(message Iso20022Message, root string) utils.ValidationErrors {
+		var errs utils.ValidationErrors
+		selectElements(reflect.ValueOf(message), root, splitPath(path), func(value reflect.Value, _ reflect.Value, path string) {
+			if value = reflect.Indirect(value); value.Kind() != reflect.Struct {
+				return
+			}
+			list := value.FieldByName(name)
+			if list.Kind() == reflect.Slice && list.Len() > max {
+				errs = append(errs, utils.ValidationError{
+					Path:  path + "/" + name,
+					Type:  value.Type().Name(),
+					Rule:  fmt.Sprintf("(must occur at most %d times)", max),
+					Value: fmt.Sprint(list.Len()),
+				})
+			}
+		})
+		return errs
+	}
+}
+
+// CharacterSetRule requires every text of the message to match the character set
+func CharacterSetRule(charset *regexp.Regexp, description string) BusinessRule {
+	return func(message Iso20022Message, root string) utils.ValidationErrors {
+		var errs utils.ValidationErrors
+		walkText(reflect.ValueOf(message), reflect.Value{}, root, func(value string, parent reflect.Value, path string) {
+			if !charset.MatchString(value) {
+				errs = append(errs, utils.ValidationError{
+					Path:  path,
+					Type:  typeName(parent),
+					Rule:  fmt.Sprintf("(must use %s)", description),
+					Value: value,
+				})
+			}
+		})
+		return errs
+	}
+}
+
+func splitPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+// elementPath names attributes like utils.ValidateAll, e.g. IntrBkSttlmAmt/@Ccy
+func attributePath(path string) string {
+	if strings.HasSuffix(path, "/Ccy") {
+		return strings.TrimSuffix(path, "Ccy") + "@Ccy"
+	}
+	return path
+}
+
+func typeName(value reflect.Value) string {
+	if value = reflect.Indirect(value); value.IsValid() {
+		return value.Type().Name()
+	}
+	return ""
+}
+
+// selectElements calls fn with every element at the path of field names below value, lists are walked with
+// their indexes. Elements below an omitted element are passed as invalid values, elements which don't exist
+// in the version of the message are skipped.
+func selectElements(value reflect.Value, path string, names []string, fn func(value, parent reflect.Value, path string)) {
+	if len(names) == 0 {
+		fn(value, reflect.Value{}, path)
+		return
+	}
+	parent := reflect.Indirect(value)
+	if parent.Kind() != reflect.Struct {
+		fn(reflect.Value{}, reflect.Value{}, path+"/"+strings.Join(names, "/"))
+		return
+	}
+	field := parent.FieldByName(names[0])
+	if !field.IsValid() {
+		return
+	}
+	if field.Kind() == reflect.Slice {
+		for i := 0; i < field.Len(); i++ {
+			selectElements(field.Index(i), fmt.Sprintf("%s/%s[%d]", path, names[0], i+1), names[1:], fn)
+		}
+		return
+	}
+	if len(names) == 1 {
+		fn(field, parent, path+"/"+names[0])
+		return
+	}
+	selectElements(field, path+"/"+names[0], names[1:], fn)
+}
+
+// walkText calls fn with every non-empty text of the exported fields below value
+func walkText(value, parent reflect.Value, path string, fn func(value string, parent reflect.Value, path string)) {
+	value = reflect.Indirect(value)
+	switch value.Kind() {
+	case reflect.String:
+		if value.String() != "" {
+			fn(value.String(), parent, path)
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			walkText(value.Index(i), parent, fmt.Sprintf("%s[%d]", path, i+1), fn)
+		}
+	case reflect.Struct:
+		if value.Type() == xmlNameType {
+			return
+		}
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" || field.Type == xmlNameType {
+				continue
+			}
+			name := field.Name
+			if strings.Contains(field.Tag.Get("xml"), ",attr") {
+				name = "@" + name
+			}
+			walkText(value.Field(i), value, path+"/"+name, fn)
+		}
+	}
+}
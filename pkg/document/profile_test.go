@@ -0,0 +1,144 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/pain_v09"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func readProfileDocument(t *testing.T, name string) Iso20022Document {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", name))
+	assert.Nil(t, err)
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+	return doc
+}
+
+func TestSepaProfile(t *testing.T) {
+	profile, err := LookupProfile("SEPA")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"pacs.002", "pacs.008", "pain.001", "pain.008"}, profile.Messages())
+
+	doc := readProfileDocument(t, "valid_pacs_v08_prefixed.xml")
+	err = profile.Validate(doc)
+	assert.Equal(t, []string{
+		"/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/IntrBkSttlmAmt/@Ccy",
+		"/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/ChrgBr",
+	}, rulePaths(t, err))
+	assert.Contains(t, err.Error(), `/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/ChrgBr: CreditTransferTransaction39 has invalid profile sepa (must be SLEV) (value: "SHAR")`)
+
+	message := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	message.CdtTrfTxInf[0].IntrBkSttlmAmt.Ccy = "EUR"
+	message.CdtTrfTxInf[0].ChrgBr = "SLEV"
+	assert.Nil(t, profile.Validate(doc))
+
+	// the Latin character subset and a single unstructured remittance line
+	name := common.Max140Text("Jürgen Müller")
+	message.CdtTrfTxInf[0].Dbtr.Nm = &name
+	message.CdtTrfTxInf[0].RmtInf = &pacs_v08.RemittanceInformation16{Ustrd: []common.Max140Text{"Invoice 1", "Invoice 2"}}
+	err = profile.Validate(doc)
+	assert.Equal(t, []string{
+		"/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/RmtInf/Ustrd",
+		"/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/Dbtr/Nm",
+	}, rulePaths(t, err))
+	assert.Contains(t, err.Error(), `profile sepa (must use the SEPA character set) (value: "Jürgen Müller")`)
+	assert.Contains(t, err.Error(), `profile sepa (must occur at most 1 times) (value: "2")`)
+
+	// the violations of the document are reported too
+	message.CdtTrfTxInf[0].Dbtr.Nm = nil
+	message.CdtTrfTxInf[0].RmtInf = nil
+	message.GrpHdr.NbOfTxs = "2"
+	assert.Equal(t, []string{"/Document/FIToFICstmrCdtTrf/GrpHdr/NbOfTxs"}, rulePaths(t, profile.Validate(doc)))
+
+	// payment initiations
+	doc = readProfileDocument(t, "valid_pain_v09.xml")
+	initiation := doc.InspectMessage().(*pain_v09.CustomerCreditTransferInitiationV09)
+	initiation.PmtInf[0].CdtTrfTxInf[0].Amt.InstdAmt.Ccy = "USD"
+	assert.Contains(t, rulePaths(t, profile.Validate(doc)), "/Document/CstmrCdtTrfInitn/PmtInf[1]/CdtTrfTxInf[1]/Amt/InstdAmt/@Ccy")
+}
+
+func TestCbprProfile(t *testing.T) {
+	profile, err := LookupProfile("cbpr+")
+	assert.Nil(t, err)
+
+	doc := readProfileDocument(t, "valid_bah_pacs_v08.xml")
+	err = profile.Validate(doc)
+	assert.Equal(t, []string{
+		"/BizMsg/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/PmtId/UETR",
+		"/BizMsg/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/InstgAgt",
+		"/BizMsg/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/InstdAgt",
+	}, rulePaths(t, err))
+	assert.Contains(t, err.Error(), `/BizMsg/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/PmtId/UETR: PaymentIdentification7 has invalid profile cbpr+ (required) (value: "")`)
+
+	message := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	uetr := common.UUIDv4Identifier("eb6305c9-1f7f-49de-aed0-16487c27b42d")
+	bic := common.BICFIDec2014Identifier("DEUTDEFFXXX")
+	agent := &pacs_v08.BranchAndFinancialInstitutionIdentification6{FinInstnId: pacs_v08.FinancialInstitutionIdentification18{BICFI: &bic}}
+	message.CdtTrfTxInf[0].PmtId.UETR = &uetr
+	message.CdtTrfTxInf[0].InstgAgt = agent
+	message.CdtTrfTxInf[0].InstdAgt = agent
+	assert.Nil(t, profile.Validate(doc))
+
+	// a single transaction and a business application header
+	message.CdtTrfTxInf = append(message.CdtTrfTxInf, message.CdtTrfTxInf[0])
+	message.GrpHdr.NbOfTxs = "2"
+	assert.Equal(t, []string{"/BizMsg/Document/FIToFICstmrCdtTrf/CdtTrfTxInf"}, rulePaths(t, profile.Validate(doc)))
+
+	message.CdtTrfTxInf = message.CdtTrfTxInf[:1]
+	message.GrpHdr.NbOfTxs = "1"
+	bare := doc.(*BusinessMessage).Document
+	assert.Equal(t, []string{"/AppHdr"}, rulePaths(t, profile.Validate(bare)))
+}
+
+func TestFedNowProfile(t *testing.T) {
+	profile, err := LookupProfile("fednow")
+	assert.Nil(t, err)
+
+	doc := readProfileDocument(t, "valid_pacs_v08_prefixed.xml")
+	message := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	message.CdtTrfTxInf[0].ChrgBr = "SLEV"
+	err = profile.Validate(doc)
+	assert.Equal(t, []string{
+		"/Document/FIToFICstmrCdtTrf/GrpHdr/SttlmInf/SttlmMtd",
+		"/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/InstgAgt/FinInstnId/ClrSysMmbId/MmbId",
+		"/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/InstdAgt/FinInstnId/ClrSysMmbId/MmbId",
+	}, rulePaths(t, err))
+	assert.Contains(t, err.Error(), `profile fednow (must be CLRG) (value: "INDA")`)
+}
+
+func TestProfileErrors(t *testing.T) {
+	_, err := LookupProfile("unknown")
+	assert.EqualError(t, err, "The profile unknown is unknown")
+
+	assert.EqualError(t, RegisterProfile(NewProfile("SEPA", "")), "The profile SEPA is already registered")
+
+	profile, err := LookupProfile("sepa")
+	assert.Nil(t, err)
+	doc := readProfileDocument(t, "valid_acmt_v03.xml")
+	assert.EqualError(t, profile.Validate(doc), "The namespace of urn:iso:std:iso:20022:tech:xsd:acmt.007.001.03 is unsupported by the profile sepa")
+
+	// custom profiles
+	custom := NewProfile("custom", "Test profile").AddRule("acmt.007", RequiredRule("Refs/Undefined"), CodeRule("Refs/MsgId/Id", "ID"))
+	assert.Nil(t, RegisterProfile(custom))
+	found, err := LookupProfile("Custom")
+	assert.Nil(t, err)
+	assert.Equal(t, custom, found)
+	assert.Contains(t, Profiles(), custom)
+
+	var verrs utils.ValidationErrors
+	err = found.Validate(doc)
+	assert.ErrorAs(t, err, &verrs)
+	assert.Len(t, verrs, 1)
+}
@@ -0,0 +1,172 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// MessageConstructor returns a new, empty message of a registered type
+type MessageConstructor func() Iso20022Message
+
+// MessageInfo describes a message type of the registry
+type MessageInfo struct {
+	// NameSpace is the xmlns of the Document, e.g. urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08
+	NameSpace string
+	// BusinessArea, MessageId, Variant and Version are the parts of the message identifier (pacs.008.001.08).
+	// They are empty when the namespace doesn't contain an ISO 20022 message identifier.
+	BusinessArea string
+	MessageId    string
+	Variant      string
+	Version      string
+	// RootElement is the element of the message inside of the Document, e.g. FIToFICstmrCdtTrf
+	RootElement string
+	// GoType is the name of the Go type of the message, e.g. pacs_v08.FIToFICustomerCreditTransferV08
+	GoType string
+
+	Type        reflect.Type       `json:"-"`
+	constructor MessageConstructor `json:"-"`
+}
+
+// Identifier returns the message identifier, e.g. pacs.008.001.08
+func (info MessageInfo) Identifier() string {
+	if info.BusinessArea == "" {
+		return ""
+	}
+	return strings.Join([]string{info.BusinessArea, info.MessageId, info.Variant, info.Version}, ".")
+}
+
+// New returns a new, empty message
+func (info MessageInfo) New() Iso20022Message {
+	return info.constructor()
+}
+
+var (
+	messageIdentifierRegexp = regexp.MustCompile(`(?:^|[:/])([a-z]{4})\.([0-9]{3})\.([0-9]{3})\.([0-9]{2})(?:\.|$)`)
+
+	registry = struct {
+		sync.RWMutex
+		messages map[string]MessageInfo
+	}{messages: map[string]MessageInfo{}}
+)
+
+func init() {
+	for namespace, constructor := range defaultMessages {
+		if err := RegisterMessage(namespace, constructor); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func newMessageInfo(namespace string, constructor MessageConstructor) (MessageInfo, error) {
+	if namespace == "" {
+		return MessageInfo{}, utils.NewErrOmittedNameSpace()
+	}
+	if constructor == nil {
+		return MessageInfo{}, errors.New("nil message constructor")
+	}
+	typ := reflect.TypeOf(constructor())
+	if typ == nil || typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Struct {
+		return MessageInfo{}, errors.New("the message constructor has to return a pointer to a struct")
+	}
+
+	info := MessageInfo{
+		NameSpace:   namespace,
+		GoType:      typ.Elem().String(),
+		Type:        typ,
+		constructor: constructor,
+	}
+
+	if match := messageIdentifierRegexp.FindStringSubmatch(namespace); match != nil {
+		info.BusinessArea, info.MessageId, info.Variant, info.Version = match[1], match[2], match[3], match[4]
+	}
+
+	if field, found := typ.Elem().FieldByName("XMLName"); found {
+		name := strings.Split(field.Tag.Get("xml"), ",")[0]
+		info.RootElement = name[strings.LastIndex(name, " ")+1:]
+	}
+
+	return info, nil
+}
+
+// RegisterMessage adds a message type for the namespace, so that documents of the namespace can be parsed.
+// Proprietary messages and versions that aren't shipped with the library are registered the same way
+// as the built-in ones. A namespace can only be registered once, see UnregisterMessage.
+func RegisterMessage(namespace string, constructor MessageConstructor) error {
+	info, err := newMessageInfo(namespace, constructor)
+	if err != nil {
+		return err
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, found := registry.messages[namespace]; found {
+		return utils.NewErrRegisteredNameSpace(namespace)
+	}
+	registry.messages[namespace] = info
+	return nil
+}
+
+// UnregisterMessage removes the message type of the namespace and reports whether it was registered
+func UnregisterMessage(namespace string) bool {
+	registry.Lock()
+	defer registry.Unlock()
+
+	_, found := registry.messages[namespace]
+	delete(registry.messages, namespace)
+	return found
+}
+
+// RegisteredMessages returns the registered message types sorted by namespace
+func RegisteredMessages() []MessageInfo {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	messages := make([]MessageInfo, 0, len(registry.messages))
+	for _, info := range registry.messages {
+		messages = append(messages, info)
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].NameSpace < messages[j].NameSpace
+	})
+	return messages
+}
+
+// LookupMessage returns the message type registered for the namespace
+func LookupMessage(namespace string) (MessageInfo, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	info, found := registry.messages[namespace]
+	return info, found
+}
+
+// LookupRootElement returns the message types whose root element is the name, sorted by namespace.
+// Every version of a message shares its root element, so there can be several of them.
+func LookupRootElement(name string) []MessageInfo {
+	var messages []MessageInfo
+	for _, info := range RegisteredMessages() {
+		if info.RootElement == name {
+			messages = append(messages, info)
+		}
+	}
+	return messages
+}
+
+func lookupConstructor(namespace string) MessageConstructor {
+	info, found := LookupMessage(namespace)
+	if !found {
+		return nil
+	}
+	return info.constructor
+}
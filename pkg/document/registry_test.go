@@ -0,0 +1,100 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const testProprietaryNameSpace = "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.99"
+
+type testProprietaryTransfer struct {
+	XMLName xml.Name         `xml:"FIToFICstmrCdtTrf"`
+	MsgId   common.Max35Text `xml:"GrpHdr>MsgId"`
+}
+
+func (r testProprietaryTransfer) Validate() error {
+	return utils.Validate(&r)
+}
+
+func TestRegisteredMessages(t *testing.T) {
+	messages := RegisteredMessages()
+	assert.Len(t, messages, len(defaultMessages))
+	for i := 1; i < len(messages); i++ {
+		assert.Less(t, messages[i-1].NameSpace, messages[i].NameSpace)
+	}
+	for _, info := range messages {
+		assert.NotEmpty(t, info.RootElement, info.NameSpace)
+		assert.NotEmpty(t, info.Identifier(), info.NameSpace)
+	}
+
+	info, found := LookupMessage(utils.DocumentPacs00800108NameSpace)
+	assert.True(t, found)
+	assert.Equal(t, "pacs", info.BusinessArea)
+	assert.Equal(t, "008", info.MessageId)
+	assert.Equal(t, "001", info.Variant)
+	assert.Equal(t, "08", info.Version)
+	assert.Equal(t, "pacs.008.001.08", info.Identifier())
+	assert.Equal(t, "FIToFICstmrCdtTrf", info.RootElement)
+	assert.Equal(t, "pacs_v08.FIToFICustomerCreditTransferV08", info.GoType)
+	assert.Equal(t, reflect.TypeOf(&pacs_v08.FIToFICustomerCreditTransferV08{}), info.Type)
+	assert.IsType(t, &pacs_v08.FIToFICustomerCreditTransferV08{}, info.New())
+
+	_, found = LookupMessage("urn:iso:std:iso:20022:tech:xsd:pacs.008.001.01")
+	assert.False(t, found)
+
+	roots := LookupRootElement("FIToFICstmrCdtTrf")
+	assert.Len(t, roots, 3)
+	assert.Equal(t, utils.DocumentPacs00800106NameSpace, roots[0].NameSpace)
+	assert.Empty(t, LookupRootElement("Unknown"))
+}
+
+func TestRegisterMessage(t *testing.T) {
+	input := []byte(`<Document xmlns="` + testProprietaryNameSpace + `"><FIToFICstmrCdtTrf><GrpHdr><MsgId>PRTRY-1</MsgId></GrpHdr></FIToFICstmrCdtTrf></Document>`)
+
+	_, err := ParseIso20022Document(input)
+	assert.Equal(t, utils.NewErrUnsupportedNameSpace(), err)
+
+	constructor := func() Iso20022Message { return &testProprietaryTransfer{} }
+	assert.Nil(t, RegisterMessage(testProprietaryNameSpace, constructor))
+	defer UnregisterMessage(testProprietaryNameSpace)
+
+	assert.Equal(t, utils.NewErrRegisteredNameSpace(testProprietaryNameSpace), RegisterMessage(testProprietaryNameSpace, constructor))
+	assert.Len(t, LookupRootElement("FIToFICstmrCdtTrf"), 4)
+
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+	assert.Equal(t, "PRTRY-1", string(doc.InspectMessage().(*testProprietaryTransfer).MsgId))
+
+	doc, err = NewDocument(testProprietaryNameSpace)
+	assert.Nil(t, err)
+	assert.IsType(t, &testProprietaryTransfer{}, doc.InspectMessage())
+
+	assert.True(t, UnregisterMessage(testProprietaryNameSpace))
+	assert.False(t, UnregisterMessage(testProprietaryNameSpace))
+	_, err = ParseIso20022Document(input)
+	assert.Equal(t, utils.NewErrUnsupportedNameSpace(), err)
+
+	// metadata is optional for proprietary namespaces
+	assert.Nil(t, RegisterMessage("urn:example:payments", constructor))
+	info, found := LookupMessage("urn:example:payments")
+	assert.True(t, found)
+	assert.Empty(t, info.Identifier())
+	assert.Equal(t, "FIToFICstmrCdtTrf", info.RootElement)
+	assert.True(t, UnregisterMessage("urn:example:payments"))
+
+	assert.Equal(t, utils.NewErrOmittedNameSpace(), RegisterMessage("", constructor))
+	assert.NotNil(t, RegisterMessage(testProprietaryNameSpace, nil))
+	assert.NotNil(t, RegisterMessage(testProprietaryNameSpace, func() Iso20022Message { return nil }))
+}
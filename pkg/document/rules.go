@@ -0,0 +1,254 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// BusinessRule checks a constraint between several elements of a message, which the facets of the single elements
+// can't express. path is the location of the message element, e.g. /Document/FIToFICstmrCdtTrf.
+type BusinessRule func(message Iso20022Message, path string) utils.ValidationErrors
+
+var businessRules = struct {
+	sync.RWMutex
+	rules map[string][]BusinessRule
+}{rules: map[string][]BusinessRule{
+	"pacs.008": {transactionTotalsRule("CdtTrfTxInf"), totalInterbankSettlementAmountRule, interbankSettlementDateRule},
+	"pacs.009": {transactionTotalsRule("CdtTrfTxInf"), totalInterbankSettlementAmountRule, interbankSettlementDateRule},
+	"pain.001": {paymentInformationTotalsRule("CdtTrfTxInf")},
+	"pain.008": {paymentInformationTotalsRule("DrctDbtTxInf")},
+}}
+
+// RegisterBusinessRule adds a rule checked by Validate for every version of a message named like pacs.008
+func RegisterBusinessRule(message string, rule BusinessRule) {
+	businessRules.Lock()
+	defer businessRules.Unlock()
+
+	businessRules.rules[message] = append(businessRules.rules[message], rule)
+}
+
+// checkBusinessRules evaluates the rules registered for the message of the namespace
+func checkBusinessRules(namespace string, message Iso20022Message, path string) utils.ValidationErrors {
+	match := messageIdentifierRegexp.FindStringSubmatch(namespace)
+	if match == nil || message == nil {
+		return nil
+	}
+
+	businessRules.RLock()
+	rules := businessRules.rules[match[1]+"."+match[2]]
+	businessRules.RUnlock()
+
+	if element := messageElement(message); element != "" {
+		path = path + "/" + element
+	}
+	var errs utils.ValidationErrors
+	for _, rule := range rules {
+		errs = append(errs, rule(message, path)...)
+	}
+	return errs
+}
+
+// messageElement returns the root element of the message like utils.ValidateAll names it
+func messageElement(message Iso20022Message) string {
+	value := reflect.Indirect(reflect.ValueOf(message))
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+	field, found := value.Type().FieldByName("XMLName")
+	if !found {
+		return ""
+	}
+	if name, ok := value.FieldByIndex(field.Index).Interface().(xml.Name); ok && name.Local != "" {
+		return name.Local
+	}
+	name := strings.Split(field.Tag.Get("xml"), ",")[0]
+	return name[strings.LastIndex(name, " ")+1:]
+}
+
+// transactionTotalsRule checks NbOfTxs and CtrlSum of the group header against the transactions of a pacs message
+func transactionTotalsRule(transactions string) BusinessRule {
+	return func(message Iso20022Message, path string) utils.ValidationErrors {
+		msg := reflect.Indirect(reflect.ValueOf(message))
+		grpHdr := msg.FieldByName("GrpHdr")
+		txs := msg.FieldByName(transactions)
+		if !grpHdr.IsValid() || txs.Kind() != reflect.Slice {
+			return nil
+		}
+		return checkTotals(grpHdr, path+"/GrpHdr", txs.Len(), sumAmounts(txs))
+	}
+}
+
+// paymentInformationTotalsRule checks NbOfTxs and CtrlSum of the group header and of every payment information
+// block against the transactions of a pain message
+func paymentInformationTotalsRule(transactions string) BusinessRule {
+	return func(message Iso20022Message, path string) utils.ValidationErrors {
+		msg := reflect.Indirect(reflect.ValueOf(message))
+		grpHdr := msg.FieldByName("GrpHdr")
+		pmtInf := msg.FieldByName("PmtInf")
+		if !grpHdr.IsValid() || pmtInf.Kind() != reflect.Slice {
+			return nil
+		}
+
+		var errs utils.ValidationErrors
+		count, sum := 0, common.Decimal{}
+		for i := 0; i < pmtInf.Len(); i++ {
+			txs := pmtInf.Index(i).FieldByName(transactions)
+			if txs.Kind() != reflect.Slice {
+				continue
+			}
+			instructionSum := sumAmounts(txs)
+			errs = append(errs, checkTotals(pmtInf.Index(i), fmt.Sprintf("%s/PmtInf[%d]", path, i+1), txs.Len(), instructionSum)...)
+			count += txs.Len()
+			sum = sum.Add(instructionSum)
+		}
+		return append(checkTotals(grpHdr, path+"/GrpHdr", count, sum), errs...)
+	}
+}
+
+// checkTotals compares NbOfTxs and CtrlSum of a block, both are skipped when they are omitted
+func checkTotals(block reflect.Value, path string, count int, sum common.Decimal) utils.ValidationErrors {
+	var errs utils.ValidationErrors
+	typeName := block.Type().Name()
+
+	if nbOfTxs := reflect.Indirect(block.FieldByName("NbOfTxs")); nbOfTxs.Kind() == reflect.String {
+		if value, err := strconv.Atoi(nbOfTxs.String()); err == nil && value != count {
+			errs = append(errs, utils.ValidationError{
+				Path:  path + "/NbOfTxs",
+				Type:  typeName,
+				Rule:  fmt.Sprintf("consistency (must equal the number of transactions %d)", count),
+				Value: nbOfTxs.String(),
+			})
+		}
+	}
+
+	if ctrlSum, ok := block.FieldByName("CtrlSum").Interface().(*common.DecimalNumber); ok && ctrlSum != nil {
+		if declared := common.Decimal(*ctrlSum); !declared.Equal(sum) {
+			errs = append(errs, utils.ValidationError{
+				Path:  path + "/CtrlSum",
+				Type:  typeName,
+				Rule:  fmt.Sprintf("consistency (must equal the sum of the transaction amounts %s)", sum),
+				Value: declared.String(),
+			})
+		}
+	}
+
+	return errs
+}
+
+// totalInterbankSettlementAmountRule implements TotalInterbankSettlementAmountRule and
+// TotalInterbankSettlementAmountAndSumRule: the total is in the currency of every transaction and equals their sum
+func totalInterbankSettlementAmountRule(message Iso20022Message, path string) utils.ValidationErrors {
+	msg := reflect.Indirect(reflect.ValueOf(message))
+	grpHdr := msg.FieldByName("GrpHdr")
+	txs := msg.FieldByName("CdtTrfTxInf")
+	if !grpHdr.IsValid() || txs.Kind() != reflect.Slice {
+		return nil
+	}
+	total, currency, found := amountValue(grpHdr.FieldByName("TtlIntrBkSttlmAmt"))
+	if !found {
+		return nil
+	}
+
+	var errs utils.ValidationErrors
+	for i := 0; i < txs.Len(); i++ {
+		_, txCurrency, found := amountValue(txs.Index(i).FieldByName("IntrBkSttlmAmt"))
+		if found && txCurrency != currency {
+			errs = append(errs, utils.ValidationError{
+				Path:  fmt.Sprintf("%s/CdtTrfTxInf[%d]/IntrBkSttlmAmt/@Ccy", path, i+1),
+				Type:  txs.Index(i).Type().Name(),
+				Rule:  fmt.Sprintf("TotalInterbankSettlementAmountRule (must be the currency %s of the total interbank settlement amount)", currency),
+				Value: txCurrency,
+			})
+		}
+	}
+	if sum := sumAmounts(txs); !sum.Equal(total) {
+		errs = append(errs, utils.ValidationError{
+			Path:  path + "/GrpHdr/TtlIntrBkSttlmAmt",
+			Type:  grpHdr.Type().Name(),
+			Rule:  fmt.Sprintf("TotalInterbankSettlementAmountAndSumRule (must equal the sum of the interbank settlement amounts %s)", sum),
+			Value: total.String(),
+		})
+	}
+	return errs
+}
+
+// interbankSettlementDateRule implements GroupHeaderInterbankSettlementDateRule: the transactions have no settlement date
+// of their own when the group header has one
+func interbankSettlementDateRule(message Iso20022Message, path string) utils.ValidationErrors {
+	msg := reflect.Indirect(reflect.ValueOf(message))
+	grpHdr := msg.FieldByName("GrpHdr")
+	txs := msg.FieldByName("CdtTrfTxInf")
+	if !grpHdr.IsValid() || txs.Kind() != reflect.Slice {
+		return nil
+	}
+	if _, found := timeValue(grpHdr.FieldByName("IntrBkSttlmDt")); !found {
+		return nil
+	}
+
+	var errs utils.ValidationErrors
+	for i := 0; i < txs.Len(); i++ {
+		if date, found := timeValue(txs.Index(i).FieldByName("IntrBkSttlmDt")); found {
+			errs = append(errs, utils.ValidationError{
+				Path:  fmt.Sprintf("%s/CdtTrfTxInf[%d]/IntrBkSttlmDt", path, i+1),
+				Type:  txs.Index(i).Type().Name(),
+				Rule:  "GroupHeaderInterbankSettlementDateRule (not allowed when the group header has an interbank settlement date)",
+				Value: date.Format("2006-01-02"),
+			})
+		}
+	}
+	return errs
+}
+
+var decimalType = reflect.TypeOf(common.Decimal{})
+
+// amountValue returns the value and the currency of an amount element such as ActiveCurrencyAndAmount
+func amountValue(amount reflect.Value) (common.Decimal, string, bool) {
+	amount = reflect.Indirect(amount)
+	if !amount.IsValid() || amount.Kind() != reflect.Struct {
+		return common.Decimal{}, "", false
+	}
+	value := amount.FieldByName("Value")
+	currency := amount.FieldByName("Ccy")
+	if !value.IsValid() || !value.Type().ConvertibleTo(decimalType) || currency.Kind() != reflect.String {
+		return common.Decimal{}, "", false
+	}
+	return value.Convert(decimalType).Interface().(common.Decimal), currency.String(), true
+}
+
+// transactionAmount returns the amount of a credit transfer or direct debit transaction
+func transactionAmount(tx reflect.Value) (common.Decimal, bool) {
+	for _, path := range [][]string{{"IntrBkSttlmAmt"}, {"Amt", "InstdAmt"}, {"Amt", "EqvtAmt", "Amt"}, {"InstdAmt"}} {
+		field := tx
+		for _, name := range path {
+			if field = reflect.Indirect(field); field.Kind() != reflect.Struct {
+				break
+			}
+			field = field.FieldByName(name)
+		}
+		if value, _, found := amountValue(field); found {
+			return value, true
+		}
+	}
+	return common.Decimal{}, false
+}
+
+func sumAmounts(txs reflect.Value) common.Decimal {
+	var sum common.Decimal
+	for i := 0; i < txs.Len(); i++ {
+		if amount, found := transactionAmount(txs.Index(i)); found {
+			sum = sum.Add(amount)
+		}
+	}
+	return sum
+}
@@ -0,0 +1,155 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/pain_v09"
+	"github.com/moov-io/iso20022/pkg/pain_v10"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func rulePaths(t *testing.T, err error) []string {
+	var verrs utils.ValidationErrors
+	if !assert.True(t, errors.As(err, &verrs), err) {
+		return nil
+	}
+	var paths []string
+	for _, verr := range verrs {
+		paths = append(paths, verr.Path)
+	}
+	return paths
+}
+
+func decimalNumber(value string) *common.DecimalNumber {
+	number := common.DecimalNumber(common.MustParseDecimal(value))
+	return &number
+}
+
+func TestCreditTransferBusinessRules(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v08_prefixed.xml"))
+	assert.Nil(t, err)
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+
+	message := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	date := common.ISODate(time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC))
+	message.GrpHdr.NbOfTxs = "2"
+	message.GrpHdr.CtrlSum = decimalNumber("1500")
+	message.GrpHdr.TtlIntrBkSttlmAmt = &pacs_v08.ActiveCurrencyAndAmount{
+		Value: common.ActiveCurrencyAndAmountSimpleType(common.MustParseDecimal("1500.20")),
+		Ccy:   "EUR",
+	}
+	message.GrpHdr.IntrBkSttlmDt = &date
+	message.CdtTrfTxInf[0].IntrBkSttlmDt = &date
+
+	err = doc.Validate()
+	assert.Equal(t, []string{
+		"/Document/FIToFICstmrCdtTrf/GrpHdr/NbOfTxs",
+		"/Document/FIToFICstmrCdtTrf/GrpHdr/CtrlSum",
+		"/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/IntrBkSttlmAmt/@Ccy",
+		"/Document/FIToFICstmrCdtTrf/GrpHdr/TtlIntrBkSttlmAmt",
+		"/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/IntrBkSttlmDt",
+	}, rulePaths(t, err))
+	assert.Contains(t, err.Error(), `/Document/FIToFICstmrCdtTrf/GrpHdr/NbOfTxs: GroupHeader93 has invalid consistency (must equal the number of transactions 1) (value: "2")`)
+	assert.Contains(t, err.Error(), `(must equal the sum of the transaction amounts 1500.25) (value: "1500")`)
+	assert.Contains(t, err.Error(), `TotalInterbankSettlementAmountRule (must be the currency EUR of the total interbank settlement amount) (value: "USD")`)
+	assert.Contains(t, err.Error(), `TotalInterbankSettlementAmountAndSumRule (must equal the sum of the interbank settlement amounts 1500.25) (value: "1500.20")`)
+	assert.Contains(t, err.Error(), `GroupHeaderInterbankSettlementDateRule (not allowed when the group header has an interbank settlement date) (value: "2022-01-03")`)
+
+	// the rules are reported together with the facets
+	message.CdtTrfTxInf[0].ChrgBr = "XXXX"
+	assert.Contains(t, rulePaths(t, doc.Validate()), "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/ChrgBr")
+
+	// equal amounts with another scale are consistent
+	message.CdtTrfTxInf[0].ChrgBr = "SHAR"
+	message.CdtTrfTxInf[0].IntrBkSttlmDt = nil
+	message.GrpHdr.NbOfTxs = "1"
+	message.GrpHdr.CtrlSum = decimalNumber("1500.25")
+	message.GrpHdr.TtlIntrBkSttlmAmt.Value = common.ActiveCurrencyAndAmountSimpleType(common.MustParseDecimal("1500.250"))
+	message.GrpHdr.TtlIntrBkSttlmAmt.Ccy = "USD"
+	assert.Nil(t, doc.Validate())
+}
+
+func TestPaymentInformationBusinessRules(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pain_v09.xml"))
+	assert.Nil(t, err)
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+
+	message := doc.InspectMessage().(*pain_v09.CustomerCreditTransferInitiationV09)
+	nbOfTxs := common.Max15NumericText("3")
+	message.PmtInf[0].NbOfTxs = &nbOfTxs
+	message.PmtInf[0].CtrlSum = decimalNumber("1500.51")
+	assert.Equal(t, []string{
+		"/Document/CstmrCdtTrfInitn/PmtInf[1]/NbOfTxs",
+		"/Document/CstmrCdtTrfInitn/PmtInf[1]/CtrlSum",
+	}, rulePaths(t, doc.Validate()))
+
+	// the group header counts the transactions of every payment information block
+	message.PmtInf[0].NbOfTxs = nil
+	message.PmtInf[0].CtrlSum = nil
+	message.PmtInf = append(message.PmtInf, message.PmtInf[0])
+	assert.Equal(t, []string{
+		"/Document/CstmrCdtTrfInitn/GrpHdr/NbOfTxs",
+		"/Document/CstmrCdtTrfInitn/GrpHdr/CtrlSum",
+	}, rulePaths(t, doc.Validate()))
+
+	// direct debits are summed from their instructed amounts
+	amount := func(value string) pain_v09.ActiveOrHistoricCurrencyAndAmount {
+		return pain_v09.ActiveOrHistoricCurrencyAndAmount{Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(common.MustParseDecimal(value)), Ccy: "CHF"}
+	}
+	debits := &pain_v09.CustomerDirectDebitInitiationV09{
+		GrpHdr: pain_v09.GroupHeader83{NbOfTxs: "2", CtrlSum: decimalNumber("4.5")},
+		PmtInf: []pain_v09.PaymentInstruction37{{
+			CtrlSum: decimalNumber("4"),
+			DrctDbtTxInf: []pain_v09.DirectDebitTransactionInformation23{
+				{InstdAmt: amount("2.25")},
+				{InstdAmt: amount("2.25")},
+			},
+		}},
+	}
+	errs := checkBusinessRules(utils.DocumentPain00800109NameSpace, debits, "/Document")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "/Document/CstmrDrctDbtInitn/PmtInf[1]/CtrlSum", errs[0].Path)
+	assert.Equal(t, "PaymentInstruction37", errs[0].Type)
+	assert.Equal(t, "4", errs[0].Value)
+}
+
+func TestRegisterBusinessRule(t *testing.T) {
+	defer func() {
+		businessRules.Lock()
+		delete(businessRules.rules, "pain.007")
+		businessRules.Unlock()
+	}()
+
+	RegisterBusinessRule("pain.007", func(message Iso20022Message, path string) utils.ValidationErrors {
+		reversal := message.(*pain_v10.CustomerPaymentReversalV10)
+		if reversal.GrpHdr.MsgId == "" {
+			return nil
+		}
+		return utils.ValidationErrors{{Path: path + "/GrpHdr/MsgId", Rule: "proprietary rule", Value: string(reversal.GrpHdr.MsgId)}}
+	})
+
+	errs := checkBusinessRules(utils.DocumentPain00700110NameSpace, &pain_v10.CustomerPaymentReversalV10{
+		GrpHdr: pain_v10.GroupHeader88{MsgId: "MSG"},
+	}, "/Document")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "/Document/CstmrPmtRvsl/GrpHdr/MsgId: invalid proprietary rule (value: \"MSG\")", errs[0].Error())
+
+	// messages without rules pass
+	assert.Empty(t, checkBusinessRules(utils.DocumentPacs00200111NameSpace, &pacs_v08.FIToFIPaymentStatusReportV08{}, "/Document"))
+}
@@ -0,0 +1,256 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+// Summary holds the references identifying a message and its transactions
+type Summary struct {
+	NameSpace string
+	// MessageType is the message identifier, e.g. pacs.008.001.08
+	MessageType      string
+	MessageId        string
+	CreationDateTime *time.Time `json:",omitempty"`
+	// OriginalMessageId is the message answered by a status report, a return, a reversal or an investigation
+	OriginalMessageId string `json:",omitempty"`
+	// Status is the status of the original group of a status report or of the resolution of an investigation
+	Status       string `json:",omitempty"`
+	Transactions []TransactionSummary
+}
+
+// TransactionSummary holds the references, the amount, the date and the parties of a transaction.
+//
+// The transactions of status reports, returns and cancellations carry the references of their original transaction.
+// Date is the settlement, execution, collection or booking date (YYYY-MM-DD). Status is the status of the transaction,
+// of its payment information or group in a status report, or of its entry. Remittance is the creditor reference
+// or else the first unstructured remittance line. The parties are the names of the debtor and the creditor and
+// the BICs of their agents.
+type TransactionSummary struct {
+	PaymentInformationId string `json:",omitempty"`
+	InstructionId        string `json:",omitempty"`
+	EndToEndId           string `json:",omitempty"`
+	TransactionId        string `json:",omitempty"`
+	UETR                 string `json:",omitempty"`
+	Amount               common.Decimal
+	Currency             string `json:",omitempty"`
+	Date                 string `json:",omitempty"`
+	Status               string `json:",omitempty"`
+	Remittance           string `json:",omitempty"`
+	Debtor               string `json:",omitempty"`
+	Creditor             string `json:",omitempty"`
+	DebtorAgent          string `json:",omitempty"`
+	CreditorAgent        string `json:",omitempty"`
+}
+
+var (
+	summaryDecimalType = reflect.TypeOf(common.Decimal{})
+
+	// the elements holding the amount of a transaction, the first one present is used
+	summaryAmounts = []string{
+		"IntrBkSttlmAmt", "Amt/InstdAmt", "Amt/EqvtAmt/Amt", "InstdAmt", "Amt", "RtrdIntrBkSttlmAmt",
+		"RvsdIntrBkSttlmAmt", "OrgnlIntrBkSttlmAmt", "OrgnlInstdAmt", "OrgnlTxRef/IntrBkSttlmAmt",
+		"OrgnlTxRef/Amt/InstdAmt", "OrgnlTxRef/Amt/EqvtAmt/Amt",
+	}
+	// the elements holding the date of a transaction, of its payment information or of its entry
+	summaryDates = []string{
+		"IntrBkSttlmDt", "GrpHdr/IntrBkSttlmDt", "ReqdExctnDt/Dt", "ReqdExctnDt/DtTm", "ReqdExctnDt", "ReqdColltnDt",
+		"BookgDt/Dt", "BookgDt/DtTm", "ValDt/Dt", "ValDt/DtTm", "OrgnlIntrBkSttlmDt", "OrgnlTxRef/IntrBkSttlmDt",
+		"OrgnlTxRef/ReqdExctnDt/Dt", "OrgnlTxRef/ReqdExctnDt/DtTm", "OrgnlTxRef/ReqdExctnDt", "OrgnlTxRef/ReqdColltnDt",
+	}
+	// the elements holding the status of a transaction, of its payment information, of its entry or of its group
+	summaryStatuses = []string{"TxSts", "TxCxlSts", "PmtInfSts", "Sts/Cd", "Sts"}
+	// the elements of a message holding the status of the original group
+	summaryGroupStatuses = []string{
+		"OrgnlGrpInfAndSts/GrpSts", "CxlDtls/OrgnlGrpInfAndSts/GrpCxlSts", "OrgnlGrpInfAndSts/GrpCxlSts", "Sts/Conf",
+	}
+	// the elements of a message holding the id of the original message
+	summaryOriginalMessageIds = []string{
+		"OrgnlGrpInfAndSts/OrgnlMsgId", "OrgnlGrpInf/OrgnlMsgId", "OrgnlGrpInfAndRtr/OrgnlMsgId",
+		"OrgnlGrpInfAndRvsl/OrgnlMsgId", "Undrlyg/OrgnlGrpInfAndCxl/OrgnlMsgId", "CxlDtls/OrgnlGrpInfAndSts/OrgnlMsgId",
+	}
+	// the elements holding the remittance reference of a transaction or of its original transaction
+	summaryRemittances = []string{
+		"RmtInf/Strd/CdtrRefInf/Ref", "RmtInf/Ustrd", "OrgnlTxRef/RmtInf/Strd/CdtrRefInf/Ref", "OrgnlTxRef/RmtInf/Ustrd",
+	}
+	// the blocks holding the parties of a transaction: the transaction, the related parties of an entry and
+	// the original transaction of a report
+	summaryParties = []string{"", "RltdPties/", "OrgnlTxRef/"}
+	summaryAgents  = []string{"", "RltdAgts/", "OrgnlTxRef/"}
+)
+
+// Summarize returns the message identification and the transaction references of a document.
+//
+// Transactions are the elements with a payment identification (PmtId), the transaction details of the entries
+// of statements and notifications (Refs) and the elements referencing an original transaction (OrgnlEndToEndId).
+func Summarize(doc Iso20022Document) Summary {
+	summary := Summary{NameSpace: doc.NameSpace()}
+	if info, found := LookupMessage(summary.NameSpace); found {
+		summary.MessageType = info.Identifier()
+	}
+	message := doc.InspectMessage()
+	if message == nil {
+		return summary
+	}
+
+	if header := groupHeader(message); header.IsValid() {
+		summary.MessageId = summaryText(header, "MsgId")
+		if created, ok := timeValue(header.FieldByName("CreDtTm")); ok {
+			summary.CreationDateTime = &created
+		}
+	} else if assignment := summaryField(reflect.ValueOf(message), "Assgnmt"); assignment.IsValid() {
+		// the investigations identify their assignment instead of a message
+		summary.MessageId = summaryText(assignment, "Id")
+		if created, ok := timeValue(assignment.FieldByName("CreDtTm")); ok {
+			summary.CreationDateTime = &created
+		}
+	}
+
+	summary.OriginalMessageId = summaryText(reflect.ValueOf(message), summaryOriginalMessageIds...)
+	summary.Status = summaryText(reflect.ValueOf(message), summaryGroupStatuses...)
+
+	// the transactions without a status of their own take the status of the group
+	summarizeTransactions(reflect.ValueOf(message), TransactionSummary{Status: summary.Status}, &summary.Transactions)
+	return summary
+}
+
+// summarizeTransactions appends the transactions found below value. A transaction without a party, a date or
+// a payment information id takes the one of its enclosing block, e.g. the debtor of the payment information of
+// a pain.001 or the booking date of the entry of a camt.053.
+func summarizeTransactions(value reflect.Value, block TransactionSummary, transactions *[]TransactionSummary) {
+	value = reflect.Indirect(value)
+	switch value.Kind() {
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			summarizeTransactions(value.Index(i), block, transactions)
+		}
+	case reflect.Struct:
+		block = summarizeBlock(value, block)
+		if isSummaryTransaction(value) {
+			*transactions = append(*transactions, summarizeTransaction(value, block))
+			return
+		}
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).IsExported() {
+				summarizeTransactions(value.Field(i), block, transactions)
+			}
+		}
+	}
+}
+
+func isSummaryTransaction(value reflect.Value) bool {
+	if _, found := value.Type().FieldByName("OrgnlEndToEndId"); found {
+		return true
+	}
+	for _, name := range []string{"PmtId", "Refs"} {
+		field, found := value.Type().FieldByName(name)
+		if !found {
+			continue
+		}
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct {
+			if _, found := fieldType.FieldByName("EndToEndId"); found {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func summarizeTransaction(tx reflect.Value, summary TransactionSummary) TransactionSummary {
+	reference := func(name string) string {
+		return summaryText(tx, "PmtId/"+name, "Refs/"+name, "Orgnl"+name)
+	}
+	summary.InstructionId = reference("InstrId")
+	summary.EndToEndId = reference("EndToEndId")
+	summary.TransactionId = reference("TxId")
+	summary.UETR = reference("UETR")
+	summary.Remittance = summaryText(tx, summaryRemittances...)
+
+	for _, path := range summaryAmounts {
+		amount := summaryField(tx, path)
+		value := summaryField(amount, "Value")
+		if value.IsValid() && value.Type().ConvertibleTo(summaryDecimalType) {
+			summary.Amount = value.Convert(summaryDecimalType).Interface().(common.Decimal)
+			summary.Currency = summaryText(amount, "Ccy")
+			break
+		}
+	}
+	return summary
+}
+
+// summarizeBlock returns the payment information id, the date, the status and the parties of the block,
+// the ones it doesn't name are kept
+func summarizeBlock(block reflect.Value, summary TransactionSummary) TransactionSummary {
+	if id := summaryText(block, "PmtInfId", "OrgnlPmtInfId"); id != "" {
+		summary.PaymentInformationId = id
+	}
+	if status := summaryText(block, summaryStatuses...); status != "" {
+		summary.Status = status
+	}
+	for _, path := range summaryDates {
+		if date, ok := timeValue(summaryField(block, path)); ok {
+			summary.Date = date.Format("2006-01-02")
+			break
+		}
+	}
+
+	var debtors, creditors, debtorAgents, creditorAgents []string
+	for _, prefix := range summaryParties {
+		debtors = append(debtors, prefix+"Dbtr/Nm", prefix+"Dbtr/Pty/Nm")
+		creditors = append(creditors, prefix+"Cdtr/Nm", prefix+"Cdtr/Pty/Nm")
+	}
+	for _, prefix := range summaryAgents {
+		debtorAgents = append(debtorAgents, prefix+"DbtrAgt/FinInstnId/BICFI", prefix+"DbtrAgt/FinInstnId/BIC")
+		creditorAgents = append(creditorAgents, prefix+"CdtrAgt/FinInstnId/BICFI", prefix+"CdtrAgt/FinInstnId/BIC")
+	}
+	for field, paths := range map[*string][]string{
+		&summary.Debtor:        debtors,
+		&summary.Creditor:      creditors,
+		&summary.DebtorAgent:   debtorAgents,
+		&summary.CreditorAgent: creditorAgents,
+	} {
+		if text := summaryText(block, paths...); text != "" {
+			*field = text
+		}
+	}
+	return summary
+}
+
+// summaryField returns the element at the path of names separated by slashes, or an invalid value.
+// A repeated element is read from its first occurrence.
+func summaryField(value reflect.Value, path string) reflect.Value {
+	for _, name := range strings.Split(path, "/") {
+		if value = reflect.Indirect(value); value.Kind() == reflect.Slice && value.Len() > 0 {
+			value = reflect.Indirect(value.Index(0))
+		}
+		if value.Kind() != reflect.Struct {
+			return reflect.Value{}
+		}
+		value = value.FieldByName(name)
+	}
+	if value = reflect.Indirect(value); value.Kind() == reflect.Slice && value.Len() > 0 {
+		value = reflect.Indirect(value.Index(0))
+	}
+	return value
+}
+
+// summaryText returns the first text found at the paths
+func summaryText(value reflect.Value, paths ...string) string {
+	for _, path := range paths {
+		if field := summaryField(value, path); field.Kind() == reflect.String && field.String() != "" {
+			return field.String()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package document
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/pacs_v11"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func TestSummarize(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_bah_pacs_v08.xml"))
+	assert.Nil(t, err)
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+
+	summary := Summarize(doc)
+	assert.Equal(t, utils.DocumentPacs00800108NameSpace, summary.NameSpace)
+	assert.Equal(t, "pacs.008.001.08", summary.MessageType)
+	assert.Equal(t, "MSG-1", summary.MessageId)
+	assert.Equal(t, time.Date(2022, 1, 2, 10, 0, 0, 0, time.UTC), summary.CreationDateTime.UTC())
+	assert.Equal(t, []TransactionSummary{{
+		EndToEndId:    "E2E-1",
+		Amount:        common.MustParseDecimal("1500.25"),
+		Currency:      "USD",
+		Debtor:        "Debtor",
+		Creditor:      "Creditor",
+		DebtorAgent:   "DEUTDEFFXXX",
+		CreditorAgent: "CHASUS33XXX",
+	}}, summary.Transactions)
+}
+
+func TestSummarizeInheritedParties(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pain_v09.xml"))
+	assert.Nil(t, err)
+	doc, err := ParseIso20022Document(input)
+	assert.Nil(t, err)
+
+	// the debtor and the execution date of a pain.001 are given by the payment information
+	summary := Summarize(doc)
+	assert.Equal(t, "ABC/220315/CCT001", summary.MessageId)
+	assert.Len(t, summary.Transactions, 2)
+	assert.Equal(t, "ABC/220315/CCT001/01", summary.Transactions[0].InstructionId)
+	assert.Equal(t, "Invoice 4562", summary.Transactions[0].Remittance)
+	assert.Equal(t, "", summary.Transactions[1].Remittance)
+	assert.Equal(t, "ABC/4563/2022-03-08", summary.Transactions[1].EndToEndId)
+	assert.Equal(t, "500.50", summary.Transactions[1].Amount.String())
+	assert.Equal(t, "EUR", summary.Transactions[1].Currency)
+	assert.Equal(t, "ABC Corporation", summary.Transactions[1].Debtor)
+	assert.Equal(t, "COBADEFFXXX", summary.Transactions[1].DebtorAgent)
+	assert.Equal(t, "GHI Semiconductors", summary.Transactions[1].Creditor)
+	assert.Equal(t, "ABC/086", summary.Transactions[1].PaymentInformationId)
+	assert.Equal(t, "2022-03-16", summary.Transactions[1].Date)
+}
+
+func TestSummarizeOriginalTransactions(t *testing.T) {
+	endToEndId := common.Max35Text("E2E-1")
+	uetr := common.UUIDv4Identifier("8a562c67-ca16-48ba-b074-65581be6f001")
+	name := common.Max140Text("Debtor")
+	groupStatus := pacs_v11.ExternalPaymentGroupStatus1Code("PART")
+	status := pacs_v11.ExternalPaymentTransactionStatus1Code("RJCT")
+	otherEndToEndId := common.Max35Text("E2E-2")
+	doc := &Iso20022DocumentObject{
+		XMLName: xml.Name{Local: "Document"},
+		Attrs:   canonicalAttrs(utils.DocumentPacs00200111NameSpace),
+		Message: &pacs_v11.FIToFIPaymentStatusReportV11{
+			GrpHdr: pacs_v11.GroupHeader91{MsgId: "STATUS-1"},
+			OrgnlGrpInfAndSts: []pacs_v11.OriginalGroupHeader17{{
+				OrgnlMsgId:   "PAYMENT-1",
+				OrgnlMsgNmId: "pacs.008.001.08",
+				GrpSts:       &groupStatus,
+			}},
+			TxInfAndSts: []pacs_v11.PaymentTransaction123{{
+				OrgnlEndToEndId: &endToEndId,
+				TxSts:           &status,
+				OrgnlUETR:       &uetr,
+				OrgnlTxRef: &pacs_v11.OriginalTransactionReference31{
+					IntrBkSttlmAmt: &pacs_v11.ActiveOrHistoricCurrencyAndAmount{
+						Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(common.MustParseDecimal("12.50")),
+						Ccy:   "EUR",
+					},
+					Dbtr: &pacs_v11.Party40Choice{Pty: &pacs_v11.PartyIdentification135{Nm: &name}},
+				},
+			}, {
+				OrgnlEndToEndId: &otherEndToEndId,
+			}},
+		},
+	}
+
+	summary := Summarize(doc)
+	assert.Equal(t, "pacs.002.001.11", summary.MessageType)
+	assert.Equal(t, "STATUS-1", summary.MessageId)
+	assert.Nil(t, summary.CreationDateTime)
+	assert.Equal(t, "PAYMENT-1", summary.OriginalMessageId)
+	assert.Equal(t, "PART", summary.Status)
+	// the transaction without a status takes the status of the group
+	assert.Equal(t, []TransactionSummary{{
+		EndToEndId: "E2E-1",
+		UETR:       "8a562c67-ca16-48ba-b074-65581be6f001",
+		Amount:     common.MustParseDecimal("12.50"),
+		Currency:   "EUR",
+		Status:     "RJCT",
+		Debtor:     "Debtor",
+	}, {
+		EndToEndId: "E2E-2",
+		Status:     "PART",
+	}}, summary.Transactions)
+}
@@ -0,0 +1,442 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package duplicate detects the documents and the transactions which are sent again, e.g. a pain.001 or a camt.054
+// resent by a bank.
+//
+// A document is reduced to a fingerprint made of its message id and of the payment information id, the end to end
+// id, the UETR, the amount, the date and the counterparty of its transactions. The fingerprints are recorded by a
+// Store, and the Detector compares a new document with the fingerprints of the same message type recorded within
+// its time window:
+//
+//   - a same message id, payment information id or UETR, or a same end to end id with a same amount, is an exact
+//     duplicate
+//   - a same end to end id with another amount, or a same amount, currency and date with a similar counterparty,
+//     is a probable duplicate
+//
+// The transactions of the document are compared with each other too.
+package duplicate
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/base/stime"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	// DefaultWindow is the time a fingerprint is compared with the new documents
+	DefaultWindow = 24 * time.Hour
+	// DefaultThreshold is the similarity of the counterparties above which a same amount and date is a probable duplicate
+	DefaultThreshold = 0.85
+
+	// notProvided is the end to end id of the transactions without one, it doesn't identify them
+	notProvided = "NOTPROVIDED"
+)
+
+// Kind tells how sure the detector is of a duplicate
+type Kind string
+
+const (
+	// Exact is a duplicate sharing an identifier which has to be unique
+	Exact Kind = "exact"
+	// Probable is a duplicate which can be a genuine transaction looking alike
+	Probable Kind = "probable"
+)
+
+// Reason is what the duplicate and its original share
+type Reason string
+
+const (
+	ReasonMessageId            Reason = "message id"
+	ReasonPaymentInformationId Reason = "payment information id"
+	ReasonUETR                 Reason = "uetr"
+	ReasonEndToEndId           Reason = "end to end id"
+	ReasonSimilarity           Reason = "amount, date and counterparty"
+)
+
+// Fingerprint holds the references of a document compared by the detector
+type Fingerprint struct {
+	DocumentID string
+	// MessageType is the business area and the message of the document, e.g. pain.001, without variant and version
+	MessageType  string
+	MessageId    string
+	SeenAt       time.Time
+	Transactions []TransactionFingerprint
+}
+
+// TransactionFingerprint holds the references of a transaction compared by the detector
+type TransactionFingerprint struct {
+	PaymentInformationId string `json:",omitempty"`
+	EndToEndId           string `json:",omitempty"`
+	UETR                 string `json:",omitempty"`
+	// Amount is the amount without trailing zeros, empty when the transaction has no currency
+	Amount   string `json:",omitempty"`
+	Currency string `json:",omitempty"`
+	// Date is the settlement, execution or booking date of the transaction (YYYY-MM-DD), the creation date of the
+	// document when it has none
+	Date string `json:",omitempty"`
+	// Counterparty is the name of the creditor, or the name of the debtor when the creditor isn't named
+	Counterparty string `json:",omitempty"`
+}
+
+// NewFingerprint returns the fingerprint of the document
+func NewFingerprint(id string, doc document.Iso20022Document, seenAt time.Time) Fingerprint {
+	summary := document.Summarize(doc)
+	fingerprint := Fingerprint{
+		DocumentID:  id,
+		MessageType: messageType(summary.MessageType),
+		MessageId:   summary.MessageId,
+		SeenAt:      seenAt.UTC().Truncate(time.Second),
+	}
+	created := ""
+	if summary.CreationDateTime != nil {
+		created = summary.CreationDateTime.Format("2006-01-02")
+	}
+	for _, txn := range summary.Transactions {
+		transaction := TransactionFingerprint{
+			PaymentInformationId: txn.PaymentInformationId,
+			EndToEndId:           txn.EndToEndId,
+			UETR:                 strings.ToLower(txn.UETR),
+			Currency:             txn.Currency,
+			Date:                 txn.Date,
+			Counterparty:         txn.Creditor,
+		}
+		if transaction.EndToEndId == notProvided {
+			transaction.EndToEndId = ""
+		}
+		if txn.Currency != "" {
+			transaction.Amount = amountKey(txn.Amount)
+		}
+		if transaction.Date == "" {
+			transaction.Date = created
+		}
+		if transaction.Counterparty == "" {
+			transaction.Counterparty = txn.Debtor
+		}
+		fingerprint.Transactions = append(fingerprint.Transactions, transaction)
+	}
+	return fingerprint
+}
+
+// Report lists the duplicates found in a document
+type Report struct {
+	DocumentID  string
+	MessageType string
+	MessageId   string
+	Duplicates  []Match
+}
+
+// HasExact reports whether the document has an exact duplicate
+func (r Report) HasExact() bool {
+	for _, match := range r.Duplicates {
+		if match.Kind == Exact {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns the error of the first exact duplicate, nil when the document has none
+func (r Report) Err() error {
+	for _, match := range r.Duplicates {
+		if match.Kind == Exact {
+			return utils.NewErrDuplicateDocument(string(match.Reason), match.Reference, match.Original.MessageId)
+		}
+	}
+	return nil
+}
+
+// Match is a duplicate of the document, or of one of its transactions, and its original
+type Match struct {
+	Kind   Kind
+	Reason Reason
+	// Reference is the shared identifier, or the amount and the currency of a similar transaction
+	Reference string
+	// Transaction is the index of the duplicate transaction in the document, -1 for the whole document
+	Transaction int
+	Original    Original
+	// Similarity is the similarity of the counterparties, between 0 and 1, of a ReasonSimilarity match
+	Similarity float64 `json:",omitempty"`
+}
+
+// Original is the document, or the transaction, first seen
+type Original struct {
+	DocumentID string
+	MessageId  string
+	SeenAt     time.Time
+	// Transaction is the index of the original transaction in its document, -1 for the whole document
+	Transaction int
+}
+
+// Detector reports the duplicates of the documents and records their fingerprints.
+// A detector is safe for concurrent use.
+type Detector struct {
+	store     Store
+	window    time.Duration
+	threshold float64
+	clock     stime.TimeService
+	mu        sync.Mutex
+}
+
+// Option changes the settings of a detector
+type Option func(*Detector)
+
+// WithWindow sets the time a fingerprint is compared with the new documents, DefaultWindow by default
+func WithWindow(window time.Duration) Option {
+	return func(d *Detector) {
+		if window > 0 {
+			d.window = window
+		}
+	}
+}
+
+// WithThreshold sets the similarity of the counterparties, between 0 and 1, from which transactions with a same
+// amount and date are probable duplicates, DefaultThreshold by default
+func WithThreshold(threshold float64) Option {
+	return func(d *Detector) {
+		if threshold > 0 && threshold <= 1 {
+			d.threshold = threshold
+		}
+	}
+}
+
+// WithClock sets the time service giving the time documents are seen
+func WithClock(clock stime.TimeService) Option {
+	return func(d *Detector) {
+		d.clock = clock
+	}
+}
+
+// NewDetector returns a detector recording the fingerprints in the store
+func NewDetector(store Store, opts ...Option) *Detector {
+	d := &Detector{
+		store:     store,
+		window:    DefaultWindow,
+		threshold: DefaultThreshold,
+		clock:     stime.NewSystemTimeService(),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Window returns the time a fingerprint is compared with the new documents
+func (d *Detector) Window() time.Duration {
+	return d.window
+}
+
+// Detect reports the duplicates of the document without recording it
+func (d *Detector) Detect(id string, doc document.Iso20022Document) (*Report, error) {
+	report, _, err := d.detect(id, doc)
+	return report, err
+}
+
+// Check reports the duplicates of the document and records it, the id of the document is generated when it's empty
+func (d *Detector) Check(id string, doc document.Iso20022Document) (*Report, error) {
+	if id == "" {
+		id = base.ID()
+	}
+	// the document is recorded before another one is compared, so two copies checked at once find each other
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	report, fingerprint, err := d.detect(id, doc)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.store.Save(fingerprint); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Purge deletes the fingerprints which left the window and returns their number
+func (d *Detector) Purge() (int64, error) {
+	return d.store.Purge(d.clock.Now().Add(-d.window))
+}
+
+func (d *Detector) detect(id string, doc document.Iso20022Document) (*Report, Fingerprint, error) {
+	now := d.clock.Now()
+	fingerprint := NewFingerprint(id, doc, now)
+	candidates, err := d.store.Candidates(fingerprint, now.Add(-d.window))
+	if err != nil {
+		return nil, fingerprint, err
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].SeenAt.Before(candidates[j].SeenAt)
+	})
+
+	report := &Report{
+		DocumentID:  fingerprint.DocumentID,
+		MessageType: fingerprint.MessageType,
+		MessageId:   fingerprint.MessageId,
+		Duplicates:  []Match{},
+	}
+	for _, candidate := range candidates {
+		if candidate.DocumentID == fingerprint.DocumentID || candidate.MessageType != fingerprint.MessageType {
+			continue
+		}
+		report.Duplicates = append(report.Duplicates, d.compare(fingerprint, candidate)...)
+	}
+	report.Duplicates = append(report.Duplicates, d.compareTransactions(fingerprint)...)
+	return report, fingerprint, nil
+}
+
+// compare returns the duplicates of fingerprint in the candidate. A duplicate message is reported alone, and the
+// transactions of a duplicate payment information aren't reported one by one.
+func (d *Detector) compare(fingerprint, candidate Fingerprint) []Match {
+	original := Original{DocumentID: candidate.DocumentID, MessageId: candidate.MessageId, SeenAt: candidate.SeenAt, Transaction: -1}
+	if fingerprint.MessageId != "" && fingerprint.MessageId == candidate.MessageId {
+		return []Match{{Kind: Exact, Reason: ReasonMessageId, Reference: fingerprint.MessageId, Transaction: -1, Original: original}}
+	}
+
+	var matches []Match
+	duplicated := map[string]bool{}
+	for i, txn := range fingerprint.Transactions {
+		id := txn.PaymentInformationId
+		if id == "" || duplicated[id] {
+			continue
+		}
+		for j, other := range candidate.Transactions {
+			if other.PaymentInformationId == id {
+				duplicated[id] = true
+				match := Match{Kind: Exact, Reason: ReasonPaymentInformationId, Reference: id, Transaction: i, Original: original}
+				match.Original.Transaction = j
+				matches = append(matches, match)
+				break
+			}
+		}
+	}
+	for i, txn := range fingerprint.Transactions {
+		if duplicated[txn.PaymentInformationId] {
+			continue
+		}
+		for j, other := range candidate.Transactions {
+			if match, ok := d.compareTransaction(txn, other); ok {
+				match.Transaction = i
+				match.Original = original
+				match.Original.Transaction = j
+				matches = append(matches, match)
+			}
+		}
+	}
+	return matches
+}
+
+// compareTransactions returns the transactions of the document duplicating an earlier transaction of the document
+func (d *Detector) compareTransactions(fingerprint Fingerprint) []Match {
+	var matches []Match
+	for i, txn := range fingerprint.Transactions {
+		for j, other := range fingerprint.Transactions[:i] {
+			if match, ok := d.compareTransaction(txn, other); ok {
+				match.Transaction = i
+				match.Original = Original{DocumentID: fingerprint.DocumentID, MessageId: fingerprint.MessageId,
+					SeenAt: fingerprint.SeenAt, Transaction: j}
+				matches = append(matches, match)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// compareTransaction returns the strongest match of two transactions
+func (d *Detector) compareTransaction(txn, other TransactionFingerprint) (Match, bool) {
+	sameAmount := txn.Amount != "" && txn.Amount == other.Amount && txn.Currency == other.Currency
+	switch {
+	case txn.UETR != "" && txn.UETR == other.UETR:
+		return Match{Kind: Exact, Reason: ReasonUETR, Reference: txn.UETR}, true
+	case txn.EndToEndId != "" && txn.EndToEndId == other.EndToEndId && sameAmount:
+		return Match{Kind: Exact, Reason: ReasonEndToEndId, Reference: txn.EndToEndId}, true
+	case txn.EndToEndId != "" && txn.EndToEndId == other.EndToEndId:
+		return Match{Kind: Probable, Reason: ReasonEndToEndId, Reference: txn.EndToEndId}, true
+	case sameAmount && txn.Date != "" && txn.Date == other.Date:
+		similarity := Similarity(txn.Counterparty, other.Counterparty)
+		if similarity >= d.threshold {
+			return Match{Kind: Probable, Reason: ReasonSimilarity, Reference: txn.Amount + " " + txn.Currency,
+				Similarity: similarity}, true
+		}
+	}
+	return Match{}, false
+}
+
+// Similarity returns the similarity of two names between 0 and 1, from the edit distance of their letters and
+// digits ignoring case. Names without letters or digits aren't similar.
+func Similarity(a, b string) float64 {
+	x, y := []rune(normalizeName(a)), []rune(normalizeName(b))
+	if len(x) == 0 || len(y) == 0 {
+		return 0
+	}
+	longest := len(x)
+	if len(y) > longest {
+		longest = len(y)
+	}
+	similarity := 1 - float64(levenshtein(x, y))/float64(longest)
+	return math.Round(similarity*1000) / 1000
+}
+
+func normalizeName(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
+}
+
+func levenshtein(x, y []rune) int {
+	previous := make([]int, len(y)+1)
+	current := make([]int, len(y)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		current[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(y)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// messageType returns the business area and the message of a message identifier, pain.001 for pain.001.001.09
+func messageType(identifier string) string {
+	parts := strings.SplitN(identifier, ".", 3)
+	if len(parts) < 2 {
+		return identifier
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// amountKey returns the amount without trailing zeros, so 10.50 and 10.5 are the same amount
+func amountKey(amount common.Decimal) string {
+	value := amount.String()
+	if strings.Contains(value, ".") {
+		value = strings.TrimRight(strings.TrimRight(value, "0"), ".")
+	}
+	return value
+}
@@ -0,0 +1,218 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package duplicate
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/base/database"
+	"github.com/moov-io/base/stime"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readDocument parses valid_pain_v09.xml after replacing the pairs of old and new strings
+func readDocument(t *testing.T, replacements ...string) document.Iso20022Document {
+	raw, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pain_v09.xml"))
+	require.Nil(t, err)
+	doc, err := document.ParseIso20022Document([]byte(strings.NewReplacer(replacements...).Replace(string(raw))))
+	require.Nil(t, err)
+	return doc
+}
+
+func testStores(t *testing.T) map[string]Store {
+	store, err := OpenSQLStore(context.Background(), nil, database.DatabaseConfig{
+		SQLite: &database.SQLiteConfig{Path: filepath.Join(t.TempDir(), "duplicates.db")},
+	})
+	require.Nil(t, err)
+	t.Cleanup(func() { store.Close() })
+	return map[string]Store{"memory": NewMemoryStore(), "sql": store}
+}
+
+func TestFingerprint(t *testing.T) {
+	seenAt := time.Date(2022, 3, 15, 14, 30, 0, 0, time.UTC)
+	fingerprint := NewFingerprint("doc-1", readDocument(t), seenAt)
+	assert.Equal(t, "pain.001", fingerprint.MessageType)
+	assert.Equal(t, "ABC/220315/CCT001", fingerprint.MessageId)
+	assert.Equal(t, seenAt, fingerprint.SeenAt)
+	assert.Equal(t, []TransactionFingerprint{{
+		PaymentInformationId: "ABC/086",
+		EndToEndId:           "ABC/4562/2022-03-08",
+		Amount:               "1000",
+		Currency:             "EUR",
+		Date:                 "2022-03-16",
+		Counterparty:         "DEF Electronics",
+	}, {
+		PaymentInformationId: "ABC/086",
+		EndToEndId:           "ABC/4563/2022-03-08",
+		Amount:               "500.5",
+		Currency:             "EUR",
+		Date:                 "2022-03-16",
+		Counterparty:         "GHI Semiconductors",
+	}}, fingerprint.Transactions)
+
+	// a missing end to end id doesn't identify the transaction
+	fingerprint = NewFingerprint("doc-2", readDocument(t, "ABC/4562/2022-03-08", "NOTPROVIDED"), seenAt)
+	assert.Equal(t, "", fingerprint.Transactions[0].EndToEndId)
+}
+
+func TestSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, Similarity("DEF Electronics", "def electronics"))
+	assert.Equal(t, 1.0, Similarity("DEF  Electronics.", "DEF Electronics"))
+	assert.Equal(t, 0.933, Similarity("DEF Electronic", "DEF Electronics"))
+	assert.Less(t, Similarity("DEF Electronics", "GHI Semiconductors"), 0.5)
+	assert.Equal(t, 0.0, Similarity("", ""))
+}
+
+func TestDetector(t *testing.T) {
+	for name, store := range testStores(t) {
+		t.Run(name, func(t *testing.T) {
+			clock := stime.NewStaticTimeService()
+			clock.Change(time.Date(2022, 3, 15, 14, 30, 0, 0, time.UTC))
+			detector := NewDetector(store, WithClock(clock), WithWindow(time.Hour))
+			assert.Equal(t, time.Hour, detector.Window())
+
+			report, err := detector.Check("original", readDocument(t))
+			require.Nil(t, err)
+			assert.Equal(t, "original", report.DocumentID)
+			assert.Empty(t, report.Duplicates)
+			assert.False(t, report.HasExact())
+
+			// the same file sent again is reported once
+			clock.Add(time.Minute)
+			report, err = detector.Check("resent", readDocument(t))
+			require.Nil(t, err)
+			require.Len(t, report.Duplicates, 1)
+			assert.True(t, report.HasExact())
+			assert.EqualError(t, report.Err(), "The document duplicates the message id ABC/220315/CCT001 of message ABC/220315/CCT001")
+			match := report.Duplicates[0]
+			assert.Equal(t, Exact, match.Kind)
+			assert.Equal(t, ReasonMessageId, match.Reason)
+			assert.Equal(t, "ABC/220315/CCT001", match.Reference)
+			assert.Equal(t, -1, match.Transaction)
+			assert.Equal(t, Original{DocumentID: "original", MessageId: "ABC/220315/CCT001",
+				SeenAt: time.Date(2022, 3, 15, 14, 30, 0, 0, time.UTC), Transaction: -1}, match.Original)
+
+			// a new message repeating the payment information
+			report, err = detector.Detect("", readDocument(t, "CCT001", "CCT002"))
+			require.Nil(t, err)
+			require.Len(t, report.Duplicates, 2)
+			for i, original := range []string{"original", "resent"} {
+				assert.Equal(t, Exact, report.Duplicates[i].Kind)
+				assert.Equal(t, ReasonPaymentInformationId, report.Duplicates[i].Reason)
+				assert.Equal(t, original, report.Duplicates[i].Original.DocumentID)
+			}
+
+			// the transactions repeated in a new payment information
+			report, err = detector.Detect("", readDocument(t, "CCT001", "CCT002", "ABC/086", "ABC/087"))
+			require.Nil(t, err)
+			require.Len(t, report.Duplicates, 4)
+			assert.Equal(t, ReasonEndToEndId, report.Duplicates[1].Reason)
+			assert.Equal(t, Exact, report.Duplicates[1].Kind)
+			assert.Equal(t, "ABC/4563/2022-03-08", report.Duplicates[1].Reference)
+			assert.Equal(t, 1, report.Duplicates[1].Transaction)
+			assert.Equal(t, 1, report.Duplicates[1].Original.Transaction)
+
+			// a same end to end id with another amount is probable
+			report, err = detector.Detect("", readDocument(t, "CCT001", "CCT002", "ABC/086", "ABC/087", "500.50", "600.50"))
+			require.Nil(t, err)
+			require.Len(t, report.Duplicates, 4)
+			assert.Equal(t, Probable, report.Duplicates[1].Kind)
+			assert.Equal(t, ReasonEndToEndId, report.Duplicates[1].Reason)
+			assert.Equal(t, Exact, report.Duplicates[0].Kind)
+
+			// new references with a same amount, date and a similar creditor are probable
+			report, err = detector.Detect("", readDocument(t, "CCT001", "CCT002", "ABC/086", "ABC/087",
+				"ABC/4562/2022-03-08", "ABC/5562", "ABC/4563/2022-03-08", "ABC/5563", "DEF Electronics", "DEF Electronic"))
+			require.Nil(t, err)
+			require.Len(t, report.Duplicates, 4)
+			assert.False(t, report.HasExact())
+			assert.Nil(t, report.Err())
+			match = report.Duplicates[0]
+			assert.Equal(t, Probable, match.Kind)
+			assert.Equal(t, ReasonSimilarity, match.Reason)
+			assert.Equal(t, "1000 EUR", match.Reference)
+			assert.Equal(t, 0.933, match.Similarity)
+
+			// another creditor isn't a duplicate
+			report, err = detector.Detect("", readDocument(t, "CCT001", "CCT002", "ABC/086", "ABC/087",
+				"ABC/4562/2022-03-08", "ABC/5562", "ABC/4563/2022-03-08", "ABC/5563", "DEF Electronics", "XYZ Trading",
+				"GHI Semiconductors", "JKL Logistics"))
+			require.Nil(t, err)
+			assert.Empty(t, report.Duplicates)
+
+			// the originals left the window
+			clock.Add(time.Hour)
+			report, err = detector.Detect("", readDocument(t))
+			require.Nil(t, err)
+			require.Len(t, report.Duplicates, 1)
+			assert.Equal(t, "resent", report.Duplicates[0].Original.DocumentID)
+
+			purged, err := detector.Purge()
+			require.Nil(t, err)
+			assert.Equal(t, int64(1), purged)
+			clock.Add(time.Minute)
+			purged, err = detector.Purge()
+			require.Nil(t, err)
+			assert.Equal(t, int64(1), purged)
+		})
+	}
+}
+
+func TestDetectorSameDocument(t *testing.T) {
+	detector := NewDetector(NewMemoryStore())
+
+	// the second transaction repeats the end to end id and the amount of the first one
+	report, err := detector.Check("", readDocument(t, "ABC/4563/2022-03-08", "ABC/4562/2022-03-08", "500.50", "1000.00"))
+	require.Nil(t, err)
+	assert.Len(t, report.DocumentID, 40)
+	require.Len(t, report.Duplicates, 1)
+	match := report.Duplicates[0]
+	assert.Equal(t, Exact, match.Kind)
+	assert.Equal(t, ReasonEndToEndId, match.Reason)
+	assert.Equal(t, 1, match.Transaction)
+	assert.Equal(t, report.DocumentID, match.Original.DocumentID)
+	assert.Equal(t, 0, match.Original.Transaction)
+}
+
+func TestSQLStoreCandidatesOfLargeDocuments(t *testing.T) {
+	seenAt := time.Date(2022, 3, 15, 14, 30, 0, 0, time.UTC)
+	fingerprint := func(id string, from, to int) Fingerprint {
+		f := Fingerprint{DocumentID: id, MessageType: "pain.001", MessageId: "MSG-" + id, SeenAt: seenAt}
+		for i := from; i < to; i++ {
+			f.Transactions = append(f.Transactions, TransactionFingerprint{
+				PaymentInformationId: fmt.Sprintf("PMT-%d", i),
+				EndToEndId:           fmt.Sprintf("E2E-%d", i),
+				UETR:                 fmt.Sprintf("UETR-%d", i),
+				Amount:               fmt.Sprint(i),
+				Currency:             "EUR",
+				Date:                 "2022-03-15",
+			})
+		}
+		return f
+	}
+
+	// the references of a document exceed the placeholders a single query can have
+	store := testStores(t)["sql"]
+	require.Nil(t, store.Save(fingerprint("first", 0, 3000)))
+	require.Nil(t, store.Save(fingerprint("last", 5999, 6000)))
+	require.Nil(t, store.Save(fingerprint("other", 9000, 9001)))
+
+	candidates, err := store.Candidates(fingerprint("new", 0, 6000), seenAt)
+	require.Nil(t, err)
+	require.Len(t, candidates, 2)
+	assert.Equal(t, "first", candidates[0].DocumentID)
+	assert.Len(t, candidates[0].Transactions, 3000)
+	assert.Equal(t, "E2E-2999", candidates[0].Transactions[2999].EndToEndId)
+	assert.Equal(t, "last", candidates[1].DocumentID)
+	assert.Len(t, candidates[1].Transactions, 1)
+}
@@ -0,0 +1,27 @@
+CREATE TABLE duplicate_fingerprints(
+    document_id VARCHAR(40) PRIMARY KEY,
+    message_type VARCHAR(20) NOT NULL,
+    message_id VARCHAR(35) NOT NULL,
+    seen_at DATETIME NOT NULL
+);
+
+CREATE INDEX duplicate_fingerprints_message_type ON duplicate_fingerprints(message_type, seen_at);
+CREATE INDEX duplicate_fingerprints_message_id ON duplicate_fingerprints(message_id);
+
+CREATE TABLE duplicate_transactions(
+    document_id VARCHAR(40) NOT NULL,
+    seq INTEGER NOT NULL,
+    payment_information_id VARCHAR(35) NOT NULL,
+    end_to_end_id VARCHAR(35) NOT NULL,
+    uetr VARCHAR(36) NOT NULL,
+    amount VARCHAR(40) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    transaction_date VARCHAR(10) NOT NULL,
+    counterparty VARCHAR(140) NOT NULL,
+    PRIMARY KEY (document_id, seq)
+);
+
+CREATE INDEX duplicate_transactions_payment_information_id ON duplicate_transactions(payment_information_id);
+CREATE INDEX duplicate_transactions_end_to_end_id ON duplicate_transactions(end_to_end_id);
+CREATE INDEX duplicate_transactions_uetr ON duplicate_transactions(uetr);
+CREATE INDEX duplicate_transactions_amount ON duplicate_transactions(amount, currency, transaction_date);
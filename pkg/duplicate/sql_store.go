@@ -0,0 +1,259 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package duplicate
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moov-io/base/database"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// SQLStore records the fingerprints in the database of SQLite or MySQL
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore returns a store recording the fingerprints in the database, the missing tables are created first
+func NewSQLStore(db *sql.DB) (*SQLStore, error) {
+	if err := utils.Migrate(db, migrations, "migrations", "duplicate_migrations"); err != nil {
+		return nil, err
+	}
+	return &SQLStore{db: db}, nil
+}
+
+// OpenSQLStore connects to the database of the config, e.g. the database of the server, and returns a store
+// recording the fingerprints in it
+func OpenSQLStore(ctx context.Context, logger log.Logger, config database.DatabaseConfig) (*SQLStore, error) {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	db, err := database.New(ctx, logger, config)
+	if err != nil {
+		return nil, err
+	}
+	store, err := NewSQLStore(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// Close closes the database of the store
+func (s *SQLStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *SQLStore) Save(fingerprint Fingerprint) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`INSERT INTO duplicate_fingerprints(document_id, message_type, message_id, seen_at) VALUES (?, ?, ?, ?)`,
+		fingerprint.DocumentID, fingerprint.MessageType, fingerprint.MessageId, fingerprint.SeenAt.UTC())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	for seq, txn := range fingerprint.Transactions {
+		_, err = tx.Exec(`INSERT INTO duplicate_transactions(document_id, seq, payment_information_id, end_to_end_id, uetr,
+amount, currency, transaction_date, counterparty) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			fingerprint.DocumentID, seq, txn.PaymentInformationId, txn.EndToEndId, txn.UETR,
+			txn.Amount, txn.Currency, txn.Date, txn.Counterparty)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// maxParameters bounds the placeholders of a query, far below the limits of SQLite (999) and MySQL (65535),
+// the lookups of large documents are split into several queries
+const maxParameters = 500
+
+// condition matches the fingerprints sharing a reference with the looked up one
+type condition struct {
+	match string
+	args  []interface{}
+}
+
+func (s *SQLStore) Candidates(fingerprint Fingerprint, since time.Time) ([]Fingerprint, error) {
+	var conditions []condition
+	if fingerprint.MessageId != "" {
+		conditions = append(conditions, condition{`f.message_id = ?`, []interface{}{fingerprint.MessageId}})
+	}
+	for _, reference := range []struct {
+		column string
+		value  func(TransactionFingerprint) string
+	}{
+		{"payment_information_id", func(t TransactionFingerprint) string { return t.PaymentInformationId }},
+		{"end_to_end_id", func(t TransactionFingerprint) string { return t.EndToEndId }},
+		{"uetr", func(t TransactionFingerprint) string { return t.UETR }},
+	} {
+		for _, values := range batches(distinct(fingerprint.Transactions, reference.value), maxParameters) {
+			conditions = append(conditions, transactionCondition(`t.`+reference.column+` IN (?`+strings.Repeat(", ?", len(values)-1)+`)`, values))
+		}
+	}
+	var amounts []interface{}
+	seen := map[[3]string]bool{}
+	for _, txn := range fingerprint.Transactions {
+		amount := [3]string{txn.Amount, txn.Currency, txn.Date}
+		if txn.Amount == "" || txn.Date == "" || seen[amount] {
+			continue
+		}
+		seen[amount] = true
+		amounts = append(amounts, txn.Amount, txn.Currency, txn.Date)
+	}
+	for _, values := range batches(amounts, maxParameters/3*3) {
+		match := strings.Repeat(` OR (t.amount = ? AND t.currency = ? AND t.transaction_date = ?)`, len(values)/3)
+		conditions = append(conditions, transactionCondition(match[len(" OR "):], values))
+	}
+
+	var candidates []Fingerprint
+	index := map[string]int{}
+	for len(conditions) > 0 {
+		// the conditions are joined into a query as long as their arguments fit
+		var matches []string
+		args := []interface{}{fingerprint.MessageType, since.UTC()}
+		for len(conditions) > 0 && (len(matches) == 0 || len(args)+len(conditions[0].args) <= maxParameters) {
+			matches = append(matches, conditions[0].match)
+			args = append(args, conditions[0].args...)
+			conditions = conditions[1:]
+		}
+		rows, err := s.db.Query(`SELECT f.document_id, f.message_type, f.message_id, f.seen_at FROM duplicate_fingerprints f
+WHERE f.message_type = ? AND f.seen_at >= ? AND (`+strings.Join(matches, " OR ")+`)`, args...)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var candidate Fingerprint
+			if err := rows.Scan(&candidate.DocumentID, &candidate.MessageType, &candidate.MessageId, &candidate.SeenAt); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			if _, found := index[candidate.DocumentID]; found {
+				continue
+			}
+			candidate.SeenAt = candidate.SeenAt.UTC()
+			index[candidate.DocumentID] = len(candidates)
+			candidates = append(candidates, candidate)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if !candidates[i].SeenAt.Equal(candidates[j].SeenAt) {
+			return candidates[i].SeenAt.Before(candidates[j].SeenAt)
+		}
+		return candidates[i].DocumentID < candidates[j].DocumentID
+	})
+	ids := make([]interface{}, len(candidates))
+	for i := range candidates {
+		ids[i] = candidates[i].DocumentID
+		index[candidates[i].DocumentID] = i
+	}
+	for _, batch := range batches(ids, maxParameters) {
+		if err := s.loadTransactions(candidates, index, batch); err != nil {
+			return nil, err
+		}
+	}
+	return candidates, nil
+}
+
+// transactionCondition matches the fingerprints with a transaction matching the condition
+func transactionCondition(match string, args []interface{}) condition {
+	return condition{`EXISTS (SELECT 1 FROM duplicate_transactions t WHERE t.document_id = f.document_id AND (` + match + `))`, args}
+}
+
+// loadTransactions adds the transactions of the documents to their candidates
+func (s *SQLStore) loadTransactions(candidates []Fingerprint, index map[string]int, ids []interface{}) error {
+	rows, err := s.db.Query(`SELECT document_id, payment_information_id, end_to_end_id, uetr, amount, currency,
+transaction_date, counterparty FROM duplicate_transactions
+WHERE document_id IN (?`+strings.Repeat(", ?", len(ids)-1)+`) ORDER BY document_id, seq`, ids...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		var txn TransactionFingerprint
+		err := rows.Scan(&id, &txn.PaymentInformationId, &txn.EndToEndId, &txn.UETR, &txn.Amount, &txn.Currency,
+			&txn.Date, &txn.Counterparty)
+		if err != nil {
+			return err
+		}
+		candidate := &candidates[index[id]]
+		candidate.Transactions = append(candidate.Transactions, txn)
+	}
+	return rows.Err()
+}
+
+func (s *SQLStore) Purge(before time.Time) (int64, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.Exec(`DELETE FROM duplicate_transactions WHERE document_id IN
+(SELECT document_id FROM duplicate_fingerprints WHERE seen_at < ?)`, before.UTC())
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	result, err := tx.Exec(`DELETE FROM duplicate_fingerprints WHERE seen_at < ?`, before.UTC())
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	return purged, tx.Commit()
+}
+
+// distinct returns the distinct values of the transactions which aren't empty, in their order
+func distinct(transactions []TransactionFingerprint, value func(TransactionFingerprint) string) []interface{} {
+	var values []interface{}
+	seen := map[string]bool{}
+	for _, txn := range transactions {
+		if v := value(txn); v != "" && !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// batches splits the values into batches of the size at most
+func batches(values []interface{}, size int) [][]interface{} {
+	var result [][]interface{}
+	for len(values) > size {
+		result = append(result, values[:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		result = append(result, values)
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package duplicate
+
+import (
+	"sync"
+	"time"
+)
+
+// Store records the fingerprints compared by a detector
+type Store interface {
+	// Save records the fingerprint
+	Save(fingerprint Fingerprint) error
+	// Candidates returns the fingerprints of the message type of fingerprint seen since the time which can be
+	// duplicated by it. The detector compares them again, so a store may return fingerprints which don't match.
+	Candidates(fingerprint Fingerprint, since time.Time) ([]Fingerprint, error)
+	// Purge deletes the fingerprints seen before the time and returns their number
+	Purge(before time.Time) (int64, error)
+}
+
+type memoryStore struct {
+	mu           sync.RWMutex
+	fingerprints []Fingerprint
+}
+
+// NewMemoryStore returns a store keeping the fingerprints in memory, they are lost when the process exits
+func NewMemoryStore() Store {
+	return &memoryStore{}
+}
+
+func (s *memoryStore) Save(fingerprint Fingerprint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fingerprints = append(s.fingerprints, fingerprint)
+	return nil
+}
+
+func (s *memoryStore) Candidates(fingerprint Fingerprint, since time.Time) ([]Fingerprint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var candidates []Fingerprint
+	for _, candidate := range s.fingerprints {
+		if candidate.MessageType == fingerprint.MessageType && !candidate.SeenAt.Before(since) {
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates, nil
+}
+
+func (s *memoryStore) Purge(before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kept := s.fingerprints[:0]
+	for _, fingerprint := range s.fingerprints {
+		if !fingerprint.SeenAt.Before(before) {
+			kept = append(kept, fingerprint)
+		}
+	}
+	purged := int64(len(s.fingerprints) - len(kept))
+	s.fingerprints = kept
+	return purged, nil
+}
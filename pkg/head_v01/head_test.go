@@ -24,8 +24,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, GenericPersonIdentification1{}.Validate())
 	assert.Nil(t, OrganisationIdentification7{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
-	assert.Nil(t, Party10Choice{}.Validate())
-	assert.Nil(t, Party9Choice{}.Validate())
+	assert.NotNil(t, Party10Choice{}.Validate())
+	assert.NotNil(t, Party9Choice{}.Validate())
 	assert.Nil(t, PartyIdentification42{}.Validate())
 	assert.Nil(t, PersonIdentification5{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
@@ -37,6 +37,8 @@ func TestTypes(t *testing.T) {
 	var type1 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type1.Validate())
 	type1 = "test"
+	assert.NotNil(t, type1.Validate())
+	type1 = "USABA"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalFinancialInstitutionIdentification1Code
@@ -46,11 +48,11 @@ func TestTypes(t *testing.T) {
 
 	var type3 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BANK"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalPersonIdentification1Code
 	assert.NotNil(t, type4.Validate())
-	type4 = "test"
+	type4 = "ARNU"
 	assert.Nil(t, type4.Validate())
 }
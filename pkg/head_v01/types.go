@@ -5,6 +5,7 @@
 package head_v01
 
 import (
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -15,7 +16,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -25,7 +26,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -35,7 +36,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -45,5 +46,5 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 0)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
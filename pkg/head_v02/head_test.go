@@ -36,6 +36,8 @@ func TestTypes(t *testing.T) {
 	var type1 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type1.Validate())
 	type1 = "test"
+	assert.NotNil(t, type1.Validate())
+	type1 = "USABA"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalFinancialInstitutionIdentification1Code
@@ -45,12 +47,12 @@ func TestTypes(t *testing.T) {
 
 	var type3 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BANK"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalPersonIdentification1Code
 	assert.NotNil(t, type4.Validate())
-	type4 = "test"
+	type4 = "ARNU"
 	assert.Nil(t, type4.Validate())
 
 	var type5 PreferredContactMethod1Code
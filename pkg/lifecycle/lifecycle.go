@@ -0,0 +1,491 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package lifecycle follows a payment through the messages exchanged about it, e.g. a pain.001, the pacs.008
+// sent by the debtor agent, the pacs.002 answering it and the camt.054 notifying the booking, possibly followed
+// by a camt.056 and its camt.029, or by a pacs.004.
+//
+// The Engine ingests parsed documents of any supported version and links their transactions by UETR, TxId and
+// EndToEndId, including the Orgnl* references of the status reports, returns and investigations. Every payment
+// has a state derived from its timeline:
+//
+//	initiated ─▶ accepted ─▶ settled ─▶ returned | cancelled
+//	    └──────────┴─▶ rejected | returned | cancelled
+//
+// Rejected, returned and cancelled are final. A message arriving late, like a pacs.002 accepting a payment which
+// is already settled, is added to the timeline without moving the payment back.
+package lifecycle
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/base/stime"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// State is the step a payment reached
+type State string
+
+const (
+	Initiated State = "initiated"
+	Accepted  State = "accepted"
+	Settled   State = "settled"
+	Rejected  State = "rejected"
+	Returned  State = "returned"
+	Cancelled State = "cancelled"
+)
+
+// Final reports whether the payment can't change its state anymore
+func (s State) Final() bool {
+	return s == Rejected || s == Returned || s == Cancelled
+}
+
+// rank orders the states a payment goes through until it's final
+func (s State) rank() int {
+	switch s {
+	case Initiated:
+		return 1
+	case Accepted:
+		return 2
+	case Settled:
+		return 3
+	}
+	return 0
+}
+
+// EventKind is the role of a message in the life of a payment
+type EventKind string
+
+const (
+	Initiation          EventKind = "initiation"
+	Transfer            EventKind = "transfer"
+	StatusReport        EventKind = "status report"
+	Notification        EventKind = "notification"
+	CancellationRequest EventKind = "cancellation request"
+	Resolution          EventKind = "resolution"
+	Return              EventKind = "return"
+	Reversal            EventKind = "reversal"
+)
+
+// eventKinds are the messages ingested by the engine, by business area and message
+var eventKinds = map[string]EventKind{
+	"pain.001": Initiation,
+	"pain.008": Initiation,
+	"pacs.003": Transfer,
+	"pacs.008": Transfer,
+	"pacs.009": Transfer,
+	"pain.002": StatusReport,
+	"pacs.002": StatusReport,
+	"camt.052": Notification,
+	"camt.053": Notification,
+	"camt.054": Notification,
+	"camt.055": CancellationRequest,
+	"camt.056": CancellationRequest,
+	"camt.029": Resolution,
+	"pacs.004": Return,
+	"pacs.007": Reversal,
+	"pain.007": Reversal,
+}
+
+// statusStates are the states set by the payment statuses of the status reports, the entry statuses of the
+// notifications and the cancellation statuses of the resolutions. The other statuses, like PDNG, don't change
+// the state.
+var statusStates = map[EventKind]map[string]State{
+	StatusReport: {
+		"ACTC": Accepted, "ACCP": Accepted, "ACSP": Accepted, "ACWC": Accepted, "ACWP": Accepted, "ACFC": Accepted,
+		"ACIS": Accepted, "ACSC": Settled, "ACCC": Settled, "RJCT": Rejected, "CANC": Cancelled,
+	},
+	Notification: {"BOOK": Settled},
+	Resolution:   {"CNCL": Cancelled, "ACCR": Cancelled},
+}
+
+// Event is a message about a payment
+type Event struct {
+	// Time is the creation time of the message, the time it was ingested when it has none
+	Time time.Time
+	Kind EventKind
+	// MessageType is the message identifier, e.g. pacs.002.001.11
+	MessageType string
+	MessageId   string
+	// Status is the status given to the payment by a status report, a notification or a resolution
+	Status string `json:",omitempty"`
+	// State is the state of the payment after the event
+	State State
+}
+
+// Payment is a transaction followed through the messages
+type Payment struct {
+	// ID identifies the payment in the engine, it's the first reference known of the payment
+	ID             string
+	UETR           string   `json:",omitempty"`
+	EndToEndId     string   `json:",omitempty"`
+	TransactionIds []string `json:",omitempty"`
+	Amount         common.Decimal
+	Currency       string `json:",omitempty"`
+	Debtor         string `json:",omitempty"`
+	Creditor       string `json:",omitempty"`
+	State          State
+	// Events is the timeline of the payment, in the order of their time
+	Events []Event
+}
+
+func (p *Payment) copy() Payment {
+	c := *p
+	c.TransactionIds = append([]string(nil), p.TransactionIds...)
+	c.Events = append([]Event(nil), p.Events...)
+	return c
+}
+
+// Engine links the messages of the payments and keeps their state. An engine is safe for concurrent use.
+type Engine struct {
+	mu       sync.RWMutex
+	clock    stime.TimeService
+	payments []*Payment
+	// references indexes the payments by UETR, EndToEndId, TxId and by the id of the messages holding them
+	references map[string][]*Payment
+}
+
+// Option changes the settings of an engine
+type Option func(*Engine)
+
+// WithClock sets the time service giving the time of the messages without creation time
+func WithClock(clock stime.TimeService) Option {
+	return func(e *Engine) {
+		e.clock = clock
+	}
+}
+
+// NewEngine returns an engine without payments
+func NewEngine(opts ...Option) *Engine {
+	e := &Engine{
+		clock:      stime.NewSystemTimeService(),
+		references: map[string][]*Payment{},
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Ingest adds the message to the timeline of its payments and returns them. The transactions of a payment
+// message start a new payment unless one of their references is known, the other messages are ignored when
+// their payments are unknown. A status report without transactions applies to the payments of its original
+// message.
+func (e *Engine) Ingest(doc document.Iso20022Document) ([]Payment, error) {
+	summary := document.Summarize(doc)
+	kind, found := eventKinds[messageType(summary.MessageType)]
+	if !found {
+		return nil, utils.NewErrUnsupportedLifecycleMessage(summary.MessageType)
+	}
+	event := Event{Kind: kind, MessageType: summary.MessageType, MessageId: summary.MessageId}
+	if summary.CreationDateTime != nil {
+		event.Time = summary.CreationDateTime.UTC()
+	} else {
+		event.Time = e.clock.Now().UTC()
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var touched []*Payment
+	if len(summary.Transactions) == 0 && summary.OriginalMessageId != "" {
+		event.Status = summary.Status
+		for _, payment := range e.references[messageKey(summary.OriginalMessageId)] {
+			e.addEvent(payment, event)
+			touched = append(touched, payment)
+		}
+	}
+	for _, txn := range summary.Transactions {
+		payment := e.lookup(txn)
+		if payment == nil {
+			if kind != Initiation && kind != Transfer {
+				continue
+			}
+			payment = e.newPayment(txn)
+		}
+		e.link(payment, txn)
+		if summary.MessageId != "" {
+			e.index(messageKey(summary.MessageId), payment)
+		}
+		event.Status = txn.Status
+		e.addEvent(payment, event)
+		touched = append(touched, payment)
+	}
+
+	payments := []Payment{}
+	seen := map[*Payment]bool{}
+	for _, payment := range touched {
+		// a payment merged into another one by a later transaction is returned by the other one
+		if !seen[payment] && containsPayment(e.payments, payment) {
+			seen[payment] = true
+			payments = append(payments, payment.copy())
+		}
+	}
+	return payments, nil
+}
+
+// Payment returns the payment with the reference: its id, UETR, EndToEndId or a TxId
+func (e *Engine) Payment(reference string) (Payment, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if payment := e.find(reference); payment != nil {
+		return payment.copy(), true
+	}
+	return Payment{}, false
+}
+
+// Timeline returns the events of the payment with the reference in the order of their time
+func (e *Engine) Timeline(reference string) ([]Event, bool) {
+	payment, found := e.Payment(reference)
+	return payment.Events, found
+}
+
+// Payments returns the payments in the order they were started
+func (e *Engine) Payments() []Payment {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	payments := make([]Payment, 0, len(e.payments))
+	for _, payment := range e.payments {
+		payments = append(payments, payment.copy())
+	}
+	return payments
+}
+
+func (e *Engine) find(reference string) *Payment {
+	for _, payment := range e.payments {
+		if payment.ID == reference {
+			return payment
+		}
+	}
+	for _, key := range []string{uetrKey(reference), transactionKey(reference), endToEndKey(reference)} {
+		if payments := e.references[key]; len(payments) > 0 {
+			return payments[0]
+		}
+	}
+	return nil
+}
+
+// lookup returns the payment of the transaction, the payments it links together are merged. A payment with
+// another UETR isn't linked by the other references.
+func (e *Engine) lookup(txn document.TransactionSummary) *Payment {
+	var found []*Payment
+	for _, key := range transactionKeys(txn) {
+		for _, payment := range e.references[key] {
+			if txn.UETR != "" && payment.UETR != "" && !strings.EqualFold(txn.UETR, payment.UETR) {
+				continue
+			}
+			if !containsPayment(found, payment) {
+				found = append(found, payment)
+			}
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	for _, other := range found[1:] {
+		e.merge(found[0], other)
+	}
+	return found[0]
+}
+
+func (e *Engine) newPayment(txn document.TransactionSummary) *Payment {
+	payment := &Payment{}
+	for _, id := range []string{txn.UETR, txn.EndToEndId, txn.TransactionId, txn.InstructionId} {
+		if id != "" && id != notProvided {
+			payment.ID = id
+			break
+		}
+	}
+	e.payments = append(e.payments, payment)
+	return payment
+}
+
+// link adds the references and the details of the transaction to the payment
+func (e *Engine) link(payment *Payment, txn document.TransactionSummary) {
+	if payment.UETR == "" && txn.UETR != "" {
+		payment.UETR = strings.ToLower(txn.UETR)
+	}
+	if payment.EndToEndId == "" && txn.EndToEndId != notProvided {
+		payment.EndToEndId = txn.EndToEndId
+	}
+	if txn.TransactionId != "" && !containsString(payment.TransactionIds, txn.TransactionId) {
+		payment.TransactionIds = append(payment.TransactionIds, txn.TransactionId)
+	}
+	if payment.Currency == "" && txn.Currency != "" {
+		payment.Amount, payment.Currency = txn.Amount, txn.Currency
+	}
+	if payment.Debtor == "" {
+		payment.Debtor = txn.Debtor
+	}
+	if payment.Creditor == "" {
+		payment.Creditor = txn.Creditor
+	}
+	for _, key := range transactionKeys(txn) {
+		e.index(key, payment)
+	}
+}
+
+func (e *Engine) index(key string, payment *Payment) {
+	if !containsPayment(e.references[key], payment) {
+		e.references[key] = append(e.references[key], payment)
+	}
+}
+
+// merge moves the references and the events of other into payment
+func (e *Engine) merge(payment, other *Payment) {
+	for key, payments := range e.references {
+		for i := range payments {
+			if payments[i] == other {
+				payments = append(payments[:i], payments[i+1:]...)
+				e.references[key] = payments
+				e.index(key, payment)
+				break
+			}
+		}
+	}
+	if payment.UETR == "" {
+		payment.UETR = other.UETR
+	}
+	if payment.EndToEndId == "" {
+		payment.EndToEndId = other.EndToEndId
+	}
+	for _, id := range other.TransactionIds {
+		if !containsString(payment.TransactionIds, id) {
+			payment.TransactionIds = append(payment.TransactionIds, id)
+		}
+	}
+	if payment.Currency == "" {
+		payment.Amount, payment.Currency = other.Amount, other.Currency
+	}
+	if payment.Debtor == "" {
+		payment.Debtor = other.Debtor
+	}
+	if payment.Creditor == "" {
+		payment.Creditor = other.Creditor
+	}
+	payment.Events = append(payment.Events, other.Events...)
+	replay(payment)
+
+	for i := range e.payments {
+		if e.payments[i] == other {
+			e.payments = append(e.payments[:i], e.payments[i+1:]...)
+			break
+		}
+	}
+}
+
+func (e *Engine) addEvent(payment *Payment, event Event) {
+	payment.Events = append(payment.Events, event)
+	replay(payment)
+}
+
+// replay orders the events of the payment by time and computes the state after each of them
+func replay(payment *Payment) {
+	sort.SliceStable(payment.Events, func(i, j int) bool {
+		return payment.Events[i].Time.Before(payment.Events[j].Time)
+	})
+	var state State
+	for i := range payment.Events {
+		state = transition(state, payment.Events[i])
+		payment.Events[i].State = state
+	}
+	payment.State = state
+}
+
+// transition returns the state of a payment in state after the event
+func transition(state State, event Event) State {
+	var target State
+	switch event.Kind {
+	case Initiation:
+		target = Initiated
+	case Transfer:
+		// the transfer of an initiated payment means its agent accepted it
+		target = Initiated
+		if state != "" {
+			target = Accepted
+		}
+	case Return, Reversal:
+		target = Returned
+	default:
+		target = statusStates[event.Kind][event.Status]
+	}
+
+	switch {
+	case target == "":
+		if state == "" {
+			// a payment first seen in a report is already under way
+			return Initiated
+		}
+		return state
+	case state == "":
+		return target
+	case state.Final():
+		return state
+	case target == Rejected:
+		// a settled payment can only be returned or cancelled
+		if state == Settled {
+			return state
+		}
+		return target
+	case target.Final():
+		return target
+	case target.rank() > state.rank():
+		return target
+	}
+	return state
+}
+
+const notProvided = "NOTPROVIDED"
+
+func uetrKey(uetr string) string      { return "uetr:" + strings.ToLower(uetr) }
+func transactionKey(id string) string { return "tx:" + id }
+func endToEndKey(id string) string    { return "e2e:" + id }
+func messageKey(id string) string     { return "msg:" + id }
+
+// transactionKeys returns the index keys of the references of a transaction, the strongest first
+func transactionKeys(txn document.TransactionSummary) []string {
+	var keys []string
+	if txn.UETR != "" {
+		keys = append(keys, uetrKey(txn.UETR))
+	}
+	if txn.TransactionId != "" {
+		keys = append(keys, transactionKey(txn.TransactionId))
+	}
+	if txn.EndToEndId != "" && txn.EndToEndId != notProvided {
+		keys = append(keys, endToEndKey(txn.EndToEndId))
+	}
+	return keys
+}
+
+// messageType returns the business area and the message of a message identifier, pacs.008 for pacs.008.001.08
+func messageType(identifier string) string {
+	parts := strings.SplitN(identifier, ".", 3)
+	if len(parts) < 2 {
+		return identifier
+	}
+	return parts[0] + "." + parts[1]
+}
+
+func containsPayment(payments []*Payment, payment *Payment) bool {
+	for _, p := range payments {
+		if p == payment {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
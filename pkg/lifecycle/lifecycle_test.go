@@ -0,0 +1,279 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lifecycle
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/builder"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testUETR = "8a562c67-ca16-48ba-b074-65581be6f001"
+
+var (
+	testTime = time.Date(2022, 3, 15, 9, 0, 0, 0, time.UTC)
+	debtor   = builder.Party{Name: "Debtor", Account: "DE89370400440532013000", Agent: "BANKDEFFXXX"}
+	creditor = builder.Party{Name: "Creditor", Account: "GB29NWBK60161331926819", Agent: "NWBKGB2LXXX"}
+)
+
+// at returns the builder options of a message created minutes after testTime
+func at(minutes int, messageId string) []builder.Option {
+	return []builder.Option{
+		builder.WithMessageIdGenerator(func() string { return messageId }),
+		builder.WithClock(func() time.Time { return testTime.Add(time.Duration(minutes) * time.Minute) }),
+	}
+}
+
+func transfer() builder.Transfer {
+	return builder.Transfer{EndToEndId: "E2E-1", UETR: testUETR, Amount: common.MustParseDecimal("100"), Currency: "EUR",
+		Debtor: debtor, Creditor: creditor}
+}
+
+func initiation(t *testing.T) document.Iso20022Document {
+	doc, err := builder.NewCustomerCreditTransferInitiation(at(0, "INIT-1")...).
+		InitiatingParty("Debtor").
+		ExecutionDate(testTime).
+		AddTransfer(transfer()).
+		Build()
+	require.Nil(t, err)
+	return doc
+}
+
+func creditTransfer(t *testing.T) document.Iso20022Document {
+	doc, err := builder.NewFIToFICustomerCreditTransfer(at(10, "PAY-1")...).
+		InstructingAgent("BANKDEFFXXX").
+		InstructedAgent("NWBKGB2LXXX").
+		AddTransfer(transfer()).
+		Build()
+	require.Nil(t, err)
+	return doc
+}
+
+func statusReport(t *testing.T, original document.Iso20022Document, minutes int, code string) document.Iso20022Document {
+	doc, err := builder.NewStatusReport(original, at(minutes, "STATUS-"+code)...).
+		TransactionStatus("E2E-1", builder.Status{Code: code}).
+		Build()
+	require.Nil(t, err)
+	return doc
+}
+
+// notification returns a camt.054 booking the payment
+func notification(t *testing.T, created string) document.Iso20022Document {
+	doc, err := document.ParseIso20022Document([]byte(strings.NewReplacer("{created}", created, "{uetr}", testUETR).Replace(
+		`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.054.001.08">
+	<BkToCstmrDbtCdtNtfctn>
+		<GrpHdr><MsgId>NTFCTN-1</MsgId><CreDtTm>{created}</CreDtTm></GrpHdr>
+		<Ntfctn>
+			<Id>NTFCTN-1</Id>
+			<CreDtTm>{created}</CreDtTm>
+			<Acct><Id><IBAN>GB29NWBK60161331926819</IBAN></Id></Acct>
+			<Ntry>
+				<Amt Ccy="EUR">100</Amt>
+				<CdtDbtInd>CRDT</CdtDbtInd>
+				<Sts><Cd>BOOK</Cd></Sts>
+				<BkTxCd><Prtry><Cd>CRDT</Cd></Prtry></BkTxCd>
+				<NtryDtls><TxDtls><Refs><EndToEndId>E2E-1</EndToEndId><UETR>{uetr}</UETR></Refs></TxDtls></NtryDtls>
+			</Ntry>
+		</Ntfctn>
+	</BkToCstmrDbtCdtNtfctn>
+</Document>`)))
+	require.Nil(t, err)
+	return doc
+}
+
+func states(events []Event) []State {
+	var list []State
+	for _, event := range events {
+		list = append(list, event.State)
+	}
+	return list
+}
+
+func TestSettledPayment(t *testing.T) {
+	engine := NewEngine()
+	payments, err := engine.Ingest(initiation(t))
+	require.Nil(t, err)
+	require.Len(t, payments, 1)
+	assert.Equal(t, testUETR, payments[0].ID)
+	assert.Equal(t, Initiated, payments[0].State)
+	assert.Equal(t, "E2E-1", payments[0].EndToEndId)
+	assert.Equal(t, "100", payments[0].Amount.String())
+	assert.Equal(t, "EUR", payments[0].Currency)
+	assert.Equal(t, "Debtor", payments[0].Debtor)
+	assert.Equal(t, "Creditor", payments[0].Creditor)
+
+	payment := creditTransfer(t)
+	payments, err = engine.Ingest(payment)
+	require.Nil(t, err)
+	require.Len(t, payments, 1)
+	assert.Equal(t, Accepted, payments[0].State)
+
+	// the booking is ingested before the status report created earlier
+	payments, err = engine.Ingest(notification(t, "2022-03-15T09:30:00Z"))
+	require.Nil(t, err)
+	assert.Equal(t, Settled, payments[0].State)
+	payments, err = engine.Ingest(statusReport(t, payment, 20, "ACSP"))
+	require.Nil(t, err)
+	assert.Equal(t, Settled, payments[0].State)
+
+	timeline, found := engine.Timeline("E2E-1")
+	require.True(t, found)
+	assert.Equal(t, []State{Initiated, Accepted, Accepted, Settled}, states(timeline))
+	assert.Equal(t, Event{Time: testTime.Add(20 * time.Minute), Kind: StatusReport, MessageType: "pacs.002.001.11",
+		MessageId: "STATUS-ACSP", Status: "ACSP", State: Accepted}, timeline[2])
+	assert.Equal(t, Notification, timeline[3].Kind)
+	assert.Equal(t, "BOOK", timeline[3].Status)
+
+	// the payment is found by each of its references
+	for _, reference := range []string{testUETR, strings.ToUpper(testUETR), "E2E-1"} {
+		payment, found := engine.Payment(reference)
+		assert.True(t, found)
+		assert.Equal(t, testUETR, payment.ID)
+	}
+	_, found = engine.Payment("unknown")
+	assert.False(t, found)
+	assert.Len(t, engine.Payments(), 1)
+}
+
+func TestReturnedPayment(t *testing.T) {
+	engine := NewEngine()
+	payment := creditTransfer(t)
+	_, err := engine.Ingest(payment)
+	require.Nil(t, err)
+	_, err = engine.Ingest(statusReport(t, payment, 20, "ACSC"))
+	require.Nil(t, err)
+
+	doc, err := builder.NewPaymentReturn(payment, at(60, "RETURN-1")...).
+		Return(builder.Exception{EndToEndId: "E2E-1", Reason: "AC04"}).
+		Build()
+	require.Nil(t, err)
+	payments, err := engine.Ingest(doc)
+	require.Nil(t, err)
+	require.Len(t, payments, 1)
+	assert.Equal(t, Returned, payments[0].State)
+	assert.Equal(t, []State{Initiated, Settled, Returned}, states(payments[0].Events))
+
+	// a final state isn't left
+	_, err = engine.Ingest(notification(t, "2022-03-15T11:00:00Z"))
+	require.Nil(t, err)
+	payment2, _ := engine.Payment("E2E-1")
+	assert.Equal(t, Returned, payment2.State)
+}
+
+func TestCancelledPayment(t *testing.T) {
+	engine := NewEngine()
+	payment := creditTransfer(t)
+	_, err := engine.Ingest(payment)
+	require.Nil(t, err)
+
+	request, err := builder.NewCancellationRequest(payment, at(30, "CANCEL-1")...).
+		Cancel(builder.Exception{EndToEndId: "E2E-1", Reason: "DUPL"}).
+		Build()
+	require.Nil(t, err)
+	payments, err := engine.Ingest(request)
+	require.Nil(t, err)
+	require.Len(t, payments, 1)
+	assert.Equal(t, Initiated, payments[0].State)
+
+	resolution, err := builder.NewResolutionOfInvestigation(request, at(40, "RESOLUTION-1")...).
+		TransactionStatus("E2E-1", builder.Status{Code: "ACCR"}).
+		Build()
+	require.Nil(t, err)
+	payments, err = engine.Ingest(resolution)
+	require.Nil(t, err)
+	require.Len(t, payments, 1)
+	assert.Equal(t, Cancelled, payments[0].State)
+	assert.Equal(t, []EventKind{Transfer, CancellationRequest, Resolution},
+		[]EventKind{payments[0].Events[0].Kind, payments[0].Events[1].Kind, payments[0].Events[2].Kind})
+}
+
+func TestRejectedGroup(t *testing.T) {
+	engine := NewEngine()
+	payment := creditTransfer(t)
+	_, err := engine.Ingest(payment)
+	require.Nil(t, err)
+
+	// the status of the whole message applies to its payments
+	report, err := builder.NewStatusReport(payment, at(20, "STATUS-1")...).
+		GroupStatus(builder.Status{Code: "RJCT", Reason: "AC01"}).
+		Build()
+	require.Nil(t, err)
+	payments, err := engine.Ingest(report)
+	require.Nil(t, err)
+	require.Len(t, payments, 1)
+	assert.Equal(t, Rejected, payments[0].State)
+	assert.Equal(t, "RJCT", payments[0].Events[1].Status)
+}
+
+func TestLinkedReferences(t *testing.T) {
+	engine := NewEngine()
+	// a notification of an unknown payment doesn't create it
+	_, err := engine.Ingest(notification(t, "2022-03-15T09:30:00Z"))
+	require.Nil(t, err)
+	assert.Empty(t, engine.Payments())
+
+	doc, err := builder.NewCustomerCreditTransferInitiation(at(0, "INIT-1")...).
+		InitiatingParty("Debtor").
+		AddTransfer(builder.Transfer{EndToEndId: "E2E-2", UETR: "5c0d0b36-1d1d-4c1c-9c0e-3a1f5e8b9d01",
+			Amount: common.MustParseDecimal("10"), Currency: "EUR", Debtor: debtor, Creditor: creditor}).
+		AddTransfer(transfer()).
+		Build()
+	require.Nil(t, err)
+	payments, err := engine.Ingest(doc)
+	require.Nil(t, err)
+	assert.Len(t, payments, 2)
+
+	// a payment with another UETR isn't linked by its end to end id
+	other := transfer()
+	other.UETR = "0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9"
+	doc, err = builder.NewFIToFICustomerCreditTransfer(at(10, "PAY-2")...).
+		InstructingAgent("BANKDEFFXXX").
+		InstructedAgent("NWBKGB2LXXX").
+		AddTransfer(other).
+		Build()
+	require.Nil(t, err)
+	payments, err = engine.Ingest(doc)
+	require.Nil(t, err)
+	require.Len(t, payments, 1)
+	assert.Equal(t, other.UETR, payments[0].ID)
+	assert.Equal(t, Initiated, payments[0].State)
+	assert.Len(t, engine.Payments(), 3)
+}
+
+func TestUnsupportedMessage(t *testing.T) {
+	doc, err := document.ParseIso20022Document([]byte(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:acmt.007.001.03"><AcctOpngReq/></Document>`))
+	require.Nil(t, err)
+	_, err = NewEngine().Ingest(doc)
+	assert.EqualError(t, err, "The message acmt.007.001.03 is unsupported by the payment lifecycle")
+}
+
+func TestTransition(t *testing.T) {
+	for _, test := range []struct {
+		state State
+		event Event
+		want  State
+	}{
+		{"", Event{Kind: Initiation}, Initiated},
+		{"", Event{Kind: Transfer}, Initiated},
+		{Initiated, Event{Kind: Transfer}, Accepted},
+		{Initiated, Event{Kind: StatusReport, Status: "PDNG"}, Initiated},
+		{Accepted, Event{Kind: StatusReport, Status: "ACSC"}, Settled},
+		{Settled, Event{Kind: StatusReport, Status: "ACTC"}, Settled},
+		{Settled, Event{Kind: StatusReport, Status: "RJCT"}, Settled},
+		{Settled, Event{Kind: Resolution, Status: "CNCL"}, Cancelled},
+		{Accepted, Event{Kind: Resolution, Status: "RJCR"}, Accepted},
+		{Accepted, Event{Kind: CancellationRequest}, Accepted},
+		{Accepted, Event{Kind: Reversal}, Returned},
+		{Rejected, Event{Kind: Notification, Status: "BOOK"}, Rejected},
+	} {
+		assert.Equal(t, test.want, transition(test.state, test.event), "%s after %s %s", test.state, test.event.Kind, test.event.Status)
+	}
+}
@@ -0,0 +1,454 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// balanceTypes maps the balance fields of an MT940 to the balance type codes
+var balanceTypes = map[string]string{
+	"60F": "OPBD", "60M": "ITBD", "62F": "CLBD", "62M": "ITBD", "64": "CLAV", "65": "FWAV",
+}
+
+// statementLineRegexp splits field 61: value date, entry date, debit or credit mark, funds code, amount,
+// transaction type, reference for the account owner and reference of the account servicing institution
+var statementLineRegexp = regexp.MustCompile(`^([0-9]{6})([0-9]{4})?(R?[CD])([A-Z])?([0-9][0-9,]*)([A-Z][A-Z0-9]{3})(.*?)(?://(.*))?$`)
+
+// transactionTypeRegexp matches the transaction type identification codes of field 61, like NTRF
+var transactionTypeRegexp = regexp.MustCompile(`^[NSF][A-Z0-9]{3}$`)
+
+// fromMT940 translates an MT940 or MT950 into a camt.053.001.08 with a single statement
+func (t *translator) fromMT940(msg *Message) (*camt_v08.BankToCustomerStatementV08, error) {
+	reference, found := msg.Field("20")
+	if !found {
+		return nil, utils.NewErrOmittedField(":20:")
+	}
+
+	stmt := camt_v08.AccountStatement9{Id: common.Max35Text(reference)}
+	var currency, previous string
+	for _, field := range msg.Fields {
+		switch field.Tag {
+		case "20":
+		case "25", "25P":
+			lines := field.Lines()
+			stmt.Acct = camt53Account(lines[0])
+			if field.Tag == "25P" && len(lines) > 1 {
+				bic := common.BICFIDec2014Identifier(lines[1])
+				stmt.Acct.Svcr = &camt_v08.BranchAndFinancialInstitutionIdentification6{
+					FinInstnId: camt_v08.FinancialInstitutionIdentification18{BICFI: &bic},
+				}
+			}
+		case "28C":
+			parts := strings.SplitN(field.Value, "/", 2)
+			number, err := strconv.ParseFloat(parts[0], 64)
+			if err != nil {
+				return nil, utils.NewErrInvalidField(":28C:")
+			}
+			stmt.LglSeqNb, stmt.ElctrncSeqNb = number, number
+			if len(parts) > 1 {
+				stmt.StmtPgntn = &camt_v08.Pagination1{PgNb: common.Max5NumericText(strings.TrimLeft(parts[1], "0"))}
+			}
+		case "60F", "60M", "62F", "62M", "64", "65":
+			balance, err := camt53Balance(field)
+			if err != nil {
+				return nil, err
+			}
+			currency = string(balance.Amt.Ccy)
+			stmt.Bal = append(stmt.Bal, balance)
+			if field.Tag == "62F" && stmt.StmtPgntn != nil {
+				stmt.StmtPgntn.LastPgInd = true
+			}
+		case "61":
+			entry, err := t.camt53Entry(field, currency)
+			if err != nil {
+				return nil, err
+			}
+			stmt.Ntry = append(stmt.Ntry, entry)
+		case "86":
+			info := optionalInfo(strings.ReplaceAll(field.Value, "\n", ""))
+			if previous == "61" {
+				stmt.Ntry[len(stmt.Ntry)-1].AddtlNtryInf = info
+			} else {
+				stmt.AddtlStmtInf = info
+			}
+		default:
+			t.lose(":"+field.Tag+":", document.LossDropped, field.Value)
+		}
+		previous = field.Tag
+	}
+	if stmt.Acct == nil {
+		return nil, utils.NewErrOmittedField(":25:")
+	}
+	// the sender is the account servicer named by field 25P, a statement has no other place for it
+	if sender := msg.Sender(); sender != "" && (stmt.Acct.Svcr == nil || logicalTerminal(sender) != logicalTerminal(string(*stmt.Acct.Svcr.FinInstnId.BICFI))) {
+		t.lose("{1:}", document.LossDropped, sender)
+	}
+
+	grpHdr := camt_v08.GroupHeader81{
+		MsgId:   common.Max35Text(reference),
+		CreDtTm: common.ISODateTime(t.opts.clock()),
+	}
+	if receiver := msg.Receiver(); receiver != "" {
+		bic := common.AnyBICDec2014Identifier(receiver)
+		grpHdr.MsgRcpt = &camt_v08.PartyIdentification135{
+			Id: &camt_v08.Party38Choice{OrgId: &camt_v08.OrganisationIdentification29{AnyBIC: &bic}},
+		}
+	}
+	return &camt_v08.BankToCustomerStatementV08{
+		GrpHdr: grpHdr,
+		Stmt:   []camt_v08.AccountStatement9{stmt},
+	}, nil
+}
+
+func camt53Account(id string) *camt_v08.CashAccount39 {
+	if iban := common.IBAN2007Identifier(id); iban.Validate() == nil {
+		return &camt_v08.CashAccount39{Id: camt_v08.AccountIdentification4Choice{IBAN: &iban}}
+	}
+	return &camt_v08.CashAccount39{Id: camt_v08.AccountIdentification4Choice{
+		Othr: &camt_v08.GenericAccountIdentification1{Id: common.Max34Text(id)},
+	}}
+}
+
+// camt53Balance reads a balance field like C220103EUR1234,56
+func camt53Balance(field Field) (camt_v08.CashBalance8, error) {
+	tag := ":" + field.Tag + ":"
+	if len(field.Value) < 1 {
+		return camt_v08.CashBalance8{}, utils.NewErrInvalidField(tag)
+	}
+	indicator, err := creditDebit(tag, field.Value[:1])
+	if err != nil {
+		return camt_v08.CashBalance8{}, err
+	}
+	date, currency, amount, err := splitAmount(tag, field.Value[1:], true)
+	if err != nil {
+		return camt_v08.CashBalance8{}, err
+	}
+
+	code := camt_v08.ExternalBalanceType1Code(balanceTypes[field.Tag])
+	return camt_v08.CashBalance8{
+		Tp: camt_v08.BalanceType13{CdOrPrtry: camt_v08.BalanceType10Choice{Cd: &code}},
+		Amt: camt_v08.ActiveOrHistoricCurrencyAndAmount{
+			Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(amount),
+			Ccy:   common.ActiveOrHistoricCurrencyCode(currency),
+		},
+		CdtDbtInd: indicator,
+		Dt:        camt_v08.DateAndDateTime2Choice{Dt: isoDate(date)},
+	}, nil
+}
+
+// camt53Entry reads a statement line, the currency of the entry is the one of the balances
+func (t *translator) camt53Entry(field Field, currency string) (camt_v08.ReportEntry10, error) {
+	lines := field.Lines()
+	match := statementLineRegexp.FindStringSubmatch(lines[0])
+	if match == nil {
+		return camt_v08.ReportEntry10{}, utils.NewErrInvalidField(":61:")
+	}
+	valueDate, err := parseDate(":61:", match[1])
+	if err != nil {
+		return camt_v08.ReportEntry10{}, err
+	}
+	indicator, err := entryCreditDebit(match[3])
+	if err != nil {
+		return camt_v08.ReportEntry10{}, err
+	}
+	amount, err := parseAmount(":61:", match[5])
+	if err != nil {
+		return camt_v08.ReportEntry10{}, err
+	}
+
+	status := camt_v08.ExternalEntryStatus1Code("BOOK")
+	issuer := common.Max35Text("SWIFT")
+	entry := camt_v08.ReportEntry10{
+		Amt: camt_v08.ActiveOrHistoricCurrencyAndAmount{
+			Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(amount),
+			Ccy:   common.ActiveOrHistoricCurrencyCode(currency),
+		},
+		CdtDbtInd: indicator,
+		RvslInd:   strings.HasPrefix(match[3], "R"),
+		Sts:       camt_v08.EntryStatus1Choice{Cd: &status},
+		ValDt:     &camt_v08.DateAndDateTime2Choice{Dt: isoDate(valueDate)},
+		BkTxCd: camt_v08.BankTransactionCodeStructure4{
+			Prtry: &camt_v08.ProprietaryBankTransactionCodeStructure1{Cd: common.Max35Text(match[6]), Issr: &issuer},
+		},
+		AcctSvcrRef: common.OptionalMax35Text(match[8]),
+	}
+	if match[2] != "" {
+		entryDate, err := time.Parse("0102", match[2])
+		if err != nil {
+			return camt_v08.ReportEntry10{}, utils.NewErrInvalidField(":61:")
+		}
+		// the entry date has no year, it's the one closest to the value date
+		entryDate = entryDate.AddDate(valueDate.Year(), 0, 0)
+		if entryDate.Sub(valueDate) > 183*24*time.Hour {
+			entryDate = entryDate.AddDate(-1, 0, 0)
+		} else if valueDate.Sub(entryDate) > 183*24*time.Hour {
+			entryDate = entryDate.AddDate(1, 0, 0)
+		}
+		entry.BookgDt = &camt_v08.DateAndDateTime2Choice{Dt: isoDate(entryDate)}
+	}
+	if match[4] != "" {
+		t.lose(":61:", document.LossDropped, match[4])
+	}
+
+	var details camt_v08.EntryTransaction10
+	if match[7] != "NONREF" && match[7] != "" {
+		details.Refs = &camt_v08.TransactionReferences6{EndToEndId: common.OptionalMax35Text(match[7])}
+	}
+	if len(lines) > 1 {
+		details.AddtlTxInf = optionalInfo(lines[1])
+	}
+	if details.Refs != nil || details.AddtlTxInf != nil {
+		entry.NtryDtls = []camt_v08.EntryDetails9{{TxDtls: []camt_v08.EntryTransaction10{details}}}
+	}
+	return entry, nil
+}
+
+// entryCreditDebit returns the direction of the entry of a statement line. A reversal of a credit (RC) is
+// a debit of the account and a reversal of a debit (RD) a credit.
+func entryCreditDebit(mark string) (common.CreditDebitCode, error) {
+	switch mark {
+	case "RC":
+		return "DBIT", nil
+	case "RD":
+		return "CRDT", nil
+	}
+	return creditDebit(":61:", mark)
+}
+
+func creditDebit(tag, mark string) (common.CreditDebitCode, error) {
+	switch mark {
+	case "C":
+		return "CRDT", nil
+	case "D":
+		return "DBIT", nil
+	}
+	return "", utils.NewErrInvalidField(tag)
+}
+
+func optionalInfo(value string) *common.Max500Text {
+	if value == "" {
+		return nil
+	}
+	text := common.Max500Text(value)
+	return &text
+}
+
+// toMT940 translates the first statement of a camt.053.001.08 into an MT940, or an MT950 which has no
+// information to the account owner (field 86)
+func (t *translator) toMT940(message *camt_v08.BankToCustomerStatementV08, path string) (*Message, error) {
+	if len(message.Stmt) == 0 {
+		return nil, utils.NewErrOmittedTransactions()
+	}
+	for i := 1; i < len(message.Stmt); i++ {
+		t.lose(fmt.Sprintf("%s/Stmt[%d]", path, i+1), document.LossDropped, string(message.Stmt[i].Id))
+	}
+	grpHdr, stmt := message.GrpHdr, message.Stmt[0]
+	stmtPath := path + "/Stmt[1]"
+	if stmt.Acct == nil {
+		return nil, utils.NewErrValueInvalid("CashAccount39")
+	}
+
+	messageType := "940"
+	if t.opts.messageType == "950" {
+		messageType = "950"
+	}
+	var servicer, recipient string
+	if stmt.Acct.Svcr != nil && stmt.Acct.Svcr.FinInstnId.BICFI != nil {
+		servicer = string(*stmt.Acct.Svcr.FinInstnId.BICFI)
+	}
+	if rcpt := grpHdr.MsgRcpt; rcpt != nil && rcpt.Id != nil && rcpt.Id.OrgId != nil && rcpt.Id.OrgId.AnyBIC != nil {
+		recipient = string(*rcpt.Id.OrgId.AnyBIC)
+	}
+	msg, err := newMessage(messageType, firstBIC(t.opts.sender, party{bic: servicer}), firstBIC(t.opts.receiver, party{bic: recipient}))
+	if err != nil {
+		return nil, err
+	}
+
+	msg.Add("20", t.reference(stmtPath+"/Id", string(stmt.Id)))
+	account := t.line(stmtPath+"/Acct/Id", camt53AccountId(stmt.Acct), 35)
+	if servicer != "" && messageType == "940" {
+		msg.Add("25P", account+"\n"+servicer)
+	} else {
+		msg.Add("25", account)
+	}
+
+	number := stmt.LglSeqNb
+	if number == 0 {
+		number = stmt.ElctrncSeqNb
+	}
+	sequence := fmt.Sprintf("%05d", int64(number))
+	if stmt.StmtPgntn != nil {
+		sequence += "/" + fmt.Sprintf("%05s", string(stmt.StmtPgntn.PgNb))
+	}
+	msg.Add("28C", sequence)
+
+	var opening, closing []Field
+	for i, balance := range stmt.Bal {
+		balancePath := fmt.Sprintf("%s/Bal[%d]", stmtPath, i+1)
+		var code string
+		if balance.Tp.CdOrPrtry.Cd != nil {
+			code = string(*balance.Tp.CdOrPrtry.Cd)
+		}
+		var tag string
+		switch code {
+		case "OPBD", "PRCD":
+			tag = "60F"
+		case "ITBD":
+			tag = "62M"
+			if len(opening) == 0 {
+				tag = "60M"
+			}
+		case "CLBD":
+			tag = "62F"
+		case "CLAV":
+			tag = "64"
+		case "FWAV":
+			tag = "65"
+		default:
+			t.lose(balancePath, document.LossDropped, code)
+			continue
+		}
+		date := t.reportDate(balancePath+"/Dt", &balance.Dt, grpHdr.CreDtTm)
+		value := camt53Mark(balance.CdtDbtInd) + date.Format("060102") + string(balance.Amt.Ccy) + formatAmount(common.Decimal(balance.Amt.Value))
+		if strings.HasPrefix(tag, "60") {
+			opening = append(opening, Field{Tag: tag, Value: value})
+		} else {
+			closing = append(closing, Field{Tag: tag, Value: value})
+		}
+		t.dropElements(balancePath, balance, "Tp", "Amt", "CdtDbtInd", "Dt")
+	}
+
+	msg.Fields = append(msg.Fields, opening...)
+	for i, entry := range stmt.Ntry {
+		t.addStatementLine(msg, fmt.Sprintf("%s/Ntry[%d]", stmtPath, i+1), entry, grpHdr.CreDtTm)
+	}
+	msg.Fields = append(msg.Fields, closing...)
+	if stmt.AddtlStmtInf != nil {
+		t.addInformation(msg, stmtPath+"/AddtlStmtInf", string(*stmt.AddtlStmtInf))
+	}
+
+	t.dropElements(path+"/GrpHdr", grpHdr, "MsgId", "CreDtTm", "MsgRcpt")
+	t.dropElements(stmtPath+"/Acct", stmt.Acct, "Id", "Svcr")
+	t.dropElements(stmtPath, stmt, "Id", "StmtPgntn", "ElctrncSeqNb", "LglSeqNb", "CreDtTm", "Acct", "Bal", "Ntry", "AddtlStmtInf")
+	t.dropElements(path, message, "GrpHdr", "Stmt")
+	return msg, nil
+}
+
+// addStatementLine adds field 61 of an entry and field 86 of its additional information
+func (t *translator) addStatementLine(msg *Message, path string, entry camt_v08.ReportEntry10, created common.ISODateTime) {
+	valueDate := entry.ValDt
+	if valueDate == nil {
+		valueDate = entry.BookgDt
+	}
+	line := t.reportDate(path+"/ValDt", valueDate, created).Format("060102")
+	if entry.BookgDt != nil {
+		line += t.reportDate(path+"/BookgDt", entry.BookgDt, created).Format("0102")
+	}
+	line += statementLineMark(entry) + formatAmount(common.Decimal(entry.Amt.Value))
+
+	code := "NMSC"
+	if prtry := entry.BkTxCd.Prtry; prtry != nil && transactionTypeRegexp.MatchString(string(prtry.Cd)) {
+		code = string(prtry.Cd)
+	} else {
+		t.lose(path+"/BkTxCd", document.LossDefaulted, code)
+	}
+	line += code
+
+	reference := "NONREF"
+	var information string
+	for i, details := range entry.NtryDtls {
+		for j, tx := range details.TxDtls {
+			txPath := fmt.Sprintf("%s/NtryDtls[%d]/TxDtls[%d]", path, i+1, j+1)
+			if i > 0 || j > 0 {
+				t.lose(txPath, document.LossDropped, "")
+				continue
+			}
+			if tx.Refs != nil && tx.Refs.EndToEndId != nil {
+				reference = t.reference(txPath+"/Refs/EndToEndId", string(*tx.Refs.EndToEndId))
+				t.dropElements(txPath+"/Refs", tx.Refs, "EndToEndId")
+			}
+			if tx.AddtlTxInf != nil {
+				information = t.line(txPath+"/AddtlTxInf", string(*tx.AddtlTxInf), 34)
+			}
+			t.dropElements(txPath, tx, "Refs", "AddtlTxInf")
+		}
+		t.dropElements(fmt.Sprintf("%s/NtryDtls[%d]", path, i+1), details, "TxDtls")
+	}
+	line += reference
+	if entry.AcctSvcrRef != nil {
+		line += "//" + t.line(path+"/AcctSvcrRef", string(*entry.AcctSvcrRef), 16)
+	}
+	if information != "" {
+		line += "\n" + information
+	}
+	msg.Add("61", line)
+
+	if entry.AddtlNtryInf != nil {
+		t.addInformation(msg, path+"/AddtlNtryInf", string(*entry.AddtlNtryInf))
+	}
+	t.dropElements(path+"/BkTxCd", entry.BkTxCd, "Prtry")
+	t.dropElements(path, entry, "Amt", "CdtDbtInd", "RvslInd", "Sts", "BookgDt", "ValDt", "AcctSvcrRef", "BkTxCd", "NtryDtls", "AddtlNtryInf")
+}
+
+// addInformation adds field 86 to an MT940, an MT950 has no such field
+func (t *translator) addInformation(msg *Message, path, text string) {
+	if msg.Type() != "940" {
+		t.lose(path, document.LossDropped, text)
+		return
+	}
+	msg.Add("86", strings.Join(t.lines(path, []string{text}, 65, 6), "\n"))
+}
+
+// reportDate returns the date of a balance or an entry, the creation date of the message is reported
+// as default when it's missing
+func (t *translator) reportDate(path string, date *camt_v08.DateAndDateTime2Choice, created common.ISODateTime) time.Time {
+	switch {
+	case date != nil && date.Dt != nil:
+		return time.Time(*date.Dt)
+	case date != nil && date.DtTm != nil:
+		return time.Time(*date.DtTm)
+	}
+	t.lose(path, document.LossDefaulted, "")
+	return time.Time(created)
+}
+
+func camt53AccountId(account *camt_v08.CashAccount39) string {
+	switch {
+	case account.Id.IBAN != nil:
+		return string(*account.Id.IBAN)
+	case account.Id.Othr != nil:
+		return string(account.Id.Othr.Id)
+	}
+	return ""
+}
+
+func camt53Mark(indicator common.CreditDebitCode) string {
+	if indicator == "DBIT" {
+		return "D"
+	}
+	return "C"
+}
+
+// statementLineMark returns the debit or credit mark of a statement line, a reversal is marked with the
+// direction of the reversed entry: RC for a debit and RD for a credit
+func statementLineMark(entry camt_v08.ReportEntry10) string {
+	if !entry.RvslInd {
+		return camt53Mark(entry.CdtDbtInd)
+	}
+	if entry.CdtDbtInd == "DBIT" {
+		return "RC"
+	}
+	return "RD"
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// replacements of characters which aren't letters with diacritics, following the CBPR+ character set guidelines
+var replacements = map[rune]string{
+	'&': "+", '@': "(at)", '_': "-", '"': "'", '`': "'", '´': "'", '[': "(", ']': ")", '{': "(", '}': ")",
+	'<': "(", '>': ")", '!': ".", ';': ",", '=': "-", '#': ".", '%': ".", '*': ".", '$': ".", '|': "/",
+	'\\': "/", '~': "-", '^': ".", '€': "EUR", '£': "GBP",
+	'ß': "ss", 'Æ': "AE", 'æ': "ae", 'Ø': "O", 'ø': "o", 'Œ': "OE", 'œ': "oe", 'Ð': "D", 'ð': "d", 'Þ': "TH",
+	'þ': "th", 'Ł': "L", 'ł': "l", 'Đ': "D", 'đ': "d", 'ı': "i",
+}
+
+// isX is true for the characters of the SWIFT X character set
+func isX(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	}
+	return strings.ContainsRune("/-?:().,'+ ", r)
+}
+
+// toX converts text into the X character set: letters lose their diacritics, other characters are replaced
+// by a similar character or by a dot. It reports whether the text is changed.
+func toX(text string) (string, bool) {
+	var b strings.Builder
+	changed := false
+	for _, r := range text {
+		if isX(r) {
+			b.WriteRune(r)
+			continue
+		}
+		changed = true
+		if r == '\n' || r == '\r' || r == '\t' {
+			b.WriteRune(' ')
+			continue
+		}
+		if replacement, found := replacements[r]; found {
+			b.WriteString(replacement)
+			continue
+		}
+		replaced := false
+		for _, d := range norm.NFD.String(string(r)) {
+			if isX(d) {
+				b.WriteRune(d)
+				replaced = true
+			} else if !unicode.Is(unicode.Mn, d) {
+				break
+			}
+		}
+		if !replaced {
+			b.WriteRune('.')
+		}
+	}
+	return b.String(), changed
+}
+
+// wrap splits text into lines of at most width characters
+func wrap(text string, width int) []string {
+	var lines []string
+	for len(text) > width {
+		lines = append(lines, text[:width])
+		text = text[width:]
+	}
+	if text != "" {
+		lines = append(lines, text)
+	}
+	return lines
+}
+
+// wrapCode splits the information of a code like /ROC/reference into lines of at most width characters,
+// the continuation lines start with //
+func wrapCode(text string, width int) []string {
+	if len(text) <= width {
+		return []string{text}
+	}
+	lines := []string{text[:width]}
+	for _, line := range wrap(text[width:], width-2) {
+		lines = append(lines, "//"+line)
+	}
+	return lines
+}
@@ -0,0 +1,268 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package mt reads and writes SWIFT MT messages in FIN format and translates MT103, MT202, MT202COV, MT940 and
+// MT950 into the pacs.008.001.08, pacs.009.001.09 and camt.053.001.08 documents of the library and back,
+// following the CBPR+ mapping rules. Every translation returns a document.ConversionReport of the data which
+// couldn't be carried over unchanged.
+package mt
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Field is a field of the text block, like :20:REFERENCE. Value keeps the lines of the field separated by \n.
+type Field struct {
+	Tag   string
+	Value string
+}
+
+// Lines returns the lines of the field value
+func (f Field) Lines() []string {
+	return strings.Split(f.Value, "\n")
+}
+
+// Message is a SWIFT MT message: the basic header block {1:}, the application header block {2:},
+// the user header block {3:}, the text block {4:} and the trailer block {5:}.
+// The headers and the trailer are kept as they are read, the text block is split into fields.
+type Message struct {
+	BasicHeader       string
+	ApplicationHeader string
+	UserHeader        string `json:",omitempty"`
+	Fields            []Field
+	Trailer           string `json:",omitempty"`
+}
+
+var (
+	fieldRegexp       = regexp.MustCompile(`^:([0-9]{2}[A-Z]?):(.*)$`)
+	headerFieldRegexp = regexp.MustCompile(`\{([0-9A-Z]{3}):([^{}]*)\}`)
+)
+
+// NewMessage returns an input message of the type (e.g. 103) from the sender to the receiver BIC
+func NewMessage(messageType, sender, receiver string) *Message {
+	return &Message{
+		BasicHeader:       "F01" + logicalTerminal(sender) + "0000000000",
+		ApplicationHeader: "I" + messageType + logicalTerminal(receiver) + "N",
+	}
+}
+
+// IsMessage is true when buf starts like the basic header block of a FIN message
+func IsMessage(buf []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(bytes.TrimPrefix(buf, []byte("\xef\xbb\xbf"))), []byte("{1:"))
+}
+
+// Parse reads a FIN message. Lines may end with CRLF or LF.
+func Parse(buf []byte) (*Message, error) {
+	text := strings.TrimSpace(strings.TrimPrefix(string(buf), "\ufeff"))
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+
+	msg := &Message{}
+	for len(text) > 0 {
+		if len(text) < 3 || text[0] != '{' || text[2] != ':' {
+			return nil, utils.NewErrInvalidBlock(firstChars(text))
+		}
+		id := text[1:2]
+
+		var content string
+		if id == "4" {
+			end := strings.Index(text, "\n-}")
+			if end < 0 {
+				return nil, utils.NewErrInvalidBlock(id)
+			}
+			content, text = text[3:end], text[end+3:]
+		} else {
+			end := closingBrace(text)
+			if end < 0 {
+				return nil, utils.NewErrInvalidBlock(id)
+			}
+			content, text = text[3:end], text[end+1:]
+		}
+		text = strings.TrimLeft(text, "\n ")
+
+		switch id {
+		case "1":
+			msg.BasicHeader = content
+		case "2":
+			msg.ApplicationHeader = content
+		case "3":
+			msg.UserHeader = content
+		case "4":
+			fields, err := parseFields(content)
+			if err != nil {
+				return nil, err
+			}
+			msg.Fields = fields
+		case "5":
+			msg.Trailer = content
+		default:
+			return nil, utils.NewErrInvalidBlock(id)
+		}
+	}
+
+	if len(msg.BasicHeader) < 15 {
+		return nil, utils.NewErrInvalidBlock("1")
+	}
+	if len(msg.ApplicationHeader) < 4 || (msg.ApplicationHeader[0] != 'I' && msg.ApplicationHeader[0] != 'O') {
+		return nil, utils.NewErrInvalidBlock("2")
+	}
+	if msg.Fields == nil {
+		return nil, utils.NewErrInvalidBlock("4")
+	}
+	return msg, nil
+}
+
+func parseFields(content string) ([]Field, error) {
+	var fields []Field
+	for _, line := range strings.Split(strings.Trim(content, "\n"), "\n") {
+		if match := fieldRegexp.FindStringSubmatch(line); match != nil {
+			fields = append(fields, Field{Tag: match[1], Value: match[2]})
+			continue
+		}
+		if len(fields) == 0 {
+			return nil, utils.NewErrInvalidBlock("4")
+		}
+		fields[len(fields)-1].Value += "\n" + line
+	}
+	return fields, nil
+}
+
+func closingBrace(text string) int {
+	depth := 0
+	for i, r := range text {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func firstChars(text string) string {
+	if len(text) > 5 {
+		return text[:5]
+	}
+	return text
+}
+
+// Type returns the message type of the application header, e.g. 103
+func (m *Message) Type() string {
+	if len(m.ApplicationHeader) < 4 {
+		return ""
+	}
+	return m.ApplicationHeader[1:4]
+}
+
+// Sender returns the BIC of the sender
+func (m *Message) Sender() string {
+	if strings.HasPrefix(m.ApplicationHeader, "O") {
+		// the message input reference of an output message: input time, date and logical terminal
+		return terminalBIC(substring(m.ApplicationHeader, 14, 26))
+	}
+	return terminalBIC(substring(m.BasicHeader, 3, 15))
+}
+
+// Receiver returns the BIC of the receiver
+func (m *Message) Receiver() string {
+	if strings.HasPrefix(m.ApplicationHeader, "O") {
+		return terminalBIC(substring(m.BasicHeader, 3, 15))
+	}
+	return terminalBIC(substring(m.ApplicationHeader, 4, 16))
+}
+
+// HeaderField returns a field of the user header, like the UETR of field 121
+func (m *Message) HeaderField(tag string) string {
+	for _, match := range headerFieldRegexp.FindAllStringSubmatch(m.UserHeader, -1) {
+		if match[1] == tag {
+			return match[2]
+		}
+	}
+	return ""
+}
+
+// SetHeaderField adds a field to the user header or changes it
+func (m *Message) SetHeaderField(tag, value string) {
+	field := "{" + tag + ":" + value + "}"
+	for _, match := range headerFieldRegexp.FindAllStringSubmatch(m.UserHeader, -1) {
+		if match[1] == tag {
+			m.UserHeader = strings.Replace(m.UserHeader, match[0], field, 1)
+			return
+		}
+	}
+	m.UserHeader += field
+}
+
+// Field returns the value of the first field with the tag
+func (m *Message) Field(tag string) (string, bool) {
+	for _, field := range m.Fields {
+		if field.Tag == tag {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+// Add appends a field to the text block, the lines of value are separated by \n
+func (m *Message) Add(tag, value string) {
+	m.Fields = append(m.Fields, Field{Tag: tag, Value: value})
+}
+
+// Bytes writes the message in FIN format with CRLF line endings
+func (m *Message) Bytes() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("{1:" + m.BasicHeader + "}")
+	buf.WriteString("{2:" + m.ApplicationHeader + "}")
+	if m.UserHeader != "" {
+		buf.WriteString("{3:" + m.UserHeader + "}")
+	}
+	buf.WriteString("{4:\r\n")
+	for _, field := range m.Fields {
+		buf.WriteString(":" + field.Tag + ":" + strings.ReplaceAll(field.Value, "\n", "\r\n") + "\r\n")
+	}
+	buf.WriteString("-}")
+	if m.Trailer != "" {
+		buf.WriteString("{5:" + m.Trailer + "}")
+	}
+	return buf.Bytes()
+}
+
+func (m *Message) String() string {
+	return string(m.Bytes())
+}
+
+// logicalTerminal returns the 12 characters logical terminal address of a BIC
+func logicalTerminal(bic string) string {
+	bic = strings.ToUpper(bic)
+	for len(bic) < 8 {
+		bic += "X"
+	}
+	branch := "XXX"
+	if len(bic) >= 11 {
+		branch = bic[8:11]
+	}
+	return bic[:8] + "A" + branch
+}
+
+// terminalBIC returns the BIC of a logical terminal address
+func terminalBIC(terminal string) string {
+	if len(terminal) != 12 {
+		return ""
+	}
+	return terminal[:8] + terminal[9:]
+}
+
+func substring(s string, from, to int) string {
+	if len(s) < to {
+		return ""
+	}
+	return s[from:to]
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readTestMessage(t *testing.T, name string) *Message {
+	buf, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", name))
+	assert.Nil(t, err)
+	assert.True(t, IsMessage(buf))
+	msg, err := Parse(buf)
+	assert.Nil(t, err)
+	return msg
+}
+
+func TestParse(t *testing.T) {
+	msg := readTestMessage(t, "valid_mt103.txt")
+	assert.Equal(t, "103", msg.Type())
+	assert.Equal(t, "BANKBEBBXXX", msg.Sender())
+	assert.Equal(t, "BANKDEFFXXX", msg.Receiver())
+	assert.Equal(t, "eb6305c9-1f7f-49de-aed0-16487c27b42d", msg.HeaderField("121"))
+	assert.Equal(t, "", msg.HeaderField("119"))
+
+	value, found := msg.Field("50K")
+	assert.True(t, found)
+	assert.Equal(t, []string{"/BE68539007547034", "Jürgen Müller", "Rue de la Loi 16", "BE/1000 Bruxelles"}, Field{Tag: "50K", Value: value}.Lines())
+	_, found = msg.Field("50A")
+	assert.False(t, found)
+
+	// CRLF line endings and a trailer
+	crlf := strings.ReplaceAll(msg.String(), "-}", "-}{5:{CHK:123456789ABC}}")
+	assert.Contains(t, crlf, "\r\n:20:REF-2022-0001\r\n")
+	parsed, err := Parse([]byte(crlf))
+	assert.Nil(t, err)
+	assert.Equal(t, msg.Fields, parsed.Fields)
+	assert.Equal(t, "{CHK:123456789ABC}", parsed.Trailer)
+}
+
+func TestParseOutputMessage(t *testing.T) {
+	msg, err := Parse([]byte("{1:F01BANKDEFFAXXX0000000000}{2:O1031200220301BANKBEBBAXXX00000000002203011200N}{4:\n:20:REF\n-}"))
+	assert.Nil(t, err)
+	assert.Equal(t, "BANKBEBBXXX", msg.Sender())
+	assert.Equal(t, "BANKDEFFXXX", msg.Receiver())
+}
+
+func TestParseErrors(t *testing.T) {
+	for input, message := range map[string]string{
+		"":            "The block 1 of MT message is invalid",
+		"<Document/>": "The block <Docu of MT message is invalid",
+		"{1:F01BANKBEBBAXXX0000000000}{2:I103BANKDEFFXXXXN}{4:\n:20:REF\n": "The block 4 of MT message is invalid",
+		"{1:F01BANKBEBBAXXX0000000000}{2:I103BANKDEFFXXXXN}{4:\nREF\n-}":   "The block 4 of MT message is invalid",
+		"{1:F01}{2:I103BANKDEFFXXXXN}{4:\n:20:REF\n-}":                     "The block 1 of MT message is invalid",
+		"{1:F01BANKBEBBAXXX0000000000}{2:X}{4:\n:20:REF\n-}":               "The block 2 of MT message is invalid",
+		"{1:F01BANKBEBBAXXX0000000000}{2:I103BANKDEFFXXXXN}{6:X}":          "The block 6 of MT message is invalid",
+	} {
+		_, err := Parse([]byte(input))
+		assert.EqualError(t, err, message, input)
+	}
+}
+
+func TestNewMessage(t *testing.T) {
+	msg := NewMessage("202", "BANKBEBB", "BANKDEFFXXX")
+	msg.SetHeaderField("121", "first")
+	msg.SetHeaderField("119", "COV")
+	msg.SetHeaderField("121", "second")
+	msg.Add("20", "REF")
+	msg.Add("72", "/INS/BANKBEBB\n/REC/NEXT")
+
+	assert.Equal(t, "{1:F01BANKBEBBAXXX0000000000}{2:I202BANKDEFFAXXXN}{3:{121:second}{119:COV}}{4:\r\n"+
+		":20:REF\r\n:72:/INS/BANKBEBB\r\n/REC/NEXT\r\n-}", msg.String())
+	assert.Equal(t, "BANKBEBBXXX", msg.Sender())
+	assert.Equal(t, "BANKDEFFXXX", msg.Receiver())
+}
+
+func TestCharacterSet(t *testing.T) {
+	for text, expected := range map[string]string{
+		"Jürgen Müller":     "Jurgen Muller",
+		"Ærøskøbing":        "AEroskobing",
+		"Straße 1 & 2":      "Strasse 1 + 2",
+		"info@moov.io; 50%": "info(at)moov.io, 50.",
+		"東京":                "..",
+	} {
+		converted, changed := toX(text)
+		assert.True(t, changed)
+		assert.Equal(t, expected, converted)
+	}
+	converted, changed := toX("Rue de la Loi 16/1000 (BE)")
+	assert.False(t, changed)
+	assert.Equal(t, "Rue de la Loi 16/1000 (BE)", converted)
+
+	assert.Equal(t, []string{"abc", "def", "g"}, wrap("abcdefg", 3))
+	assert.Nil(t, wrap("", 3))
+}
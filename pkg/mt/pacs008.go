@@ -0,0 +1,273 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const notProvided = "NOTPROVIDED"
+
+// chargeBearers maps the details of charges of field 71A to the charge bearer codes
+var chargeBearers = map[string]string{"OUR": "DEBT", "SHA": "SHAR", "BEN": "CRED"}
+
+// fromMT103 translates an MT103 into a pacs.008.001.08 with a single transaction
+func (t *translator) fromMT103(msg *Message) (*pacs_v08.FIToFICustomerCreditTransferV08, error) {
+	reference, found := msg.Field("20")
+	if !found {
+		return nil, utils.NewErrOmittedField(":20:")
+	}
+	value, found := msg.Field("32A")
+	if !found {
+		return nil, utils.NewErrOmittedField(":32A:")
+	}
+	date, currency, amount, err := splitAmount(":32A:", value, true)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, receiver := party{bic: msg.Sender()}, party{bic: msg.Receiver()}
+	tx := pacs_v08.CreditTransferTransaction39{
+		PmtId: pacs_v08.PaymentIdentification7{
+			InstrId:    common.OptionalMax35Text(reference),
+			EndToEndId: notProvided,
+			UETR:       common.OptionalUUIDv4Identifier(msg.HeaderField("121")),
+		},
+		IntrBkSttlmAmt: pacs_v08.ActiveCurrencyAndAmount{
+			Value: common.ActiveCurrencyAndAmountSimpleType(amount),
+			Ccy:   common.ActiveCurrencyCode(currency),
+		},
+		IntrBkSttlmDt: isoDate(date),
+		ChrgBr:        "SHAR",
+		InstgAgt:      sender.pacs08OptionalAgent(),
+		InstdAgt:      receiver.pacs08OptionalAgent(),
+		DbtrAgt:       sender.pacs08Agent(),
+		CdtrAgt:       receiver.pacs08Agent(),
+	}
+	settlement := pacs_v08.SettlementInstruction7{SttlmMtd: "INDA"}
+
+	for _, field := range msg.Fields {
+		switch field.Tag {
+		case "20", "32A":
+		case "23B":
+			if field.Value != "CRED" {
+				t.lose(":23B:", document.LossDropped, field.Value)
+			}
+		case "33B":
+			_, currency, amount, err := splitAmount(":33B:", field.Value, false)
+			if err != nil {
+				return nil, err
+			}
+			tx.InstdAmt = &pacs_v08.ActiveOrHistoricCurrencyAndAmount{
+				Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(amount),
+				Ccy:   common.ActiveOrHistoricCurrencyCode(currency),
+			}
+		case "36":
+			rate, err := parseAmount(":36:", field.Value)
+			if err != nil {
+				return nil, err
+			}
+			tx.XchgRate = rate.Float64()
+		case "50A", "50F", "50K":
+			p := t.party(field)
+			tx.Dbtr, tx.DbtrAcct = p.pacs08Party(), p.pacs08Account()
+		case "52A", "52D":
+			p := t.party(field)
+			tx.DbtrAgt, tx.DbtrAgtAcct = p.pacs08Agent(), p.pacs08Account()
+		case "53A", "53D":
+			p := t.party(field)
+			settlement.SttlmMtd = "COVE"
+			settlement.InstgRmbrsmntAgt, settlement.InstgRmbrsmntAgtAcct = p.pacs08OptionalAgent(), p.pacs08Account()
+		case "53B":
+			p := t.party(field)
+			settlement.SttlmAcct = p.pacs08Account()
+		case "54A", "54D":
+			p := t.party(field)
+			settlement.SttlmMtd = "COVE"
+			settlement.InstdRmbrsmntAgt, settlement.InstdRmbrsmntAgtAcct = p.pacs08OptionalAgent(), p.pacs08Account()
+		case "55A", "55D":
+			p := t.party(field)
+			settlement.ThrdRmbrsmntAgt, settlement.ThrdRmbrsmntAgtAcct = p.pacs08OptionalAgent(), p.pacs08Account()
+		case "56A", "56D":
+			p := t.party(field)
+			tx.IntrmyAgt1, tx.IntrmyAgt1Acct = p.pacs08OptionalAgent(), p.pacs08Account()
+		case "57A", "57B", "57D":
+			p := t.party(field)
+			if p.bic != "" || p.name != "" {
+				tx.CdtrAgt = p.pacs08Agent()
+			}
+			tx.CdtrAgtAcct = p.pacs08Account()
+		case "59", "59A", "59F":
+			p := t.party(field)
+			tx.Cdtr, tx.CdtrAcct = p.pacs08Party(), p.pacs08Account()
+		case "70":
+			lines := field.Lines()
+			// the end to end reference which doesn't fit into an MT103 is carried by the code ROC,
+			// it continues on the lines starting with //
+			if strings.HasPrefix(lines[0], "/ROC/") {
+				reference := strings.TrimPrefix(lines[0], "/ROC/")
+				for lines = lines[1:]; len(lines) > 0 && strings.HasPrefix(lines[0], "//"); lines = lines[1:] {
+					reference += strings.TrimPrefix(lines[0], "//")
+				}
+				tx.PmtId.EndToEndId = common.Max35Text(reference)
+			}
+			if len(lines) > 0 {
+				tx.RmtInf = &pacs_v08.RemittanceInformation16{
+					Ustrd: []common.Max140Text{common.Max140Text(strings.Join(lines, ""))},
+				}
+			}
+		case "71A":
+			bearer, found := chargeBearers[field.Value]
+			if !found {
+				return nil, utils.NewErrInvalidField(":71A:")
+			}
+			tx.ChrgBr = pacs_v08.ChargeBearerType1Code(bearer)
+		case "71F", "71G":
+			_, currency, amount, err := splitAmount(":"+field.Tag+":", field.Value, false)
+			if err != nil {
+				return nil, err
+			}
+			agent := sender.pacs08Agent()
+			if field.Tag == "71G" {
+				agent = receiver.pacs08Agent()
+			}
+			tx.ChrgsInf = append(tx.ChrgsInf, pacs_v08.Charges7{
+				Amt: pacs_v08.ActiveOrHistoricCurrencyAndAmount{
+					Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(amount),
+					Ccy:   common.ActiveOrHistoricCurrencyCode(currency),
+				},
+				Agt: agent,
+			})
+		case "72":
+			for _, line := range field.Lines() {
+				tx.InstrForNxtAgt = append(tx.InstrForNxtAgt, pacs_v08.InstructionForNextAgent1{InstrInf: common.OptionalMax140Text(line)})
+			}
+		default:
+			t.lose(":"+field.Tag+":", document.LossDropped, field.Value)
+		}
+	}
+
+	return &pacs_v08.FIToFICustomerCreditTransferV08{
+		GrpHdr: pacs_v08.GroupHeader93{
+			MsgId:    common.Max35Text(reference),
+			CreDtTm:  common.ISODateTime(t.opts.clock()),
+			NbOfTxs:  "1",
+			SttlmInf: settlement,
+		},
+		CdtTrfTxInf: []pacs_v08.CreditTransferTransaction39{tx},
+	}, nil
+}
+
+// toMT103 translates the first transaction of a pacs.008.001.08 into an MT103
+func (t *translator) toMT103(message *pacs_v08.FIToFICustomerCreditTransferV08, path string) (*Message, error) {
+	if len(message.CdtTrfTxInf) == 0 {
+		return nil, utils.NewErrOmittedTransactions()
+	}
+	for i := 1; i < len(message.CdtTrfTxInf); i++ {
+		t.lose(fmt.Sprintf("%s/CdtTrfTxInf[%d]", path, i+1), document.LossDropped, string(message.CdtTrfTxInf[i].PmtId.EndToEndId))
+	}
+	grpHdr, tx := message.GrpHdr, message.CdtTrfTxInf[0]
+	txPath := path + "/CdtTrfTxInf[1]"
+
+	sender := firstBIC(t.opts.sender, pacs08Agent(tx.InstgAgt, nil), pacs08Agent(grpHdr.InstgAgt, nil), pacs08Agent(&tx.DbtrAgt, nil))
+	receiver := firstBIC(t.opts.receiver, pacs08Agent(tx.InstdAgt, nil), pacs08Agent(grpHdr.InstdAgt, nil), pacs08Agent(&tx.CdtrAgt, nil))
+
+	msg, err := newMessage("103", sender, receiver)
+	if err != nil {
+		return nil, err
+	}
+	if tx.PmtId.UETR != nil {
+		msg.SetHeaderField("121", string(*tx.PmtId.UETR))
+	}
+	if tx.PmtId.InstrId != nil {
+		msg.Add("20", t.reference(txPath+"/PmtId/InstrId", string(*tx.PmtId.InstrId)))
+	} else {
+		msg.Add("20", t.reference(path+"/GrpHdr/MsgId", string(grpHdr.MsgId)))
+	}
+	msg.Add("23B", "CRED")
+
+	date := t.settlementDate(txPath+"/IntrBkSttlmDt", grpHdr.CreDtTm, tx.IntrBkSttlmDt, grpHdr.IntrBkSttlmDt)
+	msg.Add("32A", date.Format("060102")+string(tx.IntrBkSttlmAmt.Ccy)+formatAmount(common.Decimal(tx.IntrBkSttlmAmt.Value)))
+	if tx.InstdAmt != nil {
+		msg.Add("33B", string(tx.InstdAmt.Ccy)+formatAmount(common.Decimal(tx.InstdAmt.Value)))
+	}
+	if tx.XchgRate != 0 {
+		rate, err := common.ParseDecimal(strconv.FormatFloat(tx.XchgRate, 'f', -1, 64))
+		if err != nil {
+			return nil, err
+		}
+		msg.Add("36", formatAmount(rate))
+	}
+
+	settlement := grpHdr.SttlmInf
+	t.addParty(msg, "50", txPath+"/Dbtr", pacs08Party(&tx.Dbtr, tx.DbtrAcct))
+	t.addAgent(msg, "52", txPath+"/DbtrAgt", pacs08Agent(&tx.DbtrAgt, tx.DbtrAgtAcct))
+	if settlement.InstgRmbrsmntAgt == nil && settlement.SttlmAcct != nil {
+		t.addAgent(msg, "53", path+"/GrpHdr/SttlmInf/SttlmAcct", party{account: pacs08AccountId(settlement.SttlmAcct)})
+	}
+	t.addAgent(msg, "53", path+"/GrpHdr/SttlmInf/InstgRmbrsmntAgt", pacs08Agent(settlement.InstgRmbrsmntAgt, settlement.InstgRmbrsmntAgtAcct))
+	t.addAgent(msg, "54", path+"/GrpHdr/SttlmInf/InstdRmbrsmntAgt", pacs08Agent(settlement.InstdRmbrsmntAgt, settlement.InstdRmbrsmntAgtAcct))
+	t.addAgent(msg, "55", path+"/GrpHdr/SttlmInf/ThrdRmbrsmntAgt", pacs08Agent(settlement.ThrdRmbrsmntAgt, settlement.ThrdRmbrsmntAgtAcct))
+	t.addAgent(msg, "56", txPath+"/IntrmyAgt1", pacs08Agent(tx.IntrmyAgt1, tx.IntrmyAgt1Acct))
+	t.addAgent(msg, "57", txPath+"/CdtrAgt", pacs08Agent(&tx.CdtrAgt, tx.CdtrAgtAcct))
+	t.addParty(msg, "59", txPath+"/Cdtr", pacs08Party(&tx.Cdtr, tx.CdtrAcct))
+
+	var remittance []string
+	if tx.PmtId.EndToEndId != "" && tx.PmtId.EndToEndId != notProvided {
+		remittance = append(remittance, wrapCode("/ROC/"+string(tx.PmtId.EndToEndId), 35)...)
+	}
+	if tx.RmtInf != nil {
+		remittance = append(remittance, texts(tx.RmtInf.Ustrd)...)
+		t.dropElements(txPath+"/RmtInf", tx.RmtInf, "Ustrd")
+	}
+	if lines := t.lines(txPath+"/RmtInf", remittance, 35, 4); len(lines) > 0 {
+		msg.Add("70", strings.Join(lines, "\n"))
+	}
+
+	for bearer, code := range chargeBearers {
+		if string(tx.ChrgBr) == code {
+			msg.Add("71A", bearer)
+		}
+	}
+	if tx.ChrgBr == "SLEV" {
+		msg.Add("71A", "SHA")
+	}
+	for _, charges := range tx.ChrgsInf {
+		tag := "71F"
+		if p := pacs08Agent(&charges.Agt, nil); p.bic != "" && p.bic == receiver {
+			tag = "71G"
+		}
+		msg.Add(tag, string(charges.Amt.Ccy)+formatAmount(common.Decimal(charges.Amt.Value)))
+	}
+
+	var instructions []string
+	for _, next := range tx.InstrForNxtAgt {
+		var code string
+		if next.Cd != nil {
+			code = string(*next.Cd)
+		}
+		instructions = append(instructions, instruction(code, string(derefName(next.InstrInf))))
+	}
+	if lines := t.lines(txPath+"/InstrForNxtAgt", instructions, 35, 6); len(lines) > 0 {
+		msg.Add("72", strings.Join(lines, "\n"))
+	}
+
+	t.dropElements(path+"/GrpHdr", grpHdr, "MsgId", "CreDtTm", "NbOfTxs", "CtrlSum", "TtlIntrBkSttlmAmt", "IntrBkSttlmDt", "SttlmInf", "InstgAgt", "InstdAgt")
+	t.dropElements(path+"/GrpHdr/SttlmInf", settlement, "SttlmMtd", "SttlmAcct", "InstgRmbrsmntAgt", "InstgRmbrsmntAgtAcct",
+		"InstdRmbrsmntAgt", "InstdRmbrsmntAgtAcct", "ThrdRmbrsmntAgt", "ThrdRmbrsmntAgtAcct")
+	t.dropElements(txPath+"/PmtId", tx.PmtId, "InstrId", "EndToEndId", "UETR")
+	t.dropElements(txPath, tx, "PmtId", "IntrBkSttlmAmt", "IntrBkSttlmDt", "InstdAmt", "XchgRate", "ChrgBr", "ChrgsInf",
+		"InstgAgt", "InstdAgt", "IntrmyAgt1", "IntrmyAgt1Acct", "Dbtr", "DbtrAcct", "DbtrAgt", "DbtrAgtAcct",
+		"CdtrAgt", "CdtrAgtAcct", "Cdtr", "CdtrAcct", "InstrForNxtAgt", "RmtInf")
+	t.dropElements(path, message, "GrpHdr", "CdtTrfTxInf")
+	return msg, nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v09"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// fromMT202 translates an MT202 into a pacs.009.001.09 with a single transaction, the sequence B of an
+// MT202COV (from field 50a on) becomes the underlying customer credit transfer
+func (t *translator) fromMT202(msg *Message) (*pacs_v09.FinancialInstitutionCreditTransferV09, error) {
+	reference, found := msg.Field("20")
+	if !found {
+		return nil, utils.NewErrOmittedField(":20:")
+	}
+	value, found := msg.Field("32A")
+	if !found {
+		return nil, utils.NewErrOmittedField(":32A:")
+	}
+	date, currency, amount, err := splitAmount(":32A:", value, true)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, receiver := party{bic: msg.Sender()}, party{bic: msg.Receiver()}
+	tx := pacs_v09.CreditTransferTransaction44{
+		PmtId: pacs_v09.PaymentIdentification13{
+			InstrId:    common.OptionalMax35Text(reference),
+			EndToEndId: notProvided,
+			UETR:       common.OptionalUUIDv4Identifier(msg.HeaderField("121")),
+		},
+		IntrBkSttlmAmt: pacs_v09.ActiveCurrencyAndAmount{
+			Value: common.ActiveCurrencyAndAmountSimpleType(amount),
+			Ccy:   common.ActiveCurrencyCode(currency),
+		},
+		IntrBkSttlmDt: isoDate(date),
+		InstgAgt:      sender.pacs09OptionalAgent(),
+		InstdAgt:      receiver.pacs09OptionalAgent(),
+		Dbtr:          sender.pacs09Agent(),
+		Cdtr:          receiver.pacs09Agent(),
+	}
+	settlement := pacs_v09.SettlementInstruction7{SttlmMtd: "INDA"}
+
+	var underlying *pacs_v09.CreditTransferTransaction45
+	for _, field := range msg.Fields {
+		if strings.HasPrefix(field.Tag, "50") && underlying == nil {
+			underlying = &pacs_v09.CreditTransferTransaction45{}
+		}
+		if underlying != nil {
+			if err := t.fromSequenceB(field, underlying); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		switch field.Tag {
+		case "20", "32A":
+		case "21":
+			tx.PmtId.EndToEndId = common.Max35Text(field.Value)
+		case "52A", "52D":
+			p := t.party(field)
+			tx.Dbtr, tx.DbtrAcct = p.pacs09Agent(), p.pacs09Account()
+		case "53A", "53D":
+			p := t.party(field)
+			settlement.SttlmMtd = "COVE"
+			settlement.InstgRmbrsmntAgt, settlement.InstgRmbrsmntAgtAcct = p.pacs09OptionalAgent(), p.pacs09Account()
+		case "53B":
+			p := t.party(field)
+			settlement.SttlmAcct = p.pacs09Account()
+		case "54A", "54D":
+			p := t.party(field)
+			settlement.SttlmMtd = "COVE"
+			settlement.InstdRmbrsmntAgt, settlement.InstdRmbrsmntAgtAcct = p.pacs09OptionalAgent(), p.pacs09Account()
+		case "56A", "56D":
+			p := t.party(field)
+			tx.IntrmyAgt1, tx.IntrmyAgt1Acct = p.pacs09OptionalAgent(), p.pacs09Account()
+		case "57A", "57B", "57D":
+			p := t.party(field)
+			tx.CdtrAgt, tx.CdtrAgtAcct = p.pacs09OptionalAgent(), p.pacs09Account()
+		case "58A", "58D":
+			p := t.party(field)
+			tx.Cdtr, tx.CdtrAcct = p.pacs09Agent(), p.pacs09Account()
+		case "72":
+			for _, line := range field.Lines() {
+				tx.InstrForNxtAgt = append(tx.InstrForNxtAgt, pacs_v09.InstructionForNextAgent1{InstrInf: common.OptionalMax140Text(line)})
+			}
+		default:
+			t.lose(":"+field.Tag+":", document.LossDropped, field.Value)
+		}
+	}
+	tx.UndrlygCstmrCdtTrf = underlying
+
+	return &pacs_v09.FinancialInstitutionCreditTransferV09{
+		GrpHdr: pacs_v09.GroupHeader93{
+			MsgId:    common.Max35Text(reference),
+			CreDtTm:  common.ISODateTime(t.opts.clock()),
+			NbOfTxs:  "1",
+			SttlmInf: settlement,
+		},
+		CdtTrfTxInf: []pacs_v09.CreditTransferTransaction44{tx},
+	}, nil
+}
+
+// fromSequenceB translates a field of the underlying customer credit transfer of an MT202COV
+func (t *translator) fromSequenceB(field Field, underlying *pacs_v09.CreditTransferTransaction45) error {
+	switch field.Tag {
+	case "50A", "50F", "50K":
+		p := t.party(field)
+		underlying.Dbtr, underlying.DbtrAcct = p.pacs09Party(), p.pacs09Account()
+	case "52A", "52D":
+		p := t.party(field)
+		underlying.DbtrAgt, underlying.DbtrAgtAcct = p.pacs09Agent(), p.pacs09Account()
+	case "56A", "56D":
+		p := t.party(field)
+		underlying.IntrmyAgt1, underlying.IntrmyAgt1Acct = p.pacs09OptionalAgent(), p.pacs09Account()
+	case "57A", "57B", "57D":
+		p := t.party(field)
+		underlying.CdtrAgt, underlying.CdtrAgtAcct = p.pacs09Agent(), p.pacs09Account()
+	case "59", "59A", "59F":
+		p := t.party(field)
+		underlying.Cdtr, underlying.CdtrAcct = p.pacs09Party(), p.pacs09Account()
+	case "70":
+		underlying.RmtInf = &pacs_v09.RemittanceInformation16{
+			Ustrd: []common.Max140Text{common.Max140Text(strings.ReplaceAll(field.Value, "\n", ""))},
+		}
+	case "72":
+		for _, line := range field.Lines() {
+			underlying.InstrForNxtAgt = append(underlying.InstrForNxtAgt, pacs_v09.InstructionForNextAgent1{InstrInf: common.OptionalMax140Text(line)})
+		}
+	case "33B":
+		_, currency, amount, err := splitAmount(":33B:", field.Value, false)
+		if err != nil {
+			return err
+		}
+		underlying.InstdAmt = &pacs_v09.ActiveOrHistoricCurrencyAndAmount{
+			Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(amount),
+			Ccy:   common.ActiveOrHistoricCurrencyCode(currency),
+		}
+	default:
+		t.lose(":"+field.Tag+":", document.LossDropped, field.Value)
+	}
+	return nil
+}
+
+// toMT202 translates the first transaction of a pacs.009.001.09 into an MT202, or into an MT202COV when
+// it carries an underlying customer credit transfer
+func (t *translator) toMT202(message *pacs_v09.FinancialInstitutionCreditTransferV09, path string) (*Message, error) {
+	if len(message.CdtTrfTxInf) == 0 {
+		return nil, utils.NewErrOmittedTransactions()
+	}
+	for i := 1; i < len(message.CdtTrfTxInf); i++ {
+		t.lose(fmt.Sprintf("%s/CdtTrfTxInf[%d]", path, i+1), document.LossDropped, string(message.CdtTrfTxInf[i].PmtId.EndToEndId))
+	}
+	grpHdr, tx := message.GrpHdr, message.CdtTrfTxInf[0]
+	txPath := path + "/CdtTrfTxInf[1]"
+
+	sender := firstBIC(t.opts.sender, pacs09Agent(tx.InstgAgt, nil), pacs09Agent(grpHdr.InstgAgt, nil), pacs09Agent(&tx.Dbtr, nil))
+	receiver := firstBIC(t.opts.receiver, pacs09Agent(tx.InstdAgt, nil), pacs09Agent(grpHdr.InstdAgt, nil), pacs09Agent(tx.CdtrAgt, nil), pacs09Agent(&tx.Cdtr, nil))
+
+	msg, err := newMessage("202", sender, receiver)
+	if err != nil {
+		return nil, err
+	}
+	if tx.UndrlygCstmrCdtTrf != nil {
+		msg.SetHeaderField("119", "COV")
+	}
+	if tx.PmtId.UETR != nil {
+		msg.SetHeaderField("121", string(*tx.PmtId.UETR))
+	}
+	if tx.PmtId.InstrId != nil {
+		msg.Add("20", t.reference(txPath+"/PmtId/InstrId", string(*tx.PmtId.InstrId)))
+	} else {
+		msg.Add("20", t.reference(path+"/GrpHdr/MsgId", string(grpHdr.MsgId)))
+	}
+	msg.Add("21", t.reference(txPath+"/PmtId/EndToEndId", string(tx.PmtId.EndToEndId)))
+
+	date := t.settlementDate(txPath+"/IntrBkSttlmDt", grpHdr.CreDtTm, tx.IntrBkSttlmDt, grpHdr.IntrBkSttlmDt)
+	msg.Add("32A", date.Format("060102")+string(tx.IntrBkSttlmAmt.Ccy)+formatAmount(common.Decimal(tx.IntrBkSttlmAmt.Value)))
+
+	settlement := grpHdr.SttlmInf
+	t.addAgent(msg, "52", txPath+"/Dbtr", pacs09Agent(&tx.Dbtr, tx.DbtrAcct))
+	if settlement.InstgRmbrsmntAgt == nil && settlement.SttlmAcct != nil {
+		t.addAgent(msg, "53", path+"/GrpHdr/SttlmInf/SttlmAcct", party{account: pacs09AccountId(settlement.SttlmAcct)})
+	}
+	t.addAgent(msg, "53", path+"/GrpHdr/SttlmInf/InstgRmbrsmntAgt", pacs09Agent(settlement.InstgRmbrsmntAgt, settlement.InstgRmbrsmntAgtAcct))
+	t.addAgent(msg, "54", path+"/GrpHdr/SttlmInf/InstdRmbrsmntAgt", pacs09Agent(settlement.InstdRmbrsmntAgt, settlement.InstdRmbrsmntAgtAcct))
+	t.addAgent(msg, "56", txPath+"/IntrmyAgt1", pacs09Agent(tx.IntrmyAgt1, tx.IntrmyAgt1Acct))
+	t.addAgent(msg, "57", txPath+"/CdtrAgt", pacs09Agent(tx.CdtrAgt, tx.CdtrAgtAcct))
+	t.addAgent(msg, "58", txPath+"/Cdtr", pacs09Agent(&tx.Cdtr, tx.CdtrAcct))
+	t.addInstructions(msg, txPath+"/InstrForNxtAgt", tx.InstrForNxtAgt)
+	if tx.RmtInf != nil {
+		t.lose(txPath+"/RmtInf", document.LossDropped, strings.Join(texts(tx.RmtInf.Ustrd), ""))
+	}
+
+	if underlying := tx.UndrlygCstmrCdtTrf; underlying != nil {
+		underlyingPath := txPath + "/UndrlygCstmrCdtTrf"
+		t.addParty(msg, "50", underlyingPath+"/Dbtr", pacs09Party(&underlying.Dbtr, underlying.DbtrAcct))
+		t.addAgent(msg, "52", underlyingPath+"/DbtrAgt", pacs09Agent(&underlying.DbtrAgt, underlying.DbtrAgtAcct))
+		t.addAgent(msg, "56", underlyingPath+"/IntrmyAgt1", pacs09Agent(underlying.IntrmyAgt1, underlying.IntrmyAgt1Acct))
+		t.addAgent(msg, "57", underlyingPath+"/CdtrAgt", pacs09Agent(&underlying.CdtrAgt, underlying.CdtrAgtAcct))
+		t.addParty(msg, "59", underlyingPath+"/Cdtr", pacs09Party(&underlying.Cdtr, underlying.CdtrAcct))
+		if underlying.RmtInf != nil {
+			if lines := t.lines(underlyingPath+"/RmtInf", texts(underlying.RmtInf.Ustrd), 35, 4); len(lines) > 0 {
+				msg.Add("70", strings.Join(lines, "\n"))
+			}
+			t.dropElements(underlyingPath+"/RmtInf", underlying.RmtInf, "Ustrd")
+		}
+		t.addInstructions(msg, underlyingPath+"/InstrForNxtAgt", underlying.InstrForNxtAgt)
+		if underlying.InstdAmt != nil {
+			msg.Add("33B", string(underlying.InstdAmt.Ccy)+formatAmount(common.Decimal(underlying.InstdAmt.Value)))
+		}
+		t.dropElements(underlyingPath, underlying, "Dbtr", "DbtrAcct", "DbtrAgt", "DbtrAgtAcct", "IntrmyAgt1", "IntrmyAgt1Acct",
+			"CdtrAgt", "CdtrAgtAcct", "Cdtr", "CdtrAcct", "RmtInf", "InstrForNxtAgt", "InstdAmt")
+	}
+
+	t.dropElements(path+"/GrpHdr", grpHdr, "MsgId", "CreDtTm", "NbOfTxs", "CtrlSum", "TtlIntrBkSttlmAmt", "IntrBkSttlmDt", "SttlmInf", "InstgAgt", "InstdAgt")
+	t.dropElements(path+"/GrpHdr/SttlmInf", settlement, "SttlmMtd", "SttlmAcct", "InstgRmbrsmntAgt", "InstgRmbrsmntAgtAcct",
+		"InstdRmbrsmntAgt", "InstdRmbrsmntAgtAcct")
+	t.dropElements(txPath+"/PmtId", tx.PmtId, "InstrId", "EndToEndId", "UETR")
+	t.dropElements(txPath, tx, "PmtId", "IntrBkSttlmAmt", "IntrBkSttlmDt", "InstgAgt", "InstdAgt", "IntrmyAgt1", "IntrmyAgt1Acct",
+		"Dbtr", "DbtrAcct", "CdtrAgt", "CdtrAgtAcct", "Cdtr", "CdtrAcct", "InstrForNxtAgt", "RmtInf", "UndrlygCstmrCdtTrf")
+	t.dropElements(path, message, "GrpHdr", "CdtTrfTxInf")
+	return msg, nil
+}
+
+// addInstructions adds the instructions for the next agent as field 72
+func (t *translator) addInstructions(msg *Message, path string, instructions []pacs_v09.InstructionForNextAgent1) {
+	var lines []string
+	for _, next := range instructions {
+		var code string
+		if next.Cd != nil {
+			code = string(*next.Cd)
+		}
+		lines = append(lines, instruction(code, string(derefName(next.InstrInf))))
+	}
+	if lines = t.lines(path, lines, 35, 6); len(lines) > 0 {
+		msg.Add("72", strings.Join(lines, "\n"))
+	}
+}
+
+func texts(values []common.Max140Text) []string {
+	var texts []string
+	for _, value := range values {
+		texts = append(texts, string(value))
+	}
+	return texts
+}
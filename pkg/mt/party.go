@@ -0,0 +1,375 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/pacs_v09"
+)
+
+// party is the content of a party or agent field (50a, 52a-59a) whatever its option
+type party struct {
+	account string
+	// clearing is a clearing system code like //FW123456789, which has no equivalent here
+	clearing string
+	bic      string
+	name     string
+	address  []string
+	country  string
+	town     string
+}
+
+func (p party) empty() bool {
+	return p.account == "" && p.bic == "" && p.name == "" && len(p.address) == 0
+}
+
+// parseParty reads a party field, tag carries the option letter (50K, 57A...)
+func parseParty(tag, value string) party {
+	var p party
+	lines := strings.Split(value, "\n")
+	if strings.HasPrefix(lines[0], "//") {
+		p.clearing, lines = lines[0], lines[1:]
+	} else if strings.HasPrefix(lines[0], "/") {
+		p.account, lines = strings.TrimPrefix(lines[0], "/"), lines[1:]
+		// the debit or credit mark of field 52A /C/account or /D/account
+		if len(p.account) > 2 && p.account[1] == '/' {
+			p.account = p.account[2:]
+		}
+	}
+
+	switch option := tag[len(tag)-1:]; option {
+	case "A":
+		if len(lines) > 0 {
+			p.bic = lines[0]
+		}
+	case "F":
+		if len(lines) > 0 && !strings.Contains(lines[0][:min(len(lines[0]), 2)], "/") && p.account == "" {
+			// a party identifier like CUST/DE/ABC/123456 instead of an account
+			p.account, lines = lines[0], lines[1:]
+		}
+		for _, line := range lines {
+			switch {
+			case strings.HasPrefix(line, "1/"):
+				p.name += strings.TrimPrefix(line, "1/")
+			case strings.HasPrefix(line, "3/"):
+				parts := strings.SplitN(strings.TrimPrefix(line, "3/"), "/", 2)
+				p.country = parts[0]
+				if len(parts) > 1 {
+					p.town = parts[1]
+				}
+			case len(line) > 2 && line[1] == '/':
+				p.address = append(p.address, line[2:])
+			}
+		}
+	case "C":
+	default:
+		// options B, D, K and no letter: name and address
+		if len(lines) > 0 && lines[0] != "" {
+			p.name, p.address = lines[0], lines[1:]
+		}
+	}
+	return p
+}
+
+// addressLines returns the address lines of an MX party, a structured address is written into
+// unstructured lines: street and building number, then country, post code and town
+func addressLines(lines []common.Max70Text, street, building, postCode, town *string, country *common.CountryCode) []string {
+	var texts []string
+	for _, line := range lines {
+		texts = append(texts, string(line))
+	}
+	if len(lines) > 0 {
+		return texts
+	}
+	if line := strings.TrimSpace(deref(street) + " " + deref(building)); line != "" {
+		texts = append(texts, line)
+	}
+	if line := strings.TrimSpace(deref(postCode) + " " + deref(town)); line != "" {
+		if country != nil {
+			line = string(*country) + "/" + line
+		}
+		texts = append(texts, line)
+	} else if country != nil {
+		texts = append(texts, string(*country))
+	}
+	return texts
+}
+
+func deref(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// addParty adds the field of a customer (50a or 59a): option A for a party identified by a BIC only,
+// option K (50) or no letter (59) for name and address
+func (t *translator) addParty(msg *Message, tag, path string, p party) {
+	var lines []string
+	if p.account != "" {
+		lines = append(lines, "/"+t.line(path+"/Acct", p.account, 34))
+	}
+	if p.bic != "" && p.name == "" && len(p.address) == 0 {
+		msg.Add(tag+"A", strings.Join(append(lines, p.bic), "\n"))
+		return
+	}
+	if p.bic != "" {
+		t.lose(path+"/Id/OrgId/AnyBIC", document.LossDropped, p.bic)
+	}
+	lines = append(lines, t.lines(path, append([]string{p.name}, p.address...), 35, 4)...)
+	if tag == "50" {
+		tag = "50K"
+	}
+	msg.Add(tag, strings.Join(lines, "\n"))
+}
+
+// party reads a party field and reports the clearing code which has no equivalent
+func (t *translator) party(field Field) party {
+	p := parseParty(field.Tag, field.Value)
+	if p.clearing != "" {
+		t.lose(":"+field.Tag+":", document.LossDropped, p.clearing)
+	}
+	return p
+}
+
+// addAgent adds the field of a financial institution: option A with the BIC, D with name and address,
+// or B with the account only
+func (t *translator) addAgent(msg *Message, tag, path string, p party) {
+	if p.empty() {
+		return
+	}
+	var lines []string
+	if p.account != "" {
+		lines = append(lines, "/"+t.line(path+"/Acct", p.account, 34))
+	}
+	switch {
+	case p.bic != "":
+		if p.name != "" {
+			t.lose(path+"/FinInstnId/Nm", document.LossDropped, p.name)
+		}
+		msg.Add(tag+"A", strings.Join(append(lines, p.bic), "\n"))
+	case p.name != "":
+		lines = append(lines, t.lines(path, append([]string{p.name}, p.address...), 35, 4)...)
+		msg.Add(tag+"D", strings.Join(lines, "\n"))
+	default:
+		msg.Add(tag+"B", strings.Join(lines, "\n"))
+	}
+}
+
+// pacs.008.001.08 and pacs.009.001.09 components
+
+func (p party) pacs08Party() pacs_v08.PartyIdentification135 {
+	result := pacs_v08.PartyIdentification135{Nm: common.OptionalMax140Text(p.name)}
+	if len(p.address) > 0 || p.country != "" || p.town != "" {
+		result.PstlAdr = &pacs_v08.PostalAddress24{TwnNm: common.OptionalMax35Text(p.town)}
+		for _, line := range p.address {
+			result.PstlAdr.AdrLine = append(result.PstlAdr.AdrLine, common.Max70Text(line))
+		}
+		if p.country != "" {
+			country := common.CountryCode(p.country)
+			result.PstlAdr.Ctry = &country
+		}
+	}
+	if p.bic != "" {
+		bic := common.AnyBICDec2014Identifier(p.bic)
+		result.Id = &pacs_v08.Party38Choice{OrgId: &pacs_v08.OrganisationIdentification29{AnyBIC: &bic}}
+	}
+	return result
+}
+
+func (p party) pacs08Account() *pacs_v08.CashAccount38 {
+	if p.account == "" {
+		return nil
+	}
+	if iban := common.IBAN2007Identifier(p.account); iban.Validate() == nil {
+		return &pacs_v08.CashAccount38{Id: pacs_v08.AccountIdentification4Choice{IBAN: &iban}}
+	}
+	return &pacs_v08.CashAccount38{Id: pacs_v08.AccountIdentification4Choice{
+		Othr: &pacs_v08.GenericAccountIdentification1{Id: common.Max34Text(p.account)},
+	}}
+}
+
+func (p party) pacs08Agent() pacs_v08.BranchAndFinancialInstitutionIdentification6 {
+	agent := pacs_v08.BranchAndFinancialInstitutionIdentification6{}
+	if p.bic != "" {
+		bic := common.BICFIDec2014Identifier(p.bic)
+		agent.FinInstnId.BICFI = &bic
+	}
+	agent.FinInstnId.Nm = common.OptionalMax140Text(p.name)
+	if len(p.address) > 0 {
+		agent.FinInstnId.PstlAdr = &pacs_v08.PostalAddress24{}
+		for _, line := range p.address {
+			agent.FinInstnId.PstlAdr.AdrLine = append(agent.FinInstnId.PstlAdr.AdrLine, common.Max70Text(line))
+		}
+	}
+	return agent
+}
+
+func (p party) pacs08OptionalAgent() *pacs_v08.BranchAndFinancialInstitutionIdentification6 {
+	if p.bic == "" && p.name == "" {
+		return nil
+	}
+	agent := p.pacs08Agent()
+	return &agent
+}
+
+func pacs08Party(pty *pacs_v08.PartyIdentification135, account *pacs_v08.CashAccount38) party {
+	var p party
+	if pty != nil {
+		p.name = string(derefName(pty.Nm))
+		if adr := pty.PstlAdr; adr != nil {
+			p.address = addressLines(adr.AdrLine, (*string)(adr.StrtNm), (*string)(adr.BldgNb), (*string)(adr.PstCd), (*string)(adr.TwnNm), adr.Ctry)
+		}
+		if pty.Id != nil && pty.Id.OrgId != nil && pty.Id.OrgId.AnyBIC != nil {
+			p.bic = string(*pty.Id.OrgId.AnyBIC)
+		}
+	}
+	p.account = pacs08AccountId(account)
+	return p
+}
+
+func pacs08Agent(agent *pacs_v08.BranchAndFinancialInstitutionIdentification6, account *pacs_v08.CashAccount38) party {
+	var p party
+	if agent != nil {
+		id := agent.FinInstnId
+		if id.BICFI != nil {
+			p.bic = string(*id.BICFI)
+		}
+		p.name = string(derefName(id.Nm))
+		if adr := id.PstlAdr; adr != nil {
+			p.address = addressLines(adr.AdrLine, (*string)(adr.StrtNm), (*string)(adr.BldgNb), (*string)(adr.PstCd), (*string)(adr.TwnNm), adr.Ctry)
+		}
+	}
+	p.account = pacs08AccountId(account)
+	return p
+}
+
+func pacs08AccountId(account *pacs_v08.CashAccount38) string {
+	switch {
+	case account == nil:
+		return ""
+	case account.Id.IBAN != nil:
+		return string(*account.Id.IBAN)
+	case account.Id.Othr != nil:
+		return string(account.Id.Othr.Id)
+	}
+	return ""
+}
+
+func (p party) pacs09Party() pacs_v09.PartyIdentification135 {
+	result := pacs_v09.PartyIdentification135{Nm: common.OptionalMax140Text(p.name)}
+	if len(p.address) > 0 || p.country != "" || p.town != "" {
+		result.PstlAdr = &pacs_v09.PostalAddress24{TwnNm: common.OptionalMax35Text(p.town)}
+		for _, line := range p.address {
+			result.PstlAdr.AdrLine = append(result.PstlAdr.AdrLine, common.Max70Text(line))
+		}
+		if p.country != "" {
+			country := common.CountryCode(p.country)
+			result.PstlAdr.Ctry = &country
+		}
+	}
+	if p.bic != "" {
+		bic := common.AnyBICDec2014Identifier(p.bic)
+		result.Id = &pacs_v09.Party38Choice{OrgId: &pacs_v09.OrganisationIdentification29{AnyBIC: &bic}}
+	}
+	return result
+}
+
+func (p party) pacs09Account() *pacs_v09.CashAccount38 {
+	if p.account == "" {
+		return nil
+	}
+	if iban := common.IBAN2007Identifier(p.account); iban.Validate() == nil {
+		return &pacs_v09.CashAccount38{Id: pacs_v09.AccountIdentification4Choice{IBAN: &iban}}
+	}
+	return &pacs_v09.CashAccount38{Id: pacs_v09.AccountIdentification4Choice{
+		Othr: &pacs_v09.GenericAccountIdentification1{Id: common.Max34Text(p.account)},
+	}}
+}
+
+func (p party) pacs09Agent() pacs_v09.BranchAndFinancialInstitutionIdentification6 {
+	agent := pacs_v09.BranchAndFinancialInstitutionIdentification6{}
+	if p.bic != "" {
+		bic := common.BICFIDec2014Identifier(p.bic)
+		agent.FinInstnId.BICFI = &bic
+	}
+	agent.FinInstnId.Nm = common.OptionalMax140Text(p.name)
+	if len(p.address) > 0 {
+		agent.FinInstnId.PstlAdr = &pacs_v09.PostalAddress24{}
+		for _, line := range p.address {
+			agent.FinInstnId.PstlAdr.AdrLine = append(agent.FinInstnId.PstlAdr.AdrLine, common.Max70Text(line))
+		}
+	}
+	return agent
+}
+
+func (p party) pacs09OptionalAgent() *pacs_v09.BranchAndFinancialInstitutionIdentification6 {
+	if p.bic == "" && p.name == "" {
+		return nil
+	}
+	agent := p.pacs09Agent()
+	return &agent
+}
+
+func pacs09Party(pty *pacs_v09.PartyIdentification135, account *pacs_v09.CashAccount38) party {
+	var p party
+	if pty != nil {
+		p.name = string(derefName(pty.Nm))
+		if adr := pty.PstlAdr; adr != nil {
+			p.address = addressLines(adr.AdrLine, (*string)(adr.StrtNm), (*string)(adr.BldgNb), (*string)(adr.PstCd), (*string)(adr.TwnNm), adr.Ctry)
+		}
+		if pty.Id != nil && pty.Id.OrgId != nil && pty.Id.OrgId.AnyBIC != nil {
+			p.bic = string(*pty.Id.OrgId.AnyBIC)
+		}
+	}
+	p.account = pacs09AccountId(account)
+	return p
+}
+
+func pacs09Agent(agent *pacs_v09.BranchAndFinancialInstitutionIdentification6, account *pacs_v09.CashAccount38) party {
+	var p party
+	if agent != nil {
+		id := agent.FinInstnId
+		if id.BICFI != nil {
+			p.bic = string(*id.BICFI)
+		}
+		p.name = string(derefName(id.Nm))
+		if adr := id.PstlAdr; adr != nil {
+			p.address = addressLines(adr.AdrLine, (*string)(adr.StrtNm), (*string)(adr.BldgNb), (*string)(adr.PstCd), (*string)(adr.TwnNm), adr.Ctry)
+		}
+	}
+	p.account = pacs09AccountId(account)
+	return p
+}
+
+func pacs09AccountId(account *pacs_v09.CashAccount38) string {
+	switch {
+	case account == nil:
+		return ""
+	case account.Id.IBAN != nil:
+		return string(*account.Id.IBAN)
+	case account.Id.Othr != nil:
+		return string(account.Id.Othr.Id)
+	}
+	return ""
+}
+
+func derefName(name *common.Max140Text) common.Max140Text {
+	if name == nil {
+		return ""
+	}
+	return *name
+}
@@ -0,0 +1,317 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/pacs_v09"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Option changes a translation
+type Option func(*options)
+
+type options struct {
+	messageType string
+	sender      string
+	receiver    string
+	clock       func() time.Time
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		clock: func() time.Time { return time.Now().Truncate(time.Second) },
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithMessageType translates a camt.053 into an MT950 instead of an MT940
+func WithMessageType(messageType string) Option {
+	return func(o *options) {
+		o.messageType = strings.TrimPrefix(messageType, "MT")
+	}
+}
+
+// WithSender sets the sender BIC of an MT message, it's used when the document doesn't name the sending agent
+func WithSender(bic string) Option {
+	return func(o *options) {
+		o.sender = bic
+	}
+}
+
+// WithReceiver sets the receiver BIC of an MT message, it's used when the document doesn't name the receiving agent
+func WithReceiver(bic string) Option {
+	return func(o *options) {
+		o.receiver = bic
+	}
+}
+
+// WithClock takes CreDtTm of the translated documents from clock instead of the current time
+func WithClock(clock func() time.Time) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+// ToMX translates an MT103 into a pacs.008.001.08, an MT202 or MT202COV into a pacs.009.001.09 and an MT940
+// or MT950 into a camt.053.001.08 document. Fields without an equivalent are listed as dropped by the report.
+func ToMX(msg *Message, opts ...Option) (document.Iso20022Document, document.ConversionReport, error) {
+	t := &translator{opts: newOptions(opts)}
+	t.report.From = "MT" + msg.Type()
+
+	var namespace string
+	var message document.Iso20022Message
+	var err error
+	switch msg.Type() {
+	case "103":
+		namespace = utils.DocumentPacs00800108NameSpace
+		message, err = t.fromMT103(msg)
+	case "202":
+		if msg.HeaderField("119") == "COV" {
+			t.report.From = "MT202COV"
+		}
+		namespace = utils.DocumentPacs00900109NameSpace
+		message, err = t.fromMT202(msg)
+	case "940", "950":
+		namespace = utils.DocumentCamt05300108NameSpace
+		message, err = t.fromMT940(msg)
+	default:
+		return nil, t.report, utils.NewErrUnsupportedMessageType(t.report.From)
+	}
+	t.report.To = strings.TrimPrefix(namespace, "urn:iso:std:iso:20022:tech:xsd:")
+	if err != nil {
+		return nil, t.report, err
+	}
+
+	return &document.Iso20022DocumentObject{
+		XMLName: xml.Name{Space: namespace, Local: "Document"},
+		Attrs:   []xml.Attr{{Name: xml.Name{Local: utils.XmlDefaultNamespace}, Value: namespace}},
+		Message: message,
+	}, t.report, nil
+}
+
+// FromMX translates a pacs.008.001.08 into an MT103, a pacs.009.001.09 into an MT202 (an MT202COV when it has an
+// underlying customer credit transfer) and a camt.053.001.08 into an MT940 (see WithMessageType).
+// Texts are converted into the X character set and truncated to the size of the fields, the report lists the
+// elements which are transliterated, truncated or dropped.
+func FromMX(doc document.Iso20022Document, opts ...Option) (*Message, document.ConversionReport, error) {
+	t := &translator{opts: newOptions(opts)}
+	if info, found := document.LookupMessage(doc.NameSpace()); found {
+		t.report.From = info.Identifier()
+	}
+
+	root := "/Document"
+	if business, ok := doc.(*document.BusinessMessage); ok {
+		root = "/" + business.XMLName.Local + root
+	}
+	switch message := doc.InspectMessage().(type) {
+	case *pacs_v08.FIToFICustomerCreditTransferV08:
+		return t.result(t.toMT103(message, root+"/FIToFICstmrCdtTrf"))
+	case *pacs_v09.FinancialInstitutionCreditTransferV09:
+		return t.result(t.toMT202(message, root+"/FICdtTrf"))
+	case *camt_v08.BankToCustomerStatementV08:
+		return t.result(t.toMT940(message, root+"/BkToCstmrStmt"))
+	}
+	return nil, t.report, utils.NewErrUnsupportedNameSpace()
+}
+
+type translator struct {
+	opts   options
+	report document.ConversionReport
+}
+
+func (t *translator) result(msg *Message, err error) (*Message, document.ConversionReport, error) {
+	if err != nil {
+		return nil, t.report, err
+	}
+	t.report.To = "MT" + msg.Type()
+	if msg.HeaderField("119") == "COV" {
+		t.report.To += "COV"
+	}
+	return msg, t.report, nil
+}
+
+func (t *translator) lose(path string, kind document.LossKind, value string) {
+	t.report.Losses = append(t.report.Losses, document.Loss{Path: path, Kind: kind, Value: value})
+}
+
+// dropElements reports the elements of a component which aren't translated
+func (t *translator) dropElements(path string, component interface{}, translated ...string) {
+	value := reflect.Indirect(reflect.ValueOf(component))
+	if value.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name := strings.Split(field.Tag.Get("xml"), ",")[0]
+		if name == "" || field.Type == reflect.TypeOf(xml.Name{}) || contains(translated, name) || value.Field(i).IsZero() {
+			continue
+		}
+		if value.Field(i).Kind() == reflect.Slice && value.Field(i).Len() == 0 {
+			continue
+		}
+		t.lose(path+"/"+name, document.LossDropped, document.SimpleValue(value.Field(i)))
+	}
+}
+
+// line converts a text into a single line of the X character set of at most max characters
+func (t *translator) line(path, text string, max int) string {
+	converted, changed := toX(text)
+	if changed {
+		t.lose(path, document.LossTransliterated, text)
+	}
+	if len(converted) > max {
+		t.lose(path, document.LossTruncated, text)
+		converted = converted[:max]
+	}
+	return converted
+}
+
+// reference converts an identifier into a reference field like :20: which is at most 16 characters long and
+// neither starts nor ends with a slash nor contains two of them
+func (t *translator) reference(path, text string) string {
+	reference := t.line(path, text, 16)
+	fixed := strings.Trim(strings.ReplaceAll(reference, "//", "/"), "/")
+	if fixed != reference {
+		t.lose(path, document.LossTransliterated, text)
+	}
+	if fixed == "" {
+		return "NONREF"
+	}
+	return fixed
+}
+
+// lines converts texts into at most max lines of width characters of the X character set
+func (t *translator) lines(path string, texts []string, width, max int) []string {
+	var lines []string
+	for _, text := range texts {
+		converted, changed := toX(text)
+		if changed {
+			t.lose(path, document.LossTransliterated, text)
+		}
+		lines = append(lines, wrap(converted, width)...)
+	}
+	if len(lines) > max {
+		t.lose(path, document.LossTruncated, strings.Join(lines[max:], ""))
+		lines = lines[:max]
+	}
+	return lines
+}
+
+// settlementDate returns the first of the dates, the date of created is reported as default when there's none
+func (t *translator) settlementDate(path string, created common.ISODateTime, dates ...*common.ISODate) time.Time {
+	for _, date := range dates {
+		if date != nil {
+			return time.Time(*date)
+		}
+	}
+	t.lose(path, document.LossDefaulted, "")
+	return time.Time(created)
+}
+
+// newMessage returns the message from the sender to the receiver BIC, which have to be known since the
+// network routes the message by them
+func newMessage(messageType, sender, receiver string) (*Message, error) {
+	if sender == "" {
+		return nil, utils.NewErrOmittedBIC("sender")
+	}
+	if receiver == "" {
+		return nil, utils.NewErrOmittedBIC("receiver")
+	}
+	return NewMessage(messageType, sender, receiver), nil
+}
+
+// firstBIC returns bic, or the BIC of the first agent which has one when bic is empty
+func firstBIC(bic string, agents ...party) string {
+	for _, agent := range agents {
+		if bic == "" {
+			bic = agent.bic
+		}
+	}
+	return bic
+}
+
+// instruction writes an instruction for the next agent as a line of field 72, like /ACC/INSTRUCTION
+func instruction(code, info string) string {
+	switch {
+	case code != "":
+		return "/" + code + "/" + info
+	case strings.HasPrefix(info, "/"):
+		return info
+	}
+	return "/REC/" + info
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAmount reads an amount with a decimal comma like 1234,56
+func parseAmount(tag, value string) (common.Decimal, error) {
+	value = strings.TrimSuffix(strings.Replace(value, ",", ".", 1), ".")
+	amount, err := common.ParseDecimal(value)
+	if err != nil {
+		return common.Decimal{}, utils.NewErrInvalidField(tag)
+	}
+	return amount, nil
+}
+
+// formatAmount writes an amount with a decimal comma, which is required even without decimals
+func formatAmount(amount common.Decimal) string {
+	text := strings.Replace(amount.Abs().String(), ".", ",", 1)
+	if !strings.Contains(text, ",") {
+		text += ","
+	}
+	return text
+}
+
+func parseDate(tag, value string) (time.Time, error) {
+	date, err := time.Parse("060102", value)
+	if err != nil {
+		return time.Time{}, utils.NewErrInvalidField(tag)
+	}
+	return date, nil
+}
+
+// splitAmount splits a field like 220103EUR1234,56 or EUR1234,56 into date, currency and amount
+func splitAmount(tag, value string, withDate bool) (time.Time, string, common.Decimal, error) {
+	var date time.Time
+	var err error
+	if withDate {
+		if len(value) < 6 {
+			return date, "", common.Decimal{}, utils.NewErrInvalidField(tag)
+		}
+		if date, err = parseDate(tag, value[:6]); err != nil {
+			return date, "", common.Decimal{}, err
+		}
+		value = value[6:]
+	}
+	if len(value) < 4 {
+		return date, "", common.Decimal{}, utils.NewErrInvalidField(tag)
+	}
+	amount, err := parseAmount(tag, value[3:])
+	return date, value[:3], amount, err
+}
+
+func isoDate(date time.Time) *common.ISODate {
+	value := common.ISODate(date)
+	return &value
+}
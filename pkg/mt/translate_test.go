@@ -0,0 +1,321 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pacs_v08"
+	"github.com/moov-io/iso20022/pkg/pacs_v09"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+var testTime = time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func testClock() time.Time {
+	return testTime
+}
+
+// assertValid marshals the document and parses it again
+func assertValid(t *testing.T, doc document.Iso20022Document) {
+	assert.Nil(t, doc.Validate())
+	buf, err := xml.Marshal(doc)
+	assert.Nil(t, err)
+	parsed, err := document.ParseIso20022Document(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, doc.NameSpace(), parsed.NameSpace())
+}
+
+func TestMT103(t *testing.T) {
+	msg := readTestMessage(t, "valid_mt103.txt")
+	doc, report, err := ToMX(msg, WithClock(testClock))
+	assert.Nil(t, err)
+	assert.Equal(t, "MT103", report.From)
+	assert.Equal(t, "pacs.008.001.08", report.To)
+	assert.True(t, report.Lossless())
+	assertValid(t, doc)
+
+	message := doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08)
+	assert.Equal(t, common.Max35Text("REF-2022-0001"), message.GrpHdr.MsgId)
+	assert.Equal(t, common.ISODateTime(testTime), message.GrpHdr.CreDtTm)
+	assert.Equal(t, pacs_v08.SettlementMethod1Code("INDA"), message.GrpHdr.SttlmInf.SttlmMtd)
+	tx := message.CdtTrfTxInf[0]
+	assert.Equal(t, common.Max35Text("E2E-0001"), tx.PmtId.EndToEndId)
+	assert.Equal(t, common.UUIDv4Identifier("eb6305c9-1f7f-49de-aed0-16487c27b42d"), *tx.PmtId.UETR)
+	assert.Equal(t, "1234.56", common.Decimal(tx.IntrBkSttlmAmt.Value).String())
+	assert.Equal(t, common.ISODate(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)), *tx.IntrBkSttlmDt)
+	assert.Equal(t, pacs_v08.ChargeBearerType1Code("SHAR"), tx.ChrgBr)
+	assert.Equal(t, common.Max140Text("Jürgen Müller"), *tx.Dbtr.Nm)
+	assert.Equal(t, []common.Max70Text{"Rue de la Loi 16", "BE/1000 Bruxelles"}, tx.Dbtr.PstlAdr.AdrLine)
+	assert.Equal(t, common.IBAN2007Identifier("BE68539007547034"), *tx.DbtrAcct.Id.IBAN)
+	assert.Equal(t, common.BICFIDec2014Identifier("BANKBEBB"), *tx.DbtrAgt.FinInstnId.BICFI)
+	assert.Equal(t, common.BICFIDec2014Identifier("BANKDEFFXXX"), *tx.InstdAgt.FinInstnId.BICFI)
+	assert.Equal(t, common.Max140Text("Maria Schmidt"), *tx.Cdtr.Nm)
+	assert.Equal(t, []common.Max140Text{"Invoice 2022-42"}, tx.RmtInf.Ustrd)
+	assert.Equal(t, common.Max140Text("/INS/BANKBEBB"), *tx.InstrForNxtAgt[0].InstrInf)
+
+	// the translation back only transliterates the name of the debtor
+	translated, report, err := FromMX(doc)
+	assert.Nil(t, err)
+	assert.Equal(t, "pacs.008.001.08", report.From)
+	assert.Equal(t, "MT103", report.To)
+	assert.Equal(t, []document.Loss{
+		{Path: "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/Dbtr", Kind: document.LossTransliterated, Value: "Jürgen Müller"},
+	}, report.Losses)
+	assert.Equal(t, msg.Sender(), translated.Sender())
+	assert.Equal(t, msg.Receiver(), translated.Receiver())
+	assert.Equal(t, msg.UserHeader, translated.UserHeader)
+	value, _ := translated.Field("50K")
+	assert.Equal(t, "/BE68539007547034\nJurgen Muller\nRue de la Loi 16\nBE/1000 Bruxelles", value)
+	for i := range msg.Fields {
+		if msg.Fields[i].Tag != "50K" {
+			assert.Equal(t, msg.Fields[i], translated.Fields[i])
+		}
+	}
+	assert.Len(t, translated.Fields, len(msg.Fields))
+}
+
+func TestMT103LongEndToEndId(t *testing.T) {
+	doc, _, err := ToMX(readTestMessage(t, "valid_mt103.txt"), WithClock(testClock))
+	assert.Nil(t, err)
+	tx := &doc.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08).CdtTrfTxInf[0]
+	tx.PmtId.EndToEndId = "E2E-2022-03-01-00000000000000000042"
+	assert.Len(t, tx.PmtId.EndToEndId, 35)
+
+	// the reference continues on a line starting with // before the remittance information
+	msg, _, err := FromMX(doc)
+	assert.Nil(t, err)
+	value, _ := msg.Field("70")
+	assert.Equal(t, "/ROC/E2E-2022-03-01-000000000000000\n//00042\nInvoice 2022-42", value)
+
+	again, report, err := ToMX(msg, WithClock(testClock))
+	assert.Nil(t, err)
+	assert.True(t, report.Lossless())
+	tx = &again.InspectMessage().(*pacs_v08.FIToFICustomerCreditTransferV08).CdtTrfTxInf[0]
+	assert.Equal(t, common.Max35Text("E2E-2022-03-01-00000000000000000042"), tx.PmtId.EndToEndId)
+	assert.Equal(t, []common.Max140Text{"Invoice 2022-42"}, tx.RmtInf.Ustrd)
+}
+
+func TestMT202COV(t *testing.T) {
+	msg := readTestMessage(t, "valid_mt202cov.txt")
+	doc, report, err := ToMX(msg, WithClock(testClock))
+	assert.Nil(t, err)
+	assert.Equal(t, "MT202COV", report.From)
+	assert.Equal(t, "pacs.009.001.09", report.To)
+	assert.True(t, report.Lossless())
+	assertValid(t, doc)
+
+	message := doc.InspectMessage().(*pacs_v09.FinancialInstitutionCreditTransferV09)
+	tx := message.CdtTrfTxInf[0]
+	assert.Equal(t, common.Max35Text("COV-2022-0001"), *tx.PmtId.InstrId)
+	assert.Equal(t, common.Max35Text("REF-2022-0001"), tx.PmtId.EndToEndId)
+	assert.Equal(t, common.BICFIDec2014Identifier("BANKDEFF"), *tx.Cdtr.FinInstnId.BICFI)
+	underlying := tx.UndrlygCstmrCdtTrf
+	assert.Equal(t, common.Max140Text("Jurgen Muller"), *underlying.Dbtr.Nm)
+	assert.Equal(t, common.BICFIDec2014Identifier("BANKBEBB"), *underlying.DbtrAgt.FinInstnId.BICFI)
+	assert.Equal(t, common.IBAN2007Identifier("DE89370400440532013000"), *underlying.CdtrAcct.Id.IBAN)
+	assert.Equal(t, "1234.56", common.Decimal(underlying.InstdAmt.Value).String())
+
+	translated, report, err := FromMX(doc)
+	assert.Nil(t, err)
+	assert.Equal(t, "MT202COV", report.To)
+	assert.True(t, report.Lossless())
+	assert.Equal(t, "COV", translated.HeaderField("119"))
+	assert.Equal(t, msg.Fields, translated.Fields)
+
+	// without the underlying customer credit transfer it's an MT202
+	message.CdtTrfTxInf[0].UndrlygCstmrCdtTrf = nil
+	translated, report, err = FromMX(doc)
+	assert.Nil(t, err)
+	assert.Equal(t, "MT202", report.To)
+	assert.Equal(t, "", translated.HeaderField("119"))
+	assert.Equal(t, msg.Fields[:6], translated.Fields)
+}
+
+func TestMT940(t *testing.T) {
+	msg := readTestMessage(t, "valid_mt940.txt")
+	doc, report, err := ToMX(msg, WithClock(testClock))
+	assert.Nil(t, err)
+	assert.Equal(t, "MT940", report.From)
+	assert.Equal(t, "camt.053.001.08", report.To)
+	assert.True(t, report.Lossless())
+	assertValid(t, doc)
+
+	message := doc.InspectMessage().(*camt_v08.BankToCustomerStatementV08)
+	assert.Equal(t, common.AnyBICDec2014Identifier("CUSTBEBBXXX"), *message.GrpHdr.MsgRcpt.Id.OrgId.AnyBIC)
+	stmt := message.Stmt[0]
+	assert.Equal(t, common.Max35Text("STMT-2022-03-01"), stmt.Id)
+	assert.Equal(t, float64(42), stmt.LglSeqNb)
+	assert.Equal(t, camt_v08.Pagination1{PgNb: "1", LastPgInd: true}, *stmt.StmtPgntn)
+	assert.Equal(t, common.IBAN2007Identifier("BE68539007547034"), *stmt.Acct.Id.IBAN)
+	assert.Equal(t, common.BICFIDec2014Identifier("BANKBEBB"), *stmt.Acct.Svcr.FinInstnId.BICFI)
+	assert.Len(t, stmt.Bal, 3)
+	assert.Equal(t, camt_v08.ExternalBalanceType1Code("OPBD"), *stmt.Bal[0].Tp.CdOrPrtry.Cd)
+	assert.Equal(t, "10000.00", common.Decimal(stmt.Bal[0].Amt.Value).String())
+	assert.Equal(t, camt_v08.ExternalBalanceType1Code("CLAV"), *stmt.Bal[2].Tp.CdOrPrtry.Cd)
+
+	assert.Len(t, stmt.Ntry, 2)
+	entry := stmt.Ntry[0]
+	assert.Equal(t, common.CreditDebitCode("CRDT"), entry.CdtDbtInd)
+	assert.Equal(t, common.ActiveOrHistoricCurrencyCode("EUR"), entry.Amt.Ccy)
+	assert.Equal(t, common.ISODate(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)), *entry.BookgDt.Dt)
+	assert.Equal(t, common.Max35Text("NTRF"), entry.BkTxCd.Prtry.Cd)
+	assert.Equal(t, common.Max35Text("BANKREF-1"), *entry.AcctSvcrRef)
+	assert.Equal(t, common.Max35Text("E2E-0001"), *entry.NtryDtls[0].TxDtls[0].Refs.EndToEndId)
+	assert.Equal(t, common.Max500Text("Invoice 2022-42"), *entry.NtryDtls[0].TxDtls[0].AddtlTxInf)
+	assert.Equal(t, common.Max500Text("Transfer from Jurgen Muller"), *entry.AddtlNtryInf)
+	assert.Equal(t, common.CreditDebitCode("DBIT"), stmt.Ntry[1].CdtDbtInd)
+	assert.Nil(t, stmt.Ntry[1].NtryDtls)
+	assert.Equal(t, common.Max500Text("End of statement"), *stmt.AddtlStmtInf)
+
+	translated, report, err := FromMX(doc)
+	assert.Nil(t, err)
+	assert.Equal(t, "MT940", report.To)
+	assert.True(t, report.Lossless())
+	assert.Equal(t, "BANKBEBBXXX", translated.Sender())
+	assert.Equal(t, "CUSTBEBBXXX", translated.Receiver())
+	assert.Equal(t, msg.Fields, translated.Fields)
+
+	// an MT950 has no information to the account owner
+	translated, report, err = FromMX(doc, WithMessageType("MT950"))
+	assert.Nil(t, err)
+	assert.Equal(t, "MT950", report.To)
+	assert.Equal(t, []document.Loss{
+		{Path: "/Document/BkToCstmrStmt/Stmt[1]/Ntry[1]/AddtlNtryInf", Kind: document.LossDropped, Value: "Transfer from Jurgen Muller"},
+		{Path: "/Document/BkToCstmrStmt/Stmt[1]/Ntry[2]/AddtlNtryInf", Kind: document.LossDropped, Value: "Account fees"},
+		{Path: "/Document/BkToCstmrStmt/Stmt[1]/AddtlStmtInf", Kind: document.LossDropped, Value: "End of statement"},
+	}, report.Losses)
+	value, _ := translated.Field("25")
+	assert.Equal(t, "BE68539007547034", value)
+	_, found := translated.Field("86")
+	assert.False(t, found)
+}
+
+func TestMT940Reversals(t *testing.T) {
+	msg, err := Parse([]byte("{1:F01BANKBEBBAXXX0000000000}{2:I940CUSTBEBBXXXXN}{4:\n:20:STMT-2022-03-02\n:25:BE68539007547034\n" +
+		":28C:00043\n:60F:C220301EUR11134,56\n:61:220302RC1234,56NTRFE2E-0001\n:61:220302RD100,NCHGNONREF\n" +
+		":62F:C220302EUR10000,00\n-}"))
+	assert.Nil(t, err)
+	doc, report, err := ToMX(msg, WithClock(testClock))
+	assert.Nil(t, err)
+	assert.Equal(t, []document.Loss{{Path: "{1:}", Kind: document.LossDropped, Value: "BANKBEBBXXX"}}, report.Losses)
+	assertValid(t, doc)
+
+	// the reversal of a credit debits the account, the reversal of a debit credits it
+	stmt := doc.InspectMessage().(*camt_v08.BankToCustomerStatementV08).Stmt[0]
+	assert.Len(t, stmt.Ntry, 2)
+	assert.Equal(t, common.CreditDebitCode("DBIT"), stmt.Ntry[0].CdtDbtInd)
+	assert.True(t, stmt.Ntry[0].RvslInd)
+	assert.Equal(t, common.CreditDebitCode("CRDT"), stmt.Ntry[1].CdtDbtInd)
+	assert.True(t, stmt.Ntry[1].RvslInd)
+
+	// the sender isn't named by field 25P, so it's given again
+	_, _, err = FromMX(doc)
+	assert.EqualError(t, err, "The sender BIC of MT message is omitted")
+	translated, report, err := FromMX(doc, WithSender("BANKBEBB"))
+	assert.Nil(t, err)
+	assert.True(t, report.Lossless())
+	assert.Equal(t, "BANKBEBBXXX", translated.Sender())
+	assert.Equal(t, "CUSTBEBBXXX", translated.Receiver())
+	assert.Equal(t, msg.Fields, translated.Fields)
+
+	// a statement without recipient has no receiver
+	doc.InspectMessage().(*camt_v08.BankToCustomerStatementV08).GrpHdr.MsgRcpt = nil
+	_, _, err = FromMX(doc, WithSender("BANKBEBB"))
+	assert.EqualError(t, err, "The receiver BIC of MT message is omitted")
+}
+
+func TestFromMXLosses(t *testing.T) {
+	bic := common.BICFIDec2014Identifier("BANKBEBB")
+	name := common.Max140Text("Société Générale de Banque avec un nom très long")
+	instrId := common.Max35Text("INSTRUCTION/IDENTIFICATION/1")
+	purpose := pacs_v08.ExternalPurpose1Code("SALA")
+	tx := pacs_v08.CreditTransferTransaction39{
+		PmtId:          pacs_v08.PaymentIdentification7{InstrId: &instrId, EndToEndId: notProvided},
+		IntrBkSttlmAmt: pacs_v08.ActiveCurrencyAndAmount{Value: common.ActiveCurrencyAndAmountSimpleType(common.MustParseDecimal("10")), Ccy: "EUR"},
+		ChrgBr:         "DEBT",
+		Dbtr: pacs_v08.PartyIdentification135{
+			Nm:      &name,
+			PstlAdr: &pacs_v08.PostalAddress24{AdrLine: []common.Max70Text{"29 Boulevard Haussmann", "75009 Paris", "France"}},
+		},
+		DbtrAgt: pacs_v08.BranchAndFinancialInstitutionIdentification6{FinInstnId: pacs_v08.FinancialInstitutionIdentification18{BICFI: &bic}},
+		Cdtr:    pacs_v08.PartyIdentification135{Nm: &name},
+		Purp:    &pacs_v08.Purpose2Choice{Cd: &purpose},
+	}
+	doc := &document.Iso20022DocumentObject{
+		XMLName: xml.Name{Space: utils.DocumentPacs00800108NameSpace, Local: "Document"},
+		Message: &pacs_v08.FIToFICustomerCreditTransferV08{
+			GrpHdr:      pacs_v08.GroupHeader93{MsgId: "MsgId", CreDtTm: common.ISODateTime(testTime), NbOfTxs: "2"},
+			CdtTrfTxInf: []pacs_v08.CreditTransferTransaction39{tx, tx},
+		},
+	}
+
+	// no agent names the receiver
+	_, _, err := FromMX(doc)
+	assert.EqualError(t, err, "The receiver BIC of MT message is omitted")
+
+	msg, report, err := FromMX(doc, WithReceiver("BANKDEFF"))
+	assert.Nil(t, err)
+	path := "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]"
+	assert.Equal(t, []document.Loss{
+		{Path: "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[2]", Kind: document.LossDropped, Value: notProvided},
+		{Path: path + "/PmtId/InstrId", Kind: document.LossTruncated, Value: string(instrId)},
+		{Path: path + "/IntrBkSttlmDt", Kind: document.LossDefaulted},
+		{Path: path + "/Dbtr", Kind: document.LossTransliterated, Value: string(name)},
+		{Path: path + "/Dbtr", Kind: document.LossTruncated, Value: "France"},
+		{Path: path + "/Cdtr", Kind: document.LossTransliterated, Value: string(name)},
+		{Path: path + "/Purp", Kind: document.LossDropped},
+	}, report.Losses)
+
+	assert.Equal(t, "BANKBEBBXXX", msg.Sender())
+	assert.Equal(t, "BANKDEFFXXX", msg.Receiver())
+	value, _ := msg.Field("20")
+	assert.Equal(t, "INSTRUCTION/IDEN", value)
+	value, _ = msg.Field("32A")
+	assert.Equal(t, "220301EUR10,", value)
+	value, _ = msg.Field("50K")
+	// the name is wrapped, the address lines which don't fit are truncated
+	assert.Equal(t, "Societe Generale de Banque avec un \nnom tres long\n29 Boulevard Haussmann\n75009 Paris", value)
+	value, _ = msg.Field("71A")
+	assert.Equal(t, "OUR", value)
+	for _, line := range strings.Split(msg.String(), "\r\n") {
+		assert.LessOrEqual(t, len(line), 80)
+	}
+}
+
+func TestTranslationErrors(t *testing.T) {
+	msg, err := Parse([]byte("{1:F01BANKBEBBAXXX0000000000}{2:I199BANKDEFFXXXXN}{4:\n:20:REF\n:79:TEXT\n-}"))
+	assert.Nil(t, err)
+	_, report, err := ToMX(msg)
+	assert.EqualError(t, err, "The message type MT199 is unsupported")
+	assert.Equal(t, "MT199", report.From)
+
+	msg, err = Parse([]byte("{1:F01BANKBEBBAXXX0000000000}{2:I103BANKDEFFXXXXN}{4:\n:20:REF\n-}"))
+	assert.Nil(t, err)
+	_, _, err = ToMX(msg)
+	assert.EqualError(t, err, "The field :32A: of MT message is omitted")
+
+	msg, err = Parse([]byte("{1:F01BANKBEBBAXXX0000000000}{2:I103BANKDEFFXXXXN}{4:\n:20:REF\n:32A:2203EUR1,\n-}"))
+	assert.Nil(t, err)
+	_, _, err = ToMX(msg)
+	assert.EqualError(t, err, "The field :32A: of MT message is invalid")
+
+	buf, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pain_v11.xml"))
+	assert.Nil(t, err)
+	doc, err := document.ParseIso20022Document(buf)
+	assert.Nil(t, err)
+	_, _, err = FromMX(doc)
+	assert.EqualError(t, err, utils.NewErrUnsupportedNameSpace().Error())
+}
@@ -54,7 +54,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, CreditorReferenceInformation2{}.Validate())
 	assert.NotNil(t, CreditorReferenceType1Choice{}.Validate())
 	assert.NotNil(t, CreditorReferenceType2{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth1{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
 	assert.NotNil(t, DiscountAmountAndType1{}.Validate())
@@ -76,7 +76,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, GenericPersonIdentification1{}.Validate())
 	assert.NotNil(t, GroupHeader91{}.Validate())
 	assert.NotNil(t, MandateClassification1Choice{}.Validate())
-	assert.Nil(t, MandateRelatedData1Choice{}.Validate())
+	assert.NotNil(t, MandateRelatedData1Choice{}.Validate())
 	assert.Nil(t, MandateRelatedInformation14{}.Validate())
 	assert.NotNil(t, MandateSetupReason1Choice{}.Validate())
 	assert.Nil(t, MandateTypeInformation2{}.Validate())
@@ -86,8 +86,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OriginalGroupInformation29{}.Validate())
 	assert.Nil(t, OriginalTransactionReference31{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PaymentTransaction121{}.Validate())
 	assert.Nil(t, PaymentTypeInformation27{}.Validate())
@@ -116,22 +116,24 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalCreditorAgentInstruction1Code
@@ -147,6 +149,8 @@ func TestTypes(t *testing.T) {
 	var type7 ExternalLocalInstrument1Code
 	assert.NotNil(t, type7.Validate())
 	type7 = "test"
+	assert.NotNil(t, type7.Validate())
+	type7 = "INST"
 	assert.Nil(t, type7.Validate())
 
 	var type8 ExternalProxyAccountType1Code
@@ -157,11 +161,15 @@ func TestTypes(t *testing.T) {
 	var type9 ExternalPurpose1Code
 	assert.NotNil(t, type9.Validate())
 	type9 = "test"
+	assert.NotNil(t, type9.Validate())
+	type9 = "GDDS"
 	assert.Nil(t, type9.Validate())
 
 	var type10 ExternalServiceLevel1Code
 	assert.NotNil(t, type10.Validate())
 	type10 = "test"
+	assert.NotNil(t, type10.Validate())
+	type10 = "SEPA"
 	assert.Nil(t, type10.Validate())
 
 	var type11 ExternalCashClearingSystem1Code
@@ -171,7 +179,7 @@ func TestTypes(t *testing.T) {
 
 	var type12 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type12.Validate())
-	type12 = "test"
+	type12 = "APDS"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalDocumentLineType1Code
@@ -181,7 +189,7 @@ func TestTypes(t *testing.T) {
 
 	var type14 ExternalGarnishmentType1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "GNCS"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalMandateSetupReason1Code
@@ -191,17 +199,17 @@ func TestTypes(t *testing.T) {
 
 	var type16 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type16.Validate())
-	type16 = "test"
+	type16 = "BANK"
 	assert.Nil(t, type16.Validate())
 
 	var type17 ExternalPersonIdentification1Code
 	assert.NotNil(t, type17.Validate())
-	type17 = "test"
+	type17 = "ARNU"
 	assert.Nil(t, type17.Validate())
 
 	var type18 ExternalTaxAmountType1Code
 	assert.NotNil(t, type18.Validate())
-	type18 = "test"
+	type18 = "CITY"
 	assert.Nil(t, type18.Validate())
 
 	var type19 ClearingChannel2Code
@@ -46,7 +46,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
 	assert.Nil(t, OrganisationIdentification8{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
-	assert.Nil(t, Party11Choice{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
 	assert.Nil(t, PartyIdentification43{}.Validate())
 	assert.NotNil(t, PaymentIdentification3{}.Validate())
 	assert.Nil(t, PaymentTypeInformation21{}.Validate())
@@ -92,32 +92,36 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalTaxAmountType1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "CITY"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalServiceLevel1Code
 	assert.NotNil(t, type2.Validate())
 	type2 = "test"
+	assert.NotNil(t, type2.Validate())
+	type2 = "SEPA"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalAccountIdentification1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "AIIN"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalCashAccountType1Code
 	assert.NotNil(t, type4.Validate())
-	type4 = "test"
+	type4 = "CACC"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type5.Validate())
-	type5 = "test"
+	type5 = "BONU"
 	assert.Nil(t, type5.Validate())
 
 	var type6 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type6.Validate())
 	type6 = "test"
+	assert.NotNil(t, type6.Validate())
+	type6 = "USABA"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalCashClearingSystem1Code
@@ -127,7 +131,7 @@ func TestTypes(t *testing.T) {
 
 	var type8 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type8.Validate())
-	type8 = "test"
+	type8 = "APDS"
 	assert.Nil(t, type8.Validate())
 
 	var type9 ExternalDocumentLineType1Code
@@ -142,27 +146,31 @@ func TestTypes(t *testing.T) {
 
 	var type11 ExternalGarnishmentType1Code
 	assert.NotNil(t, type11.Validate())
-	type11 = "tes"
+	type11 = "GNCS"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalLocalInstrument1Code
 	assert.NotNil(t, type12.Validate())
 	type12 = "test"
+	assert.NotNil(t, type12.Validate())
+	type12 = "INST"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type13.Validate())
-	type13 = "test"
+	type13 = "BANK"
 	assert.Nil(t, type13.Validate())
 
 	var type14 ExternalPersonIdentification1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "ARNU"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ActiveOrHistoricCurrencyCode
 	assert.NotNil(t, type15.Validate())
 	type15 = "AAA"
+	assert.NotNil(t, type15.Validate())
+	type15 = "DEM"
 	assert.Nil(t, type15.Validate())
 
 	var type16 TaxRecordPeriod1Code
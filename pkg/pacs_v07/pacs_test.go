@@ -58,7 +58,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OriginalGroupHeader1{}.Validate())
 	assert.NotNil(t, OriginalGroupInformation3{}.Validate())
 	assert.Nil(t, OriginalTransactionReference22{}.Validate())
-	assert.Nil(t, Party11Choice{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
 	assert.Nil(t, PartyIdentification43{}.Validate())
 	assert.Nil(t, PaymentTransaction63{}.Validate())
 	assert.Nil(t, PaymentTypeInformation25{}.Validate())
@@ -93,7 +93,7 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalCashAccountType1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "CACC"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashClearingSystem1Code
@@ -103,17 +103,19 @@ func TestTypes(t *testing.T) {
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type5.Validate())
-	type5 = "test"
+	type5 = "APDS"
 	assert.Nil(t, type5.Validate())
 
 	var type6 ExternalDocumentLineType1Code
@@ -128,12 +130,14 @@ func TestTypes(t *testing.T) {
 
 	var type8 ExternalGarnishmentType1Code
 	assert.NotNil(t, type8.Validate())
-	type8 = "test"
+	type8 = "GNCS"
 	assert.Nil(t, type8.Validate())
 
 	var type9 ExternalLocalInstrument1Code
 	assert.NotNil(t, type9.Validate())
 	type9 = "test"
+	assert.NotNil(t, type9.Validate())
+	type9 = "INST"
 	assert.Nil(t, type9.Validate())
 
 	var type10 ExternalMandateSetupReason1Code
@@ -143,22 +147,26 @@ func TestTypes(t *testing.T) {
 
 	var type11 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type11.Validate())
-	type11 = "tes"
+	type11 = "BANK"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalPersonIdentification1Code
 	assert.NotNil(t, type12.Validate())
-	type12 = "test"
+	type12 = "ARNU"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalServiceLevel1Code
 	assert.NotNil(t, type13.Validate())
 	type13 = "test"
+	assert.NotNil(t, type13.Validate())
+	type13 = "SEPA"
 	assert.Nil(t, type13.Validate())
 
 	var type14 ExternalStatusReason1Code
 	assert.NotNil(t, type14.Validate())
 	type14 = "test"
+	assert.NotNil(t, type14.Validate())
+	type14 = "AC01"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalMandateSetupReason1Code
@@ -168,7 +176,7 @@ func TestTypes(t *testing.T) {
 
 	var type16 ExternalTaxAmountType1Code
 	assert.NotNil(t, type16.Validate())
-	type16 = "test"
+	type16 = "CITY"
 	assert.Nil(t, type16.Validate())
 
 	var type17 AddressType2Code
@@ -5,8 +5,12 @@
 package pacs_v08
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"testing"
 
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,8 +25,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, BranchAndFinancialInstitutionIdentification6{}.Validate())
 	assert.Nil(t, BranchData3{}.Validate())
 	assert.NotNil(t, CashAccount38{}.Validate())
-	assert.Nil(t, CashAccountType2Choice{}.Validate())
-	assert.Nil(t, CategoryPurpose1Choice{}.Validate())
+	assert.NotNil(t, CashAccountType2Choice{}.Validate())
+	assert.NotNil(t, CategoryPurpose1Choice{}.Validate())
 	assert.NotNil(t, Charges7{}.Validate())
 	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
 	assert.NotNil(t, ClearingSystemIdentification3Choice{}.Validate())
@@ -57,14 +61,14 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, GenericOrganisationIdentification1{}.Validate())
 	assert.NotNil(t, GenericPersonIdentification1{}.Validate())
 	assert.NotNil(t, GroupHeader94{}.Validate())
-	assert.Nil(t, LocalInstrument2Choice{}.Validate())
+	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
 	assert.Nil(t, MandateRelatedInformation14{}.Validate())
 	assert.NotNil(t, MandateSetupReason1Choice{}.Validate())
 	assert.NotNil(t, NameAndAddress16{}.Validate())
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.NotNil(t, PaymentIdentification7{}.Validate())
 	assert.Nil(t, PaymentTypeInformation27{}.Validate())
@@ -106,22 +110,24 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type6 ExternalFinancialInstitutionIdentification1Code
@@ -132,6 +138,8 @@ func TestTypes(t *testing.T) {
 	var type7 ExternalLocalInstrument1Code
 	assert.NotNil(t, type7.Validate())
 	type7 = "test"
+	assert.NotNil(t, type7.Validate())
+	type7 = "INST"
 	assert.Nil(t, type7.Validate())
 
 	var type8 ExternalProxyAccountType1Code
@@ -142,11 +150,15 @@ func TestTypes(t *testing.T) {
 	var type9 ExternalPurpose1Code
 	assert.NotNil(t, type9.Validate())
 	type9 = "test"
+	assert.NotNil(t, type9.Validate())
+	type9 = "GDDS"
 	assert.Nil(t, type9.Validate())
 
 	var type10 ExternalServiceLevel1Code
 	assert.NotNil(t, type10.Validate())
 	type10 = "test"
+	assert.NotNil(t, type10.Validate())
+	type10 = "SEPA"
 	assert.Nil(t, type10.Validate())
 
 	var type11 ExternalCashClearingSystem1Code
@@ -156,7 +168,7 @@ func TestTypes(t *testing.T) {
 
 	var type12 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type12.Validate())
-	type12 = "test"
+	type12 = "APDS"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalDocumentLineType1Code
@@ -166,7 +178,7 @@ func TestTypes(t *testing.T) {
 
 	var type14 ExternalGarnishmentType1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "GNCS"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalMandateSetupReason1Code
@@ -176,17 +188,17 @@ func TestTypes(t *testing.T) {
 
 	var type16 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type16.Validate())
-	type16 = "test"
+	type16 = "BANK"
 	assert.Nil(t, type16.Validate())
 
 	var type17 ExternalPersonIdentification1Code
 	assert.NotNil(t, type17.Validate())
-	type17 = "test"
+	type17 = "ARNU"
 	assert.Nil(t, type17.Validate())
 
 	var type18 ExternalTaxAmountType1Code
 	assert.NotNil(t, type18.Validate())
-	type18 = "test"
+	type18 = "CITY"
 	assert.Nil(t, type18.Validate())
 
 	var type19 ClearingChannel2Code
@@ -280,3 +292,41 @@ func TestTypes(t *testing.T) {
 	type32 = "CRED"
 	assert.Nil(t, type32.Validate())
 }
+
+func TestChoiceComponents(t *testing.T) {
+	var id AccountIdentification4Choice
+	assert.Nil(t, xml.Unmarshal([]byte(`<Id><IBAN>DE89370400440532013000</IBAN></Id>`), &id))
+	assert.Nil(t, id.Validate())
+	assert.Nil(t, id.Othr)
+
+	buf, err := xml.Marshal(&id)
+	assert.Nil(t, err)
+	assert.Equal(t, `<AccountIdentification4Choice><IBAN>DE89370400440532013000</IBAN></AccountIdentification4Choice>`, string(buf))
+	buf, err = json.Marshal(&id)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"IBAN":"DE89370400440532013000"}`, string(buf))
+
+	name, _, ok := utils.SelectedChoice(id)
+	assert.True(t, ok)
+	assert.Equal(t, "IBAN", name)
+	iban, ok := utils.ChoiceValue[common.IBAN2007Identifier](id)
+	assert.True(t, ok)
+	assert.Equal(t, common.IBAN2007Identifier("DE89370400440532013000"), iban)
+	_, ok = utils.ChoiceValue[GenericAccountIdentification1](id)
+	assert.False(t, ok)
+
+	id.Othr = &GenericAccountIdentification1{Id: "12345"}
+	assert.Equal(t, "The value of AccountIdentification4Choice has invalid choice (exactly one of IBAN, Othr)", id.Validate().Error())
+
+	id.IBAN = nil
+	assert.Nil(t, id.Validate())
+	othr, ok := utils.ChoiceValue[GenericAccountIdentification1](&id)
+	assert.True(t, ok)
+	assert.Equal(t, common.Max34Text("12345"), othr.Id)
+
+	account := CashAccount38{}
+	errs := utils.ValidateAll(&account, "/DbtrAcct")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "/DbtrAcct/Id", errs[0].Path)
+	assert.Equal(t, "choice (exactly one of IBAN, Othr)", errs[0].Rule)
+}
@@ -65,7 +65,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.NotNil(t, PaymentIdentification13{}.Validate())
 	assert.Nil(t, PaymentTypeInformation28{}.Validate())
@@ -113,22 +113,24 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalCreditorAgentInstruction1Code
@@ -144,6 +146,8 @@ func TestTypes(t *testing.T) {
 	var type7 ExternalLocalInstrument1Code
 	assert.NotNil(t, type7.Validate())
 	type7 = "test"
+	assert.NotNil(t, type7.Validate())
+	type7 = "INST"
 	assert.Nil(t, type7.Validate())
 
 	var type8 ExternalProxyAccountType1Code
@@ -154,11 +158,15 @@ func TestTypes(t *testing.T) {
 	var type9 ExternalPurpose1Code
 	assert.NotNil(t, type9.Validate())
 	type9 = "test"
+	assert.NotNil(t, type9.Validate())
+	type9 = "GDDS"
 	assert.Nil(t, type9.Validate())
 
 	var type10 ExternalServiceLevel1Code
 	assert.NotNil(t, type10.Validate())
 	type10 = "test"
+	assert.NotNil(t, type10.Validate())
+	type10 = "SEPA"
 	assert.Nil(t, type10.Validate())
 
 	var type11 ExternalCashClearingSystem1Code
@@ -168,7 +176,7 @@ func TestTypes(t *testing.T) {
 
 	var type12 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type12.Validate())
-	type12 = "test"
+	type12 = "APDS"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalDocumentLineType1Code
@@ -178,7 +186,7 @@ func TestTypes(t *testing.T) {
 
 	var type14 ExternalGarnishmentType1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "GNCS"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalMandateSetupReason1Code
@@ -188,17 +196,17 @@ func TestTypes(t *testing.T) {
 
 	var type16 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type16.Validate())
-	type16 = "test"
+	type16 = "BANK"
 	assert.Nil(t, type16.Validate())
 
 	var type17 ExternalPersonIdentification1Code
 	assert.NotNil(t, type17.Validate())
-	type17 = "test"
+	type17 = "ARNU"
 	assert.Nil(t, type17.Validate())
 
 	var type18 ExternalTaxAmountType1Code
 	assert.NotNil(t, type18.Validate())
-	type18 = "test"
+	type18 = "CITY"
 	assert.Nil(t, type18.Validate())
 
 	var type19 ClearingChannel2Code
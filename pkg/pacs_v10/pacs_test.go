@@ -34,7 +34,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, CreditorReferenceInformation2{}.Validate())
 	assert.NotNil(t, CreditorReferenceType1Choice{}.Validate())
 	assert.NotNil(t, CreditorReferenceType2{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth1{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
 	assert.NotNil(t, DiscountAmountAndType1{}.Validate())
@@ -63,7 +63,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, InstructionForNextAgent1{}.Validate())
 	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
 	assert.NotNil(t, MandateClassification1Choice{}.Validate())
-	assert.Nil(t, MandateRelatedData1Choice{}.Validate())
+	assert.NotNil(t, MandateRelatedData1Choice{}.Validate())
 	assert.Nil(t, MandateRelatedInformation14{}.Validate())
 	assert.NotNil(t, MandateSetupReason1Choice{}.Validate())
 	assert.Nil(t, MandateTypeInformation2{}.Validate())
@@ -73,8 +73,8 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OriginalGroupInformation29{}.Validate())
 	assert.Nil(t, OriginalTransactionReference32{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PaymentReturnReason6{}.Validate())
 	assert.NotNil(t, PaymentReturnV10{}.Validate())
@@ -110,7 +110,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, TaxPeriod2{}.Validate())
 	assert.Nil(t, TaxRecord2{}.Validate())
 	assert.NotNil(t, TaxRecordDetails2{}.Validate())
-	assert.Nil(t, TransactionParties8{}.Validate())
+	assert.NotNil(t, TransactionParties8{}.Validate())
 	assert.NotNil(t, FIToFIPaymentReversalV10{}.Validate())
 	assert.NotNil(t, GroupHeader89{}.Validate())
 	assert.NotNil(t, OriginalGroupHeader16{}.Validate())
@@ -122,7 +122,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OriginalGroupHeader17{}.Validate())
 	assert.Nil(t, PaymentTransaction110{}.Validate())
 	assert.Nil(t, StatusReasonInformation12{}.Validate())
-	assert.Nil(t, StatusReason6Choice{}.Validate())
+	assert.NotNil(t, StatusReason6Choice{}.Validate())
 	assert.Nil(t, OriginalTransactionReference28{}.Validate())
 	assert.NotNil(t, NumberOfTransactionsPerStatus5{}.Validate())
 }
@@ -130,22 +130,24 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalCreditorAgentInstruction1Code
@@ -161,6 +163,8 @@ func TestTypes(t *testing.T) {
 	var type7 ExternalLocalInstrument1Code
 	assert.NotNil(t, type7.Validate())
 	type7 = "test"
+	assert.NotNil(t, type7.Validate())
+	type7 = "INST"
 	assert.Nil(t, type7.Validate())
 
 	var type8 ExternalProxyAccountType1Code
@@ -171,11 +175,15 @@ func TestTypes(t *testing.T) {
 	var type9 ExternalPurpose1Code
 	assert.NotNil(t, type9.Validate())
 	type9 = "test"
+	assert.NotNil(t, type9.Validate())
+	type9 = "GDDS"
 	assert.Nil(t, type9.Validate())
 
 	var type10 ExternalServiceLevel1Code
 	assert.NotNil(t, type10.Validate())
 	type10 = "test"
+	assert.NotNil(t, type10.Validate())
+	type10 = "SEPA"
 	assert.Nil(t, type10.Validate())
 
 	var type11 ExternalCashClearingSystem1Code
@@ -185,7 +193,7 @@ func TestTypes(t *testing.T) {
 
 	var type12 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type12.Validate())
-	type12 = "test"
+	type12 = "APDS"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalDocumentLineType1Code
@@ -195,7 +203,7 @@ func TestTypes(t *testing.T) {
 
 	var type14 ExternalGarnishmentType1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "GNCS"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalMandateSetupReason1Code
@@ -205,17 +213,17 @@ func TestTypes(t *testing.T) {
 
 	var type16 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type16.Validate())
-	type16 = "test"
+	type16 = "BANK"
 	assert.Nil(t, type16.Validate())
 
 	var type17 ExternalPersonIdentification1Code
 	assert.NotNil(t, type17.Validate())
-	type17 = "test"
+	type17 = "ARNU"
 	assert.Nil(t, type17.Validate())
 
 	var type18 ExternalTaxAmountType1Code
 	assert.NotNil(t, type18.Validate())
-	type18 = "test"
+	type18 = "CITY"
 	assert.Nil(t, type18.Validate())
 
 	var type19 ClearingChannel2Code
@@ -317,20 +325,24 @@ func TestTypes(t *testing.T) {
 	var type33 ExternalReturnReason1Code
 	assert.NotNil(t, type33.Validate())
 	type33 = "test"
+	assert.NotNil(t, type33.Validate())
+	type33 = "AC04"
 	assert.Nil(t, type33.Validate())
 
 	var type34 ExternalStatusReason1Code
 	assert.NotNil(t, type34.Validate())
 	type34 = "test"
+	assert.NotNil(t, type34.Validate())
+	type34 = "AC01"
 	assert.Nil(t, type34.Validate())
 
 	var type35 ExternalPaymentTransactionStatus1Code
 	assert.NotNil(t, type35.Validate())
-	type35 = "test"
+	type35 = "ACCC"
 	assert.Nil(t, type35.Validate())
 
 	var type36 ExternalPaymentGroupStatus1Code
 	assert.NotNil(t, type36.Validate())
-	type36 = "test"
+	type36 = "ACCC"
 	assert.Nil(t, type36.Validate())
 }
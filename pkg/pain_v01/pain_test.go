@@ -53,7 +53,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OriginalMandate4Choice{}.Validate())
 	assert.NotNil(t, OriginalMessageInformation1{}.Validate())
-	assert.Nil(t, Party11Choice{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
 	assert.Nil(t, PartyIdentification43{}.Validate())
 	assert.Nil(t, PersonIdentification5{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
@@ -73,22 +73,24 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalAuthenticationChannel1Code
@@ -109,6 +111,8 @@ func TestTypes(t *testing.T) {
 	var type12 ExternalLocalInstrument1Code
 	assert.NotNil(t, type12.Validate())
 	type12 = "test"
+	assert.NotNil(t, type12.Validate())
+	type12 = "INST"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalMandateSetupReason1Code
@@ -118,12 +122,12 @@ func TestTypes(t *testing.T) {
 
 	var type14 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "BANK"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalPersonIdentification1Code
 	assert.NotNil(t, type15.Validate())
-	type15 = "test"
+	type15 = "ARNU"
 	assert.Nil(t, type15.Validate())
 
 	var type17 ExternalMandateSuspensionReason1Code
@@ -134,6 +138,8 @@ func TestTypes(t *testing.T) {
 	var type18 ExternalServiceLevel1Code
 	assert.NotNil(t, type18.Validate())
 	type18 = "test"
+	assert.NotNil(t, type18.Validate())
+	type18 = "SEPA"
 	assert.Nil(t, type18.Validate())
 
 	var type28 SequenceType2Code
@@ -0,0 +1,720 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pain_v03
+
+import (
+	"encoding/xml"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+type AccountIdentification4Choice struct {
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r AccountIdentification4Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type AccountSchemeName1Choice struct {
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r AccountSchemeName1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ActiveOrHistoricCurrencyAndAmount struct {
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
+}
+
+func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
+	return utils.Validate(&r)
+}
+
+type AmountType3Choice struct {
+	InstdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty" json:",omitempty"`
+	EqvtAmt  *EquivalentAmount2                 `xml:"EqvtAmt,omitempty" json:",omitempty"`
+}
+
+func (r AmountType3Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type Authorisation1Choice struct {
+	Cd    *common.Authorisation1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max128Text         `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r Authorisation1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type BranchAndFinancialInstitutionIdentification4 struct {
+	FinInstnId FinancialInstitutionIdentification7 `xml:"FinInstnId"`
+	BrnchId    *BranchData2                        `xml:"BrnchId,omitempty" json:",omitempty"`
+}
+
+func (r BranchAndFinancialInstitutionIdentification4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type BranchData2 struct {
+	Id      *common.Max35Text  `xml:"Id,omitempty" json:",omitempty"`
+	Nm      *common.Max140Text `xml:"Nm,omitempty" json:",omitempty"`
+	PstlAdr *PostalAddress6    `xml:"PstlAdr,omitempty" json:",omitempty"`
+}
+
+func (r BranchData2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashAccount16 struct {
+	Id  AccountIdentification4Choice         `xml:"Id"`
+	Tp  *CashAccountType2                    `xml:"Tp,omitempty" json:",omitempty"`
+	Ccy *common.ActiveOrHistoricCurrencyCode `xml:"Ccy,omitempty" json:",omitempty"`
+	Nm  *common.Max70Text                    `xml:"Nm,omitempty" json:",omitempty"`
+}
+
+func (r CashAccount16) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CashAccountType2 struct {
+	Cd    *CashAccountType4Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r CashAccountType2) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type CategoryPurpose1Choice struct {
+	Cd    *ExternalCategoryPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r CategoryPurpose1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type Cheque6 struct {
+	ChqTp       *ChequeType2Code             `xml:"ChqTp,omitempty" json:",omitempty"`
+	ChqNb       *common.Max35Text            `xml:"ChqNb,omitempty" json:",omitempty"`
+	ChqFr       *NameAndAddress10            `xml:"ChqFr,omitempty" json:",omitempty"`
+	DlvryMtd    *ChequeDeliveryMethod1Choice `xml:"DlvryMtd,omitempty" json:",omitempty"`
+	DlvrTo      *NameAndAddress10            `xml:"DlvrTo,omitempty" json:",omitempty"`
+	InstrPrty   *Priority2Code               `xml:"InstrPrty,omitempty" json:",omitempty"`
+	ChqMtrtyDt  *common.ISODate              `xml:"ChqMtrtyDt,omitempty" json:",omitempty"`
+	FrmsCd      *common.Max35Text            `xml:"FrmsCd,omitempty" json:",omitempty"`
+	MemoFld     []common.Max35Text           `xml:"MemoFld,omitempty" json:",omitempty"`
+	RgnlClrZone *common.Max35Text            `xml:"RgnlClrZone,omitempty" json:",omitempty"`
+	PrtLctn     *common.Max35Text            `xml:"PrtLctn,omitempty" json:",omitempty"`
+}
+
+func (r Cheque6) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ChequeDeliveryMethod1Choice struct {
+	Cd    *ChequeDelivery1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text    `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ChequeDeliveryMethod1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ClearingSystemIdentification2Choice struct {
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ClearingSystemIdentification2Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ClearingSystemMemberIdentification2 struct {
+	ClrSysId *ClearingSystemIdentification2Choice `xml:"ClrSysId,omitempty" json:",omitempty"`
+	MmbId    common.Max35Text                     `xml:"MmbId"`
+}
+
+func (r ClearingSystemMemberIdentification2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ContactDetails2 struct {
+	NmPrfx   *common.NamePrefix1Code `xml:"NmPrfx,omitempty" json:",omitempty"`
+	Nm       *common.Max140Text      `xml:"Nm,omitempty" json:",omitempty"`
+	PhneNb   *common.PhoneNumber     `xml:"PhneNb,omitempty" json:",omitempty"`
+	MobNb    *common.PhoneNumber     `xml:"MobNb,omitempty" json:",omitempty"`
+	FaxNb    *common.PhoneNumber     `xml:"FaxNb,omitempty" json:",omitempty"`
+	EmailAdr *common.Max2048Text     `xml:"EmailAdr,omitempty" json:",omitempty"`
+	Othr     *common.Max35Text       `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r ContactDetails2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CreditTransferTransactionInformation10 struct {
+	PmtId           PaymentIdentification1                        `xml:"PmtId"`
+	PmtTpInf        *PaymentTypeInformation19                     `xml:"PmtTpInf,omitempty" json:",omitempty"`
+	Amt             AmountType3Choice                             `xml:"Amt"`
+	XchgRateInf     *ExchangeRateInformation1                     `xml:"XchgRateInf,omitempty" json:",omitempty"`
+	ChrgBr          *ChargeBearerType1Code                        `xml:"ChrgBr,omitempty" json:",omitempty"`
+	ChqInstr        *Cheque6                                      `xml:"ChqInstr,omitempty" json:",omitempty"`
+	UltmtDbtr       *PartyIdentification32                        `xml:"UltmtDbtr,omitempty" json:",omitempty"`
+	IntrmyAgt1      *BranchAndFinancialInstitutionIdentification4 `xml:"IntrmyAgt1,omitempty" json:",omitempty"`
+	IntrmyAgt1Acct  *CashAccount16                                `xml:"IntrmyAgt1Acct,omitempty" json:",omitempty"`
+	IntrmyAgt2      *BranchAndFinancialInstitutionIdentification4 `xml:"IntrmyAgt2,omitempty" json:",omitempty"`
+	IntrmyAgt2Acct  *CashAccount16                                `xml:"IntrmyAgt2Acct,omitempty" json:",omitempty"`
+	IntrmyAgt3      *BranchAndFinancialInstitutionIdentification4 `xml:"IntrmyAgt3,omitempty" json:",omitempty"`
+	IntrmyAgt3Acct  *CashAccount16                                `xml:"IntrmyAgt3Acct,omitempty" json:",omitempty"`
+	CdtrAgt         *BranchAndFinancialInstitutionIdentification4 `xml:"CdtrAgt,omitempty" json:",omitempty"`
+	CdtrAgtAcct     *CashAccount16                                `xml:"CdtrAgtAcct,omitempty" json:",omitempty"`
+	Cdtr            *PartyIdentification32                        `xml:"Cdtr,omitempty" json:",omitempty"`
+	CdtrAcct        *CashAccount16                                `xml:"CdtrAcct,omitempty" json:",omitempty"`
+	UltmtCdtr       *PartyIdentification32                        `xml:"UltmtCdtr,omitempty" json:",omitempty"`
+	InstrForCdtrAgt []InstructionForCreditorAgent1                `xml:"InstrForCdtrAgt,omitempty" json:",omitempty"`
+	InstrForDbtrAgt *common.Max140Text                            `xml:"InstrForDbtrAgt,omitempty" json:",omitempty"`
+	Purp            *Purpose2Choice                               `xml:"Purp,omitempty" json:",omitempty"`
+	RgltryRptg      []RegulatoryReporting3                        `xml:"RgltryRptg,omitempty" json:",omitempty"`
+	Tax             *TaxInformation3                              `xml:"Tax,omitempty" json:",omitempty"`
+	RltdRmtInf      []RemittanceLocation2                         `xml:"RltdRmtInf,omitempty" json:",omitempty"`
+	RmtInf          *RemittanceInformation5                       `xml:"RmtInf,omitempty" json:",omitempty"`
+}
+
+func (r CreditTransferTransactionInformation10) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CreditorReferenceInformation2 struct {
+	Tp  *CreditorReferenceType2 `xml:"Tp,omitempty" json:",omitempty"`
+	Ref *common.Max35Text       `xml:"Ref,omitempty" json:",omitempty"`
+}
+
+func (r CreditorReferenceInformation2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CreditorReferenceType1Choice struct {
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r CreditorReferenceType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type CreditorReferenceType2 struct {
+	CdOrPrtry CreditorReferenceType1Choice `xml:"CdOrPrtry"`
+	Issr      *common.Max35Text            `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r CreditorReferenceType2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CustomerCreditTransferInitiationV03 struct {
+	XMLName xml.Name                         `xml:"CstmrCdtTrfInitn"`
+	GrpHdr  GroupHeader32                    `xml:"GrpHdr"`
+	PmtInf  []PaymentInstructionInformation3 `xml:"PmtInf" json:",omitempty"`
+}
+
+func (r CustomerCreditTransferInitiationV03) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DateAndPlaceOfBirth struct {
+	BirthDt     common.ISODate     `xml:"BirthDt"`
+	PrvcOfBirth *common.Max35Text  `xml:"PrvcOfBirth,omitempty" json:",omitempty"`
+	CityOfBirth common.Max35Text   `xml:"CityOfBirth"`
+	CtryOfBirth common.CountryCode `xml:"CtryOfBirth"`
+}
+
+func (r DateAndPlaceOfBirth) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DatePeriodDetails struct {
+	FrDt common.ISODate `xml:"FrDt"`
+	ToDt common.ISODate `xml:"ToDt"`
+}
+
+func (r DatePeriodDetails) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DocumentAdjustment1 struct {
+	Amt       ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd *common.CreditDebitCode           `xml:"CdtDbtInd,omitempty" json:",omitempty"`
+	Rsn       *common.Max4Text                  `xml:"Rsn,omitempty" json:",omitempty"`
+	AddtlInf  *common.Max140Text                `xml:"AddtlInf,omitempty" json:",omitempty"`
+}
+
+func (r DocumentAdjustment1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type EquivalentAmount2 struct {
+	Amt      ActiveOrHistoricCurrencyAndAmount   `xml:"Amt"`
+	CcyOfTrf common.ActiveOrHistoricCurrencyCode `xml:"CcyOfTrf"`
+}
+
+func (r EquivalentAmount2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ExchangeRateInformation1 struct {
+	XchgRate float64                `xml:"XchgRate,omitempty" json:",omitempty"`
+	RateTp   *ExchangeRateType1Code `xml:"RateTp,omitempty" json:",omitempty"`
+	CtrctId  *common.Max35Text      `xml:"CtrctId,omitempty" json:",omitempty"`
+}
+
+func (r ExchangeRateInformation1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type FinancialIdentificationSchemeName1Choice struct {
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r FinancialIdentificationSchemeName1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type FinancialInstitutionIdentification7 struct {
+	BIC         *common.BICIdentifier                `xml:"BIC,omitempty" json:",omitempty"`
+	ClrSysMmbId *ClearingSystemMemberIdentification2 `xml:"ClrSysMmbId,omitempty" json:",omitempty"`
+	Nm          *common.Max140Text                   `xml:"Nm,omitempty" json:",omitempty"`
+	PstlAdr     *PostalAddress6                      `xml:"PstlAdr,omitempty" json:",omitempty"`
+	Othr        *GenericFinancialIdentification1     `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r FinancialInstitutionIdentification7) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericAccountIdentification1 struct {
+	Id      common.Max34Text          `xml:"Id"`
+	SchmeNm *AccountSchemeName1Choice `xml:"SchmeNm,omitempty" json:",omitempty"`
+	Issr    *common.Max35Text         `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r GenericAccountIdentification1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericFinancialIdentification1 struct {
+	Id      common.Max35Text                          `xml:"Id"`
+	SchmeNm *FinancialIdentificationSchemeName1Choice `xml:"SchmeNm,omitempty" json:",omitempty"`
+	Issr    *common.Max35Text                         `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r GenericFinancialIdentification1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericOrganisationIdentification1 struct {
+	Id      common.Max35Text                             `xml:"Id"`
+	SchmeNm *OrganisationIdentificationSchemeName1Choice `xml:"SchmeNm,omitempty" json:",omitempty"`
+	Issr    *common.Max35Text                            `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r GenericOrganisationIdentification1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GenericPersonIdentification1 struct {
+	Id      common.Max35Text                       `xml:"Id"`
+	SchmeNm *PersonIdentificationSchemeName1Choice `xml:"SchmeNm,omitempty" json:",omitempty"`
+	Issr    *common.Max35Text                      `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r GenericPersonIdentification1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GroupHeader32 struct {
+	MsgId    common.Max35Text                              `xml:"MsgId"`
+	CreDtTm  common.ISODateTime                            `xml:"CreDtTm"`
+	Authstn  []Authorisation1Choice                        `xml:"Authstn,omitempty" json:",omitempty"`
+	NbOfTxs  common.Max15NumericText                       `xml:"NbOfTxs"`
+	CtrlSum  *common.DecimalNumber                         `xml:"CtrlSum,omitempty" json:",omitempty"`
+	InitgPty PartyIdentification32                         `xml:"InitgPty"`
+	FwdgAgt  *BranchAndFinancialInstitutionIdentification4 `xml:"FwdgAgt,omitempty" json:",omitempty"`
+}
+
+func (r GroupHeader32) Validate() error {
+	return utils.Validate(&r)
+}
+
+type InstructionForCreditorAgent1 struct {
+	Cd       *Instruction3Code  `xml:"Cd,omitempty" json:",omitempty"`
+	InstrInf *common.Max140Text `xml:"InstrInf,omitempty" json:",omitempty"`
+}
+
+func (r InstructionForCreditorAgent1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type LocalInstrument2Choice struct {
+	Cd    *ExternalLocalInstrument1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r LocalInstrument2Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type NameAndAddress10 struct {
+	Nm  common.Max140Text `xml:"Nm"`
+	Adr PostalAddress6    `xml:"Adr"`
+}
+
+func (r NameAndAddress10) Validate() error {
+	return utils.Validate(&r)
+}
+
+type OrganisationIdentification4 struct {
+	BICOrBEI *common.AnyBICIdentifier             `xml:"BICOrBEI,omitempty" json:",omitempty"`
+	Othr     []GenericOrganisationIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r OrganisationIdentification4) Validate() error {
+	return utils.Validate(&r)
+}
+
+type OrganisationIdentificationSchemeName1Choice struct {
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type Party6Choice struct {
+	OrgId  *OrganisationIdentification4 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification5       `xml:"PrvtId,omitempty" json:",omitempty"`
+}
+
+func (r Party6Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type PartyIdentification32 struct {
+	Nm        *common.Max140Text  `xml:"Nm,omitempty" json:",omitempty"`
+	PstlAdr   *PostalAddress6     `xml:"PstlAdr,omitempty" json:",omitempty"`
+	Id        *Party6Choice       `xml:"Id,omitempty" json:",omitempty"`
+	CtryOfRes *common.CountryCode `xml:"CtryOfRes,omitempty" json:",omitempty"`
+	CtctDtls  *ContactDetails2    `xml:"CtctDtls,omitempty" json:",omitempty"`
+}
+
+func (r PartyIdentification32) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PaymentIdentification1 struct {
+	InstrId    *common.Max35Text `xml:"InstrId,omitempty" json:",omitempty"`
+	EndToEndId common.Max35Text  `xml:"EndToEndId"`
+}
+
+func (r PaymentIdentification1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PaymentInstructionInformation3 struct {
+	PmtInfId        common.Max35Text                              `xml:"PmtInfId"`
+	PmtMtd          PaymentMethod3Code                            `xml:"PmtMtd"`
+	BtchBookg       bool                                          `xml:"BtchBookg,omitempty" json:",omitempty"`
+	NbOfTxs         *common.Max15NumericText                      `xml:"NbOfTxs,omitempty" json:",omitempty"`
+	CtrlSum         *common.DecimalNumber                         `xml:"CtrlSum,omitempty" json:",omitempty"`
+	PmtTpInf        *PaymentTypeInformation19                     `xml:"PmtTpInf,omitempty" json:",omitempty"`
+	ReqdExctnDt     common.ISODate                                `xml:"ReqdExctnDt"`
+	PoolgAdjstmntDt *common.ISODate                               `xml:"PoolgAdjstmntDt,omitempty" json:",omitempty"`
+	Dbtr            PartyIdentification32                         `xml:"Dbtr"`
+	DbtrAcct        CashAccount16                                 `xml:"DbtrAcct"`
+	DbtrAgt         BranchAndFinancialInstitutionIdentification4  `xml:"DbtrAgt"`
+	DbtrAgtAcct     *CashAccount16                                `xml:"DbtrAgtAcct,omitempty" json:",omitempty"`
+	UltmtDbtr       *PartyIdentification32                        `xml:"UltmtDbtr,omitempty" json:",omitempty"`
+	ChrgBr          *ChargeBearerType1Code                        `xml:"ChrgBr,omitempty" json:",omitempty"`
+	ChrgsAcct       *CashAccount16                                `xml:"ChrgsAcct,omitempty" json:",omitempty"`
+	ChrgsAcctAgt    *BranchAndFinancialInstitutionIdentification4 `xml:"ChrgsAcctAgt,omitempty" json:",omitempty"`
+	CdtTrfTxInf     []CreditTransferTransactionInformation10      `xml:"CdtTrfTxInf" json:",omitempty"`
+}
+
+func (r PaymentInstructionInformation3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PaymentTypeInformation19 struct {
+	InstrPrty *Priority2Code          `xml:"InstrPrty,omitempty" json:",omitempty"`
+	SvcLvl    *ServiceLevel8Choice    `xml:"SvcLvl,omitempty" json:",omitempty"`
+	LclInstrm *LocalInstrument2Choice `xml:"LclInstrm,omitempty" json:",omitempty"`
+	CtgyPurp  *CategoryPurpose1Choice `xml:"CtgyPurp,omitempty" json:",omitempty"`
+}
+
+func (r PaymentTypeInformation19) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PersonIdentification5 struct {
+	DtAndPlcOfBirth *DateAndPlaceOfBirth           `xml:"DtAndPlcOfBirth,omitempty" json:",omitempty"`
+	Othr            []GenericPersonIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
+}
+
+func (r PersonIdentification5) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PersonIdentificationSchemeName1Choice struct {
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r PersonIdentificationSchemeName1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type PostalAddress6 struct {
+	AdrTp       *common.AddressType2Code `xml:"AdrTp,omitempty" json:",omitempty"`
+	Dept        *common.Max70Text        `xml:"Dept,omitempty" json:",omitempty"`
+	SubDept     *common.Max70Text        `xml:"SubDept,omitempty" json:",omitempty"`
+	StrtNm      *common.Max70Text        `xml:"StrtNm,omitempty" json:",omitempty"`
+	BldgNb      *common.Max16Text        `xml:"BldgNb,omitempty" json:",omitempty"`
+	PstCd       *common.Max16Text        `xml:"PstCd,omitempty" json:",omitempty"`
+	TwnNm       *common.Max35Text        `xml:"TwnNm,omitempty" json:",omitempty"`
+	CtrySubDvsn *common.Max35Text        `xml:"CtrySubDvsn,omitempty" json:",omitempty"`
+	Ctry        *common.CountryCode      `xml:"Ctry,omitempty" json:",omitempty"`
+	AdrLine     []common.Max70Text       `xml:"AdrLine,omitempty" json:",omitempty"`
+}
+
+func (r PostalAddress6) Validate() error {
+	return utils.Validate(&r)
+}
+
+type Purpose2Choice struct {
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r Purpose2Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ReferredDocumentInformation3 struct {
+	Tp     *ReferredDocumentType2 `xml:"Tp,omitempty" json:",omitempty"`
+	Nb     *common.Max35Text      `xml:"Nb,omitempty" json:",omitempty"`
+	RltdDt *common.ISODate        `xml:"RltdDt,omitempty" json:",omitempty"`
+}
+
+func (r ReferredDocumentInformation3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ReferredDocumentType1Choice struct {
+	Cd    *DocumentType5Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ReferredDocumentType1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type ReferredDocumentType2 struct {
+	CdOrPrtry ReferredDocumentType1Choice `xml:"CdOrPrtry"`
+	Issr      *common.Max35Text           `xml:"Issr,omitempty" json:",omitempty"`
+}
+
+func (r ReferredDocumentType2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RegulatoryAuthority2 struct {
+	Nm   *common.Max140Text  `xml:"Nm,omitempty" json:",omitempty"`
+	Ctry *common.CountryCode `xml:"Ctry,omitempty" json:",omitempty"`
+}
+
+func (r RegulatoryAuthority2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RegulatoryReporting3 struct {
+	DbtCdtRptgInd *RegulatoryReportingType1Code    `xml:"DbtCdtRptgInd,omitempty" json:",omitempty"`
+	Authrty       *RegulatoryAuthority2            `xml:"Authrty,omitempty" json:",omitempty"`
+	Dtls          []StructuredRegulatoryReporting3 `xml:"Dtls,omitempty" json:",omitempty"`
+}
+
+func (r RegulatoryReporting3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RemittanceAmount1 struct {
+	DuePyblAmt        *ActiveOrHistoricCurrencyAndAmount `xml:"DuePyblAmt,omitempty" json:",omitempty"`
+	DscntApldAmt      *ActiveOrHistoricCurrencyAndAmount `xml:"DscntApldAmt,omitempty" json:",omitempty"`
+	CdtNoteAmt        *ActiveOrHistoricCurrencyAndAmount `xml:"CdtNoteAmt,omitempty" json:",omitempty"`
+	TaxAmt            *ActiveOrHistoricCurrencyAndAmount `xml:"TaxAmt,omitempty" json:",omitempty"`
+	AdjstmntAmtAndRsn []DocumentAdjustment1              `xml:"AdjstmntAmtAndRsn,omitempty" json:",omitempty"`
+	RmtdAmt           *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt,omitempty" json:",omitempty"`
+}
+
+func (r RemittanceAmount1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RemittanceInformation5 struct {
+	Ustrd []common.Max140Text                `xml:"Ustrd,omitempty" json:",omitempty"`
+	Strd  []StructuredRemittanceInformation7 `xml:"Strd,omitempty" json:",omitempty"`
+}
+
+func (r RemittanceInformation5) Validate() error {
+	return utils.Validate(&r)
+}
+
+type RemittanceLocation2 struct {
+	RmtId             *common.Max35Text              `xml:"RmtId,omitempty" json:",omitempty"`
+	RmtLctnMtd        *RemittanceLocationMethod2Code `xml:"RmtLctnMtd,omitempty" json:",omitempty"`
+	RmtLctnElctrncAdr *common.Max2048Text            `xml:"RmtLctnElctrncAdr,omitempty" json:",omitempty"`
+	RmtLctnPstlAdr    *NameAndAddress10              `xml:"RmtLctnPstlAdr,omitempty" json:",omitempty"`
+}
+
+func (r RemittanceLocation2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ServiceLevel8Choice struct {
+	Cd    *ExternalServiceLevel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ServiceLevel8Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type StructuredRegulatoryReporting3 struct {
+	Tp   *common.Max35Text                  `xml:"Tp,omitempty" json:",omitempty"`
+	Dt   *common.ISODate                    `xml:"Dt,omitempty" json:",omitempty"`
+	Ctry *common.CountryCode                `xml:"Ctry,omitempty" json:",omitempty"`
+	Cd   *common.Max10Text                  `xml:"Cd,omitempty" json:",omitempty"`
+	Amt  *ActiveOrHistoricCurrencyAndAmount `xml:"Amt,omitempty" json:",omitempty"`
+	Inf  []common.Max35Text                 `xml:"Inf,omitempty" json:",omitempty"`
+}
+
+func (r StructuredRegulatoryReporting3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type StructuredRemittanceInformation7 struct {
+	RfrdDocInf  []ReferredDocumentInformation3 `xml:"RfrdDocInf,omitempty" json:",omitempty"`
+	RfrdDocAmt  *RemittanceAmount1             `xml:"RfrdDocAmt,omitempty" json:",omitempty"`
+	CdtrRefInf  *CreditorReferenceInformation2 `xml:"CdtrRefInf,omitempty" json:",omitempty"`
+	Invcr       *PartyIdentification32         `xml:"Invcr,omitempty" json:",omitempty"`
+	Invcee      *PartyIdentification32         `xml:"Invcee,omitempty" json:",omitempty"`
+	AddtlRmtInf []common.Max140Text            `xml:"AddtlRmtInf,omitempty" json:",omitempty"`
+}
+
+func (r StructuredRemittanceInformation7) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxAmount1 struct {
+	Rate         float64                            `xml:"Rate,omitempty" json:",omitempty"`
+	TaxblBaseAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TaxblBaseAmt,omitempty" json:",omitempty"`
+	TtlAmt       *ActiveOrHistoricCurrencyAndAmount `xml:"TtlAmt,omitempty" json:",omitempty"`
+	Dtls         []TaxRecordDetails1                `xml:"Dtls,omitempty" json:",omitempty"`
+}
+
+func (r TaxAmount1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxAuthorisation1 struct {
+	Titl *common.Max35Text  `xml:"Titl,omitempty" json:",omitempty"`
+	Nm   *common.Max140Text `xml:"Nm,omitempty" json:",omitempty"`
+}
+
+func (r TaxAuthorisation1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxInformation3 struct {
+	Cdtr            *TaxParty1                         `xml:"Cdtr,omitempty" json:",omitempty"`
+	Dbtr            *TaxParty2                         `xml:"Dbtr,omitempty" json:",omitempty"`
+	AdmstnZn        *common.Max35Text                  `xml:"AdmstnZn,omitempty" json:",omitempty"`
+	RefNb           *common.Max140Text                 `xml:"RefNb,omitempty" json:",omitempty"`
+	Mtd             *common.Max35Text                  `xml:"Mtd,omitempty" json:",omitempty"`
+	TtlTaxblBaseAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlTaxblBaseAmt,omitempty" json:",omitempty"`
+	TtlTaxAmt       *ActiveOrHistoricCurrencyAndAmount `xml:"TtlTaxAmt,omitempty" json:",omitempty"`
+	Dt              *common.ISODate                    `xml:"Dt,omitempty" json:",omitempty"`
+	SeqNb           float64                            `xml:"SeqNb,omitempty" json:",omitempty"`
+	Rcrd            []TaxRecord1                       `xml:"Rcrd,omitempty" json:",omitempty"`
+}
+
+func (r TaxInformation3) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxParty1 struct {
+	TaxId  *common.Max35Text `xml:"TaxId,omitempty" json:",omitempty"`
+	RegnId *common.Max35Text `xml:"RegnId,omitempty" json:",omitempty"`
+	TaxTp  *common.Max35Text `xml:"TaxTp,omitempty" json:",omitempty"`
+}
+
+func (r TaxParty1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxParty2 struct {
+	TaxId   *common.Max35Text  `xml:"TaxId,omitempty" json:",omitempty"`
+	RegnId  *common.Max35Text  `xml:"RegnId,omitempty" json:",omitempty"`
+	TaxTp   *common.Max35Text  `xml:"TaxTp,omitempty" json:",omitempty"`
+	Authstn *TaxAuthorisation1 `xml:"Authstn,omitempty" json:",omitempty"`
+}
+
+func (r TaxParty2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxPeriod1 struct {
+	Yr     *common.ISODate       `xml:"Yr,omitempty" json:",omitempty"`
+	Tp     *TaxRecordPeriod1Code `xml:"Tp,omitempty" json:",omitempty"`
+	FrToDt *DatePeriodDetails    `xml:"FrToDt,omitempty" json:",omitempty"`
+}
+
+func (r TaxPeriod1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxRecord1 struct {
+	Tp       *common.Max35Text  `xml:"Tp,omitempty" json:",omitempty"`
+	Ctgy     *common.Max35Text  `xml:"Ctgy,omitempty" json:",omitempty"`
+	CtgyDtls *common.Max35Text  `xml:"CtgyDtls,omitempty" json:",omitempty"`
+	DbtrSts  *common.Max35Text  `xml:"DbtrSts,omitempty" json:",omitempty"`
+	CertId   *common.Max35Text  `xml:"CertId,omitempty" json:",omitempty"`
+	FrmsCd   *common.Max35Text  `xml:"FrmsCd,omitempty" json:",omitempty"`
+	Prd      *TaxPeriod1        `xml:"Prd,omitempty" json:",omitempty"`
+	TaxAmt   *TaxAmount1        `xml:"TaxAmt,omitempty" json:",omitempty"`
+	AddtlInf *common.Max140Text `xml:"AddtlInf,omitempty" json:",omitempty"`
+}
+
+func (r TaxRecord1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type TaxRecordDetails1 struct {
+	Prd *TaxPeriod1                       `xml:"Prd,omitempty" json:",omitempty"`
+	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
+}
+
+func (r TaxRecordDetails1) Validate() error {
+	return utils.Validate(&r)
+}
@@ -0,0 +1,225 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pain_v03
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNestedTypes(t *testing.T) {
+	assert.NotNil(t, AccountIdentification4Choice{}.Validate())
+	assert.NotNil(t, AccountSchemeName1Choice{}.Validate())
+	assert.NotNil(t, ActiveOrHistoricCurrencyAndAmount{}.Validate())
+	assert.NotNil(t, AmountType3Choice{}.Validate())
+	assert.NotNil(t, Authorisation1Choice{}.Validate())
+	assert.Nil(t, BranchAndFinancialInstitutionIdentification4{}.Validate())
+	assert.Nil(t, BranchData2{}.Validate())
+	assert.NotNil(t, CashAccount16{}.Validate())
+	assert.NotNil(t, CashAccountType2{}.Validate())
+	assert.NotNil(t, CategoryPurpose1Choice{}.Validate())
+	assert.Nil(t, Cheque6{}.Validate())
+	assert.NotNil(t, ChequeDeliveryMethod1Choice{}.Validate())
+	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
+	assert.NotNil(t, ClearingSystemMemberIdentification2{}.Validate())
+	assert.Nil(t, ContactDetails2{}.Validate())
+	assert.NotNil(t, CreditTransferTransactionInformation10{}.Validate())
+	assert.Nil(t, CreditorReferenceInformation2{}.Validate())
+	assert.NotNil(t, CreditorReferenceType1Choice{}.Validate())
+	assert.NotNil(t, CreditorReferenceType2{}.Validate())
+	assert.NotNil(t, CustomerCreditTransferInitiationV03{}.Validate())
+	assert.NotNil(t, DateAndPlaceOfBirth{}.Validate())
+	assert.Nil(t, DatePeriodDetails{}.Validate())
+	assert.NotNil(t, DocumentAdjustment1{}.Validate())
+	assert.NotNil(t, EquivalentAmount2{}.Validate())
+	assert.Nil(t, ExchangeRateInformation1{}.Validate())
+	assert.NotNil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
+	assert.Nil(t, FinancialInstitutionIdentification7{}.Validate())
+	assert.NotNil(t, GenericAccountIdentification1{}.Validate())
+	assert.NotNil(t, GenericFinancialIdentification1{}.Validate())
+	assert.NotNil(t, GenericOrganisationIdentification1{}.Validate())
+	assert.NotNil(t, GenericPersonIdentification1{}.Validate())
+	assert.NotNil(t, GroupHeader32{}.Validate())
+	assert.Nil(t, InstructionForCreditorAgent1{}.Validate())
+	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
+	assert.NotNil(t, NameAndAddress10{}.Validate())
+	assert.Nil(t, OrganisationIdentification4{}.Validate())
+	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
+	assert.NotNil(t, Party6Choice{}.Validate())
+	assert.Nil(t, PartyIdentification32{}.Validate())
+	assert.NotNil(t, PaymentIdentification1{}.Validate())
+	assert.NotNil(t, PaymentInstructionInformation3{}.Validate())
+	assert.Nil(t, PaymentTypeInformation19{}.Validate())
+	assert.Nil(t, PersonIdentification5{}.Validate())
+	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
+	assert.Nil(t, PostalAddress6{}.Validate())
+	assert.NotNil(t, Purpose2Choice{}.Validate())
+	assert.Nil(t, ReferredDocumentInformation3{}.Validate())
+	assert.NotNil(t, ReferredDocumentType1Choice{}.Validate())
+	assert.NotNil(t, ReferredDocumentType2{}.Validate())
+	assert.Nil(t, RegulatoryAuthority2{}.Validate())
+	assert.Nil(t, RegulatoryReporting3{}.Validate())
+	assert.Nil(t, RemittanceAmount1{}.Validate())
+	assert.Nil(t, RemittanceInformation5{}.Validate())
+	assert.Nil(t, RemittanceLocation2{}.Validate())
+	assert.NotNil(t, ServiceLevel8Choice{}.Validate())
+	assert.Nil(t, StructuredRegulatoryReporting3{}.Validate())
+	assert.Nil(t, StructuredRemittanceInformation7{}.Validate())
+	assert.Nil(t, TaxAmount1{}.Validate())
+	assert.Nil(t, TaxAuthorisation1{}.Validate())
+	assert.Nil(t, TaxInformation3{}.Validate())
+	assert.Nil(t, TaxParty1{}.Validate())
+	assert.Nil(t, TaxParty2{}.Validate())
+	assert.Nil(t, TaxPeriod1{}.Validate())
+	assert.Nil(t, TaxRecord1{}.Validate())
+	assert.NotNil(t, TaxRecordDetails1{}.Validate())
+}
+
+func TestTypes(t *testing.T) {
+	var type1 ExternalAccountIdentification1Code
+	assert.NotNil(t, type1.Validate())
+	type1 = "AIIN"
+	assert.Nil(t, type1.Validate())
+
+	var type2 ExternalCategoryPurpose1Code
+	assert.NotNil(t, type2.Validate())
+	type2 = "BONU"
+	assert.Nil(t, type2.Validate())
+
+	var type3 ExternalClearingSystemIdentification1Code
+	assert.NotNil(t, type3.Validate())
+	type3 = "test"
+	assert.NotNil(t, type3.Validate())
+	type3 = "USABA"
+	assert.Nil(t, type3.Validate())
+
+	var type4 ExternalFinancialInstitutionIdentification1Code
+	assert.NotNil(t, type4.Validate())
+	type4 = "test"
+	assert.Nil(t, type4.Validate())
+
+	var type5 ExternalLocalInstrument1Code
+	assert.NotNil(t, type5.Validate())
+	type5 = "test"
+	assert.NotNil(t, type5.Validate())
+	type5 = "INST"
+	assert.Nil(t, type5.Validate())
+
+	var type6 ExternalOrganisationIdentification1Code
+	assert.NotNil(t, type6.Validate())
+	type6 = "BANK"
+	assert.Nil(t, type6.Validate())
+
+	var type7 ExternalPersonIdentification1Code
+	assert.NotNil(t, type7.Validate())
+	type7 = "ARNU"
+	assert.Nil(t, type7.Validate())
+
+	var type8 ExternalPurpose1Code
+	assert.NotNil(t, type8.Validate())
+	type8 = "test"
+	assert.NotNil(t, type8.Validate())
+	type8 = "GDDS"
+	assert.Nil(t, type8.Validate())
+
+	var type9 ExternalServiceLevel1Code
+	assert.NotNil(t, type9.Validate())
+	type9 = "test"
+	assert.NotNil(t, type9.Validate())
+	type9 = "SEPA"
+	assert.Nil(t, type9.Validate())
+
+	var type10 CashAccountType4Code
+	assert.NotNil(t, type10.Validate())
+	type10 = "test"
+	assert.NotNil(t, type10.Validate())
+	type10 = "CASH"
+	assert.Nil(t, type10.Validate())
+
+	var type11 ChargeBearerType1Code
+	assert.NotNil(t, type11.Validate())
+	type11 = "test"
+	assert.NotNil(t, type11.Validate())
+	type11 = "DEBT"
+	assert.Nil(t, type11.Validate())
+
+	var type12 ChequeDelivery1Code
+	assert.NotNil(t, type12.Validate())
+	type12 = "test"
+	assert.NotNil(t, type12.Validate())
+	type12 = "MLDB"
+	assert.Nil(t, type12.Validate())
+
+	var type13 ChequeType2Code
+	assert.NotNil(t, type13.Validate())
+	type13 = "test"
+	assert.NotNil(t, type13.Validate())
+	type13 = "CCHQ"
+	assert.Nil(t, type13.Validate())
+
+	var type14 DocumentType3Code
+	assert.NotNil(t, type14.Validate())
+	type14 = "test"
+	assert.NotNil(t, type14.Validate())
+	type14 = "RADM"
+	assert.Nil(t, type14.Validate())
+
+	var type15 DocumentType5Code
+	assert.NotNil(t, type15.Validate())
+	type15 = "test"
+	assert.NotNil(t, type15.Validate())
+	type15 = "MSIN"
+	assert.Nil(t, type15.Validate())
+
+	var type16 ExchangeRateType1Code
+	assert.NotNil(t, type16.Validate())
+	type16 = "test"
+	assert.NotNil(t, type16.Validate())
+	type16 = "SPOT"
+	assert.Nil(t, type16.Validate())
+
+	var type17 Instruction3Code
+	assert.NotNil(t, type17.Validate())
+	type17 = "test"
+	assert.NotNil(t, type17.Validate())
+	type17 = "CHQB"
+	assert.Nil(t, type17.Validate())
+
+	var type18 PaymentMethod3Code
+	assert.NotNil(t, type18.Validate())
+	type18 = "test"
+	assert.NotNil(t, type18.Validate())
+	type18 = "CHK"
+	assert.Nil(t, type18.Validate())
+
+	var type19 Priority2Code
+	assert.NotNil(t, type19.Validate())
+	type19 = "test"
+	assert.NotNil(t, type19.Validate())
+	type19 = "HIGH"
+	assert.Nil(t, type19.Validate())
+
+	var type20 RegulatoryReportingType1Code
+	assert.NotNil(t, type20.Validate())
+	type20 = "test"
+	assert.NotNil(t, type20.Validate())
+	type20 = "CRED"
+	assert.Nil(t, type20.Validate())
+
+	var type21 RemittanceLocationMethod2Code
+	assert.NotNil(t, type21.Validate())
+	type21 = "test"
+	assert.NotNil(t, type21.Validate())
+	type21 = "FAXI"
+	assert.Nil(t, type21.Validate())
+
+	var type22 TaxRecordPeriod1Code
+	assert.NotNil(t, type22.Validate())
+	type22 = "test"
+	assert.NotNil(t, type22.Validate())
+	type22 = "MM01"
+	assert.Nil(t, type22.Validate())
+}
@@ -0,0 +1,284 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pain_v03
+
+import (
+	"reflect"
+
+	"github.com/moov-io/iso20022/pkg/codes"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Must be at least 1 items long
+type ExternalAccountIdentification1Code string
+
+func (r ExternalAccountIdentification1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
+	}
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalCategoryPurpose1Code string
+
+func (r ExternalCategoryPurpose1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalCategoryPurpose1Code", 1, 4)
+	}
+	return codes.Validate("ExternalCategoryPurpose1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalClearingSystemIdentification1Code string
+
+func (r ExternalClearingSystemIdentification1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 5 {
+		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
+	}
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalFinancialInstitutionIdentification1Code string
+
+func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
+	}
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalLocalInstrument1Code string
+
+func (r ExternalLocalInstrument1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 35 {
+		return utils.NewErrTextLengthInvalid("ExternalLocalInstrument1Code", 1, 35)
+	}
+	return codes.Validate("ExternalLocalInstrument1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalOrganisationIdentification1Code string
+
+func (r ExternalOrganisationIdentification1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
+	}
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalPersonIdentification1Code string
+
+func (r ExternalPersonIdentification1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
+	}
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalPurpose1Code string
+
+func (r ExternalPurpose1Code) Validate() error {
+	if len(string(r)) < 1 {
+		return utils.NewErrTextLengthInvalid("ExternalPurpose1Code", 1, 0)
+	}
+	return codes.Validate("ExternalPurpose1Code", string(r))
+}
+
+// Must be at least 1 items long
+type ExternalServiceLevel1Code string
+
+func (r ExternalServiceLevel1Code) Validate() error {
+	if len(string(r)) < 1 || len(string(r)) > 4 {
+		return utils.NewErrTextLengthInvalid("ExternalServiceLevel1Code", 1, 4)
+	}
+	return codes.Validate("ExternalServiceLevel1Code", string(r))
+}
+
+// May be one of CASH, CHAR, COMM, TAXE, CISH, TRAS, SACC, CACC, SVGS, ONDP, MGLD, NREX, MOMA, LOAN, SLRY, ODFT
+type CashAccountType4Code string
+
+func (r CashAccountType4Code) Validate() error {
+	for _, vv := range []string{
+		"CASH", "CHAR", "COMM", "TAXE", "CISH", "TRAS", "SACC", "CACC", "SVGS", "ONDP", "MGLD", "NREX", "MOMA", "LOAN", "SLRY", "ODFT",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("CashAccountType4Code")
+}
+
+// May be one of DEBT, CRED, SHAR, SLEV
+type ChargeBearerType1Code string
+
+func (r ChargeBearerType1Code) Validate() error {
+	for _, vv := range []string{
+		"DEBT", "CRED", "SHAR", "SLEV",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("ChargeBearerType1Code")
+}
+
+// May be one of MLDB, MLCD, MLFA, CRDB, CRCD, CRFA, PUDB, PUCD, PUFA, RGDB, RGCD, RGFA
+type ChequeDelivery1Code string
+
+func (r ChequeDelivery1Code) Validate() error {
+	for _, vv := range []string{
+		"MLDB", "MLCD", "MLFA", "CRDB", "CRCD", "CRFA", "PUDB", "PUCD", "PUFA", "RGDB", "RGCD", "RGFA",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("ChequeDelivery1Code")
+}
+
+// May be one of CCHQ, CCCH, BCHQ, DRFT, ELDR
+type ChequeType2Code string
+
+func (r ChequeType2Code) Validate() error {
+	for _, vv := range []string{
+		"CCHQ", "CCCH", "BCHQ", "DRFT", "ELDR",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("ChequeType2Code")
+}
+
+// May be one of RADM, RPIN, FXDR, DISP, PUOR, SCOR
+type DocumentType3Code string
+
+func (r DocumentType3Code) Validate() error {
+	for _, vv := range []string{
+		"RADM", "RPIN", "FXDR", "DISP", "PUOR", "SCOR",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("DocumentType3Code")
+}
+
+// May be one of MSIN, CNFA, DNFA, CINV, CREN, DEBN, HIRI, SBIN, CMCN, SOAC, DISP, BOLD, VCHR, AROI, TSUT
+type DocumentType5Code string
+
+func (r DocumentType5Code) Validate() error {
+	for _, vv := range []string{
+		"MSIN", "CNFA", "DNFA", "CINV", "CREN", "DEBN", "HIRI", "SBIN", "CMCN", "SOAC", "DISP", "BOLD", "VCHR", "AROI", "TSUT",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("DocumentType5Code")
+}
+
+// May be one of SPOT, SALE, AGRD
+type ExchangeRateType1Code string
+
+func (r ExchangeRateType1Code) Validate() error {
+	for _, vv := range []string{
+		"SPOT", "SALE", "AGRD",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("ExchangeRateType1Code")
+}
+
+// May be one of CHQB, HOLD, PHOB, TELB
+type Instruction3Code string
+
+func (r Instruction3Code) Validate() error {
+	for _, vv := range []string{
+		"CHQB", "HOLD", "PHOB", "TELB",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("Instruction3Code")
+}
+
+// May be one of CHK, TRF, TRA
+type PaymentMethod3Code string
+
+func (r PaymentMethod3Code) Validate() error {
+	for _, vv := range []string{
+		"CHK", "TRF", "TRA",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("PaymentMethod3Code")
+}
+
+// May be one of HIGH, NORM
+type Priority2Code string
+
+func (r Priority2Code) Validate() error {
+	for _, vv := range []string{
+		"HIGH", "NORM",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("Priority2Code")
+}
+
+// May be one of CRED, DEBT, BOTH
+type RegulatoryReportingType1Code string
+
+func (r RegulatoryReportingType1Code) Validate() error {
+	for _, vv := range []string{
+		"CRED", "DEBT", "BOTH",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("RegulatoryReportingType1Code")
+}
+
+// May be one of FAXI, EDIC, URID, EMAL, POST, SMSM
+type RemittanceLocationMethod2Code string
+
+func (r RemittanceLocationMethod2Code) Validate() error {
+	for _, vv := range []string{
+		"FAXI", "EDIC", "URID", "EMAL", "POST", "SMSM",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("RemittanceLocationMethod2Code")
+}
+
+// May be one of MM01, MM02, MM03, MM04, MM05, MM06, MM07, MM08, MM09, MM10, MM11, MM12, QTR1, QTR2, QTR3, QTR4, HLF1, HLF2
+type TaxRecordPeriod1Code string
+
+func (r TaxRecordPeriod1Code) Validate() error {
+	for _, vv := range []string{
+		"MM01", "MM02", "MM03", "MM04", "MM05", "MM06", "MM07", "MM08", "MM09", "MM10", "MM11", "MM12", "QTR1", "QTR2", "QTR3", "QTR4", "HLF1", "HLF2",
+	} {
+		if reflect.DeepEqual(string(r), vv) {
+			return nil
+		}
+	}
+	return utils.NewErrValueInvalid("TaxRecordPeriod1Code")
+}
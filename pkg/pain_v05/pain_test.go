@@ -48,7 +48,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, MandateTypeInformation2{}.Validate())
 	assert.Nil(t, OrganisationIdentification8{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
-	assert.Nil(t, Party11Choice{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
 	assert.Nil(t, PartyIdentification43{}.Validate())
 	assert.Nil(t, PersonIdentification5{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
@@ -139,22 +139,24 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalAuthenticationChannel1Code
@@ -170,6 +172,8 @@ func TestTypes(t *testing.T) {
 	var type12 ExternalLocalInstrument1Code
 	assert.NotNil(t, type12.Validate())
 	type12 = "test"
+	assert.NotNil(t, type12.Validate())
+	type12 = "INST"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalMandateSetupReason1Code
@@ -179,12 +183,12 @@ func TestTypes(t *testing.T) {
 
 	var type14 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "BANK"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalPersonIdentification1Code
 	assert.NotNil(t, type15.Validate())
-	type15 = "test"
+	type15 = "ARNU"
 	assert.Nil(t, type15.Validate())
 
 	var type17 ExternalMandateReason1Code
@@ -195,6 +199,8 @@ func TestTypes(t *testing.T) {
 	var type18 ExternalServiceLevel1Code
 	assert.NotNil(t, type18.Validate())
 	type18 = "test"
+	assert.NotNil(t, type18.Validate())
+	type18 = "SEPA"
 	assert.Nil(t, type18.Validate())
 
 	var type24 Frequency10Code
@@ -282,7 +288,7 @@ func TestTypes(t *testing.T) {
 
 	var type43 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type43.Validate())
-	type43 = "CRED"
+	type43 = "APDS"
 	assert.Nil(t, type43.Validate())
 
 	var type44 ExternalDocumentLineType1Code
@@ -292,22 +298,26 @@ func TestTypes(t *testing.T) {
 
 	var type45 ExternalGarnishmentType1Code
 	assert.NotNil(t, type45.Validate())
-	type45 = "CRED"
+	type45 = "GNCS"
 	assert.Nil(t, type45.Validate())
 
 	var type46 ExternalPurpose1Code
 	assert.NotNil(t, type46.Validate())
 	type46 = "CRED"
+	assert.NotNil(t, type46.Validate())
+	type46 = "GDDS"
 	assert.Nil(t, type46.Validate())
 
 	var type47 ExternalTaxAmountType1Code
 	assert.NotNil(t, type47.Validate())
-	type47 = "CRED"
+	type47 = "CITY"
 	assert.Nil(t, type47.Validate())
 
 	var type48 ExternalStatusReason1Code
 	assert.NotNil(t, type48.Validate())
 	type48 = "CRED"
+	assert.NotNil(t, type48.Validate())
+	type48 = "AC01"
 	assert.Nil(t, type48.Validate())
 
 	var type49 PaymentMethod4Code
@@ -16,7 +16,7 @@ type AccountIdentification4Choice struct {
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
@@ -25,12 +25,12 @@ type AccountSchemeName1Choice struct {
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveCurrencyAndAmount struct {
-	Value float64            `xml:",chardata"`
-	Ccy   ActiveCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   ActiveCurrencyCode                       `xml:"Ccy,attr"`
 }
 
 func (r ActiveCurrencyAndAmount) Validate() error {
@@ -38,8 +38,8 @@ func (r ActiveCurrencyAndAmount) Validate() error {
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
-	Value float64                      `xml:",chardata"`
-	Ccy   ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   ActiveOrHistoricCurrencyCode                       `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
@@ -52,16 +52,16 @@ type AddressType3Choice struct {
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AmountOrRate1Choice struct {
 	Amt  *ActiveCurrencyAndAmount `xml:"Amt,omitempty" json:",omitempty"`
-	Rate float64                  `xml:"Rate,omitempty" json:",omitempty"`
+	Rate *float64                 `xml:"Rate,omitempty" json:",omitempty"`
 }
 
 func (r AmountOrRate1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AmountType4Choice struct {
@@ -70,7 +70,7 @@ type AmountType4Choice struct {
 }
 
 func (r AmountType4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -111,7 +111,7 @@ type CashAccountType2Choice struct {
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CategoryPurpose1Choice struct {
@@ -120,7 +120,7 @@ type CategoryPurpose1Choice struct {
 }
 
 func (r CategoryPurpose1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Charges7 struct {
@@ -138,7 +138,7 @@ type ClearingSystemIdentification2Choice struct {
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -196,7 +196,7 @@ type CreditorReferenceType1Choice struct {
 }
 
 func (r CreditorReferenceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorReferenceType2 struct {
@@ -214,7 +214,7 @@ type DateAndDateTime2Choice struct {
 }
 
 func (r DateAndDateTime2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateAndPlaceOfBirth1 struct {
@@ -252,7 +252,7 @@ type DiscountAmountType1Choice struct {
 }
 
 func (r DiscountAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Document12 struct {
@@ -288,7 +288,7 @@ type DocumentFormat1Choice struct {
 }
 
 func (r DocumentFormat1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentLineIdentification1 struct {
@@ -326,7 +326,7 @@ type DocumentLineType1Choice struct {
 }
 
 func (r DocumentLineType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentType1Choice struct {
@@ -335,7 +335,7 @@ type DocumentType1Choice struct {
 }
 
 func (r DocumentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EquivalentAmount2 struct {
@@ -353,7 +353,7 @@ type FinancialIdentificationSchemeName1Choice struct {
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -399,7 +399,7 @@ type GarnishmentType1Choice struct {
 }
 
 func (r GarnishmentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericAccountIdentification1 struct {
@@ -480,13 +480,13 @@ type LocalInstrument2Choice struct {
 }
 
 func (r LocalInstrument2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type NumberOfTransactionsPerStatus5 struct {
 	DtldNbOfTxs common.Max15NumericText               `xml:"DtldNbOfTxs"`
 	DtldSts     ExternalPaymentTransactionStatus1Code `xml:"DtldSts"`
-	DtldCtrlSum float64                               `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
+	DtldCtrlSum *common.DecimalNumber                 `xml:"DtldCtrlSum,omitempty" json:",omitempty"`
 }
 
 func (r NumberOfTransactionsPerStatus5) Validate() error {
@@ -509,7 +509,7 @@ type OrganisationIdentificationSchemeName1Choice struct {
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OriginalGroupInformation30 struct {
@@ -517,7 +517,7 @@ type OriginalGroupInformation30 struct {
 	OrgnlMsgNmId  common.Max35Text                 `xml:"OrgnlMsgNmId"`
 	OrgnlCreDtTm  *common.ISODateTime              `xml:"OrgnlCreDtTm,omitempty" json:",omitempty"`
 	OrgnlNbOfTxs  *common.Max15NumericText         `xml:"OrgnlNbOfTxs,omitempty" json:",omitempty"`
-	OrgnlCtrlSum  float64                          `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
+	OrgnlCtrlSum  *common.DecimalNumber            `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
 	GrpSts        *ExternalPaymentGroupStatus1Code `xml:"GrpSts,omitempty" json:",omitempty"`
 	StsRsnInf     []StatusReasonInformation12      `xml:"StsRsnInf,omitempty" json:",omitempty"`
 	NbOfTxsPerSts []NumberOfTransactionsPerStatus5 `xml:"NbOfTxsPerSts,omitempty" json:",omitempty"`
@@ -530,7 +530,7 @@ func (r OriginalGroupInformation30) Validate() error {
 type OriginalPaymentInstruction31 struct {
 	OrgnlPmtInfId common.Max35Text                 `xml:"OrgnlPmtInfId"`
 	OrgnlNbOfTxs  *common.Max15NumericText         `xml:"OrgnlNbOfTxs,omitempty" json:",omitempty"`
-	OrgnlCtrlSum  float64                          `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
+	OrgnlCtrlSum  *common.DecimalNumber            `xml:"OrgnlCtrlSum,omitempty" json:",omitempty"`
 	PmtInfSts     *ExternalPaymentGroupStatus1Code `xml:"PmtInfSts,omitempty" json:",omitempty"`
 	StsRsnInf     []StatusReasonInformation12      `xml:"StsRsnInf,omitempty" json:",omitempty"`
 	NbOfTxsPerSts []NumberOfTransactionsPerStatus5 `xml:"NbOfTxsPerSts,omitempty" json:",omitempty"`
@@ -579,7 +579,7 @@ type Party38Choice struct {
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyAndSignature3 struct {
@@ -673,7 +673,7 @@ type PersonIdentificationSchemeName1Choice struct {
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress24 struct {
@@ -714,7 +714,7 @@ type ProxyAccountType1Choice struct {
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentInformation7 struct {
@@ -734,7 +734,7 @@ type ReferredDocumentType3Choice struct {
 }
 
 func (r ReferredDocumentType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentType4 struct {
@@ -787,7 +787,7 @@ type ServiceLevel8Choice struct {
 }
 
 func (r ServiceLevel8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type SkipPayload struct {
@@ -804,7 +804,7 @@ type StatusReason6Choice struct {
 }
 
 func (r StatusReason6Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StatusReasonInformation12 struct {
@@ -875,7 +875,7 @@ type TaxAmountType1Choice struct {
 }
 
 func (r TaxAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxAuthorisation1 struct {
@@ -986,7 +986,7 @@ type ChequeDeliveryMethod1Choice struct {
 }
 
 func (r ChequeDeliveryMethod1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditTransferTransaction35 struct {
@@ -1033,7 +1033,7 @@ type GroupHeader78 struct {
 	MsgId    common.Max35Text        `xml:"MsgId"`
 	CreDtTm  common.ISODateTime      `xml:"CreDtTm"`
 	NbOfTxs  common.Max15NumericText `xml:"NbOfTxs"`
-	CtrlSum  float64                 `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum  *common.DecimalNumber   `xml:"CtrlSum,omitempty" json:",omitempty"`
 	InitgPty PartyIdentification135  `xml:"InitgPty"`
 }
 
@@ -1094,7 +1094,7 @@ type Purpose2Choice struct {
 }
 
 func (r Purpose2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type RegulatoryAuthority2 struct {
@@ -5,59 +5,60 @@
 package pain_v07
 
 import (
+	"encoding/xml"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNestedTypes(t *testing.T) {
-	assert.Nil(t, AccountIdentification4Choice{}.Validate())
-	assert.Nil(t, AccountSchemeName1Choice{}.Validate())
+	assert.NotNil(t, AccountIdentification4Choice{}.Validate())
+	assert.NotNil(t, AccountSchemeName1Choice{}.Validate())
 	assert.NotNil(t, ActiveCurrencyAndAmount{}.Validate())
 	assert.NotNil(t, ActiveOrHistoricCurrencyAndAmount{}.Validate())
-	assert.Nil(t, AddressType3Choice{}.Validate())
-	assert.Nil(t, AmountOrRate1Choice{}.Validate())
-	assert.Nil(t, AmountType4Choice{}.Validate())
+	assert.NotNil(t, AddressType3Choice{}.Validate())
+	assert.NotNil(t, AmountOrRate1Choice{}.Validate())
+	assert.NotNil(t, AmountType4Choice{}.Validate())
 	assert.Nil(t, BranchAndFinancialInstitutionIdentification6{}.Validate())
-	assert.Nil(t, CashAccountType2Choice{}.Validate())
-	assert.Nil(t, CategoryPurpose1Choice{}.Validate())
-	assert.Nil(t, ClearingSystemIdentification2Choice{}.Validate())
+	assert.NotNil(t, CashAccountType2Choice{}.Validate())
+	assert.NotNil(t, CategoryPurpose1Choice{}.Validate())
+	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
 	assert.NotNil(t, ClearingSystemMemberIdentification2{}.Validate())
 	assert.Nil(t, BranchData3{}.Validate())
-	assert.Nil(t, CashAccount38{}.Validate())
-	assert.Nil(t, CashAccountType2Choice{}.Validate())
-	assert.Nil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
+	assert.NotNil(t, CashAccount38{}.Validate())
+	assert.NotNil(t, CashAccountType2Choice{}.Validate())
+	assert.NotNil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, Charges7{}.Validate())
-	assert.Nil(t, ClearingSystemIdentification2Choice{}.Validate())
+	assert.NotNil(t, ClearingSystemIdentification2Choice{}.Validate())
 	assert.Nil(t, Contact4{}.Validate())
 	assert.NotNil(t, GenericAccountIdentification1{}.Validate())
 	assert.NotNil(t, GenericFinancialIdentification1{}.Validate())
 	assert.NotNil(t, GenericOrganisationIdentification1{}.Validate())
 	assert.NotNil(t, GenericPersonIdentification1{}.Validate())
-	assert.Nil(t, LocalInstrument2Choice{}.Validate())
+	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
 	assert.Nil(t, CreditorReferenceInformation2{}.Validate())
-	assert.Nil(t, CreditorReferenceType1Choice{}.Validate())
-	assert.Nil(t, CreditorReferenceType2{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, CreditorReferenceType1Choice{}.Validate())
+	assert.NotNil(t, CreditorReferenceType2{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth1{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
 	assert.NotNil(t, DiscountAmountAndType1{}.Validate())
-	assert.Nil(t, DiscountAmountType1Choice{}.Validate())
+	assert.NotNil(t, DiscountAmountType1Choice{}.Validate())
 	assert.NotNil(t, Document12{}.Validate())
-	assert.Nil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
+	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, DocumentAdjustment1{}.Validate())
-	assert.Nil(t, DocumentFormat1Choice{}.Validate())
+	assert.NotNil(t, DocumentFormat1Choice{}.Validate())
 	assert.Nil(t, DocumentLineIdentification1{}.Validate())
 	assert.Nil(t, DocumentLineInformation1{}.Validate())
-	assert.Nil(t, DocumentLineType1{}.Validate())
-	assert.Nil(t, DocumentLineType1Choice{}.Validate())
-	assert.Nil(t, DocumentType1Choice{}.Validate())
+	assert.NotNil(t, DocumentLineType1{}.Validate())
+	assert.NotNil(t, DocumentLineType1Choice{}.Validate())
+	assert.NotNil(t, DocumentType1Choice{}.Validate())
 	assert.NotNil(t, EquivalentAmount2{}.Validate())
-	assert.Nil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
+	assert.NotNil(t, FinancialIdentificationSchemeName1Choice{}.Validate())
 	assert.Nil(t, FinancialInstitutionIdentification18{}.Validate())
-	assert.Nil(t, Garnishment3{}.Validate())
-	assert.Nil(t, GarnishmentType1{}.Validate())
-	assert.Nil(t, GarnishmentType1Choice{}.Validate())
+	assert.NotNil(t, Garnishment3{}.Validate())
+	assert.NotNil(t, GarnishmentType1{}.Validate())
+	assert.NotNil(t, GarnishmentType1Choice{}.Validate())
 	assert.NotNil(t, GenericAccountIdentification1{}.Validate())
 	assert.NotNil(t, GenericFinancialIdentification1{}.Validate())
 	assert.NotNil(t, GenericIdentification1{}.Validate())
@@ -65,15 +66,15 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, GenericOrganisationIdentification1{}.Validate())
 	assert.NotNil(t, GenericPersonIdentification1{}.Validate())
 	assert.NotNil(t, GroupHeader87{}.Validate())
-	assert.Nil(t, LocalInstrument2Choice{}.Validate())
+	assert.NotNil(t, LocalInstrument2Choice{}.Validate())
 	assert.NotNil(t, NumberOfTransactionsPerStatus5{}.Validate())
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
-	assert.Nil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
+	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OriginalGroupInformation30{}.Validate())
 	assert.NotNil(t, OriginalPaymentInstruction31{}.Validate())
 	assert.Nil(t, OriginalTransactionReference29{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyAndSignature3{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PaymentCondition1{}.Validate())
@@ -81,26 +82,26 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, PaymentTransaction104{}.Validate())
 	assert.Nil(t, PaymentTypeInformation26{}.Validate())
 	assert.Nil(t, PersonIdentification13{}.Validate())
-	assert.Nil(t, PersonIdentificationSchemeName1Choice{}.Validate())
+	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
 	assert.Nil(t, PostalAddress24{}.Validate())
 	assert.NotNil(t, ProxyAccountIdentification1{}.Validate())
-	assert.Nil(t, ProxyAccountType1Choice{}.Validate())
+	assert.NotNil(t, ProxyAccountType1Choice{}.Validate())
 	assert.Nil(t, ReferredDocumentInformation7{}.Validate())
-	assert.Nil(t, ReferredDocumentType3Choice{}.Validate())
-	assert.Nil(t, ReferredDocumentType4{}.Validate())
+	assert.NotNil(t, ReferredDocumentType3Choice{}.Validate())
+	assert.NotNil(t, ReferredDocumentType4{}.Validate())
 	assert.Nil(t, RemittanceAmount2{}.Validate())
 	assert.Nil(t, RemittanceAmount3{}.Validate())
 	assert.Nil(t, RemittanceInformation16{}.Validate())
-	assert.Nil(t, ServiceLevel8Choice{}.Validate())
+	assert.NotNil(t, ServiceLevel8Choice{}.Validate())
 	assert.Nil(t, SkipPayload{}.Validate())
-	assert.Nil(t, StatusReason6Choice{}.Validate())
+	assert.NotNil(t, StatusReason6Choice{}.Validate())
 	assert.Nil(t, StatusReasonInformation12{}.Validate())
 	assert.Nil(t, StructuredRemittanceInformation16{}.Validate())
 	assert.Nil(t, SupplementaryData1{}.Validate())
 	assert.Nil(t, SupplementaryDataEnvelope1{}.Validate())
 	assert.Nil(t, TaxAmount2{}.Validate())
 	assert.NotNil(t, TaxAmountAndType1{}.Validate())
-	assert.Nil(t, TaxAmountType1Choice{}.Validate())
+	assert.NotNil(t, TaxAmountType1Choice{}.Validate())
 	assert.Nil(t, TaxAuthorisation1{}.Validate())
 	assert.Nil(t, TaxInformation7{}.Validate())
 	assert.Nil(t, TaxParty1{}.Validate())
@@ -109,7 +110,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, TaxRecord2{}.Validate())
 	assert.NotNil(t, TaxRecordDetails2{}.Validate())
 	assert.Nil(t, Cheque11{}.Validate())
-	assert.Nil(t, ChequeDeliveryMethod1Choice{}.Validate())
+	assert.NotNil(t, ChequeDeliveryMethod1Choice{}.Validate())
 	assert.NotNil(t, CreditTransferTransaction35{}.Validate())
 	assert.NotNil(t, CreditorPaymentActivationRequestV07{}.Validate())
 	assert.NotNil(t, GroupHeader78{}.Validate())
@@ -117,7 +118,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, NameAndAddress16{}.Validate())
 	assert.NotNil(t, PaymentIdentification6{}.Validate())
 	assert.NotNil(t, PaymentInstruction31{}.Validate())
-	assert.Nil(t, Purpose2Choice{}.Validate())
+	assert.NotNil(t, Purpose2Choice{}.Validate())
 	assert.Nil(t, RegulatoryAuthority2{}.Validate())
 	assert.Nil(t, RegulatoryReporting3{}.Validate())
 	assert.Nil(t, RemittanceLocation7{}.Validate())
@@ -129,22 +130,24 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type10 ExternalFinancialInstitutionIdentification1Code
@@ -155,21 +158,25 @@ func TestTypes(t *testing.T) {
 	var type12 ExternalLocalInstrument1Code
 	assert.NotNil(t, type12.Validate())
 	type12 = "test"
+	assert.NotNil(t, type12.Validate())
+	type12 = "INST"
 	assert.Nil(t, type12.Validate())
 
 	var type14 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "BANK"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalPersonIdentification1Code
 	assert.NotNil(t, type15.Validate())
-	type15 = "test"
+	type15 = "ARNU"
 	assert.Nil(t, type15.Validate())
 
 	var type18 ExternalServiceLevel1Code
 	assert.NotNil(t, type18.Validate())
 	type18 = "test"
+	assert.NotNil(t, type18.Validate())
+	type18 = "SEPA"
 	assert.Nil(t, type18.Validate())
 
 	var type29 DocumentType6Code
@@ -231,7 +238,7 @@ func TestTypes(t *testing.T) {
 
 	var type43 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type43.Validate())
-	type43 = "CRED"
+	type43 = "APDS"
 	assert.Nil(t, type43.Validate())
 
 	var type44 ExternalDocumentLineType1Code
@@ -241,22 +248,26 @@ func TestTypes(t *testing.T) {
 
 	var type45 ExternalGarnishmentType1Code
 	assert.NotNil(t, type45.Validate())
-	type45 = "CRED"
+	type45 = "GNCS"
 	assert.Nil(t, type45.Validate())
 
 	var type46 ExternalPurpose1Code
 	assert.NotNil(t, type46.Validate())
 	type46 = "CRED"
+	assert.NotNil(t, type46.Validate())
+	type46 = "GDDS"
 	assert.Nil(t, type46.Validate())
 
 	var type47 ExternalTaxAmountType1Code
 	assert.NotNil(t, type47.Validate())
-	type47 = "CRED"
+	type47 = "CITY"
 	assert.Nil(t, type47.Validate())
 
 	var type48 ExternalStatusReason1Code
 	assert.NotNil(t, type48.Validate())
 	type48 = "CRED"
+	assert.NotNil(t, type48.Validate())
+	type48 = "AC01"
 	assert.Nil(t, type48.Validate())
 
 	var type49 PaymentMethod4Code
@@ -264,3 +275,16 @@ func TestTypes(t *testing.T) {
 	type49 = "CHK"
 	assert.Nil(t, type49.Validate())
 }
+
+func TestAmountOrRateChoice(t *testing.T) {
+	rate := 0.0
+	assert.Nil(t, AmountOrRate1Choice{Rate: &rate}.Validate())
+
+	buf, err := xml.Marshal(&AmountOrRate1Choice{Rate: &rate})
+	assert.Nil(t, err)
+	assert.Equal(t, "<AmountOrRate1Choice><Rate>0</Rate></AmountOrRate1Choice>", string(buf))
+
+	var choice AmountOrRate1Choice
+	assert.Nil(t, xml.Unmarshal(buf, &choice))
+	assert.Nil(t, choice.Validate())
+}
@@ -5,39 +5,34 @@
 package pain_v07
 
 import (
+	"github.com/moov-io/iso20022/pkg/codes"
+	"github.com/moov-io/iso20022/pkg/common"
 	"github.com/moov-io/iso20022/pkg/utils"
 	"reflect"
 	"regexp"
 )
 
-// Must match the pattern [A-Z]{3,3}
+// Must match the pattern [A-Z]{3,3} and be an active ISO 4217 code
 type ActiveCurrencyCode string
 
 func (r ActiveCurrencyCode) Validate() error {
-	reg := regexp.MustCompile(`[A-Z]{3,3}`)
-	if !reg.MatchString(string(r)) {
-		return utils.NewErrValueInvalid("ActiveCurrencyCode")
-	}
-	return nil
+	return common.ActiveCurrencyCode(r).Validate()
 }
 
-// Must match the pattern [A-Z]{3,3}
+// Must match the pattern [A-Z]{3,3} and be an active or historic ISO 4217 code
 type ActiveOrHistoricCurrencyCode string
 
 func (r ActiveOrHistoricCurrencyCode) Validate() error {
-	reg := regexp.MustCompile(`[A-Z]{3,3}`)
-	if !reg.MatchString(string(r)) {
-		return utils.NewErrValueInvalid("ActiveOrHistoricCurrencyCode")
-	}
-	return nil
+	return common.ActiveOrHistoricCurrencyCode(r).Validate()
 }
 
 // Must match the pattern [a-zA-Z0-9]{4}
 type Exact4AlphaNumericText string
 
+var exact4AlphaNumericTextRegexp = regexp.MustCompile(`^[a-zA-Z0-9]{4}$`)
+
 func (r Exact4AlphaNumericText) Validate() error {
-	reg := regexp.MustCompile(`[a-zA-Z0-9]{4}`)
-	if !reg.MatchString(string(r)) {
+	if !exact4AlphaNumericTextRegexp.MatchString(string(r)) {
 		return utils.NewErrValueInvalid("Exact4AlphaNumericText")
 	}
 	return nil
@@ -50,7 +45,7 @@ func (r ExternalAccountIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalAccountIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalAccountIdentification1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -60,7 +55,7 @@ func (r ExternalCashAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCashAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCashAccountType1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -70,7 +65,7 @@ func (r ExternalCategoryPurpose1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCategoryPurpose1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCategoryPurpose1Code", string(r))
 }
 
 // May be no more than 5 items long
@@ -80,7 +75,7 @@ func (r ExternalClearingSystemIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 5 {
 		return utils.NewErrTextLengthInvalid("ExternalClearingSystemIdentification1Code", 1, 5)
 	}
-	return nil
+	return codes.Validate("ExternalClearingSystemIdentification1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -90,7 +85,7 @@ func (r ExternalDiscountAmountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDiscountAmountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDiscountAmountType1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -100,7 +95,7 @@ func (r ExternalDocumentFormat1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDocumentFormat1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDocumentFormat1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -110,7 +105,7 @@ func (r ExternalDocumentLineType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDocumentLineType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDocumentLineType1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -120,7 +115,7 @@ func (r ExternalDocumentType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDocumentType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDocumentType1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -130,7 +125,7 @@ func (r ExternalFinancialInstitutionIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalFinancialInstitutionIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalFinancialInstitutionIdentification1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -140,7 +135,7 @@ func (r ExternalGarnishmentType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalGarnishmentType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalGarnishmentType1Code", string(r))
 }
 
 // May be no more than 35 items long
@@ -150,7 +145,7 @@ func (r ExternalLocalInstrument1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 35 {
 		return utils.NewErrTextLengthInvalid("ExternalLocalInstrument1Code", 1, 35)
 	}
-	return nil
+	return codes.Validate("ExternalLocalInstrument1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -160,7 +155,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -170,7 +165,7 @@ func (r ExternalPaymentGroupStatus1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPaymentGroupStatus1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPaymentGroupStatus1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -180,7 +175,7 @@ func (r ExternalPaymentTransactionStatus1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPaymentTransactionStatus1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPaymentTransactionStatus1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -190,7 +185,7 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -200,7 +195,7 @@ func (r ExternalProxyAccountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalProxyAccountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalProxyAccountType1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -210,7 +205,7 @@ func (r ExternalServiceLevel1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalServiceLevel1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalServiceLevel1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -220,7 +215,7 @@ func (r ExternalStatusReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalStatusReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalStatusReason1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -230,7 +225,7 @@ func (r ExternalTaxAmountType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalTaxAmountType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalTaxAmountType1Code", string(r))
 }
 
 // May be no more than 4 items long
@@ -240,7 +235,7 @@ func (r ExternalPurpose1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPurpose1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPurpose1Code", string(r))
 }
 
 // May be one of CHK, TRF, DD, TRA
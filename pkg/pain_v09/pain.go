@@ -12,26 +12,26 @@ import (
 )
 
 type AccountIdentification4Choice struct {
-	IBAN common.IBAN2007Identifier     `xml:"IBAN"`
-	Othr GenericAccountIdentification1 `xml:"Othr"`
+	IBAN *common.IBAN2007Identifier     `xml:"IBAN,omitempty" json:",omitempty"`
+	Othr *GenericAccountIdentification1 `xml:"Othr,omitempty" json:",omitempty"`
 }
 
 func (r AccountIdentification4Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AccountSchemeName1Choice struct {
-	Cd    ExternalAccountIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                   `xml:"Prtry"`
+	Cd    *ExternalAccountIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                   `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AccountSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
-	Value float64                             `xml:",chardata"`
-	Ccy   common.ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
+	Value common.ActiveOrHistoricCurrencyAndAmountSimpleType `xml:",chardata"`
+	Ccy   common.ActiveOrHistoricCurrencyCode                `xml:"Ccy,attr"`
 }
 
 func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
@@ -39,12 +39,12 @@ func (r ActiveOrHistoricCurrencyAndAmount) Validate() error {
 }
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AdviceType1 struct {
@@ -57,12 +57,12 @@ func (r AdviceType1) Validate() error {
 }
 
 type AdviceType1Choice struct {
-	Cd    AdviceType1Code  `xml:"Cd"`
-	Prtry common.Max35Text `xml:"Prtry"`
+	Cd    *AdviceType1Code  `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AdviceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type AmendmentInformationDetails13 struct {
@@ -85,12 +85,12 @@ func (r AmendmentInformationDetails13) Validate() error {
 }
 
 type Authorisation1Choice struct {
-	Cd    common.Authorisation1Code `xml:"Cd"`
-	Prtry common.Max128Text         `xml:"Prtry"`
+	Cd    *common.Authorisation1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max128Text         `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Authorisation1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -126,30 +126,30 @@ func (r CashAccount38) Validate() error {
 }
 
 type CashAccountType2Choice struct {
-	Cd    ExternalCashAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCashAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CashAccountType2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CategoryPurpose1Choice struct {
-	Cd    ExternalCategoryPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalCategoryPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CategoryPurpose1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemIdentification2Choice struct {
-	Cd    ExternalClearingSystemIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalClearingSystemIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ClearingSystemIdentification2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -190,12 +190,12 @@ func (r CreditorReferenceInformation2) Validate() error {
 }
 
 type CreditorReferenceType1Choice struct {
-	Cd    DocumentType3Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType3Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorReferenceType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorReferenceType2 struct {
@@ -284,12 +284,12 @@ func (r DiscountAmountAndType1) Validate() error {
 }
 
 type DiscountAmountType1Choice struct {
-	Cd    ExternalDiscountAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text                `xml:"Prtry"`
+	Cd    *ExternalDiscountAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DiscountAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentAdjustment1 struct {
@@ -333,21 +333,21 @@ func (r DocumentLineType1) Validate() error {
 }
 
 type DocumentLineType1Choice struct {
-	Cd    ExternalDocumentLineType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalDocumentLineType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DocumentLineType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
-	Cd    ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalFinancialInstitutionIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r FinancialIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -364,13 +364,13 @@ func (r FinancialInstitutionIdentification18) Validate() error {
 }
 
 type Frequency36Choice struct {
-	Tp     Frequency6Code      `xml:"Tp"`
-	Prd    FrequencyPeriod1    `xml:"Prd"`
-	PtInTm FrequencyAndMoment1 `xml:"PtInTm"`
+	Tp     *Frequency6Code      `xml:"Tp,omitempty" json:",omitempty"`
+	Prd    *FrequencyPeriod1    `xml:"Prd,omitempty" json:",omitempty"`
+	PtInTm *FrequencyAndMoment1 `xml:"PtInTm,omitempty" json:",omitempty"`
 }
 
 func (r Frequency36Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type FrequencyAndMoment1 struct {
@@ -416,12 +416,12 @@ func (r GarnishmentType1) Validate() error {
 }
 
 type GarnishmentType1Choice struct {
-	Cd    ExternalGarnishmentType1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalGarnishmentType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r GarnishmentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type GenericAccountIdentification1 struct {
@@ -479,7 +479,7 @@ type GroupHeader83 struct {
 	CreDtTm  common.ISODateTime                            `xml:"CreDtTm"`
 	Authstn  []Authorisation1Choice                        `xml:"Authstn,omitempty" json:",omitempty"`
 	NbOfTxs  common.Max15NumericText                       `xml:"NbOfTxs"`
-	CtrlSum  float64                                       `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum  *common.DecimalNumber                         `xml:"CtrlSum,omitempty" json:",omitempty"`
 	InitgPty PartyIdentification135                        `xml:"InitgPty"`
 	FwdgAgt  *BranchAndFinancialInstitutionIdentification6 `xml:"FwdgAgt,omitempty" json:",omitempty"`
 }
@@ -489,12 +489,12 @@ func (r GroupHeader83) Validate() error {
 }
 
 type LocalInstrument2Choice struct {
-	Cd    ExternalLocalInstrument1Code `xml:"Cd"`
-	Prtry common.Max35Text             `xml:"Prtry"`
+	Cd    *ExternalLocalInstrument1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r LocalInstrument2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type MandateRelatedInformation14 struct {
@@ -515,12 +515,12 @@ func (r MandateRelatedInformation14) Validate() error {
 }
 
 type MandateSetupReason1Choice struct {
-	Cd    ExternalMandateSetupReason1Code `xml:"Cd"`
-	Prtry common.Max70Text                `xml:"Prtry"`
+	Cd    *ExternalMandateSetupReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max70Text                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r MandateSetupReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type NameAndAddress16 struct {
@@ -543,12 +543,12 @@ func (r OrganisationIdentification29) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OtherContact1 struct {
@@ -561,12 +561,12 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party38Choice struct {
-	OrgId  OrganisationIdentification29 `xml:"OrgId"`
-	PrvtId PersonIdentification13       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification29 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification13       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party38Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PartyIdentification135 struct {
@@ -597,7 +597,7 @@ type PaymentInstruction37 struct {
 	ReqdAdvcTp   *AdviceType1                                  `xml:"ReqdAdvcTp,omitempty" json:",omitempty"`
 	BtchBookg    bool                                          `xml:"BtchBookg,omitempty" json:",omitempty"`
 	NbOfTxs      *common.Max15NumericText                      `xml:"NbOfTxs,omitempty" json:",omitempty"`
-	CtrlSum      float64                                       `xml:"CtrlSum,omitempty" json:",omitempty"`
+	CtrlSum      *common.DecimalNumber                         `xml:"CtrlSum,omitempty" json:",omitempty"`
 	PmtTpInf     *PaymentTypeInformation29                     `xml:"PmtTpInf,omitempty" json:",omitempty"`
 	ReqdColltnDt common.ISODate                                `xml:"ReqdColltnDt"`
 	Cdtr         PartyIdentification135                        `xml:"Cdtr"`
@@ -638,12 +638,12 @@ func (r PersonIdentification13) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PostalAddress24 struct {
@@ -679,21 +679,21 @@ func (r ProxyAccountIdentification1) Validate() error {
 }
 
 type ProxyAccountType1Choice struct {
-	Cd    ExternalProxyAccountType1Code `xml:"Cd"`
-	Prtry common.Max35Text              `xml:"Prtry"`
+	Cd    *ExternalProxyAccountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ProxyAccountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Purpose2Choice struct {
-	Cd    ExternalPurpose1Code `xml:"Cd"`
-	Prtry common.Max35Text     `xml:"Prtry"`
+	Cd    *ExternalPurpose1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text     `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r Purpose2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentInformation7 struct {
@@ -708,12 +708,12 @@ func (r ReferredDocumentInformation7) Validate() error {
 }
 
 type ReferredDocumentType3Choice struct {
-	Cd    DocumentType6Code `xml:"Cd"`
-	Prtry common.Max35Text  `xml:"Prtry"`
+	Cd    *DocumentType6Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ReferredDocumentType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ReferredDocumentType4 struct {
@@ -799,12 +799,12 @@ func (r RemittanceLocationData1) Validate() error {
 }
 
 type ServiceLevel8Choice struct {
-	Cd    ExternalServiceLevel1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ExternalServiceLevel1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ServiceLevel8Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type StructuredRegulatoryReporting3 struct {
@@ -873,12 +873,12 @@ func (r TaxAmountAndType1) Validate() error {
 }
 
 type TaxAmountType1Choice struct {
-	Cd    ExternalTaxAmountType1Code `xml:"Cd"`
-	Prtry common.Max35Text           `xml:"Prtry"`
+	Cd    *ExternalTaxAmountType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text           `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r TaxAmountType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type TaxAuthorisation1 struct {
@@ -980,3 +980,172 @@ type TaxRecordDetails2 struct {
 func (r TaxRecordDetails2) Validate() error {
 	return utils.Validate(&r)
 }
+
+type AmountType4Choice struct {
+	InstdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt,omitempty" json:",omitempty"`
+	EqvtAmt  *EquivalentAmount2                 `xml:"EqvtAmt,omitempty" json:",omitempty"`
+}
+
+func (r AmountType4Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type Cheque11 struct {
+	ChqTp       *ChequeType2Code             `xml:"ChqTp,omitempty" json:",omitempty"`
+	ChqNb       *common.Max35Text            `xml:"ChqNb,omitempty" json:",omitempty"`
+	ChqFr       *NameAndAddress16            `xml:"ChqFr,omitempty" json:",omitempty"`
+	DlvryMtd    *ChequeDeliveryMethod1Choice `xml:"DlvryMtd,omitempty" json:",omitempty"`
+	DlvrTo      *NameAndAddress16            `xml:"DlvrTo,omitempty" json:",omitempty"`
+	InstrPrty   *Priority2Code               `xml:"InstrPrty,omitempty" json:",omitempty"`
+	ChqMtrtyDt  *common.ISODate              `xml:"ChqMtrtyDt,omitempty" json:",omitempty"`
+	FrmsCd      *common.Max35Text            `xml:"FrmsCd,omitempty" json:",omitempty"`
+	MemoFld     []common.Max35Text           `xml:"MemoFld,omitempty" json:",omitempty"`
+	RgnlClrZone *common.Max35Text            `xml:"RgnlClrZone,omitempty" json:",omitempty"`
+	PrtLctn     *common.Max35Text            `xml:"PrtLctn,omitempty" json:",omitempty"`
+	Sgntr       []common.Max70Text           `xml:"Sgntr,omitempty" json:",omitempty"`
+}
+
+func (r Cheque11) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ChequeDeliveryMethod1Choice struct {
+	Cd    *ChequeDelivery1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text    `xml:"Prtry,omitempty" json:",omitempty"`
+}
+
+func (r ChequeDeliveryMethod1Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type CreditTransferTransaction34 struct {
+	PmtId           PaymentIdentification6                        `xml:"PmtId"`
+	PmtTpInf        *PaymentTypeInformation26                     `xml:"PmtTpInf,omitempty" json:",omitempty"`
+	Amt             AmountType4Choice                             `xml:"Amt"`
+	XchgRateInf     *ExchangeRate1                                `xml:"XchgRateInf,omitempty" json:",omitempty"`
+	ChrgBr          *ChargeBearerType1Code                        `xml:"ChrgBr,omitempty" json:",omitempty"`
+	ChqInstr        *Cheque11                                     `xml:"ChqInstr,omitempty" json:",omitempty"`
+	UltmtDbtr       *PartyIdentification135                       `xml:"UltmtDbtr,omitempty" json:",omitempty"`
+	IntrmyAgt1      *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt1,omitempty" json:",omitempty"`
+	IntrmyAgt1Acct  *CashAccount38                                `xml:"IntrmyAgt1Acct,omitempty" json:",omitempty"`
+	IntrmyAgt2      *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt2,omitempty" json:",omitempty"`
+	IntrmyAgt2Acct  *CashAccount38                                `xml:"IntrmyAgt2Acct,omitempty" json:",omitempty"`
+	IntrmyAgt3      *BranchAndFinancialInstitutionIdentification6 `xml:"IntrmyAgt3,omitempty" json:",omitempty"`
+	IntrmyAgt3Acct  *CashAccount38                                `xml:"IntrmyAgt3Acct,omitempty" json:",omitempty"`
+	CdtrAgt         *BranchAndFinancialInstitutionIdentification6 `xml:"CdtrAgt,omitempty" json:",omitempty"`
+	CdtrAgtAcct     *CashAccount38                                `xml:"CdtrAgtAcct,omitempty" json:",omitempty"`
+	Cdtr            *PartyIdentification135                       `xml:"Cdtr,omitempty" json:",omitempty"`
+	CdtrAcct        *CashAccount38                                `xml:"CdtrAcct,omitempty" json:",omitempty"`
+	UltmtCdtr       *PartyIdentification135                       `xml:"UltmtCdtr,omitempty" json:",omitempty"`
+	InstrForCdtrAgt []InstructionForCreditorAgent1                `xml:"InstrForCdtrAgt,omitempty" json:",omitempty"`
+	InstrForDbtrAgt *common.Max140Text                            `xml:"InstrForDbtrAgt,omitempty" json:",omitempty"`
+	Purp            *Purpose2Choice                               `xml:"Purp,omitempty" json:",omitempty"`
+	RgltryRptg      []RegulatoryReporting3                        `xml:"RgltryRptg,omitempty" json:",omitempty"`
+	Tax             *TaxInformation8                              `xml:"Tax,omitempty" json:",omitempty"`
+	RltdRmtInf      []RemittanceLocation7                         `xml:"RltdRmtInf,omitempty" json:",omitempty"`
+	RmtInf          *RemittanceInformation16                      `xml:"RmtInf,omitempty" json:",omitempty"`
+	SplmtryData     []SupplementaryData1                          `xml:"SplmtryData,omitempty" json:",omitempty"`
+}
+
+func (r CreditTransferTransaction34) Validate() error {
+	return utils.Validate(&r)
+}
+
+type CustomerCreditTransferInitiationV09 struct {
+	XMLName     xml.Name               `xml:"CstmrCdtTrfInitn"`
+	GrpHdr      GroupHeader85          `xml:"GrpHdr"`
+	PmtInf      []PaymentInstruction30 `xml:"PmtInf" json:",omitempty"`
+	SplmtryData []SupplementaryData1   `xml:"SplmtryData,omitempty" json:",omitempty"`
+}
+
+func (r CustomerCreditTransferInitiationV09) Validate() error {
+	return utils.Validate(&r)
+}
+
+type DateAndDateTime2Choice struct {
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
+}
+
+func (r DateAndDateTime2Choice) Validate() error {
+	return utils.ValidateChoice(&r)
+}
+
+type EquivalentAmount2 struct {
+	Amt      ActiveOrHistoricCurrencyAndAmount   `xml:"Amt"`
+	CcyOfTrf common.ActiveOrHistoricCurrencyCode `xml:"CcyOfTrf"`
+}
+
+func (r EquivalentAmount2) Validate() error {
+	return utils.Validate(&r)
+}
+
+type ExchangeRate1 struct {
+	UnitCcy  *common.ActiveOrHistoricCurrencyCode `xml:"UnitCcy,omitempty" json:",omitempty"`
+	XchgRate float64                              `xml:"XchgRate,omitempty" json:",omitempty"`
+	RateTp   *ExchangeRateType1Code               `xml:"RateTp,omitempty" json:",omitempty"`
+	CtrctId  *common.Max35Text                    `xml:"CtrctId,omitempty" json:",omitempty"`
+}
+
+func (r ExchangeRate1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type GroupHeader85 struct {
+	MsgId    common.Max35Text                              `xml:"MsgId"`
+	CreDtTm  common.ISODateTime                            `xml:"CreDtTm"`
+	Authstn  []Authorisation1Choice                        `xml:"Authstn,omitempty" json:",omitempty"`
+	NbOfTxs  common.Max15NumericText                       `xml:"NbOfTxs"`
+	CtrlSum  *common.DecimalNumber                         `xml:"CtrlSum,omitempty" json:",omitempty"`
+	InitgPty PartyIdentification135                        `xml:"InitgPty"`
+	FwdgAgt  *BranchAndFinancialInstitutionIdentification6 `xml:"FwdgAgt,omitempty" json:",omitempty"`
+}
+
+func (r GroupHeader85) Validate() error {
+	return utils.Validate(&r)
+}
+
+type InstructionForCreditorAgent1 struct {
+	Cd       *Instruction3Code  `xml:"Cd,omitempty" json:",omitempty"`
+	InstrInf *common.Max140Text `xml:"InstrInf,omitempty" json:",omitempty"`
+}
+
+func (r InstructionForCreditorAgent1) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PaymentInstruction30 struct {
+	PmtInfId        common.Max35Text                              `xml:"PmtInfId"`
+	PmtMtd          PaymentMethod3Code                            `xml:"PmtMtd"`
+	BtchBookg       bool                                          `xml:"BtchBookg,omitempty" json:",omitempty"`
+	NbOfTxs         *common.Max15NumericText                      `xml:"NbOfTxs,omitempty" json:",omitempty"`
+	CtrlSum         *common.DecimalNumber                         `xml:"CtrlSum,omitempty" json:",omitempty"`
+	PmtTpInf        *PaymentTypeInformation26                     `xml:"PmtTpInf,omitempty" json:",omitempty"`
+	ReqdExctnDt     DateAndDateTime2Choice                        `xml:"ReqdExctnDt"`
+	PoolgAdjstmntDt *common.ISODate                               `xml:"PoolgAdjstmntDt,omitempty" json:",omitempty"`
+	Dbtr            PartyIdentification135                        `xml:"Dbtr"`
+	DbtrAcct        CashAccount38                                 `xml:"DbtrAcct"`
+	DbtrAgt         BranchAndFinancialInstitutionIdentification6  `xml:"DbtrAgt"`
+	DbtrAgtAcct     *CashAccount38                                `xml:"DbtrAgtAcct,omitempty" json:",omitempty"`
+	InstrForDbtrAgt *common.Max140Text                            `xml:"InstrForDbtrAgt,omitempty" json:",omitempty"`
+	UltmtDbtr       *PartyIdentification135                       `xml:"UltmtDbtr,omitempty" json:",omitempty"`
+	ChrgBr          *ChargeBearerType1Code                        `xml:"ChrgBr,omitempty" json:",omitempty"`
+	ChrgsAcct       *CashAccount38                                `xml:"ChrgsAcct,omitempty" json:",omitempty"`
+	ChrgsAcctAgt    *BranchAndFinancialInstitutionIdentification6 `xml:"ChrgsAcctAgt,omitempty" json:",omitempty"`
+	CdtTrfTxInf     []CreditTransferTransaction34                 `xml:"CdtTrfTxInf" json:",omitempty"`
+}
+
+func (r PaymentInstruction30) Validate() error {
+	return utils.Validate(&r)
+}
+
+type PaymentTypeInformation26 struct {
+	InstrPrty *Priority2Code          `xml:"InstrPrty,omitempty" json:",omitempty"`
+	SvcLvl    []ServiceLevel8Choice   `xml:"SvcLvl,omitempty" json:",omitempty"`
+	LclInstrm *LocalInstrument2Choice `xml:"LclInstrm,omitempty" json:",omitempty"`
+	CtgyPurp  *CategoryPurpose1Choice `xml:"CtgyPurp,omitempty" json:",omitempty"`
+}
+
+func (r PaymentTypeInformation26) Validate() error {
+	return utils.Validate(&r)
+}
@@ -63,7 +63,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.NotNil(t, PaymentIdentification6{}.Validate())
 	assert.NotNil(t, PaymentInstruction37{}.Validate())
@@ -100,32 +100,46 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, TaxPeriod2{}.Validate())
 	assert.Nil(t, TaxRecord2{}.Validate())
 	assert.NotNil(t, TaxRecordDetails2{}.Validate())
+	assert.NotNil(t, AmountType4Choice{}.Validate())
+	assert.Nil(t, Cheque11{}.Validate())
+	assert.NotNil(t, ChequeDeliveryMethod1Choice{}.Validate())
+	assert.NotNil(t, CreditTransferTransaction34{}.Validate())
+	assert.NotNil(t, CustomerCreditTransferInitiationV09{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, EquivalentAmount2{}.Validate())
+	assert.Nil(t, ExchangeRate1{}.Validate())
+	assert.NotNil(t, GroupHeader85{}.Validate())
+	assert.Nil(t, InstructionForCreditorAgent1{}.Validate())
+	assert.NotNil(t, PaymentInstruction30{}.Validate())
+	assert.Nil(t, PaymentTypeInformation26{}.Validate())
 }
 
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type6 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type6.Validate())
-	type6 = "test"
+	type6 = "APDS"
 	assert.Nil(t, type6.Validate())
 
 	var type8 ExternalDocumentLineType1Code
@@ -140,12 +154,14 @@ func TestTypes(t *testing.T) {
 
 	var type11 ExternalGarnishmentType1Code
 	assert.NotNil(t, type11.Validate())
-	type11 = "test"
+	type11 = "GNCS"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalLocalInstrument1Code
 	assert.NotNil(t, type12.Validate())
 	type12 = "test"
+	assert.NotNil(t, type12.Validate())
+	type12 = "INST"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalMandateSetupReason1Code
@@ -155,12 +171,12 @@ func TestTypes(t *testing.T) {
 
 	var type14 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "BANK"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalPersonIdentification1Code
 	assert.NotNil(t, type15.Validate())
-	type15 = "test"
+	type15 = "ARNU"
 	assert.Nil(t, type15.Validate())
 
 	var type16 ExternalProxyAccountType1Code
@@ -171,16 +187,20 @@ func TestTypes(t *testing.T) {
 	var type17 ExternalPurpose1Code
 	assert.NotNil(t, type17.Validate())
 	type17 = "test"
+	assert.NotNil(t, type17.Validate())
+	type17 = "GDDS"
 	assert.Nil(t, type17.Validate())
 
 	var type18 ExternalServiceLevel1Code
 	assert.NotNil(t, type18.Validate())
 	type18 = "test"
+	assert.NotNil(t, type18.Validate())
+	type18 = "SEPA"
 	assert.Nil(t, type18.Validate())
 
 	var type19 ExternalTaxAmountType1Code
 	assert.NotNil(t, type19.Validate())
-	type19 = "test"
+	type19 = "CITY"
 	assert.Nil(t, type19.Validate())
 
 	var type24 AdviceType1Code
@@ -266,4 +286,39 @@ func TestTypes(t *testing.T) {
 	assert.NotNil(t, type37.Validate())
 	type37 = "FRST"
 	assert.Nil(t, type37.Validate())
+
+	var type38 ChequeDelivery1Code
+	assert.NotNil(t, type38.Validate())
+	type38 = "test"
+	assert.NotNil(t, type38.Validate())
+	type38 = "MLDB"
+	assert.Nil(t, type38.Validate())
+
+	var type39 ChequeType2Code
+	assert.NotNil(t, type39.Validate())
+	type39 = "test"
+	assert.NotNil(t, type39.Validate())
+	type39 = "CCHQ"
+	assert.Nil(t, type39.Validate())
+
+	var type40 ExchangeRateType1Code
+	assert.NotNil(t, type40.Validate())
+	type40 = "test"
+	assert.NotNil(t, type40.Validate())
+	type40 = "SPOT"
+	assert.Nil(t, type40.Validate())
+
+	var type41 PaymentMethod3Code
+	assert.NotNil(t, type41.Validate())
+	type41 = "test"
+	assert.NotNil(t, type41.Validate())
+	type41 = "TRF"
+	assert.Nil(t, type41.Validate())
+
+	var type42 Instruction3Code
+	assert.NotNil(t, type42.Validate())
+	type42 = "test"
+	assert.NotNil(t, type42.Validate())
+	type42 = "PHOB"
+	assert.Nil(t, type42.Validate())
 }
@@ -35,7 +35,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, CreditorReferenceType1Choice{}.Validate())
 	assert.NotNil(t, CreditorReferenceType2{}.Validate())
 	assert.NotNil(t, CustomerCreditTransferInitiationV10{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth1{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
 	assert.NotNil(t, DiscountAmountAndType1{}.Validate())
@@ -71,7 +71,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.NotNil(t, PaymentIdentification6{}.Validate())
 	assert.NotNil(t, PaymentInitiationSource1{}.Validate())
@@ -113,12 +113,12 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ClearingSystemIdentification3Choice{}.Validate())
 	assert.NotNil(t, CustomerPaymentReversalV10{}.Validate())
 	assert.NotNil(t, GroupHeader88{}.Validate())
-	assert.Nil(t, MandateRelatedData1Choice{}.Validate())
+	assert.NotNil(t, MandateRelatedData1Choice{}.Validate())
 	assert.Nil(t, MandateRelatedInformation14{}.Validate())
 	assert.NotNil(t, OriginalGroupHeader16{}.Validate())
 	assert.NotNil(t, OriginalPaymentInstruction37{}.Validate())
 	assert.Nil(t, OriginalTransactionReference31{}.Validate())
-	assert.Nil(t, Party40Choice{}.Validate())
+	assert.NotNil(t, Party40Choice{}.Validate())
 	assert.Nil(t, PaymentReversalReason9{}.Validate())
 	assert.Nil(t, PaymentTransaction125{}.Validate())
 	assert.Nil(t, PaymentTypeInformation27{}.Validate())
@@ -129,22 +129,24 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalCreditorAgentInstruction1Code
@@ -154,7 +156,7 @@ func TestTypes(t *testing.T) {
 
 	var type6 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type6.Validate())
-	type6 = "test"
+	type6 = "APDS"
 	assert.Nil(t, type6.Validate())
 
 	var type7 ExternalDebtorAgentInstruction1Code
@@ -179,12 +181,14 @@ func TestTypes(t *testing.T) {
 
 	var type11 ExternalGarnishmentType1Code
 	assert.NotNil(t, type11.Validate())
-	type11 = "test"
+	type11 = "GNCS"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalLocalInstrument1Code
 	assert.NotNil(t, type12.Validate())
 	type12 = "test"
+	assert.NotNil(t, type12.Validate())
+	type12 = "INST"
 	assert.Nil(t, type12.Validate())
 
 	var type13 ExternalMandateSetupReason1Code
@@ -194,12 +198,12 @@ func TestTypes(t *testing.T) {
 
 	var type14 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "BANK"
 	assert.Nil(t, type14.Validate())
 
 	var type15 ExternalPersonIdentification1Code
 	assert.NotNil(t, type15.Validate())
-	type15 = "test"
+	type15 = "ARNU"
 	assert.Nil(t, type15.Validate())
 
 	var type16 ExternalProxyAccountType1Code
@@ -210,16 +214,20 @@ func TestTypes(t *testing.T) {
 	var type17 ExternalPurpose1Code
 	assert.NotNil(t, type17.Validate())
 	type17 = "test"
+	assert.NotNil(t, type17.Validate())
+	type17 = "GDDS"
 	assert.Nil(t, type17.Validate())
 
 	var type18 ExternalServiceLevel1Code
 	assert.NotNil(t, type18.Validate())
 	type18 = "test"
+	assert.NotNil(t, type18.Validate())
+	type18 = "SEPA"
 	assert.Nil(t, type18.Validate())
 
 	var type19 ExternalTaxAmountType1Code
 	assert.NotNil(t, type19.Validate())
-	type19 = "test"
+	type19 = "CITY"
 	assert.Nil(t, type19.Validate())
 
 	var type21 ExternalReversalReason1Code
@@ -0,0 +1,279 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package reconcile
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Instruction is a payment sent to the bank
+type Instruction struct {
+	MessageId            string
+	PaymentInformationId string `json:",omitempty"`
+	InstructionId        string `json:",omitempty"`
+	EndToEndId           string `json:",omitempty"`
+	TransactionId        string `json:",omitempty"`
+	UETR                 string `json:",omitempty"`
+	// AccountServicerReference is the reference given by the bank, e.g. in the pain.002 accepting the payment.
+	// Instructions read from a pain.001 or a pacs.008 don't know it.
+	AccountServicerReference string `json:",omitempty"`
+	Amount                   common.Decimal
+	Currency                 string
+	// Date is the requested execution date or the interbank settlement date (YYYY-MM-DD)
+	Date       string `json:",omitempty"`
+	Debtor     string `json:",omitempty"`
+	Creditor   string `json:",omitempty"`
+	Remittance string `json:",omitempty"`
+}
+
+// Entry is an entry of a statement, an account report or a notification
+type Entry struct {
+	MessageId string
+	// StatementId is the id of the statement, the report or the notification holding the entry
+	StatementId string
+	// Index is the position of the entry in its statement, counted from 0
+	Index                    int
+	Account                  string `json:",omitempty"`
+	Reference                string `json:",omitempty"`
+	AccountServicerReference string `json:",omitempty"`
+	Amount                   common.Decimal
+	Currency                 string
+	// CreditDebit is CRDT or DBIT
+	CreditDebit string
+	Reversal    bool   `json:",omitempty"`
+	Status      string `json:",omitempty"`
+	BookingDate string `json:",omitempty"`
+	ValueDate   string `json:",omitempty"`
+	// BankTransactionCode is the domain, family and sub family of the entry, e.g. PMNT/ICDT/RRTN for a returned
+	// credit transfer
+	BankTransactionCode string `json:",omitempty"`
+	Information         string `json:",omitempty"`
+	// Batches are the batches booked by the entry
+	Batches      []EntryBatch       `json:",omitempty"`
+	Transactions []EntryTransaction `json:",omitempty"`
+}
+
+// EntryBatch is the batch of transactions booked by an entry
+type EntryBatch struct {
+	MessageId            string `json:",omitempty"`
+	PaymentInformationId string `json:",omitempty"`
+	// NumberOfTransactions is 0 when the batch doesn't give it
+	NumberOfTransactions int `json:",omitempty"`
+	// Amount is the total amount of the batch, the currency is empty when the batch doesn't give it
+	Amount   common.Decimal
+	Currency string `json:",omitempty"`
+}
+
+// EntryTransaction is a transaction detailed by an entry, the currency is empty when it doesn't give its amount
+type EntryTransaction struct {
+	MessageId                string `json:",omitempty"`
+	PaymentInformationId     string `json:",omitempty"`
+	InstructionId            string `json:",omitempty"`
+	EndToEndId               string `json:",omitempty"`
+	TransactionId            string `json:",omitempty"`
+	UETR                     string `json:",omitempty"`
+	AccountServicerReference string `json:",omitempty"`
+	Amount                   common.Decimal
+	Currency                 string `json:",omitempty"`
+	// Counterparty is the creditor of a debit and the debtor of a credit
+	Counterparty string `json:",omitempty"`
+	Remittance   string `json:",omitempty"`
+	// ReturnReason is the reason code of a returned transaction
+	ReturnReason string `json:",omitempty"`
+}
+
+// Instructions returns the transactions of a pain.001 or a pacs.008 of any supported version
+func Instructions(doc document.Iso20022Document) ([]Instruction, error) {
+	summary := document.Summarize(doc)
+	if !strings.HasPrefix(summary.MessageType, "pain.001.") && !strings.HasPrefix(summary.MessageType, "pacs.008.") {
+		return nil, utils.NewErrUnsupportedReconciliationMessage(summary.MessageType)
+	}
+	instructions := []Instruction{}
+	for _, txn := range summary.Transactions {
+		instructions = append(instructions, Instruction{
+			MessageId:            summary.MessageId,
+			PaymentInformationId: txn.PaymentInformationId,
+			InstructionId:        txn.InstructionId,
+			EndToEndId:           txn.EndToEndId,
+			TransactionId:        txn.TransactionId,
+			UETR:                 txn.UETR,
+			Amount:               txn.Amount,
+			Currency:             txn.Currency,
+			Date:                 txn.Date,
+			Debtor:               txn.Debtor,
+			Creditor:             txn.Creditor,
+			Remittance:           txn.Remittance,
+		})
+	}
+	return instructions, nil
+}
+
+// Entries returns the entries of a camt.052.001.08 account report, a camt.053.001.08 statement or
+// a camt.054.001.08 notification
+func Entries(doc document.Iso20022Document) ([]Entry, error) {
+	entries := []Entry{}
+	switch message := doc.InspectMessage().(type) {
+	case *camt_v08.BankToCustomerAccountReportV08:
+		for _, report := range message.Rpt {
+			entries = appendEntries(entries, message.GrpHdr, string(report.Id), report.Acct, report.Ntry)
+		}
+	case *camt_v08.BankToCustomerStatementV08:
+		for _, statement := range message.Stmt {
+			entries = appendEntries(entries, message.GrpHdr, string(statement.Id), statement.Acct, statement.Ntry)
+		}
+	case *camt_v08.BankToCustomerDebitCreditNotificationV08:
+		for _, notification := range message.Ntfctn {
+			account := notification.Acct
+			entries = appendEntries(entries, message.GrpHdr, string(notification.Id), &account, notification.Ntry)
+		}
+	default:
+		return nil, utils.NewErrUnsupportedReconciliationMessage(document.Summarize(doc).MessageType)
+	}
+	return entries, nil
+}
+
+func appendEntries(entries []Entry, header camt_v08.GroupHeader81, statementId string, account *camt_v08.CashAccount39,
+	list []camt_v08.ReportEntry10) []Entry {
+	for i, ntry := range list {
+		entry := Entry{
+			MessageId:                string(header.MsgId),
+			StatementId:              statementId,
+			Index:                    i,
+			Reference:                text(ntry.NtryRef),
+			AccountServicerReference: text(ntry.AcctSvcrRef),
+			Amount:                   common.Decimal(ntry.Amt.Value),
+			Currency:                 string(ntry.Amt.Ccy),
+			CreditDebit:              string(ntry.CdtDbtInd),
+			Reversal:                 ntry.RvslInd,
+			BookingDate:              date(ntry.BookgDt),
+			ValueDate:                date(ntry.ValDt),
+			Information:              text(ntry.AddtlNtryInf),
+		}
+		if account != nil {
+			entry.Account = accountId(account.Id)
+		}
+		if domain := ntry.BkTxCd.Domn; domain != nil {
+			entry.BankTransactionCode = strings.Join([]string{string(domain.Cd), string(domain.Fmly.Cd), string(domain.Fmly.SubFmlyCd)}, "/")
+		}
+		switch {
+		case ntry.Sts.Cd != nil:
+			entry.Status = string(*ntry.Sts.Cd)
+		case ntry.Sts.Prtry != nil:
+			entry.Status = string(*ntry.Sts.Prtry)
+		}
+
+		for _, details := range ntry.NtryDtls {
+			if btch := details.Btch; btch != nil {
+				batch := EntryBatch{MessageId: text(btch.MsgId), PaymentInformationId: text(btch.PmtInfId)}
+				if btch.NbOfTxs != nil {
+					batch.NumberOfTransactions, _ = strconv.Atoi(string(*btch.NbOfTxs))
+				}
+				if btch.TtlAmt != nil {
+					batch.Amount, batch.Currency = common.Decimal(btch.TtlAmt.Value), string(btch.TtlAmt.Ccy)
+				}
+				entry.Batches = append(entry.Batches, batch)
+			}
+			for _, tx := range details.TxDtls {
+				entry.Transactions = append(entry.Transactions, entryTransaction(tx, entry.CreditDebit))
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func entryTransaction(tx camt_v08.EntryTransaction10, creditDebit string) EntryTransaction {
+	var transaction EntryTransaction
+	if refs := tx.Refs; refs != nil {
+		transaction.MessageId = text(refs.MsgId)
+		transaction.PaymentInformationId = text(refs.PmtInfId)
+		transaction.InstructionId = text(refs.InstrId)
+		transaction.EndToEndId = text(refs.EndToEndId)
+		transaction.TransactionId = text(refs.TxId)
+		transaction.AccountServicerReference = text(refs.AcctSvcrRef)
+		if refs.UETR != nil {
+			transaction.UETR = string(*refs.UETR)
+		}
+	}
+	if tx.Amt != nil {
+		transaction.Amount, transaction.Currency = common.Decimal(tx.Amt.Value), string(tx.Amt.Ccy)
+	}
+	if tx.CdtDbtInd != nil {
+		creditDebit = string(*tx.CdtDbtInd)
+	}
+	if rtrInf := tx.RtrInf; rtrInf != nil && rtrInf.Rsn != nil {
+		transaction.ReturnReason = text(rtrInf.Rsn.Cd)
+		if transaction.ReturnReason == "" {
+			transaction.ReturnReason = text(rtrInf.Rsn.Prtry)
+		}
+	}
+	if parties := tx.RltdPties; parties != nil {
+		counterparty := parties.Cdtr
+		if creditDebit == "CRDT" {
+			counterparty = parties.Dbtr
+		}
+		transaction.Counterparty = partyName(counterparty)
+	}
+	if rmtInf := tx.RmtInf; rmtInf != nil {
+		for _, strd := range rmtInf.Strd {
+			if strd.CdtrRefInf != nil && transaction.Remittance == "" {
+				transaction.Remittance = text(strd.CdtrRefInf.Ref)
+			}
+		}
+		if transaction.Remittance == "" && len(rmtInf.Ustrd) > 0 {
+			transaction.Remittance = string(rmtInf.Ustrd[0])
+		}
+	}
+	return transaction
+}
+
+// date returns the date of the choice (YYYY-MM-DD), or an empty string
+func date(choice *camt_v08.DateAndDateTime2Choice) string {
+	switch {
+	case choice == nil:
+		return ""
+	case choice.Dt != nil:
+		return time.Time(*choice.Dt).Format("2006-01-02")
+	case choice.DtTm != nil:
+		return time.Time(*choice.DtTm).Format("2006-01-02")
+	}
+	return ""
+}
+
+func accountId(id camt_v08.AccountIdentification4Choice) string {
+	switch {
+	case id.IBAN != nil:
+		return string(*id.IBAN)
+	case id.Othr != nil:
+		return string(id.Othr.Id)
+	}
+	return ""
+}
+
+func partyName(party *camt_v08.Party40Choice) string {
+	switch {
+	case party == nil:
+		return ""
+	case party.Pty != nil && party.Pty.Nm != nil:
+		return string(*party.Pty.Nm)
+	case party.Agt != nil && party.Agt.FinInstnId.Nm != nil:
+		return string(*party.Agt.FinInstnId.Nm)
+	}
+	return ""
+}
+
+func text[T ~string](value *T) string {
+	if value == nil {
+		return ""
+	}
+	return string(*value)
+}
@@ -0,0 +1,589 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package reconcile matches the payments sent to a bank, read from pain.001 and pacs.008 messages, with the entries
+// of the camt.052, camt.053 and camt.054 messages received from it.
+//
+// An entry is matched transaction by transaction when it details them, or as a whole otherwise. A transaction is
+// first looked up by its references: EndToEndId, AcctSvcrRef and remittance reference. The amount and the value
+// date of a found instruction are then compared, within the tolerances of the Reconciler, and a difference makes
+// the item partially matched. An entry booking a batch of transactions is matched with the instructions of its
+// payment information or message. Entries and instructions left are finally paired by amount and value date only,
+// which is never more than a partial match.
+//
+// Only the booked debit entries which aren't reversals are payments of the instructions. A booked credit entry
+// reversing a debit, or returning a payment, is reported as reversed with the instruction its references point to.
+package reconcile
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Rule is a comparison between an entry and instructions
+type Rule string
+
+const (
+	// EndToEndId compares the end to end ids, NOTPROVIDED is ignored
+	EndToEndId Rule = "EndToEndId"
+	// AccountServicerReference compares the AcctSvcrRef of the entry with the one known by the instruction
+	AccountServicerReference Rule = "AcctSvcrRef"
+	// Remittance compares the remittance references, ignoring case and spaces
+	Remittance Rule = "Remittance"
+	// Amount compares the amounts, which may differ by the tolerance
+	Amount Rule = "Amount"
+	// ValueDate compares the value date of the entry with the date of the instruction, which may differ by
+	// the date tolerance
+	ValueDate Rule = "ValueDate"
+	// Batch matches an entry booking a batch with the instructions of the batch
+	Batch Rule = "Batch"
+)
+
+// Rules are all the rules, applied by default
+var Rules = []Rule{EndToEndId, AccountServicerReference, Remittance, Amount, ValueDate, Batch}
+
+// ParseRule returns the rule of the name, ignoring case
+func ParseRule(name string) (Rule, error) {
+	for _, rule := range Rules {
+		if strings.EqualFold(string(rule), strings.TrimSpace(name)) {
+			return rule, nil
+		}
+	}
+	return "", utils.NewErrUnknownReconciliationRule(name)
+}
+
+// Status is the result of the reconciliation of an item
+type Status string
+
+const (
+	// Matched items agree on a reference and on every compared amount and date
+	Matched Status = "matched"
+	// PartiallyMatched items agree on a reference but differ by amount, date or number of transactions, or only
+	// agree on amount and date
+	PartiallyMatched Status = "partially matched"
+	// Reversed items are reversals or returns of payments, with the instruction they refer to
+	Reversed Status = "reversed"
+	// Unmatched items are entries or instructions without counterpart
+	Unmatched Status = "unmatched"
+)
+
+// DefaultDateTolerance is the number of days the value date of an entry may differ from the date of an instruction
+const DefaultDateTolerance = 1
+
+// Item is an entry, or a transaction of an entry, and the instructions it's matched with
+type Item struct {
+	Status Status
+	// Entry is nil for an unmatched instruction
+	Entry *Entry `json:",omitempty"`
+	// Transaction is the position of the matched transaction in the entry, -1 for the whole entry
+	Transaction int
+	// Instructions are the matched instructions, several ones for a batch
+	Instructions []Instruction `json:",omitempty"`
+	// Rules are the rules the entry and the instructions agree on
+	Rules []Rule `json:",omitempty"`
+	// Mismatches are the rules they disagree on
+	Mismatches []Rule `json:",omitempty"`
+	// Difference is the amount of the entry, or of its transaction, minus the amount of the instructions
+	Difference common.Decimal
+}
+
+// Report holds the items of a reconciliation: the entries in their order, then the unmatched instructions
+type Report struct {
+	Items            []Item
+	Matched          int
+	PartiallyMatched int
+	Reversed         int
+	Unmatched        int
+}
+
+// Reconciler matches instructions with entries. A reconciler is safe for concurrent use.
+type Reconciler struct {
+	rules         map[Rule]bool
+	tolerance     common.Decimal
+	dateTolerance int
+}
+
+// Option changes the settings of a reconciler
+type Option func(*Reconciler)
+
+// WithRules sets the rules applied, all of them by default
+func WithRules(rules ...Rule) Option {
+	return func(r *Reconciler) {
+		r.rules = map[Rule]bool{}
+		for _, rule := range rules {
+			r.rules[rule] = true
+		}
+	}
+}
+
+// WithTolerance sets the difference allowed between the amount of an entry and the amount of its instructions,
+// 0 by default
+func WithTolerance(amount common.Decimal) Option {
+	return func(r *Reconciler) {
+		r.tolerance = amount.Abs()
+	}
+}
+
+// WithDateTolerance sets the number of days the value date of an entry may differ from the date of its
+// instructions, DefaultDateTolerance by default
+func WithDateTolerance(days int) Option {
+	return func(r *Reconciler) {
+		r.dateTolerance = days
+	}
+}
+
+// NewReconciler returns a reconciler applying all the rules without amount tolerance
+func NewReconciler(opts ...Option) *Reconciler {
+	r := &Reconciler{dateTolerance: DefaultDateTolerance}
+	WithRules(Rules...)(r)
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// ReconcileDocuments reconciles the instructions of the pain.001 and pacs.008 documents with the entries of the
+// camt.052, camt.053 and camt.054 documents
+func (r *Reconciler) ReconcileDocuments(docs ...document.Iso20022Document) (Report, error) {
+	var instructions []Instruction
+	var entries []Entry
+	for _, doc := range docs {
+		if list, err := Entries(doc); err == nil {
+			entries = append(entries, list...)
+			continue
+		}
+		list, err := Instructions(doc)
+		if err != nil {
+			return Report{}, err
+		}
+		instructions = append(instructions, list...)
+	}
+	return r.Reconcile(instructions, entries), nil
+}
+
+// posting is an entry, or one of its transactions, to be matched
+type posting struct {
+	entry       int
+	transaction int
+	references  map[Rule]string
+	amount      common.Decimal
+	currency    string
+	date        string
+	item        *Item
+}
+
+// Reconcile matches the instructions with the entries
+func (r *Reconciler) Reconcile(instructions []Instruction, entries []Entry) Report {
+	var postings []*posting
+	for i := range entries {
+		postings = append(postings, entryPostings(entries, i)...)
+	}
+	matched := make([]bool, len(instructions))
+
+	// the references identify the transactions, the instruction also agreeing on amount and date is preferred
+	for _, p := range postings {
+		if !payment(entries[p.entry]) {
+			continue
+		}
+		best, bestRules, bestScore := -1, []Rule(nil), 0
+		for i, instruction := range instructions {
+			if matched[i] {
+				continue
+			}
+			rules := r.referenceRules(p, instruction)
+			if len(rules) == 0 {
+				continue
+			}
+			score := len(rules) + len(r.valueRules(p, instruction.Amount, instruction.Currency, instruction.Date))
+			if best < 0 || score > bestScore {
+				best, bestRules, bestScore = i, rules, score
+			}
+		}
+		if best >= 0 {
+			matched[best] = true
+			p.item = r.newItem(entries, p, bestRules, true, instructions[best])
+		}
+	}
+
+	// an entry booking a batch takes the instructions of the batch
+	if r.rules[Batch] {
+		for i := range entries {
+			if len(entries[i].Batches) == 0 || !payment(entries[i]) || entryMatched(postings, i) {
+				continue
+			}
+			var batch []int
+			count, taken := 0, map[int]bool{}
+			for _, b := range entries[i].Batches {
+				count += b.NumberOfTransactions
+				for j, instruction := range instructions {
+					if matched[j] || taken[j] {
+						continue
+					}
+					if (b.PaymentInformationId != "" && b.PaymentInformationId == instruction.PaymentInformationId) ||
+						(b.PaymentInformationId == "" && b.MessageId != "" && b.MessageId == instruction.MessageId) {
+						taken[j] = true
+						batch = append(batch, j)
+					}
+				}
+			}
+			if len(batch) == 0 {
+				continue
+			}
+			item := r.newItem(entries, entryPosting(entries, i), []Rule{Batch}, true, pick(instructions, matched, batch)...)
+			if count > 0 && count != len(batch) {
+				item.Mismatches = append(item.Mismatches, Batch)
+				item.Status = PartiallyMatched
+			}
+			replacePostings(postings, i, item)
+		}
+	}
+
+	// the amount and the date pair what is left
+	if r.rules[Amount] && r.rules[ValueDate] {
+		for _, p := range postings {
+			if p.item != nil || p.currency == "" || !payment(entries[p.entry]) {
+				continue
+			}
+			best, distance := -1, 0
+			for i, instruction := range instructions {
+				if matched[i] || !r.sameAmount(p.amount, p.currency, instruction.Amount, instruction.Currency) {
+					continue
+				}
+				days, ok := dateDistance(p.date, instruction.Date)
+				if ok && days <= r.dateTolerance && (best < 0 || days < distance) {
+					best, distance = i, days
+				}
+			}
+			if best >= 0 {
+				matched[best] = true
+				p.item = r.newItem(entries, p, nil, false, instructions[best])
+			}
+		}
+		if r.rules[Batch] {
+			for i := range entries {
+				if len(entries[i].Transactions) > 0 || !payment(entries[i]) || entryMatched(postings, i) {
+					continue
+				}
+				if batch := r.amountBatch(entries[i], instructions, matched); batch != nil {
+					replacePostings(postings, i, r.newItem(entries, entryPosting(entries, i), nil, false,
+						pick(instructions, matched, batch)...))
+				}
+			}
+		}
+	}
+
+	// a reversal or a return refers to its instruction, which may be matched with the payment too
+	for _, p := range postings {
+		if !reversal(entries[p.entry]) {
+			continue
+		}
+		for i, instruction := range instructions {
+			if rules := r.referenceRules(p, instruction); len(rules) > 0 {
+				matched[i] = true
+				p.item = r.newItem(entries, p, rules, true, instruction)
+				p.item.Status = Reversed
+				break
+			}
+		}
+	}
+
+	report := Report{}
+	var last *Item
+	for _, p := range postings {
+		switch {
+		case p.item == nil:
+			entry := entries[p.entry]
+			report.add(Item{Status: Unmatched, Entry: &entry, Transaction: p.transaction})
+		case p.item != last:
+			report.add(*p.item)
+		}
+		last = p.item
+	}
+	for i, instruction := range instructions {
+		if !matched[i] {
+			report.add(Item{Status: Unmatched, Transaction: -1, Instructions: []Instruction{instruction}})
+		}
+	}
+	return report
+}
+
+func (report *Report) add(item Item) {
+	switch item.Status {
+	case Matched:
+		report.Matched++
+	case PartiallyMatched:
+		report.PartiallyMatched++
+	case Reversed:
+		report.Reversed++
+	default:
+		report.Unmatched++
+	}
+	report.Items = append(report.Items, item)
+}
+
+// payment reports whether the entry is a booked payment, a debit which isn't a reversal
+func payment(entry Entry) bool {
+	return entry.Status == "BOOK" && entry.CreditDebit == "DBIT" && !entry.Reversal
+}
+
+// reversal reports whether the entry is a booked reversal of a debit, or a booked return of a payment
+func reversal(entry Entry) bool {
+	if entry.Status != "BOOK" || entry.CreditDebit != "CRDT" {
+		return false
+	}
+	if entry.Reversal || strings.HasSuffix(entry.BankTransactionCode, "/RRTN") {
+		return true
+	}
+	for _, tx := range entry.Transactions {
+		if tx.ReturnReason != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// entryPostings returns a posting for each transaction of the entry, or for the entry without transactions
+func entryPostings(entries []Entry, index int) []*posting {
+	entry := entries[index]
+	if len(entry.Transactions) == 0 {
+		return []*posting{entryPosting(entries, index)}
+	}
+	var postings []*posting
+	for i, tx := range entry.Transactions {
+		p := &posting{
+			entry:       index,
+			transaction: i,
+			references: map[Rule]string{
+				EndToEndId:               tx.EndToEndId,
+				AccountServicerReference: tx.AccountServicerReference,
+				Remittance:               tx.Remittance,
+			},
+			amount:   tx.Amount,
+			currency: tx.Currency,
+			date:     entryDate(entry),
+		}
+		// the only transaction of an entry is identified by the entry reference and booked for its amount
+		if len(entry.Transactions) == 1 {
+			if p.references[AccountServicerReference] == "" {
+				p.references[AccountServicerReference] = entry.AccountServicerReference
+			}
+			if p.currency == "" {
+				p.amount, p.currency = entry.Amount, entry.Currency
+			}
+		}
+		postings = append(postings, p)
+	}
+	return postings
+}
+
+func entryPosting(entries []Entry, index int) *posting {
+	entry := entries[index]
+	return &posting{
+		entry:       index,
+		transaction: -1,
+		references:  map[Rule]string{AccountServicerReference: entry.AccountServicerReference},
+		amount:      entry.Amount,
+		currency:    entry.Currency,
+		date:        entryDate(entry),
+	}
+}
+
+// entryDate returns the value date of the entry, or its booking date
+func entryDate(entry Entry) string {
+	if entry.ValueDate != "" {
+		return entry.ValueDate
+	}
+	return entry.BookingDate
+}
+
+func entryMatched(postings []*posting, entry int) bool {
+	for _, p := range postings {
+		if p.entry == entry && p.item != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// replacePostings matches all the postings of the entry with the item
+func replacePostings(postings []*posting, entry int, item *Item) {
+	for _, p := range postings {
+		if p.entry == entry {
+			p.item = item
+		}
+	}
+}
+
+// pick marks the instructions at the indexes as matched and returns them
+func pick(instructions []Instruction, matched []bool, indexes []int) []Instruction {
+	var list []Instruction
+	for _, i := range indexes {
+		matched[i] = true
+		list = append(list, instructions[i])
+	}
+	return list
+}
+
+// referenceRules returns the enabled reference rules the posting and the instruction agree on
+func (r *Reconciler) referenceRules(p *posting, instruction Instruction) []Rule {
+	var rules []Rule
+	for _, reference := range []struct {
+		rule  Rule
+		value string
+		same  func(a, b string) bool
+	}{
+		{EndToEndId, instruction.EndToEndId, sameEndToEndId},
+		{AccountServicerReference, instruction.AccountServicerReference, sameText},
+		{Remittance, instruction.Remittance, sameRemittance},
+	} {
+		if r.rules[reference.rule] && reference.same(p.references[reference.rule], reference.value) {
+			rules = append(rules, reference.rule)
+		}
+	}
+	return rules
+}
+
+// valueRules returns the enabled amount and date rules the posting agrees on with the amount and the date of
+// instructions
+func (r *Reconciler) valueRules(p *posting, amount common.Decimal, currency, date string) []Rule {
+	var rules []Rule
+	if r.rules[Amount] && r.sameAmount(p.amount, p.currency, amount, currency) {
+		rules = append(rules, Amount)
+	}
+	if r.rules[ValueDate] {
+		if days, ok := dateDistance(p.date, date); ok && days <= r.dateTolerance {
+			rules = append(rules, ValueDate)
+		}
+	}
+	return rules
+}
+
+// newItem returns the item of the posting matched with the instructions. The amount and the date are compared,
+// an item found by a reference is matched when they agree.
+func (r *Reconciler) newItem(entries []Entry, p *posting, rules []Rule, byReference bool, instructions ...Instruction) *Item {
+	entry := entries[p.entry]
+	item := &Item{Entry: &entry, Transaction: p.transaction, Instructions: instructions, Status: PartiallyMatched}
+
+	total, currency, date := instructions[0].Amount, instructions[0].Currency, instructions[0].Date
+	for _, instruction := range instructions[1:] {
+		total = total.Add(instruction.Amount)
+		if instruction.Currency != currency {
+			currency = ""
+		}
+		if instruction.Date > date {
+			date = instruction.Date
+		}
+	}
+	if p.currency != "" && p.currency == currency {
+		item.Difference = p.amount.Sub(total)
+	}
+
+	values := r.valueRules(p, total, currency, date)
+	item.Rules = append(append([]Rule{}, rules...), values...)
+	for _, rule := range []Rule{Amount, ValueDate} {
+		if r.rules[rule] && !containsRule(values, rule) && r.comparable(rule, p, currency, date) {
+			item.Mismatches = append(item.Mismatches, rule)
+		}
+	}
+	if byReference && len(item.Mismatches) == 0 {
+		item.Status = Matched
+	}
+	return item
+}
+
+// comparable reports whether the posting and the instructions both give the value compared by the rule
+func (r *Reconciler) comparable(rule Rule, p *posting, currency, date string) bool {
+	if rule == Amount {
+		return p.currency != "" && currency != ""
+	}
+	return p.date != "" && date != ""
+}
+
+// amountBatch returns the unmatched instructions of a payment information, or of a message, whose total is the
+// amount of the entry and whose date is its value date
+func (r *Reconciler) amountBatch(entry Entry, instructions []Instruction, matched []bool) []int {
+	groups := map[string][]int{}
+	var keys []string
+	for i, instruction := range instructions {
+		if matched[i] || instruction.Currency != entry.Currency {
+			continue
+		}
+		if days, ok := dateDistance(entryDate(entry), instruction.Date); !ok || days > r.dateTolerance {
+			continue
+		}
+		for _, key := range []string{"pmtinf:" + instruction.PaymentInformationId, "msg:" + instruction.MessageId} {
+			if !strings.HasSuffix(key, ":") {
+				if len(groups[key]) == 0 {
+					keys = append(keys, key)
+				}
+				groups[key] = append(groups[key], i)
+			}
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return len(groups[keys[i]]) < len(groups[keys[j]]) })
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) < 2 {
+			continue
+		}
+		total := common.Decimal{}
+		for _, i := range group {
+			total = total.Add(instructions[i].Amount)
+		}
+		if r.sameAmount(entry.Amount, entry.Currency, total, entry.Currency) {
+			return group
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) sameAmount(amount common.Decimal, currency string, other common.Decimal, otherCurrency string) bool {
+	return currency != "" && currency == otherCurrency && amount.Sub(other).Abs().Cmp(r.tolerance) <= 0
+}
+
+// dateDistance returns the number of days between the dates, when both are given
+func dateDistance(date, other string) (int, bool) {
+	a, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return 0, false
+	}
+	b, err := time.Parse("2006-01-02", other)
+	if err != nil {
+		return 0, false
+	}
+	days := int(a.Sub(b).Hours() / 24)
+	if days < 0 {
+		days = -days
+	}
+	return days, true
+}
+
+func containsRule(rules []Rule, rule Rule) bool {
+	for _, r := range rules {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+func sameText(a, b string) bool {
+	return a != "" && a == b
+}
+
+func sameEndToEndId(a, b string) bool {
+	return a != "NOTPROVIDED" && sameText(a, b)
+}
+
+func sameRemittance(a, b string) bool {
+	normalize := func(s string) string {
+		return strings.ToUpper(strings.Join(strings.Fields(s), ""))
+	}
+	return sameText(normalize(a), normalize(b))
+}
@@ -0,0 +1,299 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package reconcile
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readDocument(t *testing.T, name string) document.Iso20022Document {
+	raw, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", name))
+	require.Nil(t, err)
+	doc, err := document.ParseIso20022Document(raw)
+	require.Nil(t, err)
+	return doc
+}
+
+func instruction(endToEndId, amount, date string) Instruction {
+	return Instruction{MessageId: "MSG-1", PaymentInformationId: "PMT-1", EndToEndId: endToEndId,
+		Amount: common.MustParseDecimal(amount), Currency: "EUR", Date: date}
+}
+
+func entry(amount, date string, transactions ...EntryTransaction) Entry {
+	return Entry{MessageId: "STMT-1", StatementId: "STMT-1", Amount: common.MustParseDecimal(amount), Currency: "EUR",
+		CreditDebit: "DBIT", Status: "BOOK", BookingDate: date, ValueDate: date, Transactions: transactions}
+}
+
+func transaction(endToEndId, amount string) EntryTransaction {
+	return EntryTransaction{EndToEndId: endToEndId, Amount: common.MustParseDecimal(amount), Currency: "EUR"}
+}
+
+func TestInstructions(t *testing.T) {
+	instructions, err := Instructions(readDocument(t, "valid_pain_v09.xml"))
+	require.Nil(t, err)
+	require.Len(t, instructions, 2)
+	assert.Equal(t, Instruction{
+		MessageId:            "ABC/220315/CCT001",
+		PaymentInformationId: "ABC/086",
+		InstructionId:        "ABC/220315/CCT001/01",
+		EndToEndId:           "ABC/4562/2022-03-08",
+		Amount:               common.MustParseDecimal("1000.00"),
+		Currency:             "EUR",
+		Date:                 "2022-03-16",
+		Debtor:               "ABC Corporation",
+		Creditor:             "DEF Electronics",
+		Remittance:           "Invoice 4562",
+	}, instructions[0])
+
+	_, err = Instructions(readDocument(t, "valid_camt053_v08.xml"))
+	assert.EqualError(t, err, "The message camt.053.001.08 is unsupported by the reconciliation")
+}
+
+func TestEntries(t *testing.T) {
+	entries, err := Entries(readDocument(t, "valid_camt053_v08.xml"))
+	require.Nil(t, err)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, "COBA/220316/STMT001", entries[0].MessageId)
+	assert.Equal(t, "COBA/220316/001", entries[0].StatementId)
+	assert.Equal(t, "DE89370400440532013000", entries[0].Account)
+	assert.Equal(t, "COBA/220316/0001", entries[0].AccountServicerReference)
+	assert.Equal(t, "1500.50", entries[0].Amount.String())
+	assert.Equal(t, "DBIT", entries[0].CreditDebit)
+	assert.Equal(t, "BOOK", entries[0].Status)
+	assert.Equal(t, "2022-03-16", entries[0].ValueDate)
+	assert.Equal(t, "PMNT/ICDT/ESCT", entries[0].BankTransactionCode)
+	assert.Equal(t, []EntryBatch{{MessageId: "ABC/220315/CCT001", PaymentInformationId: "ABC/086", NumberOfTransactions: 2,
+		Amount: common.MustParseDecimal("1500.50"), Currency: "EUR"}}, entries[0].Batches)
+	assert.Empty(t, entries[0].Transactions)
+
+	assert.Equal(t, 1, entries[1].Index)
+	assert.Equal(t, []EntryTransaction{{EndToEndId: "XYZ/INV/778", Amount: common.MustParseDecimal("250.00"), Currency: "EUR",
+		Counterparty: "XYZ Trading", Remittance: "RF18539007547034"}}, entries[1].Transactions)
+	assert.Equal(t, "Charges", entries[2].Information)
+
+	_, err = Entries(readDocument(t, "valid_pain_v09.xml"))
+	assert.EqualError(t, err, "The message pain.001.001.09 is unsupported by the reconciliation")
+}
+
+func TestReconcileDocuments(t *testing.T) {
+	report, err := NewReconciler().ReconcileDocuments(readDocument(t, "valid_pain_v09.xml"), readDocument(t, "valid_camt053_v08.xml"))
+	require.Nil(t, err)
+	assert.Equal(t, 1, report.Matched)
+	assert.Equal(t, 0, report.PartiallyMatched)
+	assert.Equal(t, 2, report.Unmatched)
+	require.Len(t, report.Items, 3)
+
+	// the batch booking of the payment information
+	item := report.Items[0]
+	assert.Equal(t, Matched, item.Status)
+	assert.Equal(t, -1, item.Transaction)
+	assert.Len(t, item.Instructions, 2)
+	assert.Equal(t, []Rule{Batch, Amount, ValueDate}, item.Rules)
+	assert.True(t, item.Difference.IsZero())
+
+	assert.Equal(t, Unmatched, report.Items[1].Status)
+	assert.Equal(t, 0, report.Items[1].Transaction)
+	assert.Equal(t, Unmatched, report.Items[2].Status)
+	assert.Equal(t, "Charges", report.Items[2].Entry.Information)
+
+	_, err = NewReconciler().ReconcileDocuments(readDocument(t, "valid_acmt_v03.xml"))
+	assert.EqualError(t, err, "The message acmt.007.001.03 is unsupported by the reconciliation")
+}
+
+func TestReconcile(t *testing.T) {
+	t.Run("references", func(t *testing.T) {
+		instructions := []Instruction{instruction("E2E-1", "100", "2022-03-16"), instruction("E2E-2", "200", "2022-03-16")}
+		instructions[1].AccountServicerReference = "BANK-2"
+		first := entry("100", "2022-03-16", transaction("E2E-1", "100"))
+		second := entry("205", "2022-03-17", EntryTransaction{})
+		second.AccountServicerReference = "BANK-2"
+
+		report := NewReconciler().Reconcile(instructions, []Entry{first, second})
+		require.Len(t, report.Items, 2)
+		assert.Equal(t, Matched, report.Items[0].Status)
+		assert.Equal(t, 0, report.Items[0].Transaction)
+		assert.Equal(t, []Rule{EndToEndId, Amount, ValueDate}, report.Items[0].Rules)
+		assert.Empty(t, report.Items[0].Mismatches)
+
+		// the entry reference of a transaction without amount, which is the entry amount
+		item := report.Items[1]
+		assert.Equal(t, PartiallyMatched, item.Status)
+		assert.Equal(t, []Rule{AccountServicerReference, ValueDate}, item.Rules)
+		assert.Equal(t, []Rule{Amount}, item.Mismatches)
+		assert.Equal(t, "5", item.Difference.String())
+
+		// the difference is accepted within the tolerance
+		report = NewReconciler(WithTolerance(common.MustParseDecimal("5"))).Reconcile(instructions, []Entry{first, second})
+		assert.Equal(t, 2, report.Matched)
+
+		// the dates are compared without the value date rule
+		report = NewReconciler(WithRules(EndToEndId, AccountServicerReference), WithDateTolerance(0)).Reconcile(instructions, []Entry{first, second})
+		assert.Equal(t, 2, report.Matched)
+		assert.Equal(t, []Rule{AccountServicerReference}, report.Items[1].Rules)
+	})
+
+	t.Run("remittance", func(t *testing.T) {
+		instructions := []Instruction{instruction("NOTPROVIDED", "50", "2022-03-16"), instruction("NOTPROVIDED", "50", "2022-03-16")}
+		instructions[1].Remittance = "RF18 5390 0754 7034"
+		tx := transaction("NOTPROVIDED", "50")
+		tx.Remittance = "rf18539007547034"
+
+		report := NewReconciler().Reconcile(instructions, []Entry{entry("50", "2022-03-16", tx)})
+		require.Len(t, report.Items, 2)
+		assert.Equal(t, Matched, report.Items[0].Status)
+		assert.Equal(t, []Rule{Remittance, Amount, ValueDate}, report.Items[0].Rules)
+		assert.Equal(t, "RF18 5390 0754 7034", report.Items[0].Instructions[0].Remittance)
+		assert.Equal(t, Unmatched, report.Items[1].Status)
+		assert.Nil(t, report.Items[1].Entry)
+	})
+
+	t.Run("several transactions", func(t *testing.T) {
+		instructions := []Instruction{instruction("E2E-1", "100", "2022-03-16"), instruction("E2E-2", "200", "2022-03-10")}
+		report := NewReconciler().Reconcile(instructions, []Entry{
+			entry("300", "2022-03-16", transaction("E2E-1", "100"), transaction("E2E-2", "200"), transaction("E2E-3", "0")),
+		})
+		require.Len(t, report.Items, 3)
+		assert.Equal(t, Matched, report.Items[0].Status)
+		assert.Equal(t, PartiallyMatched, report.Items[1].Status)
+		assert.Equal(t, 1, report.Items[1].Transaction)
+		assert.Equal(t, []Rule{ValueDate}, report.Items[1].Mismatches)
+		assert.Equal(t, Unmatched, report.Items[2].Status)
+		assert.Equal(t, 2, report.Items[2].Transaction)
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		instructions := []Instruction{
+			instruction("E2E-1", "100", "2022-03-16"), instruction("E2E-2", "200", "2022-03-16"), instruction("E2E-3", "300", "2022-03-16"),
+		}
+		instructions[2].PaymentInformationId = "PMT-2"
+		batch := entry("300", "2022-03-16")
+		batch.Batches = []EntryBatch{{PaymentInformationId: "PMT-1", NumberOfTransactions: 3}}
+
+		report := NewReconciler().Reconcile(instructions, []Entry{batch})
+		require.Len(t, report.Items, 2)
+		item := report.Items[0]
+		assert.Equal(t, PartiallyMatched, item.Status)
+		assert.Len(t, item.Instructions, 2)
+		assert.Equal(t, []Rule{Batch, Amount, ValueDate}, item.Rules)
+		assert.Equal(t, []Rule{Batch}, item.Mismatches)
+		assert.Equal(t, "E2E-3", report.Items[1].Instructions[0].EndToEndId)
+
+		// without batch rule the entry is paired by amount
+		report = NewReconciler(WithRules(EndToEndId, Amount, ValueDate)).Reconcile(instructions, []Entry{batch})
+		require.Len(t, report.Items, 3)
+		assert.Equal(t, PartiallyMatched, report.Items[0].Status)
+		assert.Equal(t, []Rule{Amount, ValueDate}, report.Items[0].Rules)
+		assert.Equal(t, "E2E-3", report.Items[0].Instructions[0].EndToEndId)
+	})
+
+	t.Run("amount and date", func(t *testing.T) {
+		instructions := []Instruction{
+			instruction("E2E-1", "75", "2022-03-14"), instruction("E2E-2", "75", "2022-03-16"),
+			instruction("E2E-3", "40", "2022-03-16"), instruction("E2E-4", "60", "2022-03-16"),
+		}
+		instructions[2].PaymentInformationId = "PMT-2"
+		instructions[3].PaymentInformationId = "PMT-2"
+
+		report := NewReconciler().Reconcile(instructions, []Entry{entry("75", "2022-03-17"), entry("100", "2022-03-16")})
+		require.Len(t, report.Items, 3)
+		assert.Equal(t, PartiallyMatched, report.Items[0].Status)
+		assert.Equal(t, "E2E-2", report.Items[0].Instructions[0].EndToEndId)
+		assert.Equal(t, []Rule{Amount, ValueDate}, report.Items[0].Rules)
+
+		// a batch booked without batch details
+		assert.Equal(t, PartiallyMatched, report.Items[1].Status)
+		assert.Len(t, report.Items[1].Instructions, 2)
+		assert.Equal(t, "PMT-2", report.Items[1].Instructions[0].PaymentInformationId)
+		assert.Equal(t, Unmatched, report.Items[2].Status)
+		assert.Equal(t, "E2E-1", report.Items[2].Instructions[0].EndToEndId)
+	})
+
+	t.Run("credits and pending entries", func(t *testing.T) {
+		instructions := []Instruction{instruction("E2E-1", "100", "2022-03-16"), instruction("E2E-2", "200", "2022-03-16")}
+		credit := entry("100", "2022-03-16", transaction("E2E-1", "100"))
+		credit.CreditDebit = "CRDT"
+		pending := entry("200", "2022-03-16", transaction("E2E-2", "200"))
+		pending.Status = "PDNG"
+
+		report := NewReconciler().Reconcile(instructions, []Entry{credit, pending})
+		assert.Equal(t, 0, report.Matched+report.PartiallyMatched+report.Reversed)
+		assert.Equal(t, 4, report.Unmatched)
+
+		// the booked payment is matched once it arrives
+		booked := entry("200", "2022-03-17", transaction("E2E-2", "200"))
+		report = NewReconciler().Reconcile(instructions, []Entry{pending, booked})
+		require.Len(t, report.Items, 3)
+		assert.Equal(t, Unmatched, report.Items[0].Status)
+		assert.Equal(t, Matched, report.Items[1].Status)
+		assert.Equal(t, "E2E-2", report.Items[1].Instructions[0].EndToEndId)
+	})
+
+	t.Run("reversals and returns", func(t *testing.T) {
+		instructions := []Instruction{
+			instruction("E2E-1", "100", "2022-03-16"), instruction("E2E-2", "200", "2022-03-16"), instruction("E2E-3", "300", "2022-03-16"),
+		}
+		payment := entry("100", "2022-03-16", transaction("E2E-1", "100"))
+		reversal := entry("100", "2022-03-17", transaction("E2E-1", "100"))
+		reversal.CreditDebit, reversal.Reversal = "CRDT", true
+		returned := entry("200", "2022-03-18", transaction("E2E-2", "200"))
+		returned.CreditDebit, returned.BankTransactionCode = "CRDT", "PMNT/ICDT/RRTN"
+		withReason := entry("300", "2022-03-18", transaction("E2E-3", "300"))
+		withReason.CreditDebit, withReason.Transactions[0].ReturnReason = "CRDT", "AC04"
+		// a debit reversal is no payment, even for the amount of an instruction
+		debitReversal := entry("100", "2022-03-16", transaction("E2E-1", "100"))
+		debitReversal.Reversal = true
+
+		report := NewReconciler().Reconcile(instructions, []Entry{debitReversal, payment, reversal, returned, withReason})
+		require.Len(t, report.Items, 5)
+		assert.Equal(t, Unmatched, report.Items[0].Status)
+		assert.Equal(t, Matched, report.Items[1].Status)
+
+		item := report.Items[2]
+		assert.Equal(t, Reversed, item.Status)
+		assert.Equal(t, "E2E-1", item.Instructions[0].EndToEndId)
+		assert.Equal(t, []Rule{EndToEndId, Amount, ValueDate}, item.Rules)
+		assert.Equal(t, Reversed, report.Items[3].Status)
+		assert.Equal(t, "E2E-2", report.Items[3].Instructions[0].EndToEndId)
+		assert.Equal(t, []Rule{ValueDate}, report.Items[3].Mismatches)
+		assert.Equal(t, Reversed, report.Items[4].Status)
+		assert.Equal(t, "E2E-3", report.Items[4].Instructions[0].EndToEndId)
+		assert.Equal(t, 1, report.Matched)
+		assert.Equal(t, 3, report.Reversed)
+		assert.Equal(t, 1, report.Unmatched)
+	})
+}
+
+func TestParseRule(t *testing.T) {
+	rule, err := ParseRule(" acctsvcrref")
+	require.Nil(t, err)
+	assert.Equal(t, AccountServicerReference, rule)
+
+	_, err = ParseRule("Creditor")
+	assert.EqualError(t, err, "The reconciliation rule Creditor is unknown")
+}
+
+func TestReportWrite(t *testing.T) {
+	report, err := NewReconciler().ReconcileDocuments(readDocument(t, "valid_pain_v09.xml"), readDocument(t, "valid_camt053_v08.xml"))
+	require.Nil(t, err)
+
+	var buf bytes.Buffer
+	require.Nil(t, report.Write(&buf))
+	assert.Equal(t, `STATUS     STATEMENT        ENTRY  TRANSACTION  AMOUNT        INSTRUCTIONS                              RULES                     MISMATCHES  DIFFERENCE
+matched    COBA/220316/001  1      -            -1500.50 EUR  ABC/4562/2022-03-08, ABC/4563/2022-03-08  Batch, Amount, ValueDate  -           -
+unmatched  COBA/220316/001  2      1            250.00 EUR    -                                         -                         -           -
+unmatched  COBA/220316/001  3      -            -0.50 EUR     -                                         -                         -           -
+
+1 matched, 0 partially matched, 0 reversed, 2 unmatched
+`, buf.String())
+}
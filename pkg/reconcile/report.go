@@ -0,0 +1,83 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package reconcile
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Write writes the items of the report as a table followed by the number of items of each status
+func (report Report) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "STATUS\tSTATEMENT\tENTRY\tTRANSACTION\tAMOUNT\tINSTRUCTIONS\tRULES\tMISMATCHES\tDIFFERENCE")
+	for _, item := range report.Items {
+		statement, entry, transaction, amount := "-", "-", "-", "-"
+		if item.Entry != nil {
+			statement = item.Entry.StatementId
+			entry = strconv.Itoa(item.Entry.Index + 1)
+			amount = signedAmount(item.Entry.CreditDebit, item.Entry.Amount.String()) + " " + item.Entry.Currency
+			if item.Transaction >= 0 {
+				transaction = strconv.Itoa(item.Transaction + 1)
+				if tx := item.Entry.Transactions[item.Transaction]; tx.Currency != "" {
+					amount = signedAmount(item.Entry.CreditDebit, tx.Amount.String()) + " " + tx.Currency
+				}
+			}
+		} else if len(item.Instructions) == 1 {
+			amount = item.Instructions[0].Amount.String() + " " + item.Instructions[0].Currency
+		}
+		var instructions []string
+		for _, instruction := range item.Instructions {
+			instructions = append(instructions, instructionReference(instruction))
+		}
+		difference := "-"
+		if item.Status != Unmatched && !item.Difference.IsZero() {
+			difference = item.Difference.String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", item.Status, statement, entry, transaction, amount,
+			orDash(strings.Join(instructions, ", ")), orDash(joinRules(item.Rules)), orDash(joinRules(item.Mismatches)), difference)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "\n%d matched, %d partially matched, %d reversed, %d unmatched\n", report.Matched, report.PartiallyMatched,
+		report.Reversed, report.Unmatched)
+	return err
+}
+
+// instructionReference returns the end to end id of the instruction, or its other first reference
+func instructionReference(instruction Instruction) string {
+	for _, reference := range []string{instruction.EndToEndId, instruction.InstructionId, instruction.TransactionId, instruction.UETR} {
+		if reference != "" && reference != "NOTPROVIDED" {
+			return reference
+		}
+	}
+	return instruction.MessageId
+}
+
+func signedAmount(creditDebit, amount string) string {
+	if creditDebit == "DBIT" {
+		return "-" + amount
+	}
+	return amount
+}
+
+func joinRules(rules []Rule) string {
+	var names []string
+	for _, rule := range rules {
+		names = append(names, string(rule))
+	}
+	return strings.Join(names, ", ")
+}
+
+func orDash(text string) string {
+	if text == "" {
+		return "-"
+	}
+	return text
+}
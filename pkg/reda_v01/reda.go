@@ -12,12 +12,12 @@ import (
 )
 
 type AddressType3Choice struct {
-	Cd    common.AddressType2Code `xml:"Cd"`
-	Prtry GenericIdentification30 `xml:"Prtry"`
+	Cd    *common.AddressType2Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification30 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r AddressType3Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type Contact4 struct {
@@ -89,12 +89,12 @@ func (r CustomerTypeRequest2) Validate() error {
 }
 
 type DateAndDateTime2Choice struct {
-	Dt   common.ISODate     `xml:"Dt"`
-	DtTm common.ISODateTime `xml:"DtTm"`
+	Dt   *common.ISODate     `xml:"Dt,omitempty" json:",omitempty"`
+	DtTm *common.ISODateTime `xml:"DtTm,omitempty" json:",omitempty"`
 }
 
 func (r DateAndDateTime2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DateAndPlaceOfBirth1 struct {
@@ -109,21 +109,21 @@ func (r DateAndPlaceOfBirth1) Validate() error {
 }
 
 type DocumentFormat2Choice struct {
-	Cd    ExternalDocumentFormat1Code `xml:"Cd"`
-	Prtry GenericIdentification1      `xml:"Prtry"`
+	Cd    *ExternalDocumentFormat1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification1      `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DocumentFormat2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DocumentType1Choice struct {
-	Cd    ExternalDocumentType1Code `xml:"Cd"`
-	Prtry GenericIdentification1    `xml:"Prtry"`
+	Cd    *ExternalDocumentType1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *GenericIdentification1    `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DocumentType1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EnrolmentHeader2 struct {
@@ -208,12 +208,12 @@ func (r OrganisationIdentification37) Validate() error {
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
-	Cd    ExternalOrganisationIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                        `xml:"Prtry"`
+	Cd    *ExternalOrganisationIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                        `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r OrganisationIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type OrganisationType2 struct {
@@ -237,12 +237,12 @@ func (r OtherContact1) Validate() error {
 }
 
 type Party49Choice struct {
-	OrgId  OrganisationIdentification37 `xml:"OrgId"`
-	PrvtId PersonIdentification17       `xml:"PrvtId"`
+	OrgId  *OrganisationIdentification37 `xml:"OrgId,omitempty" json:",omitempty"`
+	PrvtId *PersonIdentification17       `xml:"PrvtId,omitempty" json:",omitempty"`
 }
 
 func (r Party49Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PersonIdentification17 struct {
@@ -256,12 +256,12 @@ func (r PersonIdentification17) Validate() error {
 }
 
 type PersonIdentificationSchemeName1Choice struct {
-	Cd    ExternalPersonIdentification1Code `xml:"Cd"`
-	Prtry common.Max35Text                  `xml:"Prtry"`
+	Cd    *ExternalPersonIdentification1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                  `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r PersonIdentificationSchemeName1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type PersonType2 struct {
@@ -383,12 +383,12 @@ func (r CreditorEnrolmentAmendment4) Validate() error {
 }
 
 type CreditorEnrolmentAmendmentReason1Choice struct {
-	Cd    ExternalCreditorEnrolmentAmendmentReason1Code `xml:"Cd"`
-	Prtry common.Max35Text                              `xml:"Prtry"`
+	Cd    *ExternalCreditorEnrolmentAmendmentReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                              `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorEnrolmentAmendmentReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorEnrolmentAmendmentReason2 struct {
@@ -425,12 +425,12 @@ func (r OriginalBusinessInstruction1) Validate() error {
 }
 
 type OriginalEnrolment2Choice struct {
-	OrgnlCdtrId      Party49Choice      `xml:"OrgnlCdtrId"`
-	OrgnlEnrlmntData CreditorEnrolment3 `xml:"OrgnlEnrlmntData"`
+	OrgnlCdtrId      *Party49Choice      `xml:"OrgnlCdtrId,omitempty" json:",omitempty"`
+	OrgnlEnrlmntData *CreditorEnrolment3 `xml:"OrgnlEnrlmntData,omitempty" json:",omitempty"`
 }
 
 func (r OriginalEnrolment2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type RequestToPayCreditorEnrolmentAmendmentRequestV01 struct {
@@ -456,12 +456,12 @@ func (r CreditorEnrolmentCancellation2) Validate() error {
 }
 
 type CreditorEnrolmentCancellationReason1Choice struct {
-	Cd    ExternalCreditorEnrolmentCancellationReason1Code `xml:"Cd"`
-	Prtry common.Max35Text                                 `xml:"Prtry"`
+	Cd    *ExternalCreditorEnrolmentCancellationReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                 `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorEnrolmentCancellationReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type CreditorEnrolmentCancellationReason2 struct {
@@ -496,12 +496,12 @@ func (r CreditorEnrolmentStatusReason2) Validate() error {
 }
 
 type CreditorEnrolmentStatusReason2Choice struct {
-	Cd    ExternalCreditorEnrolmentStatusReason1Code `xml:"Cd"`
-	Prtry common.Max35Text                           `xml:"Prtry"`
+	Cd    *ExternalCreditorEnrolmentStatusReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                           `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r CreditorEnrolmentStatusReason2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type EnrolmentStatus2 struct {
@@ -529,12 +529,12 @@ func (r RequestToPayCreditorEnrolmentStatusReportV01) Validate() error {
 }
 
 type ServiceStatus1Choice struct {
-	Cd    ServiceRequestStatus1Code `xml:"Cd"`
-	Prtry common.Max35Text          `xml:"Prtry"`
+	Cd    *ServiceRequestStatus1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r ServiceStatus1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type ActivationHeader2 struct {
@@ -642,12 +642,12 @@ func (r DebtorActivationAmendment4) Validate() error {
 }
 
 type DebtorActivationAmendmentReason1Choice struct {
-	Cd    ExternalDebtorActivationAmendmentReason1Code `xml:"Cd"`
-	Prtry common.Max35Text                             `xml:"Prtry"`
+	Cd    *ExternalDebtorActivationAmendmentReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                             `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DebtorActivationAmendmentReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DebtorActivationAmendmentReason2 struct {
@@ -683,12 +683,12 @@ func (r DebtorActivationCancellation2) Validate() error {
 }
 
 type DebtorActivationCancellationReason1Choice struct {
-	Cd    ExternalDebtorActivationCancellationReason1Code `xml:"Cd"`
-	Prtry common.Max35Text                                `xml:"Prtry"`
+	Cd    *ExternalDebtorActivationCancellationReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                                `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DebtorActivationCancellationReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DebtorActivationCancellationReason2 struct {
@@ -702,12 +702,12 @@ func (r DebtorActivationCancellationReason2) Validate() error {
 }
 
 type OriginalActivation2Choice struct {
-	OrgnlDbtrId     Party49Choice     `xml:"OrgnlDbtrId"`
-	OrgnlActvtnData DebtorActivation3 `xml:"OrgnlActvtnData"`
+	OrgnlDbtrId     *Party49Choice     `xml:"OrgnlDbtrId,omitempty" json:",omitempty"`
+	OrgnlActvtnData *DebtorActivation3 `xml:"OrgnlActvtnData,omitempty" json:",omitempty"`
 }
 
 func (r OriginalActivation2Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type RequestToPayDebtorActivationCancellationRequestV01 struct {
@@ -735,12 +735,12 @@ func (r ActivationStatus2) Validate() error {
 }
 
 type DebtorActivationStatusReason1Choice struct {
-	Cd    ExternalDebtorActivationStatusReason1Code `xml:"Cd"`
-	Prtry common.Max35Text                          `xml:"Prtry"`
+	Cd    *ExternalDebtorActivationStatusReason1Code `xml:"Cd,omitempty" json:",omitempty"`
+	Prtry *common.Max35Text                          `xml:"Prtry,omitempty" json:",omitempty"`
 }
 
 func (r DebtorActivationStatusReason1Choice) Validate() error {
-	return utils.Validate(&r)
+	return utils.ValidateChoice(&r)
 }
 
 type DebtorActivationStatusReason2 struct {
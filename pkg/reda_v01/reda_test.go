@@ -17,7 +17,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, CreditorInvoice3{}.Validate())
 	assert.Nil(t, CreditorServiceEnrolment1{}.Validate())
 	assert.Nil(t, CustomerTypeRequest2{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth1{}.Validate())
 	assert.NotNil(t, DocumentFormat2Choice{}.Validate())
 	assert.NotNil(t, DocumentType1Choice{}.Validate())
@@ -32,7 +32,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.Nil(t, OrganisationType2{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party49Choice{}.Validate())
+	assert.NotNil(t, Party49Choice{}.Validate())
 	assert.Nil(t, PersonIdentification17{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
 	assert.Nil(t, PersonType2{}.Validate())
@@ -66,15 +66,15 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, ElectronicInvoice1{}.Validate())
 	assert.NotNil(t, RequestToPayDebtorActivationRequestV01{}.Validate())
 	assert.Nil(t, DebtorActivation4{}.Validate())
-	assert.Nil(t, DebtorActivationAmendment3{}.Validate())
+	assert.NotNil(t, DebtorActivationAmendment3{}.Validate())
 	assert.Nil(t, DebtorActivationAmendment4{}.Validate())
 	assert.NotNil(t, DebtorActivationAmendmentReason1Choice{}.Validate())
 	assert.NotNil(t, DebtorActivationAmendmentReason2{}.Validate())
 	assert.NotNil(t, RequestToPayDebtorActivationAmendmentRequestV01{}.Validate())
-	assert.Nil(t, DebtorActivationCancellation2{}.Validate())
+	assert.NotNil(t, DebtorActivationCancellation2{}.Validate())
 	assert.NotNil(t, DebtorActivationCancellationReason1Choice{}.Validate())
 	assert.NotNil(t, DebtorActivationCancellationReason2{}.Validate())
-	assert.Nil(t, OriginalActivation2Choice{}.Validate())
+	assert.NotNil(t, OriginalActivation2Choice{}.Validate())
 	assert.NotNil(t, RequestToPayDebtorActivationCancellationRequestV01{}.Validate())
 	assert.NotNil(t, ActivationStatus2{}.Validate())
 	assert.NotNil(t, DebtorActivationStatusReason1Choice{}.Validate())
@@ -95,12 +95,12 @@ func TestTypes(t *testing.T) {
 
 	var type3 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BANK"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalPersonIdentification1Code
 	assert.NotNil(t, type4.Validate())
-	type4 = "test"
+	type4 = "ARNU"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalCreditorEnrolmentAmendmentReason1Code
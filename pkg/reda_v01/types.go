@@ -7,6 +7,7 @@ package reda_v01
 import (
 	"reflect"
 
+	"github.com/moov-io/iso20022/pkg/codes"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
@@ -17,7 +18,7 @@ func (r ExternalDocumentFormat1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDocumentFormat1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDocumentFormat1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -27,7 +28,7 @@ func (r ExternalDocumentType1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDocumentType1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDocumentType1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -37,7 +38,7 @@ func (r ExternalOrganisationIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalOrganisationIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalOrganisationIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -47,7 +48,7 @@ func (r ExternalPersonIdentification1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalPersonIdentification1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalPersonIdentification1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -57,7 +58,7 @@ func (r ExternalCreditorEnrolmentAmendmentReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCreditorEnrolmentAmendmentReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCreditorEnrolmentAmendmentReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -67,7 +68,7 @@ func (r ExternalCreditorEnrolmentCancellationReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCreditorEnrolmentCancellationReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCreditorEnrolmentCancellationReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -77,7 +78,7 @@ func (r ExternalCreditorEnrolmentStatusReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalCreditorEnrolmentCancellationReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalCreditorEnrolmentStatusReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -87,7 +88,7 @@ func (r ExternalDebtorActivationAmendmentReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDebtorActivationAmendmentReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDebtorActivationAmendmentReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -97,7 +98,7 @@ func (r ExternalDebtorActivationCancellationReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDebtorActivationCancellationReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDebtorActivationCancellationReason1Code", string(r))
 }
 
 // Must be at least 1 items long
@@ -107,7 +108,7 @@ func (r ExternalDebtorActivationStatusReason1Code) Validate() error {
 	if len(string(r)) < 1 || len(string(r)) > 4 {
 		return utils.NewErrTextLengthInvalid("ExternalDebtorActivationStatusReason1Code", 1, 4)
 	}
-	return nil
+	return codes.Validate("ExternalDebtorActivationStatusReason1Code", string(r))
 }
 
 // May be one of LETT, MAIL, PHON, FAXX, CELL
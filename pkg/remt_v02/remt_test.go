@@ -30,7 +30,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, OrganisationIdentification29{}.Validate())
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PersonIdentification13{}.Validate())
 	assert.NotNil(t, PersonIdentificationSchemeName1Choice{}.Validate())
@@ -41,7 +41,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, SupplementaryData1{}.Validate())
 	assert.Nil(t, SupplementaryDataEnvelope1{}.Validate())
 	assert.NotNil(t, TransactionReferences5{}.Validate())
-	assert.Nil(t, AccountIdentification4Choice{}.Validate())
+	assert.NotNil(t, AccountIdentification4Choice{}.Validate())
 	assert.NotNil(t, GenericAccountIdentification1{}.Validate())
 	assert.NotNil(t, AccountSchemeName1Choice{}.Validate())
 	assert.NotNil(t, TaxRecordDetails1{}.Validate())
@@ -55,7 +55,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, BranchAndFinancialInstitutionIdentification5{}.Validate())
 	assert.Nil(t, BranchData2{}.Validate())
 	assert.Nil(t, FinancialInstitutionIdentification8{}.Validate())
-	assert.Nil(t, CashAccount24{}.Validate())
+	assert.NotNil(t, CashAccount24{}.Validate())
 	assert.NotNil(t, CashAccountType2Choice{}.Validate())
 	assert.NotNil(t, CategoryPurpose1Choice{}.Validate())
 	assert.Nil(t, ContactDetails2{}.Validate())
@@ -76,7 +76,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, GroupHeader62{}.Validate())
 	assert.Nil(t, OrganisationIdentification8{}.Validate())
 	assert.Nil(t, OriginalPaymentInformation6{}.Validate())
-	assert.Nil(t, Party11Choice{}.Validate())
+	assert.NotNil(t, Party11Choice{}.Validate())
 	assert.Nil(t, PartyIdentification43{}.Validate())
 	assert.Nil(t, PaymentTypeInformation19{}.Validate())
 	assert.Nil(t, PersonIdentification5{}.Validate())
@@ -105,6 +105,8 @@ func TestTypes(t *testing.T) {
 	var type1 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type1.Validate())
 	type1 = "test"
+	assert.NotNil(t, type1.Validate())
+	type1 = "USABA"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalFinancialInstitutionIdentification1Code
@@ -114,12 +116,12 @@ func TestTypes(t *testing.T) {
 
 	var type3 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BANK"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalPersonIdentification1Code
 	assert.NotNil(t, type4.Validate())
-	type4 = "test"
+	type4 = "ARNU"
 	assert.Nil(t, type4.Validate())
 
 	var type5 PreferredContactMethod1Code
@@ -138,7 +140,7 @@ func TestTypes(t *testing.T) {
 
 	var type7 ExternalAccountIdentification1Code
 	assert.NotNil(t, type7.Validate())
-	type7 = "test"
+	type7 = "AIIN"
 	assert.Nil(t, type7.Validate())
 
 	var type8 TaxRecordPeriod1Code
@@ -158,7 +160,7 @@ func TestTypes(t *testing.T) {
 
 	var type11 ExternalCashAccountType1Code
 	assert.NotNil(t, type11.Validate())
-	type11 = "AUTH"
+	type11 = "CACC"
 	assert.Nil(t, type11.Validate())
 
 	var type12 DocumentType6Code
@@ -178,12 +180,12 @@ func TestTypes(t *testing.T) {
 
 	var type15 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type15.Validate())
-	type15 = "SPOT"
+	type15 = "BONU"
 	assert.Nil(t, type15.Validate())
 
 	var type16 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type16.Validate())
-	type16 = "SPOT"
+	type16 = "APDS"
 	assert.Nil(t, type16.Validate())
 
 	var type17 ExternalDocumentLineType1Code
@@ -193,22 +195,26 @@ func TestTypes(t *testing.T) {
 
 	var type18 ExternalGarnishmentType1Code
 	assert.NotNil(t, type18.Validate())
-	type18 = "SPOT"
+	type18 = "GNCS"
 	assert.Nil(t, type18.Validate())
 
 	var type19 ExternalLocalInstrument1Code
 	assert.NotNil(t, type19.Validate())
 	type19 = "SPOT"
+	assert.NotNil(t, type19.Validate())
+	type19 = "INST"
 	assert.Nil(t, type19.Validate())
 
 	var type20 ExternalServiceLevel1Code
 	assert.NotNil(t, type20.Validate())
 	type20 = "SPOT"
+	assert.NotNil(t, type20.Validate())
+	type20 = "SEPA"
 	assert.Nil(t, type20.Validate())
 
 	var type21 ExternalTaxAmountType1Code
 	assert.NotNil(t, type21.Validate())
-	type21 = "SPOT"
+	type21 = "CITY"
 	assert.Nil(t, type21.Validate())
 
 	var type22 Priority2Code
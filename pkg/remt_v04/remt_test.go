@@ -28,7 +28,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.Nil(t, CreditorReferenceInformation2{}.Validate())
 	assert.NotNil(t, CreditorReferenceType1Choice{}.Validate())
 	assert.NotNil(t, CreditorReferenceType2{}.Validate())
-	assert.Nil(t, DateAndDateTime2Choice{}.Validate())
+	assert.NotNil(t, DateAndDateTime2Choice{}.Validate())
 	assert.NotNil(t, DateAndPlaceOfBirth1{}.Validate())
 	assert.Nil(t, DatePeriod2{}.Validate())
 	assert.NotNil(t, DiscountAmountAndType1{}.Validate())
@@ -56,7 +56,7 @@ func TestNestedTypes(t *testing.T) {
 	assert.NotNil(t, OrganisationIdentificationSchemeName1Choice{}.Validate())
 	assert.NotNil(t, OriginalPaymentInformation8{}.Validate())
 	assert.NotNil(t, OtherContact1{}.Validate())
-	assert.Nil(t, Party38Choice{}.Validate())
+	assert.NotNil(t, Party38Choice{}.Validate())
 	assert.Nil(t, PartyIdentification135{}.Validate())
 	assert.Nil(t, PaymentTypeInformation26{}.Validate())
 	assert.Nil(t, PersonIdentification13{}.Validate())
@@ -91,27 +91,29 @@ func TestNestedTypes(t *testing.T) {
 func TestTypes(t *testing.T) {
 	var type1 ExternalAccountIdentification1Code
 	assert.NotNil(t, type1.Validate())
-	type1 = "test"
+	type1 = "AIIN"
 	assert.Nil(t, type1.Validate())
 
 	var type2 ExternalCashAccountType1Code
 	assert.NotNil(t, type2.Validate())
-	type2 = "test"
+	type2 = "CACC"
 	assert.Nil(t, type2.Validate())
 
 	var type3 ExternalCategoryPurpose1Code
 	assert.NotNil(t, type3.Validate())
-	type3 = "test"
+	type3 = "BONU"
 	assert.Nil(t, type3.Validate())
 
 	var type4 ExternalClearingSystemIdentification1Code
 	assert.NotNil(t, type4.Validate())
 	type4 = "test"
+	assert.NotNil(t, type4.Validate())
+	type4 = "USABA"
 	assert.Nil(t, type4.Validate())
 
 	var type5 ExternalDiscountAmountType1Code
 	assert.NotNil(t, type5.Validate())
-	type5 = "test"
+	type5 = "APDS"
 	assert.Nil(t, type5.Validate())
 
 	var type6 ExternalDocumentLineType1Code
@@ -126,22 +128,24 @@ func TestTypes(t *testing.T) {
 
 	var type8 ExternalGarnishmentType1Code
 	assert.NotNil(t, type8.Validate())
-	type8 = "test"
+	type8 = "GNCS"
 	assert.Nil(t, type8.Validate())
 
 	var type9 ExternalLocalInstrument1Code
 	assert.NotNil(t, type9.Validate())
 	type9 = "test"
+	assert.NotNil(t, type9.Validate())
+	type9 = "INST"
 	assert.Nil(t, type9.Validate())
 
 	var type10 ExternalOrganisationIdentification1Code
 	assert.NotNil(t, type10.Validate())
-	type10 = "test"
+	type10 = "BANK"
 	assert.Nil(t, type10.Validate())
 
 	var type11 ExternalPersonIdentification1Code
 	assert.NotNil(t, type11.Validate())
-	type11 = "test"
+	type11 = "ARNU"
 	assert.Nil(t, type11.Validate())
 
 	var type12 ExternalProxyAccountType1Code
@@ -152,11 +156,13 @@ func TestTypes(t *testing.T) {
 	var type13 ExternalServiceLevel1Code
 	assert.NotNil(t, type13.Validate())
 	type13 = "test"
+	assert.NotNil(t, type13.Validate())
+	type13 = "SEPA"
 	assert.Nil(t, type13.Validate())
 
 	var type14 ExternalTaxAmountType1Code
 	assert.NotNil(t, type14.Validate())
-	type14 = "test"
+	type14 = "CITY"
 	assert.Nil(t, type14.Validate())
 
 	var type15 TaxRecordPeriod1Code
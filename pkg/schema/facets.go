@@ -0,0 +1,161 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+const xsdNamespacePrefix = "xs:"
+
+var (
+	timezonePattern   = `(Z|[+-][0-9]{2}:[0-9]{2})?`
+	datePattern       = regexp.MustCompile(`^(-?[0-9]{4,})-([0-9]{2})-([0-9]{2})` + timezonePattern + `$`)
+	dateTimePattern   = regexp.MustCompile(`^(-?[0-9]{4,})-([0-9]{2})-([0-9]{2})T([0-9]{2}):([0-9]{2}):([0-9]{2})(\.[0-9]+)?` + timezonePattern + `$`)
+	timePattern       = regexp.MustCompile(`^([0-9]{2}):([0-9]{2}):([0-9]{2})(\.[0-9]+)?` + timezonePattern + `$`)
+	gYearMonthPattern = regexp.MustCompile(`^-?[0-9]{4,}-(0[1-9]|1[0-2])` + timezonePattern + `$`)
+	gYearPattern      = regexp.MustCompile(`^-?[0-9]{4,}` + timezonePattern + `$`)
+)
+
+// checkBuiltin returns the name of the built-in XSD type the value doesn't conform to
+func checkBuiltin(base, value string) string {
+	switch strings.TrimPrefix(base, xsdNamespacePrefix) {
+	case "decimal":
+		if _, err := common.ParseDecimal(value); err != nil {
+			return "decimal"
+		}
+	case "boolean":
+		switch value {
+		case "true", "false", "1", "0":
+		default:
+			return "boolean"
+		}
+	case "date":
+		m := datePattern.FindStringSubmatch(value)
+		if m == nil || !validDate(m[1], m[2], m[3]) {
+			return "date"
+		}
+	case "dateTime":
+		m := dateTimePattern.FindStringSubmatch(value)
+		if m == nil || !validDate(m[1], m[2], m[3]) || !validTime(m[4], m[5], m[6]) {
+			return "dateTime"
+		}
+	case "time":
+		m := timePattern.FindStringSubmatch(value)
+		if m == nil || !validTime(m[1], m[2], m[3]) {
+			return "time"
+		}
+	case "gYearMonth":
+		if !gYearMonthPattern.MatchString(value) {
+			return "gYearMonth"
+		}
+	case "gYear":
+		if !gYearPattern.MatchString(value) {
+			return "gYear"
+		}
+	}
+	return ""
+}
+
+func validDate(year, month, day string) bool {
+	year = strings.TrimPrefix(year, "-")
+	if len(year) > 4 {
+		// time.Parse handles 4 digit years only, the month and day still have to be valid
+		year = "2000"
+	}
+	_, err := time.Parse("2006-01-02", year+"-"+month+"-"+day)
+	return err == nil
+}
+
+func validTime(hour, minute, second string) bool {
+	if hour == "24" && minute == "00" && second == "00" {
+		return true
+	}
+	_, err := time.Parse("15:04:05", hour+":"+minute+":"+second)
+	return err == nil
+}
+
+// check returns the broken rule of the simple type, or an empty string when the value is valid
+func (st *simpleType) check(value string) string {
+	if st.base != "xs:string" {
+		// every built-in type but string collapses white space
+		value = strings.TrimSpace(value)
+	}
+
+	if rule := checkBuiltin(st.base, value); rule != "" {
+		return rule
+	}
+
+	if len(st.patterns) > 0 {
+		matched := false
+		for _, reg := range st.patterns {
+			if reg.MatchString(value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Sprintf("pattern (%s)", strings.Join(st.patternSource, "|"))
+		}
+	}
+
+	if len(st.enumeration) > 0 {
+		matched := false
+		for _, enum := range st.enumeration {
+			if value == enum {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Sprintf("enumeration (%s)", strings.Join(st.enumeration, ", "))
+		}
+	}
+
+	length := utf8.RuneCountInString(value)
+	if st.length != nil && length != *st.length {
+		return fmt.Sprintf("length (length:%d)", *st.length)
+	}
+	if (st.minLength != nil && length < *st.minLength) || (st.maxLength != nil && length > *st.maxLength) {
+		switch {
+		case st.minLength != nil && st.maxLength != nil:
+			return fmt.Sprintf("length (minLength:%d, maxLength:%d)", *st.minLength, *st.maxLength)
+		case st.minLength != nil:
+			return fmt.Sprintf("length (minLength:%d)", *st.minLength)
+		default:
+			return fmt.Sprintf("length (maxLength:%d)", *st.maxLength)
+		}
+	}
+
+	if st.base == "xs:decimal" {
+		d, _ := common.ParseDecimal(value)
+		if st.totalDigits != nil && d.TotalDigits() > *st.totalDigits {
+			return fmt.Sprintf("totalDigits (%d)", *st.totalDigits)
+		}
+		if st.fractionDigits != nil && d.FractionDigits() > *st.fractionDigits {
+			return fmt.Sprintf("fractionDigits (%d)", *st.fractionDigits)
+		}
+		if st.minInclusive != nil && d.Cmp(*st.minInclusive) < 0 {
+			return fmt.Sprintf("minInclusive (%s)", st.minInclusive)
+		}
+		if st.maxInclusive != nil && d.Cmp(*st.maxInclusive) > 0 {
+			return fmt.Sprintf("maxInclusive (%s)", st.maxInclusive)
+		}
+		if st.minExclusive != nil && d.Cmp(*st.minExclusive) <= 0 {
+			return fmt.Sprintf("minExclusive (%s)", st.minExclusive)
+		}
+		if st.maxExclusive != nil && d.Cmp(*st.maxExclusive) >= 0 {
+			return fmt.Sprintf("maxExclusive (%s)", st.maxExclusive)
+		}
+	}
+
+	return ""
+}
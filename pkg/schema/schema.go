@@ -0,0 +1,298 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package schema validates raw ISO 20022 XML against the official XSD message definitions.
+//
+// Only the XSD subset used by the ISO 20022 message definitions is supported: global elements,
+// complex types built from a sequence or a choice of elements (or a single wildcard), simple content
+// extensions with attributes, and simple types restricting a built-in type with pattern, enumeration,
+// length, digits and range facets.
+package schema
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/moov-io/iso20022/pkg/common"
+)
+
+const unbounded = -1
+
+// Schema is a compiled XSD message definition
+type Schema struct {
+	// Namespace is the target namespace of the schema, e.g. urn:iso:std:iso:20022:tech:xsd:pacs.008.001.09
+	Namespace string
+
+	elements     map[string]string
+	complexTypes map[string]*complexType
+	simpleTypes  map[string]*simpleType
+}
+
+type particle struct {
+	name      string
+	typeName  string
+	min       int
+	max       int
+	wildcard  bool
+	namespace string
+}
+
+func (p particle) matches(name xml.Name, targetNamespace string) bool {
+	if p.wildcard {
+		switch p.namespace {
+		case "", "##any":
+			return true
+		case "##other":
+			return name.Space != targetNamespace
+		case "##targetNamespace":
+			return name.Space == targetNamespace
+		}
+		return name.Space == p.namespace
+	}
+	return name.Local == p.name && name.Space == targetNamespace
+}
+
+type attribute struct {
+	name     string
+	typeName string
+	required bool
+}
+
+type complexType struct {
+	name       string
+	choice     bool
+	particles  []particle
+	simple     bool
+	base       string
+	attributes []attribute
+}
+
+type simpleType struct {
+	name           string
+	base           string
+	patterns       []*regexp.Regexp
+	patternSource  []string
+	enumeration    []string
+	length         *int
+	minLength      *int
+	maxLength      *int
+	totalDigits    *int
+	fractionDigits *int
+	minInclusive   *common.Decimal
+	maxInclusive   *common.Decimal
+	minExclusive   *common.Decimal
+	maxExclusive   *common.Decimal
+}
+
+// raw XSD documents
+
+type xsdSchema struct {
+	TargetNamespace string           `xml:"targetNamespace,attr"`
+	Elements        []xsdParticle    `xml:"element"`
+	ComplexTypes    []xsdComplexType `xml:"complexType"`
+	SimpleTypes     []xsdSimpleType  `xml:"simpleType"`
+}
+
+type xsdParticle struct {
+	XMLName   xml.Name
+	Name      string `xml:"name,attr"`
+	Type      string `xml:"type,attr"`
+	MinOccurs string `xml:"minOccurs,attr"`
+	MaxOccurs string `xml:"maxOccurs,attr"`
+	Namespace string `xml:"namespace,attr"`
+}
+
+type xsdGroup struct {
+	Particles []xsdParticle `xml:",any"`
+}
+
+type xsdAttribute struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+	Use  string `xml:"use,attr"`
+}
+
+type xsdComplexType struct {
+	Name          string    `xml:"name,attr"`
+	Sequence      *xsdGroup `xml:"sequence"`
+	Choice        *xsdGroup `xml:"choice"`
+	SimpleContent *struct {
+		Extension struct {
+			Base       string         `xml:"base,attr"`
+			Attributes []xsdAttribute `xml:"attribute"`
+		} `xml:"extension"`
+	} `xml:"simpleContent"`
+}
+
+type xsdFacet struct {
+	XMLName xml.Name
+	Value   string `xml:"value,attr"`
+}
+
+type xsdSimpleType struct {
+	Name        string `xml:"name,attr"`
+	Restriction struct {
+		Base   string     `xml:"base,attr"`
+		Facets []xsdFacet `xml:",any"`
+	} `xml:"restriction"`
+}
+
+// Parse reads and compiles a XSD message definition
+func Parse(r io.Reader) (*Schema, error) {
+	var raw xsdSchema
+	if err := xml.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+	if raw.TargetNamespace == "" {
+		return nil, fmt.Errorf("the schema has no target namespace")
+	}
+
+	s := &Schema{
+		Namespace:    raw.TargetNamespace,
+		elements:     make(map[string]string),
+		complexTypes: make(map[string]*complexType),
+		simpleTypes:  make(map[string]*simpleType),
+	}
+
+	for _, elm := range raw.Elements {
+		s.elements[elm.Name] = elm.Type
+	}
+
+	for _, raw := range raw.ComplexTypes {
+		ct := &complexType{name: raw.Name}
+		var group *xsdGroup
+		switch {
+		case raw.Sequence != nil:
+			group = raw.Sequence
+		case raw.Choice != nil:
+			group = raw.Choice
+			ct.choice = true
+		case raw.SimpleContent != nil:
+			ct.simple = true
+			ct.base = raw.SimpleContent.Extension.Base
+			for _, attr := range raw.SimpleContent.Extension.Attributes {
+				ct.attributes = append(ct.attributes, attribute{
+					name:     attr.Name,
+					typeName: attr.Type,
+					required: attr.Use == "required",
+				})
+			}
+		}
+		if group != nil {
+			for _, p := range group.Particles {
+				compiled, err := compileParticle(p)
+				if err != nil {
+					return nil, fmt.Errorf("complex type %s: %v", raw.Name, err)
+				}
+				ct.particles = append(ct.particles, compiled)
+			}
+		}
+		s.complexTypes[ct.name] = ct
+	}
+
+	for _, raw := range raw.SimpleTypes {
+		st, err := compileSimpleType(raw)
+		if err != nil {
+			return nil, fmt.Errorf("simple type %s: %v", raw.Name, err)
+		}
+		s.simpleTypes[st.name] = st
+	}
+
+	return s, nil
+}
+
+func compileParticle(raw xsdParticle) (particle, error) {
+	p := particle{
+		name:      raw.Name,
+		typeName:  raw.Type,
+		min:       1,
+		max:       1,
+		wildcard:  raw.XMLName.Local == "any",
+		namespace: raw.Namespace,
+	}
+	if !p.wildcard && raw.XMLName.Local != "element" {
+		return p, fmt.Errorf("unsupported particle %s", raw.XMLName.Local)
+	}
+
+	var err error
+	if raw.MinOccurs != "" {
+		if p.min, err = strconv.Atoi(raw.MinOccurs); err != nil {
+			return p, err
+		}
+	}
+	if raw.MaxOccurs == "unbounded" {
+		p.max = unbounded
+	} else if raw.MaxOccurs != "" {
+		if p.max, err = strconv.Atoi(raw.MaxOccurs); err != nil {
+			return p, err
+		}
+	}
+	return p, nil
+}
+
+func compileSimpleType(raw xsdSimpleType) (*simpleType, error) {
+	st := &simpleType{
+		name: raw.Name,
+		base: raw.Restriction.Base,
+	}
+
+	intFacet := func(value string) (*int, error) {
+		n, err := strconv.Atoi(value)
+		return &n, err
+	}
+	decimalFacet := func(value string) (*common.Decimal, error) {
+		d, err := common.ParseDecimal(value)
+		return &d, err
+	}
+
+	var err error
+	for _, facet := range raw.Restriction.Facets {
+		switch facet.XMLName.Local {
+		case "pattern":
+			var reg *regexp.Regexp
+			// XSD patterns are implicitly anchored
+			reg, err = regexp.Compile(`^(?:` + facet.Value + `)$`)
+			st.patterns = append(st.patterns, reg)
+			st.patternSource = append(st.patternSource, facet.Value)
+		case "enumeration":
+			st.enumeration = append(st.enumeration, facet.Value)
+		case "length":
+			st.length, err = intFacet(facet.Value)
+		case "minLength":
+			st.minLength, err = intFacet(facet.Value)
+		case "maxLength":
+			st.maxLength, err = intFacet(facet.Value)
+		case "totalDigits":
+			st.totalDigits, err = intFacet(facet.Value)
+		case "fractionDigits":
+			st.fractionDigits, err = intFacet(facet.Value)
+		case "minInclusive":
+			st.minInclusive, err = decimalFacet(facet.Value)
+		case "maxInclusive":
+			st.maxInclusive, err = decimalFacet(facet.Value)
+		case "minExclusive":
+			st.minExclusive, err = decimalFacet(facet.Value)
+		case "maxExclusive":
+			st.maxExclusive, err = decimalFacet(facet.Value)
+		default:
+			err = fmt.Errorf("unsupported facet %s", facet.XMLName.Local)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return st, nil
+}
+
+// RootElements returns the names of the global elements of the schema
+func (s *Schema) RootElements() []string {
+	names := make([]string, 0, len(s.elements))
+	for name := range s.elements {
+		names = append(names, name)
+	}
+	return names
+}
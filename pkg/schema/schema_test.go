@@ -0,0 +1,203 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const testSchema = `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns="urn:test" xmlns:xs="http://www.w3.org/2001/XMLSchema" elementFormDefault="qualified" targetNamespace="urn:test">
+    <xs:element name="Document" type="Document"/>
+    <xs:complexType name="Document">
+        <xs:sequence>
+            <xs:element name="Id" type="Max5Text"/>
+            <xs:element maxOccurs="1" minOccurs="0" name="Cd" type="Code"/>
+            <xs:element maxOccurs="2" minOccurs="1" name="Amt" type="Amount"/>
+            <xs:element maxOccurs="1" minOccurs="0" name="Pty" type="Party1Choice"/>
+            <xs:element maxOccurs="1" minOccurs="0" name="Dt" type="ISODate"/>
+            <xs:element maxOccurs="1" minOccurs="0" name="Ext" type="Extension"/>
+        </xs:sequence>
+    </xs:complexType>
+    <xs:complexType name="Party1Choice">
+        <xs:choice>
+            <xs:element name="Nm" type="Max5Text"/>
+            <xs:element name="BIC" type="BICIdentifier"/>
+        </xs:choice>
+    </xs:complexType>
+    <xs:complexType name="Extension">
+        <xs:sequence>
+            <xs:any namespace="##any" processContents="lax"/>
+        </xs:sequence>
+    </xs:complexType>
+    <xs:complexType name="Amount">
+        <xs:simpleContent>
+            <xs:extension base="Amount_SimpleType">
+                <xs:attribute name="Ccy" type="CurrencyCode" use="required"/>
+            </xs:extension>
+        </xs:simpleContent>
+    </xs:complexType>
+    <xs:simpleType name="Amount_SimpleType">
+        <xs:restriction base="xs:decimal">
+            <xs:fractionDigits value="2"/>
+            <xs:totalDigits value="6"/>
+            <xs:minInclusive value="0"/>
+        </xs:restriction>
+    </xs:simpleType>
+    <xs:simpleType name="CurrencyCode">
+        <xs:restriction base="xs:string">
+            <xs:pattern value="[A-Z]{3,3}"/>
+        </xs:restriction>
+    </xs:simpleType>
+    <xs:simpleType name="BICIdentifier">
+        <xs:restriction base="xs:string">
+            <xs:pattern value="[A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}"/>
+        </xs:restriction>
+    </xs:simpleType>
+    <xs:simpleType name="Code">
+        <xs:restriction base="xs:string">
+            <xs:enumeration value="CRDT"/>
+            <xs:enumeration value="DBIT"/>
+        </xs:restriction>
+    </xs:simpleType>
+    <xs:simpleType name="ISODate">
+        <xs:restriction base="xs:date"/>
+    </xs:simpleType>
+    <xs:simpleType name="Max5Text">
+        <xs:restriction base="xs:string">
+            <xs:minLength value="1"/>
+            <xs:maxLength value="5"/>
+        </xs:restriction>
+    </xs:simpleType>
+</xs:schema>`
+
+func parseTestSchema(t *testing.T) *Schema {
+	s, err := Parse(strings.NewReader(testSchema))
+	assert.Nil(t, err)
+	assert.Equal(t, "urn:test", s.Namespace)
+	assert.Equal(t, []string{"Document"}, s.RootElements())
+	return s
+}
+
+func validationErrors(t *testing.T, err error) utils.ValidationErrors {
+	var errs utils.ValidationErrors
+	assert.True(t, errors.As(err, &errs), "%v", err)
+	return errs
+}
+
+func TestValidSchemaDocument(t *testing.T) {
+	s := parseTestSchema(t)
+
+	doc := `<Document xmlns="urn:test" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+	<Id>ID1</Id>
+	<Cd>CRDT</Cd>
+	<Amt Ccy="EUR">1000.50</Amt>
+	<Amt Ccy="USD">0</Amt>
+	<Pty><BIC>DEUTDEFF</BIC></Pty>
+	<Dt>2022-02-28</Dt>
+	<Ext><Anything xmlns="urn:other"><Nested/></Anything></Ext>
+</Document>`
+	assert.Nil(t, s.Validate([]byte(doc)))
+}
+
+func TestSchemaStructure(t *testing.T) {
+	s := parseTestSchema(t)
+
+	doc := `<Document xmlns="urn:test">
+	<Amt Ccy="EUR">1</Amt>
+	<Id>ID1</Id>
+	<Amt Ccy="EUR">2</Amt>
+	<Amt Ccy="EUR">3</Amt>
+	<Unknown>x</Unknown>
+	<Pty><Nm>A</Nm><BIC>DEUTDEFF</BIC></Pty>
+</Document>`
+	errs := validationErrors(t, s.Validate([]byte(doc)))
+	assert.Equal(t, utils.ValidationErrors{
+		{Path: "/Document/Id", Type: "Document", Rule: "element order (Id must precede Amt)", Line: 3, Column: 2},
+		{Path: "/Document/Amt[3]", Type: "Amount", Rule: "cardinality (maxOccurs:2)", Line: 5, Column: 2},
+		{Path: "/Document/Unknown", Type: "Document", Rule: "content (unexpected element)", Line: 6, Column: 2},
+		{Path: "/Document/Pty/BIC", Type: "Party1Choice", Rule: "choice (exactly one of Nm, BIC)", Line: 7, Column: 17},
+	}, errs)
+
+	doc = `<Document xmlns="urn:test"><Cd>CRDT</Cd><Pty></Pty></Document>`
+	errs = validationErrors(t, s.Validate([]byte(doc)))
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, "/Document/Pty", errs[0].Path)
+	assert.Equal(t, "choice (exactly one of Nm, BIC)", errs[0].Rule)
+	assert.Equal(t, "/Document/Id", errs[1].Path)
+	assert.Equal(t, "cardinality (minOccurs:1)", errs[1].Rule)
+	assert.Equal(t, "1:52: /Document/Amt: Amount has invalid cardinality (minOccurs:1) (value: \"\")", errs[2].Error())
+
+	errs = validationErrors(t, s.Validate([]byte(`<Document xmlns="urn:other"/>`)))
+	assert.Equal(t, "content (unexpected root element)", errs[0].Rule)
+
+	_, err := Parse(strings.NewReader(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"/>`))
+	assert.NotNil(t, err)
+	assert.NotNil(t, s.Validate([]byte(`<Document xmlns="urn:test"><Id>`)))
+}
+
+func TestSchemaFacets(t *testing.T) {
+	s := parseTestSchema(t)
+
+	doc := `<Document xmlns="urn:test">
+	<Id>TOOLONG</Id>
+	<Cd>XXXX</Cd>
+	<Amt Ccy="eur" Foo="bar">1.234</Amt>
+	<Amt>-1</Amt>
+	<Pty><BIC>xxDEUTDEFFxx</BIC></Pty>
+	<Dt>2022-02-30</Dt>
+</Document>`
+	errs := validationErrors(t, s.Validate([]byte(doc)))
+
+	rules := make(map[string]string)
+	for _, verr := range errs {
+		rules[verr.Path] = verr.Rule
+	}
+	assert.Equal(t, map[string]string{
+		"/Document/Id":          "length (minLength:1, maxLength:5)",
+		"/Document/Cd":          "enumeration (CRDT, DBIT)",
+		"/Document/Amt[1]/@Ccy": "pattern ([A-Z]{3,3})",
+		"/Document/Amt[1]/@Foo": "content (unexpected attribute)",
+		"/Document/Amt[1]":      "fractionDigits (2)",
+		"/Document/Amt[2]/@Ccy": "content (missing required attribute)",
+		"/Document/Amt[2]":      "minInclusive (0)",
+		"/Document/Pty/BIC":     "pattern ([A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1})",
+		"/Document/Dt":          "date",
+	}, rules)
+	assert.Equal(t, 9, len(errs))
+}
+
+func TestShippedSpecifications(t *testing.T) {
+	set := Default()
+	assert.Contains(t, set.Namespaces(), "urn:iso:std:iso:20022:tech:xsd:pacs.002.001.11")
+	assert.Contains(t, set.Namespaces(), "urn:iso:std:iso:20022:tech:xsd:pain.001.001.10")
+
+	for _, namespace := range set.Namespaces() {
+		_, err := set.Schema(namespace)
+		assert.Nil(t, err, namespace)
+	}
+
+	buf, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "valid_pacs_v11.xml"))
+	assert.Nil(t, err)
+	assert.Nil(t, Validate(buf))
+
+	invalid := strings.Replace(string(buf), "<MsgId>MsgId</MsgId>", "<MsgId>MsgId</MsgId><Extra/>", 1)
+	errs := validationErrors(t, Validate([]byte(invalid)))
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "/Document/FIToFIPmtStsRpt/GrpHdr/Extra", errs[0].Path)
+	assert.Equal(t, 4, errs[0].Line)
+
+	_, err = set.Schema("urn:iso:std:iso:20022:tech:xsd:pacs.008.001.01")
+	assert.NotNil(t, err)
+	assert.NotNil(t, Validate([]byte(`<Document/>`)))
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"sync"
+
+	"github.com/moov-io/iso20022/docs/specifications"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+type entry struct {
+	once   sync.Once
+	load   func() (*Schema, error)
+	schema *Schema
+	err    error
+}
+
+// Set is a collection of schemas indexed by target namespace.
+// Schemas added from a file system are compiled the first time they are used.
+type Set struct {
+	mu      sync.RWMutex
+	entries map[string]*entry
+}
+
+// NewSet returns an empty schema set
+func NewSet() *Set {
+	return &Set{entries: make(map[string]*entry)}
+}
+
+// Add registers a compiled schema, replacing any schema of the same namespace
+func (s *Set) Add(schema *Schema) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[schema.Namespace] = &entry{
+		load: func() (*Schema, error) { return schema, nil },
+	}
+}
+
+// AddFS registers every .xsd file of fsys under its target namespace.
+// Only the root element is read here, the schema is compiled on first use.
+func (s *Set) AddFS(fsys fs.FS) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(name) != ".xsd" {
+			return err
+		}
+		namespace, err := targetNamespace(fsys, name)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		s.entries[namespace] = &entry{
+			load: func() (*Schema, error) {
+				file, err := fsys.Open(name)
+				if err != nil {
+					return nil, err
+				}
+				defer file.Close()
+				schema, err := Parse(file)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %v", name, err)
+				}
+				return schema, nil
+			},
+		}
+		return nil
+	})
+}
+
+func targetNamespace(fsys fs.FS, name string) (string, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	decoder := xml.NewDecoder(file)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			for _, attr := range start.Attr {
+				if attr.Name.Local == "targetNamespace" {
+					return attr.Value, nil
+				}
+			}
+			return "", fmt.Errorf("the schema has no target namespace")
+		}
+	}
+}
+
+// Namespaces returns the sorted namespaces of the set
+func (s *Set) Namespaces() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	namespaces := make([]string, 0, len(s.entries))
+	for namespace := range s.entries {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// Schema returns the compiled schema of the namespace
+func (s *Set) Schema(namespace string) (*Schema, error) {
+	s.mu.RLock()
+	e := s.entries[namespace]
+	s.mu.RUnlock()
+	if e == nil {
+		return nil, utils.NewErrSchemaNotFound(namespace)
+	}
+	e.once.Do(func() {
+		e.schema, e.err = e.load()
+	})
+	return e.schema, e.err
+}
+
+// Validate checks a raw XML document against the schema of its root element namespace
+func (s *Set) Validate(buf []byte) error {
+	buf, err := utils.DecodeToUTF8(buf)
+	if err != nil {
+		return err
+	}
+	namespace, err := rootNamespace(buf)
+	if err != nil {
+		return err
+	}
+	schema, err := s.Schema(namespace)
+	if err != nil {
+		return err
+	}
+	return schema.Validate(buf)
+}
+
+func rootNamespace(buf []byte) (string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(buf))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			if start.Name.Space == "" {
+				return "", utils.NewErrOmittedNameSpace()
+			}
+			return start.Name.Space, nil
+		}
+	}
+}
+
+var (
+	defaultSet     *Set
+	defaultSetOnce sync.Once
+)
+
+// Default returns the set of the specifications shipped in docs/specifications
+func Default() *Set {
+	defaultSetOnce.Do(func() {
+		defaultSet = NewSet()
+		if err := defaultSet.AddFS(specifications.Files); err != nil {
+			panic(err)
+		}
+	})
+	return defaultSet
+}
+
+// Validate checks a raw XML document against the shipped specification of its namespace
+func Validate(buf []byte) error {
+	return Default().Validate(buf)
+}
@@ -0,0 +1,298 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+type validator struct {
+	schema     *Schema
+	buf        []byte
+	decoder    *xml.Decoder
+	lineStarts []int
+	errs       utils.ValidationErrors
+}
+
+// Validate checks a raw XML document against the schema.
+// Every violation is returned together as utils.ValidationErrors, other errors mean that the document is not well-formed.
+// Documents in another charset than UTF-8 are converted first, see utils.DecodeToUTF8.
+func (s *Schema) Validate(buf []byte) error {
+	buf, err := utils.DecodeToUTF8(buf)
+	if err != nil {
+		return err
+	}
+	v := &validator{
+		schema:  s,
+		buf:     buf,
+		decoder: xml.NewDecoder(bytes.NewReader(buf)),
+	}
+
+	start, offset, err := v.nextStart()
+	if err != nil {
+		return err
+	}
+
+	path := "/" + start.Name.Local
+	typeName, found := s.elements[start.Name.Local]
+	if !found || start.Name.Space != s.Namespace {
+		v.report(path, "", "content (unexpected root element)", start.Name.Space, offset)
+		return v.errs
+	}
+
+	if err = v.element(start, typeName, path, offset); err != nil {
+		return err
+	}
+	if len(v.errs) > 0 {
+		return v.errs
+	}
+	return nil
+}
+
+// position returns the line and the column of a byte offset, it is only computed for reported violations
+func (v *validator) position(offset int64) (int, int) {
+	if v.lineStarts == nil {
+		v.lineStarts = []int{0}
+		for i, c := range v.buf {
+			if c == '\n' {
+				v.lineStarts = append(v.lineStarts, i+1)
+			}
+		}
+	}
+	line := sort.Search(len(v.lineStarts), func(i int) bool { return v.lineStarts[i] > int(offset) })
+	start := v.lineStarts[line-1]
+	end := int(offset)
+	if end > len(v.buf) {
+		end = len(v.buf)
+	}
+	return line, utf8.RuneCount(v.buf[start:end]) + 1
+}
+
+func (v *validator) report(path, typeName, rule, value string, offset int64) {
+	line, column := v.position(offset)
+	v.errs = append(v.errs, utils.ValidationError{
+		Path:   path,
+		Type:   typeName,
+		Rule:   rule,
+		Value:  value,
+		Line:   line,
+		Column: column,
+	})
+}
+
+// nextStart returns the first start element of the document
+func (v *validator) nextStart() (xml.StartElement, int64, error) {
+	for {
+		offset := v.decoder.InputOffset()
+		token, err := v.decoder.Token()
+		if err != nil {
+			return xml.StartElement{}, 0, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start, offset, nil
+		}
+	}
+}
+
+// element validates the attributes and content of an element whose start tag has just been read
+func (v *validator) element(start xml.StartElement, typeName, path string, pos int64) error {
+	if st, ok := v.schema.simpleTypes[typeName]; ok {
+		v.attributes(start, nil, path, typeName, pos)
+		return v.simpleContent(st, typeName, path, pos)
+	}
+
+	ct, ok := v.schema.complexTypes[typeName]
+	if !ok {
+		// types of other schemas are not known, the content is accepted as is
+		return v.decoder.Skip()
+	}
+
+	v.attributes(start, ct.attributes, path, typeName, pos)
+	if ct.simple {
+		st, ok := v.schema.simpleTypes[ct.base]
+		if !ok {
+			st = &simpleType{name: ct.base, base: ct.base}
+		}
+		return v.simpleContent(st, typeName, path, pos)
+	}
+	return v.complexContent(ct, path, pos)
+}
+
+func (v *validator) attributes(start xml.StartElement, declared []attribute, path, typeName string, pos int64) {
+	seen := make(map[string]bool)
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") || attr.Name.Space == xsiNamespace {
+			continue
+		}
+
+		var decl *attribute
+		for i := range declared {
+			if attr.Name.Space == "" && declared[i].name == attr.Name.Local {
+				decl = &declared[i]
+			}
+		}
+		if decl == nil {
+			v.report(path+"/@"+attr.Name.Local, typeName, "content (unexpected attribute)", attr.Value, pos)
+			continue
+		}
+
+		seen[decl.name] = true
+		if st, ok := v.schema.simpleTypes[decl.typeName]; ok {
+			if rule := st.check(attr.Value); rule != "" {
+				v.report(path+"/@"+decl.name, decl.typeName, rule, attr.Value, pos)
+			}
+		}
+	}
+
+	for _, decl := range declared {
+		if decl.required && !seen[decl.name] {
+			v.report(path+"/@"+decl.name, typeName, "content (missing required attribute)", "", pos)
+		}
+	}
+}
+
+func (v *validator) simpleContent(st *simpleType, typeName, path string, pos int64) error {
+	var value strings.Builder
+	for {
+		offset := v.decoder.InputOffset()
+		token, err := v.decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.CharData:
+			value.Write(t)
+		case xml.StartElement:
+			v.report(path+"/"+t.Name.Local, typeName, "content (unexpected element)", "", offset)
+			if err = v.decoder.Skip(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			if rule := st.check(value.String()); rule != "" {
+				v.report(path, st.name, rule, value.String(), pos)
+			}
+			return nil
+		}
+	}
+}
+
+func (v *validator) complexContent(ct *complexType, path string, pos int64) error {
+	counts := make([]int, len(ct.particles))
+	current := 0
+	chosen := -1
+
+	for {
+		offset := v.decoder.InputOffset()
+		token, err := v.decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.CharData:
+			if text := strings.TrimSpace(string(t)); text != "" {
+				v.report(path, ct.name, "content (unexpected text)", text, offset)
+			}
+
+		case xml.StartElement:
+			index := -1
+			if ct.choice {
+				for j, p := range ct.particles {
+					if p.matches(t.Name, v.schema.Namespace) {
+						index = j
+						break
+					}
+				}
+				if index >= 0 && chosen >= 0 && index != chosen {
+					v.report(path+"/"+t.Name.Local, ct.name, fmt.Sprintf("choice (exactly one of %s)", strings.Join(ct.branchNames(), ", ")), "", offset)
+				} else if index >= 0 {
+					chosen = index
+				}
+			} else {
+				for j := current; j < len(ct.particles); j++ {
+					if ct.particles[j].matches(t.Name, v.schema.Namespace) {
+						index = j
+						break
+					}
+				}
+				if index >= 0 {
+					current = index
+				} else {
+					for j := 0; j < current; j++ {
+						if ct.particles[j].matches(t.Name, v.schema.Namespace) {
+							index = j
+							break
+						}
+					}
+					if index >= 0 {
+						v.report(path+"/"+t.Name.Local, ct.name, fmt.Sprintf("element order (%s must precede %s)", t.Name.Local, ct.particles[current].name), "", offset)
+					}
+				}
+			}
+
+			if index < 0 {
+				v.report(path+"/"+t.Name.Local, ct.name, "content (unexpected element)", "", offset)
+				if err = v.decoder.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+
+			p := ct.particles[index]
+			counts[index]++
+			childPath := path + "/" + t.Name.Local
+			if p.max != 1 {
+				childPath = fmt.Sprintf("%s[%d]", childPath, counts[index])
+			}
+			if p.max != unbounded && counts[index] > p.max {
+				v.report(childPath, p.typeName, fmt.Sprintf("cardinality (maxOccurs:%d)", p.max), "", offset)
+			}
+
+			if p.wildcard {
+				err = v.decoder.Skip()
+			} else {
+				err = v.element(t, p.typeName, childPath, offset)
+			}
+			if err != nil {
+				return err
+			}
+
+		case xml.EndElement:
+			if ct.choice {
+				if chosen < 0 && len(ct.particles) > 0 {
+					v.report(path, ct.name, fmt.Sprintf("choice (exactly one of %s)", strings.Join(ct.branchNames(), ", ")), "", offset)
+				} else if chosen >= 0 && counts[chosen] < ct.particles[chosen].min {
+					p := ct.particles[chosen]
+					v.report(path+"/"+p.name, p.typeName, fmt.Sprintf("cardinality (minOccurs:%d)", p.min), "", offset)
+				}
+			} else {
+				// missing elements are reported once the whole content has been seen,
+				// so that an element out of order isn't reported as missing as well
+				for k, p := range ct.particles {
+					if counts[k] < p.min && !p.wildcard {
+						v.report(path+"/"+p.name, p.typeName, fmt.Sprintf("cardinality (minOccurs:%d)", p.min), "", offset)
+					}
+				}
+			}
+			return nil
+		}
+	}
+}
+
+func (ct *complexType) branchNames() []string {
+	names := make([]string, 0, len(ct.particles))
+	for _, p := range ct.particles {
+		names = append(names, p.name)
+	}
+	return names
+}
@@ -6,6 +6,7 @@ package server_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/moov-io/base/config"
 	"github.com/moov-io/base/log"
@@ -22,4 +23,6 @@ func Test_ConfigLoading(t *testing.T) {
 	gc := &server.GlobalConfig{}
 	err := ConfigService.Load(gc)
 	require.Nil(t, err)
+	require.Equal(t, 720*time.Hour, gc.ISO20022.Store.Retention)
+	require.Equal(t, 24*time.Hour, gc.ISO20022.Duplicates.Window)
 }
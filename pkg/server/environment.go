@@ -9,12 +9,15 @@ import (
 	"database/sql"
 	"io/ioutil"
 	"path/filepath"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/moov-io/base/config"
 	"github.com/moov-io/base/database"
 	"github.com/moov-io/base/log"
 	"github.com/moov-io/base/stime"
+	"github.com/moov-io/iso20022/pkg/codes"
+	"github.com/moov-io/iso20022/pkg/duplicate"
 )
 
 // Environment - Contains everything thats been instantiated for this service.
@@ -23,6 +26,8 @@ type Environment struct {
 	Config       *Config
 	TimeService  *stime.TimeService
 	PublicRouter *mux.Router
+	Repository   Repository
+	Duplicates   *duplicate.Detector
 	Shutdown     func()
 }
 
@@ -43,28 +48,57 @@ func NewEnvironment(env *Environment) (*Environment, error) {
 		env.Config = &global.ISO20022
 	}
 
+	if env.Config.CodeSets != "" {
+		if err := codes.LoadFile(env.Config.CodeSets); err != nil {
+			return nil, err
+		}
+	}
+
 	//db setup
 	db, close, err := initializeDatabase(env.Logger, env.Config.Database)
 	if err != nil {
 		close()
 		return nil, err
 	}
-	_ = db // delete once used.
 
 	if env.TimeService == nil {
 		t := stime.NewSystemTimeService()
 		env.TimeService = &t
 	}
 
+	// document store
+	if env.Repository == nil {
+		env.Repository, err = NewRepository(db, *env.TimeService)
+		if err != nil {
+			close()
+			return nil, env.Logger.Fatal().LogErrorf("Error creating document store", err).Err()
+		}
+	}
+
+	// duplicate detection
+	if env.Duplicates == nil {
+		store, err := duplicate.NewSQLStore(db)
+		if err != nil {
+			close()
+			return nil, env.Logger.Fatal().LogErrorf("Error creating duplicate store", err).Err()
+		}
+		env.Duplicates = duplicate.NewDetector(store,
+			duplicate.WithWindow(env.Config.Duplicates.Window),
+			duplicate.WithThreshold(env.Config.Duplicates.Threshold),
+			duplicate.WithClock(*env.TimeService))
+	}
+	stopPurge := purgeDocuments(env.Logger, env.Repository, env.Duplicates, *env.TimeService, env.Config.Store)
+
 	// router
 	if env.PublicRouter == nil {
 		env.PublicRouter = mux.NewRouter()
 	}
 
 	// configure custom handlers
-	ConfigureHandlers(env.PublicRouter)
+	ConfigureHandlers(env.PublicRouter, WithRepository(env.Repository), WithDuplicateDetector(env.Duplicates))
 
 	env.Shutdown = func() {
+		stopPurge()
 		close()
 	}
 
@@ -80,6 +114,11 @@ func initializeDatabase(logger log.Logger, config database.DatabaseConfig) (*sql
 		return nil, cancelFunc, logger.Fatal().LogErrorf("Error creating database", err).Err()
 	}
 
+	// every connection to a SQLite database in memory opens a new database
+	if config.SQLite != nil && config.SQLite.Path == ":memory:" {
+		db.SetMaxOpenConns(1)
+	}
+
 	shutdown := func() {
 		logger.Info().Log("Shutting down the db")
 		cancelFunc()
@@ -101,3 +140,49 @@ func initializeDatabase(logger log.Logger, config database.DatabaseConfig) (*sql
 
 	return db, shutdown, err
 }
+
+// purgeDocuments deletes the documents older than the retention, and the fingerprints which left the window of
+// the detector, every purge interval until the returned function is called
+func purgeDocuments(logger log.Logger, repository Repository, detector *duplicate.Detector, clock stime.TimeService, config StoreConfig) func() {
+	if config.Retention <= 0 && detector == nil {
+		return func() {}
+	}
+	interval := config.PurgeInterval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if config.Retention > 0 {
+				deleted, err := repository.PurgeDocuments(clock.Now().Add(-config.Retention))
+				if err != nil {
+					logger.Error().LogErrorf("Error purging documents", err)
+				} else if deleted > 0 {
+					logger.Info().Logf("purged %d documents", deleted)
+				}
+			}
+			if detector != nil {
+				deleted, err := detector.Purge()
+				if err != nil {
+					logger.Error().LogErrorf("Error purging duplicate fingerprints", err)
+				} else if deleted > 0 {
+					logger.Info().Logf("purged %d duplicate fingerprints", deleted)
+				}
+			}
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
@@ -6,13 +6,19 @@ package server_test
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/go-kit/log"
+	"github.com/moov-io/base/database"
 	baseLog "github.com/moov-io/base/log"
+	"github.com/moov-io/base/stime"
+	"github.com/moov-io/iso20022/pkg/duplicate"
 	"github.com/moov-io/iso20022/pkg/server"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_Environment_Startup(t *testing.T) {
@@ -33,3 +39,58 @@ func Test_Environment_Startup(t *testing.T) {
 
 	t.Cleanup(shutdown)
 }
+
+func Test_Environment_CodeSets(t *testing.T) {
+	_, err := server.NewEnvironment(&server.Environment{
+		Config: &server.Config{CodeSets: filepath.Join(t.TempDir(), "missing.json")},
+	})
+	assert.Error(t, err)
+}
+
+func Test_Environment_Retention(t *testing.T) {
+	// the document is stored two hours ago
+	past := stime.NewStaticTimeService()
+	past.Add(-2 * time.Hour)
+	repository, err := server.NewRepository(testDatabase(t), past)
+	require.Nil(t, err)
+	raw, doc := readTestDocument(t, "valid_pain_v09.xml")
+	_, err = repository.SaveDocument(raw, doc)
+	require.Nil(t, err)
+
+	env, err := server.NewEnvironment(&server.Environment{
+		Repository: repository,
+		Config: &server.Config{
+			Database: database.DatabaseConfig{SQLite: &database.SQLiteConfig{Path: ":memory:"}},
+			Store:    server.StoreConfig{Retention: time.Hour, PurgeInterval: 10 * time.Millisecond},
+		},
+	})
+	require.Nil(t, err)
+	defer env.Shutdown()
+
+	assert.Eventually(t, func() bool {
+		documents, err := repository.SearchDocuments(server.DocumentQuery{})
+		return err == nil && len(documents) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func Test_Environment_Duplicates(t *testing.T) {
+	// the fingerprint is recorded two hours ago
+	store := duplicate.NewMemoryStore()
+	fingerprint := duplicate.Fingerprint{DocumentID: "original", MessageType: "pain.001", SeenAt: time.Now().Add(-2 * time.Hour)}
+	require.Nil(t, store.Save(fingerprint))
+
+	env, err := server.NewEnvironment(&server.Environment{
+		Duplicates: duplicate.NewDetector(store, duplicate.WithWindow(time.Hour)),
+		Config: &server.Config{
+			Database: database.DatabaseConfig{SQLite: &database.SQLiteConfig{Path: ":memory:"}},
+			Store:    server.StoreConfig{PurgeInterval: 10 * time.Millisecond},
+		},
+	})
+	require.Nil(t, err)
+	defer env.Shutdown()
+
+	assert.Eventually(t, func() bool {
+		candidates, err := store.Candidates(fingerprint, time.Time{})
+		return err == nil && len(candidates) == 0
+	}, time.Second, 10*time.Millisecond)
+}
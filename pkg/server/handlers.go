@@ -9,21 +9,27 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"errors"
-
+	"fmt"
 	"io"
 	"net/http"
 
 	"github.com/gorilla/mux"
 	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/duplicate"
 	"github.com/moov-io/iso20022/pkg/utils"
 )
 
 func outputError(w http.ResponseWriter, code int, err error) {
 	w.WriteHeader(code)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	json.NewEncoder(w).Encode(map[string]interface{}{
+	response := map[string]interface{}{
 		"error": err.Error(),
-	})
+	}
+	var verrs utils.ValidationErrors
+	if errors.As(err, &verrs) {
+		response["errors"] = verrs
+	}
+	json.NewEncoder(w).Encode(response)
 }
 
 func outputSuccess(w http.ResponseWriter, output string) {
@@ -34,19 +40,69 @@ func outputSuccess(w http.ResponseWriter, output string) {
 	})
 }
 
-func parseInputFromRequest(r *http.Request) (document.Iso20022Document, error) {
+func outputJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(body)
+}
+
+func parseInputFromRequest(r *http.Request) ([]byte, document.Iso20022Document, error) {
 	inputFile, _, err := r.FormFile("input")
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer inputFile.Close()
 
 	var input bytes.Buffer
 	if _, err = io.Copy(&input, inputFile); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return document.ParseIso20022Document(input.Bytes())
+	doc, err := document.ParseIso20022Document(input.Bytes())
+	return input.Bytes(), doc, err
+}
+
+// handlers - the endpoints depending on the options of ConfigureHandlers
+type handlers struct {
+	repository Repository
+	duplicates *duplicate.Detector
+}
+
+// HandlerOption configures the endpoints registered by ConfigureHandlers
+type HandlerOption func(*handlers)
+
+// WithRepository stores every submitted document in the repository and registers the endpoints of the stored documents
+func WithRepository(repository Repository) HandlerOption {
+	return func(h *handlers) {
+		h.repository = repository
+	}
+}
+
+// WithDuplicateDetector registers the endpoint reporting the duplicates of a document and lets the validator
+// check the duplicates
+func WithDuplicateDetector(detector *duplicate.Detector) HandlerOption {
+	return func(h *handlers) {
+		h.duplicates = detector
+	}
+}
+
+// parseInput parses the submitted document and stores it when the server has a repository,
+// the errors are written to the response
+func (h *handlers) parseInput(w http.ResponseWriter, r *http.Request) (document.Iso20022Document, bool) {
+	raw, doc, err := parseInputFromRequest(r)
+	if err != nil {
+		outputError(w, http.StatusBadRequest, err)
+		return nil, false
+	}
+	if h.repository != nil {
+		stored, err := h.repository.SaveDocument(raw, doc)
+		if err != nil {
+			outputError(w, http.StatusInternalServerError, err)
+			return nil, false
+		}
+		w.Header().Set(documentIdHeader, stored.ID)
+	}
+	return doc, true
 }
 
 func messageToBuf(format string, doc document.Iso20022Document) ([]byte, error) {
@@ -85,16 +141,47 @@ func getFormat(r *http.Request) (string, error) {
 }
 
 // validator - validate the file based on publication 1220
-func validator(w http.ResponseWriter, r *http.Request) {
-	doc, err := parseInputFromRequest(r)
-	if err != nil {
-		outputError(w, http.StatusBadRequest, err)
+func (h *handlers) validator(w http.ResponseWriter, r *http.Request) {
+	doc, ok := h.parseInput(w, r)
+	if !ok {
 		return
 	}
 
-	err = doc.Validate()
-	if err != nil {
-		outputError(w, http.StatusNotImplemented, err)
+	if name := r.FormValue("profile"); name != "" {
+		profile, err := document.LookupProfile(name)
+		if err != nil {
+			outputError(w, http.StatusBadRequest, err)
+			return
+		}
+		err = profile.Validate(doc)
+		if err != nil {
+			outputError(w, http.StatusNotImplemented, err)
+			return
+		}
+	} else {
+		err := doc.Validate()
+		if err != nil {
+			outputError(w, http.StatusNotImplemented, err)
+			return
+		}
+	}
+
+	if r.FormValue("duplicates") == "true" && h.duplicates != nil {
+		report, ok := h.checkDuplicates(w, doc)
+		if !ok {
+			return
+		}
+		if err := report.Err(); err != nil {
+			outputJSON(w, http.StatusConflict, map[string]interface{}{
+				"error":      err.Error(),
+				"duplicates": report.Duplicates,
+			})
+			return
+		}
+		outputJSON(w, http.StatusOK, map[string]interface{}{
+			"status":     "valid file",
+			"duplicates": report.Duplicates,
+		})
 		return
 	}
 
@@ -102,10 +189,9 @@ func validator(w http.ResponseWriter, r *http.Request) {
 }
 
 // validator - print file with ascii or json format
-func print(w http.ResponseWriter, r *http.Request) {
-	doc, err := parseInputFromRequest(r)
-	if err != nil {
-		outputError(w, http.StatusBadRequest, err)
+func (h *handlers) print(w http.ResponseWriter, r *http.Request) {
+	doc, ok := h.parseInput(w, r)
+	if !ok {
 		return
 	}
 
@@ -124,10 +210,9 @@ func print(w http.ResponseWriter, r *http.Request) {
 }
 
 // convert - convert file with ascii or json format
-func convert(w http.ResponseWriter, r *http.Request) {
-	message, err := parseInputFromRequest(r)
-	if err != nil {
-		outputError(w, http.StatusBadRequest, err)
+func (h *handlers) convert(w http.ResponseWriter, r *http.Request) {
+	message, ok := h.parseInput(w, r)
+	if !ok {
 		return
 	}
 
@@ -152,16 +237,63 @@ func convert(w http.ResponseWriter, r *http.Request) {
 	w.Write(output)
 }
 
+// messages - list the registered message types, optionally filtered by namespace or root element
+func messages(w http.ResponseWriter, r *http.Request) {
+	list := document.RegisteredMessages()
+	if namespace := r.URL.Query().Get("namespace"); namespace != "" {
+		info, found := document.LookupMessage(namespace)
+		if !found {
+			outputError(w, http.StatusNotFound, utils.NewErrUnsupportedNameSpace())
+			return
+		}
+		list = []document.MessageInfo{info}
+	}
+	if root := r.URL.Query().Get("root"); root != "" {
+		var filtered []document.MessageInfo
+		for _, info := range list {
+			if info.RootElement == root {
+				filtered = append(filtered, info)
+			}
+		}
+		if len(filtered) == 0 {
+			outputError(w, http.StatusNotFound, fmt.Errorf("The root element %s is unsupported", root))
+			return
+		}
+		list = filtered
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(list)
+}
+
 // health - health check
 func health(w http.ResponseWriter, r *http.Request) {
 	outputSuccess(w, "alive")
 }
 
 // configure handlers
-func ConfigureHandlers(r *mux.Router) error {
+func ConfigureHandlers(r *mux.Router, opts ...HandlerOption) error {
+	h := &handlers{}
+	for _, opt := range opts {
+		opt(h)
+	}
+
 	r.HandleFunc("/health", health).Methods("GET")
-	r.HandleFunc("/print", print).Methods("POST")
-	r.HandleFunc("/validator", validator).Methods("POST")
-	r.HandleFunc("/convert", convert).Methods("POST")
+	r.HandleFunc("/print", h.print).Methods("POST")
+	r.HandleFunc("/validator", h.validator).Methods("POST")
+	r.HandleFunc("/convert", h.convert).Methods("POST")
+	r.HandleFunc("/export", h.export).Methods("POST")
+	r.HandleFunc("/messages", messages).Methods("GET")
+	if h.repository != nil {
+		r.HandleFunc("/documents", h.saveDocument).Methods("POST")
+		r.HandleFunc("/documents", h.searchDocuments).Methods("GET")
+		r.HandleFunc("/documents/{documentId}", h.getDocument).Methods("GET")
+		r.HandleFunc("/documents/{documentId}/raw", h.getRawDocument).Methods("GET")
+		r.HandleFunc("/documents/{documentId}", h.deleteDocument).Methods("DELETE")
+	}
+	if h.duplicates != nil {
+		r.HandleFunc("/duplicates", h.duplicateReport).Methods("POST")
+	}
 	return nil
 }
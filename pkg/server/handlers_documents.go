@@ -0,0 +1,137 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// documentIdHeader is the response header holding the id of the stored document
+const documentIdHeader = "X-Document-Id"
+
+// saveDocument - store the submitted document
+func (h *handlers) saveDocument(w http.ResponseWriter, r *http.Request) {
+	raw, doc, err := parseInputFromRequest(r)
+	if err != nil {
+		outputError(w, http.StatusBadRequest, err)
+		return
+	}
+	stored, err := h.repository.SaveDocument(raw, doc)
+	if err != nil {
+		outputError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set(documentIdHeader, stored.ID)
+	outputJSON(w, http.StatusCreated, stored)
+}
+
+// searchDocuments - list the stored documents matching the query parameters
+func (h *handlers) searchDocuments(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	query := DocumentQuery{
+		MessageType:   params.Get("messageType"),
+		MessageId:     params.Get("messageId"),
+		EndToEndId:    params.Get("endToEndId"),
+		TransactionId: params.Get("transactionId"),
+		UETR:          params.Get("uetr"),
+		Party:         params.Get("party"),
+	}
+	times := []struct {
+		name  string
+		field *time.Time
+	}{{"from", &query.StoredFrom}, {"to", &query.StoredTo}}
+	for _, param := range times {
+		if value := params.Get(param.name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				outputError(w, http.StatusBadRequest, utils.NewErrInvalidQueryParameter(param.name))
+				return
+			}
+			*param.field = t
+		}
+	}
+	numbers := []struct {
+		name  string
+		field *int
+	}{{"limit", &query.Limit}, {"offset", &query.Offset}}
+	for _, param := range numbers {
+		if value := params.Get(param.name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				outputError(w, http.StatusBadRequest, utils.NewErrInvalidQueryParameter(param.name))
+				return
+			}
+			*param.field = n
+		}
+	}
+
+	documents, err := h.repository.SearchDocuments(query)
+	if err != nil {
+		outputError(w, http.StatusInternalServerError, err)
+		return
+	}
+	outputJSON(w, http.StatusOK, documents)
+}
+
+// lookupDocument returns the stored document of the request path, the errors are written to the response
+func (h *handlers) lookupDocument(w http.ResponseWriter, r *http.Request) (*StoredDocument, bool) {
+	id := mux.Vars(r)["documentId"]
+	stored, err := h.repository.GetDocument(id)
+	if err != nil {
+		outputError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	if stored == nil {
+		outputError(w, http.StatusNotFound, utils.NewErrDocumentNotFound(id))
+		return nil, false
+	}
+	return stored, true
+}
+
+// getDocument - return the references of a stored document
+func (h *handlers) getDocument(w http.ResponseWriter, r *http.Request) {
+	if stored, ok := h.lookupDocument(w, r); ok {
+		outputJSON(w, http.StatusOK, stored)
+	}
+}
+
+// getRawDocument - return a stored document as it was submitted
+func (h *handlers) getRawDocument(w http.ResponseWriter, r *http.Request) {
+	stored, ok := h.lookupDocument(w, r)
+	if !ok {
+		return
+	}
+	contentType := "text/plain; charset=utf-8"
+	switch trimmed := bytes.TrimSpace(stored.Raw); {
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		contentType = "application/xml"
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		contentType = "application/json"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(stored.Raw)
+}
+
+// deleteDocument - delete a stored document
+func (h *handlers) deleteDocument(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["documentId"]
+	deleted, err := h.repository.DeleteDocument(id)
+	if err != nil {
+		outputError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if !deleted {
+		outputError(w, http.StatusNotFound, utils.NewErrDocumentNotFound(id))
+		return
+	}
+	outputSuccess(w, "deleted")
+}
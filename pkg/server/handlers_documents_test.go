@@ -0,0 +1,113 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/stime"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postDocument(t *testing.T, router *mux.Router, url, name string) *httptest.ResponseRecorder {
+	raw, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", name))
+	require.Nil(t, err)
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("input", name)
+	require.Nil(t, err)
+	part.Write(raw)
+	writer.Close()
+
+	request, err := http.NewRequest(http.MethodPost, url, body)
+	require.Nil(t, err)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func getDocuments(t *testing.T, router *mux.Router, method, url string) *httptest.ResponseRecorder {
+	request, err := http.NewRequest(method, url, nil)
+	require.Nil(t, err)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestDocumentHandlers(t *testing.T) {
+	repository, err := server.NewRepository(testDatabase(t), stime.NewSystemTimeService())
+	require.Nil(t, err)
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router, server.WithRepository(repository)))
+
+	recorder := postDocument(t, router, "/documents", "valid_pain_v09.xml")
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	var stored server.StoredDocument
+	require.Nil(t, json.NewDecoder(recorder.Body).Decode(&stored))
+	assert.Equal(t, stored.ID, recorder.Header().Get("X-Document-Id"))
+	assert.Equal(t, "pain.001.001.09", stored.MessageType)
+	assert.Len(t, stored.Transactions, 2)
+
+	// the documents submitted to the other endpoints are stored too
+	recorder = postDocument(t, router, "/validator", "valid_bah_pacs_v08.xml")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	validated := recorder.Header().Get("X-Document-Id")
+	assert.NotEmpty(t, validated)
+
+	recorder = getDocuments(t, router, http.MethodGet, "/documents?endToEndId=E2E-1")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var documents []server.StoredDocument
+	require.Nil(t, json.NewDecoder(recorder.Body).Decode(&documents))
+	assert.Len(t, documents, 1)
+	assert.Equal(t, validated, documents[0].ID)
+	assert.Equal(t, "DEUTDEFFXXX", documents[0].Transactions[0].DebtorAgent)
+
+	recorder = getDocuments(t, router, http.MethodGet, "/documents?limit=10&messageType=pain")
+	require.Nil(t, json.NewDecoder(recorder.Body).Decode(&documents))
+	assert.Len(t, documents, 1)
+	assert.Equal(t, stored.ID, documents[0].ID)
+
+	recorder = getDocuments(t, router, http.MethodGet, "/documents?from=yesterday")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "The query parameter from is invalid")
+	recorder = getDocuments(t, router, http.MethodGet, "/documents?limit=-1")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = getDocuments(t, router, http.MethodGet, "/documents/"+stored.ID)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"MessageId":"ABC/220315/CCT001"`)
+
+	raw, _ := readTestDocument(t, "valid_pain_v09.xml")
+	recorder = getDocuments(t, router, http.MethodGet, "/documents/"+stored.ID+"/raw")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/xml", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, raw, recorder.Body.Bytes())
+
+	recorder = getDocuments(t, router, http.MethodDelete, "/documents/"+stored.ID)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	recorder = getDocuments(t, router, http.MethodDelete, "/documents/"+stored.ID)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	recorder = getDocuments(t, router, http.MethodGet, "/documents/"+stored.ID)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "The document "+stored.ID+" is not found")
+
+	// invalid input isn't stored
+	recorder = postDocument(t, router, "/documents", "invalid_file1")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder = getDocuments(t, router, http.MethodGet, "/documents")
+	require.Nil(t, json.NewDecoder(recorder.Body).Decode(&documents))
+	assert.Len(t, documents, 1)
+}
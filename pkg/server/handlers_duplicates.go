@@ -0,0 +1,34 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/duplicate"
+)
+
+// checkDuplicates reports the duplicates of the document and records its fingerprint under the id of the stored
+// document, the errors are written to the response
+func (h *handlers) checkDuplicates(w http.ResponseWriter, doc document.Iso20022Document) (*duplicate.Report, bool) {
+	report, err := h.duplicates.Check(w.Header().Get(documentIdHeader), doc)
+	if err != nil {
+		outputError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return report, true
+}
+
+// duplicateReport - report the exact and probable duplicates of the submitted document and record it
+func (h *handlers) duplicateReport(w http.ResponseWriter, r *http.Request) {
+	doc, ok := h.parseInput(w, r)
+	if !ok {
+		return
+	}
+	if report, ok := h.checkDuplicates(w, doc); ok {
+		outputJSON(w, http.StatusOK, report)
+	}
+}
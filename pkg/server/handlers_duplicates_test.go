@@ -0,0 +1,71 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/stime"
+	"github.com/moov-io/iso20022/pkg/duplicate"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuplicateHandlers(t *testing.T) {
+	db := testDatabase(t)
+	repository, err := server.NewRepository(db, stime.NewSystemTimeService())
+	require.Nil(t, err)
+	store, err := duplicate.NewSQLStore(db)
+	require.Nil(t, err)
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router, server.WithRepository(repository),
+		server.WithDuplicateDetector(duplicate.NewDetector(store))))
+
+	recorder := postDocument(t, router, "/duplicates", "valid_pain_v09.xml")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var report duplicate.Report
+	require.Nil(t, json.NewDecoder(recorder.Body).Decode(&report))
+	original := recorder.Header().Get("X-Document-Id")
+	// the fingerprint is recorded under the id of the stored document
+	assert.Equal(t, original, report.DocumentID)
+	assert.Equal(t, "pain.001", report.MessageType)
+	assert.Empty(t, report.Duplicates)
+
+	recorder = postDocument(t, router, "/duplicates", "valid_pain_v09.xml")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	require.Nil(t, json.NewDecoder(recorder.Body).Decode(&report))
+	require.Len(t, report.Duplicates, 1)
+	assert.Equal(t, duplicate.Exact, report.Duplicates[0].Kind)
+	assert.Equal(t, original, report.Duplicates[0].Original.DocumentID)
+
+	// the validator checks the duplicates on demand
+	recorder = postDocument(t, router, "/validator", "valid_pain_v09.xml")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "duplicates")
+
+	recorder = postDocument(t, router, "/validator?duplicates=true", "valid_pain_v09.xml")
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	var response struct {
+		Error      string
+		Duplicates []duplicate.Match
+	}
+	require.Nil(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Equal(t, "The document duplicates the message id ABC/220315/CCT001 of message ABC/220315/CCT001", response.Error)
+	assert.Len(t, response.Duplicates, 2)
+
+	recorder = postDocument(t, router, "/validator?duplicates=true", "valid_bah_pacs_v08.xml")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"status":"valid file","duplicates":[]}`, recorder.Body.String())
+
+	// the endpoint is registered with a detector only
+	router = mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router))
+	recorder = postDocument(t, router, "/duplicates", "valid_pain_v09.xml")
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
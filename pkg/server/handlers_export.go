@@ -0,0 +1,75 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/statement"
+)
+
+// exportOptions returns the options of the export read from the form, csv by default
+func exportOptions(r *http.Request) (statement.Format, []statement.Option, error) {
+	format := statement.CSV
+	if name := r.FormValue("format"); name != "" {
+		var err error
+		if format, err = statement.ParseFormat(name); err != nil {
+			return format, nil, err
+		}
+	}
+	opts := []statement.Option{statement.WithFormat(format)}
+	if names := r.FormValue("columns"); names != "" {
+		var columns []statement.Column
+		for _, name := range strings.Split(names, ",") {
+			column, err := statement.ParseColumn(name)
+			if err != nil {
+				return format, nil, err
+			}
+			columns = append(columns, column)
+		}
+		opts = append(opts, statement.WithColumns(columns...))
+	}
+	if delimiter := r.FormValue("delimiter"); delimiter != "" {
+		runes := []rune(delimiter)
+		if len(runes) != 1 {
+			return format, nil, errors.New("the delimiter should be a single character")
+		}
+		opts = append(opts, statement.WithDelimiter(runes[0]))
+	}
+	if statuses := r.FormValue("statuses"); statuses != "" {
+		opts = append(opts, statement.WithStatuses(strings.Split(statuses, ",")...))
+	}
+	return format, opts, nil
+}
+
+// export - export the statements of a camt.052, camt.053 or camt.054 file with csv, ofx or qif format
+func (h *handlers) export(w http.ResponseWriter, r *http.Request) {
+	doc, ok := h.parseInput(w, r)
+	if !ok {
+		return
+	}
+
+	format, opts, err := exportOptions(r)
+	if err != nil {
+		outputError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var output bytes.Buffer
+	if err = statement.NewExporter(opts...).Export(&output, doc); err != nil {
+		outputError(w, http.StatusNotImplemented, err)
+		return
+	}
+
+	filename := "exported_statement." + string(format)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
+	w.Header().Set("Expires", "0")
+	w.WriteHeader(http.StatusOK)
+	w.Write(output.Bytes())
+}
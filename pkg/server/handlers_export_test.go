@@ -0,0 +1,58 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportHandler(t *testing.T) {
+	router := mux.NewRouter()
+	require.Nil(t, server.ConfigureHandlers(router))
+
+	recorder := postDocument(t, router, "/export?columns=record,entry,amount,counterparty", "valid_camt053_v08.xml")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename=exported_statement.csv", recorder.Header().Get("Content-Disposition"))
+	assert.Equal(t, `record,entry,amount,counterparty
+balance,,10000.00,
+balance,,8749.00,
+entry,1,-1500.50,
+transaction,2,250.00,XYZ Trading
+entry,3,-0.50,
+`, recorder.Body.String())
+
+	recorder = postDocument(t, router, "/export?format=ofx", "valid_camt053_v08.xml")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/x-ofx", recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), "<FITID>COBA/220316/0002</FITID>")
+
+	recorder = postDocument(t, router, "/export?format=qif", "valid_camt053_v08.xml")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, strings.HasPrefix(recorder.Body.String(), "!Type:Bank\n"))
+
+	// the entries of the statement are all booked
+	recorder = postDocument(t, router, "/export?columns=record,entry&statuses=PDNG", "valid_camt053_v08.xml")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "record,entry\nbalance,\nbalance,\n", recorder.Body.String())
+
+	recorder = postDocument(t, router, "/export?format=xlsx", "valid_camt053_v08.xml")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "The export format xlsx is unsupported")
+
+	recorder = postDocument(t, router, "/export?delimiter=;;", "valid_camt053_v08.xml")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = postDocument(t, router, "/export", "valid_pain_v09.xml")
+	assert.Equal(t, http.StatusNotImplemented, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "The message pain.001.001.09 is unsupported by the statement export")
+}
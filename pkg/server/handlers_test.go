@@ -6,6 +6,7 @@ package server_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -151,6 +152,76 @@ func (suite *HandlersTest) TestValidator() {
 	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
 }
 
+func (suite *HandlersTest) TestValidatorWithBusinessMessage() {
+	writer, body := suite.getWriter("valid_bah_pacs_v08.xml")
+	err := writer.Close()
+	assert.Equal(suite.T(), nil, err)
+	recorder, request := suite.makeRequest(http.MethodPost, "/validator", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+}
+
+func (suite *HandlersTest) TestValidatorWithProfile() {
+	writer, body := suite.getWriter("valid_bah_pacs_v08.xml")
+	err := writer.WriteField("profile", "cbpr+")
+	assert.Equal(suite.T(), nil, err)
+	err = writer.Close()
+	assert.Equal(suite.T(), nil, err)
+	recorder, request := suite.makeRequest(http.MethodPost, "/validator", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusNotImplemented, recorder.Code)
+
+	var response struct {
+		Errors utils.ValidationErrors `json:"errors"`
+	}
+	assert.Nil(suite.T(), json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Len(suite.T(), response.Errors, 3)
+	assert.Equal(suite.T(), "/BizMsg/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[1]/PmtId/UETR", response.Errors[0].Path)
+
+	writer, body = suite.getWriter("valid_bah_pacs_v08.xml")
+	err = writer.WriteField("profile", "unknown")
+	assert.Equal(suite.T(), nil, err)
+	err = writer.Close()
+	assert.Equal(suite.T(), nil, err)
+	recorder, request = suite.makeRequest(http.MethodPost, "/validator", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusBadRequest, recorder.Code)
+}
+
+func (suite *HandlersTest) TestMessages() {
+	recorder, request := suite.makeRequest(http.MethodGet, "/messages", "")
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+	var list []map[string]string
+	assert.Nil(suite.T(), json.NewDecoder(recorder.Body).Decode(&list))
+	assert.Greater(suite.T(), len(list), 100)
+
+	recorder, request = suite.makeRequest(http.MethodGet, "/messages?root=FIToFICstmrCdtTrf", "")
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+	assert.Nil(suite.T(), json.NewDecoder(recorder.Body).Decode(&list))
+	assert.Len(suite.T(), list, 3)
+
+	recorder, request = suite.makeRequest(http.MethodGet, "/messages?namespace="+utils.DocumentPacs00800108NameSpace, "")
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
+	assert.Nil(suite.T(), json.NewDecoder(recorder.Body).Decode(&list))
+	assert.Len(suite.T(), list, 1)
+	assert.Equal(suite.T(), "pacs_v08.FIToFICustomerCreditTransferV08", list[0]["GoType"])
+	assert.Equal(suite.T(), "008", list[0]["MessageId"])
+
+	recorder, request = suite.makeRequest(http.MethodGet, "/messages?namespace=unknown", "")
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusNotFound, recorder.Code)
+
+	recorder, request = suite.makeRequest(http.MethodGet, "/messages?root=Unknown", "")
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusNotFound, recorder.Code)
+}
+
 func (suite *HandlersTest) TestPrintWithInvalidForm() {
 	writer, body := suite.getErrWriter(testFileName)
 	err := writer.WriteField("format", utils.DocumentTypeJson)
@@ -334,3 +405,21 @@ func (suite *HandlersTest) TestValidatorWithXmlFile() {
 	suite.testServer.ServeHTTP(recorder, request)
 	assert.Equal(suite.T(), http.StatusOK, recorder.Code)
 }
+
+func (suite *HandlersTest) TestValidatorWithInvalidDocument() {
+	writer, body := suite.getWriter("invalid_pacs_v08.xml")
+	err := writer.Close()
+	assert.Equal(suite.T(), nil, err)
+	recorder, request := suite.makeRequest(http.MethodPost, "/validator", body.String())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	suite.testServer.ServeHTTP(recorder, request)
+	assert.Equal(suite.T(), http.StatusNotImplemented, recorder.Code)
+
+	var response struct {
+		Error  string                 `json:"error"`
+		Errors utils.ValidationErrors `json:"errors"`
+	}
+	assert.Nil(suite.T(), json.NewDecoder(recorder.Body).Decode(&response))
+	assert.Len(suite.T(), response.Errors, 3)
+	assert.Equal(suite.T(), "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf[2]/IntrBkSttlmAmt", response.Errors[0].Path)
+}
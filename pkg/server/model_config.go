@@ -5,6 +5,8 @@
 package server
 
 import (
+	"time"
+
 	"github.com/moov-io/base/database"
 )
 
@@ -14,8 +16,30 @@ type GlobalConfig struct {
 
 // Config defines all the configuration for the app
 type Config struct {
-	Servers  ServerConfig
-	Database database.DatabaseConfig
+	Servers    ServerConfig
+	Database   database.DatabaseConfig
+	Store      StoreConfig
+	Duplicates DuplicatesConfig
+
+	// CodeSets is the path of a json file of external code sets replacing the bundled ones of the same name
+	CodeSets string
+}
+
+// StoreConfig - Configures the retention of the documents stored by the server
+type StoreConfig struct {
+	// Retention is how long a document is kept, documents are kept forever when it's zero
+	Retention time.Duration
+	// PurgeInterval is how often the documents older than Retention are deleted, every hour by default
+	PurgeInterval time.Duration
+}
+
+// DuplicatesConfig - Configures the detection of the duplicate documents, whose fingerprints are recorded in the database
+type DuplicatesConfig struct {
+	// Window is how long a document is compared with the new ones, 24 hours by default
+	Window time.Duration
+	// Threshold is the similarity of the counterparties, between 0 and 1, from which transactions with a same amount
+	// and date are probable duplicates, 0.85 by default
+	Threshold float64
 }
 
 // ServerConfig - Groups all the http configs for the servers and ports that get opened.
@@ -0,0 +1,41 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/document"
+)
+
+// StoredDocument is a document persisted by the server with the references of its message
+type StoredDocument struct {
+	ID string
+	document.Summary
+	StoredAt time.Time
+	// Size is the length of the document as submitted
+	Size int
+	// Raw is the document as submitted, it's only loaded by GetDocument
+	Raw []byte `json:"-"`
+}
+
+// DocumentQuery filters the stored documents, every field which isn't empty has to match
+type DocumentQuery struct {
+	// MessageType is a message identifier or its beginning, e.g. pacs.008.001.08 or pacs.008
+	MessageType string
+	MessageId   string
+	// EndToEndId, TransactionId, UETR and Party have to match the same transaction of the document
+	EndToEndId    string
+	TransactionId string
+	UETR          string
+	// Party is a part of the name of the debtor or the creditor, or the BIC of their agents
+	Party string
+	// StoredFrom and StoredTo limit the time the documents were stored, StoredTo is excluded
+	StoredFrom time.Time
+	StoredTo   time.Time
+	// Limit is the maximum number of documents returned, 100 by default. The newest documents come first.
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,306 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"database/sql"
+	"embed"
+	"strings"
+	"time"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/base/stime"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+const (
+	defaultDocumentLimit = 100
+	maxDocumentLimit     = 1000
+)
+
+//go:embed store_migrations/*.sql
+var migrations embed.FS
+
+// Repository persists the submitted documents
+type Repository interface {
+	// SaveDocument stores the document with its raw bytes and the references of its message
+	SaveDocument(raw []byte, doc document.Iso20022Document) (*StoredDocument, error)
+	// GetDocument returns the stored document with its raw bytes, or nil when it isn't stored
+	GetDocument(id string) (*StoredDocument, error)
+	// SearchDocuments returns the documents matching the query without their raw bytes
+	SearchDocuments(query DocumentQuery) ([]StoredDocument, error)
+	// DeleteDocument deletes the document and reports whether it was stored
+	DeleteDocument(id string) (bool, error)
+	// PurgeDocuments deletes the documents stored before the time and returns their number
+	PurgeDocuments(before time.Time) (int64, error)
+}
+
+type sqlRepository struct {
+	db    *sql.DB
+	clock stime.TimeService
+}
+
+// NewRepository returns a repository storing the documents in the database of SQLite or MySQL,
+// the missing tables are created first
+func NewRepository(db *sql.DB, clock stime.TimeService) (Repository, error) {
+	if err := utils.Migrate(db, migrations, "store_migrations", "document_migrations"); err != nil {
+		return nil, err
+	}
+	return &sqlRepository{db: db, clock: clock}, nil
+}
+
+func (r *sqlRepository) SaveDocument(raw []byte, doc document.Iso20022Document) (*StoredDocument, error) {
+	stored := &StoredDocument{
+		ID:       base.ID(),
+		Summary:  document.Summarize(doc),
+		StoredAt: r.clock.Now().UTC().Truncate(time.Second),
+		Size:     len(raw),
+		Raw:      raw,
+	}
+	var created sql.NullTime
+	if stored.CreationDateTime != nil {
+		created = sql.NullTime{Time: stored.CreationDateTime.UTC(), Valid: true}
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(`INSERT INTO documents(document_id, namespace, message_type, message_id, created_at, original_message_id,
+status, stored_at, raw) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, stored.ID, stored.NameSpace, stored.MessageType, stored.MessageId,
+		created, stored.OriginalMessageId, stored.Status, stored.StoredAt, raw)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	for seq, txn := range stored.Transactions {
+		amount := ""
+		if txn.Currency != "" {
+			amount = txn.Amount.String()
+		}
+		_, err = tx.Exec(`INSERT INTO document_transactions(document_id, seq, payment_information_id, instruction_id, end_to_end_id,
+transaction_id, uetr, amount, currency, transaction_date, status, remittance, debtor, creditor, debtor_agent, creditor_agent)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			stored.ID, seq, txn.PaymentInformationId, txn.InstructionId, txn.EndToEndId, txn.TransactionId, txn.UETR,
+			amount, txn.Currency, txn.Date, txn.Status, txn.Remittance, txn.Debtor, txn.Creditor, txn.DebtorAgent, txn.CreditorAgent)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	return stored, tx.Commit()
+}
+
+const documentColumns = `document_id, namespace, message_type, message_id, created_at, original_message_id, status, stored_at,
+LENGTH(raw)`
+
+func (r *sqlRepository) GetDocument(id string) (*StoredDocument, error) {
+	rows, err := r.db.Query(`SELECT `+documentColumns+`, raw FROM documents WHERE document_id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	var stored StoredDocument
+	if err := scanDocument(rows, &stored, &stored.Raw); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	transactions, err := r.transactions([]string{id})
+	if err != nil {
+		return nil, err
+	}
+	stored.Transactions = transactions[id]
+	return &stored, nil
+}
+
+func (r *sqlRepository) SearchDocuments(query DocumentQuery) ([]StoredDocument, error) {
+	var conditions, txConditions []string
+	var args, txArgs []interface{}
+	if query.MessageType != "" {
+		conditions = append(conditions, `message_type LIKE ? ESCAPE '!'`)
+		args = append(args, escapeLike(query.MessageType)+"%")
+	}
+	if query.MessageId != "" {
+		conditions = append(conditions, `message_id = ?`)
+		args = append(args, query.MessageId)
+	}
+	if !query.StoredFrom.IsZero() {
+		conditions = append(conditions, `stored_at >= ?`)
+		args = append(args, query.StoredFrom.UTC())
+	}
+	if !query.StoredTo.IsZero() {
+		conditions = append(conditions, `stored_at < ?`)
+		args = append(args, query.StoredTo.UTC())
+	}
+	for _, reference := range [][2]string{
+		{"end_to_end_id", query.EndToEndId},
+		{"transaction_id", query.TransactionId},
+		{"uetr", query.UETR},
+	} {
+		if reference[1] != "" {
+			txConditions = append(txConditions, "t."+reference[0]+" = ?")
+			txArgs = append(txArgs, reference[1])
+		}
+	}
+	if query.Party != "" {
+		name := "%" + escapeLike(query.Party) + "%"
+		txConditions = append(txConditions, `(t.debtor LIKE ? ESCAPE '!' OR t.creditor LIKE ? ESCAPE '!' OR t.debtor_agent = ? OR t.creditor_agent = ?)`)
+		txArgs = append(txArgs, name, name, query.Party, query.Party)
+	}
+	if len(txConditions) > 0 {
+		conditions = append(conditions, `EXISTS (SELECT 1 FROM document_transactions t WHERE t.document_id = documents.document_id AND `+
+			strings.Join(txConditions, " AND ")+`)`)
+		args = append(args, txArgs...)
+	}
+
+	statement := `SELECT ` + documentColumns + ` FROM documents`
+	if len(conditions) > 0 {
+		statement += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultDocumentLimit
+	}
+	if limit > maxDocumentLimit {
+		limit = maxDocumentLimit
+	}
+	statement += ` ORDER BY stored_at DESC, document_id LIMIT ? OFFSET ?`
+	args = append(args, limit, query.Offset)
+
+	rows, err := r.db.Query(statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	documents := []StoredDocument{}
+	var ids []string
+	for rows.Next() {
+		var stored StoredDocument
+		if err := scanDocument(rows, &stored); err != nil {
+			return nil, err
+		}
+		documents = append(documents, stored)
+		ids = append(ids, stored.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	transactions, err := r.transactions(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range documents {
+		documents[i].Transactions = transactions[documents[i].ID]
+	}
+	return documents, nil
+}
+
+func (r *sqlRepository) DeleteDocument(id string) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	if _, err := tx.Exec(`DELETE FROM document_transactions WHERE document_id = ?`, id); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	result, err := tx.Exec(`DELETE FROM documents WHERE document_id = ?`, id)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	return deleted > 0, tx.Commit()
+}
+
+func (r *sqlRepository) PurgeDocuments(before time.Time) (int64, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.Exec(`DELETE FROM document_transactions WHERE document_id IN (SELECT document_id FROM documents WHERE stored_at < ?)`, before.UTC())
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	result, err := tx.Exec(`DELETE FROM documents WHERE stored_at < ?`, before.UTC())
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	return deleted, tx.Commit()
+}
+
+// transactions returns the transactions of the documents by document id
+func (r *sqlRepository) transactions(ids []string) (map[string][]document.TransactionSummary, error) {
+	transactions := map[string][]document.TransactionSummary{}
+	if len(ids) == 0 {
+		return transactions, nil
+	}
+	args := make([]interface{}, len(ids))
+	for i := range ids {
+		args[i] = ids[i]
+	}
+	rows, err := r.db.Query(`SELECT document_id, payment_information_id, instruction_id, end_to_end_id, transaction_id, uetr,
+amount, currency, transaction_date, status, remittance, debtor, creditor, debtor_agent, creditor_agent FROM document_transactions
+WHERE document_id IN (?`+strings.Repeat(", ?", len(ids)-1)+`) ORDER BY document_id, seq`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, amount string
+		var txn document.TransactionSummary
+		err := rows.Scan(&id, &txn.PaymentInformationId, &txn.InstructionId, &txn.EndToEndId, &txn.TransactionId, &txn.UETR,
+			&amount, &txn.Currency, &txn.Date, &txn.Status, &txn.Remittance, &txn.Debtor, &txn.Creditor, &txn.DebtorAgent, &txn.CreditorAgent)
+		if err != nil {
+			return nil, err
+		}
+		if amount != "" {
+			if txn.Amount, err = common.ParseDecimal(amount); err != nil {
+				return nil, err
+			}
+		}
+		transactions[id] = append(transactions[id], txn)
+	}
+	return transactions, rows.Err()
+}
+
+func scanDocument(rows *sql.Rows, stored *StoredDocument, extra ...interface{}) error {
+	var created sql.NullTime
+	dest := append([]interface{}{&stored.ID, &stored.NameSpace, &stored.MessageType, &stored.MessageId, &created,
+		&stored.OriginalMessageId, &stored.Status, &stored.StoredAt, &stored.Size}, extra...)
+	if err := rows.Scan(dest...); err != nil {
+		return err
+	}
+	if created.Valid {
+		stored.CreationDateTime = &created.Time
+	}
+	stored.StoredAt = stored.StoredAt.UTC()
+	return nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern with the escape character !
+func escapeLike(value string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(value)
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package server_test
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/moov-io/base/database"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/base/stime"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDatabase(t *testing.T) *sql.DB {
+	db, err := database.New(context.Background(), log.NewNopLogger(), database.DatabaseConfig{
+		SQLite: &database.SQLiteConfig{Path: filepath.Join(t.TempDir(), "iso20022.db")},
+	})
+	require.Nil(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func readTestDocument(t *testing.T, name string) ([]byte, document.Iso20022Document) {
+	raw, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", name))
+	require.Nil(t, err)
+	doc, err := document.ParseIso20022Document(raw)
+	require.Nil(t, err)
+	return raw, doc
+}
+
+func TestRepository(t *testing.T) {
+	db := testDatabase(t)
+	clock := stime.NewStaticTimeService()
+	clock.Change(time.Date(2022, 3, 14, 9, 30, 0, 0, time.UTC))
+
+	repository, err := server.NewRepository(db, clock)
+	require.Nil(t, err)
+	// the migrations are applied once
+	_, err = server.NewRepository(db, clock)
+	require.Nil(t, err)
+
+	raw, doc := readTestDocument(t, "valid_bah_pacs_v08.xml")
+	payment, err := repository.SaveDocument(raw, doc)
+	require.Nil(t, err)
+	assert.Len(t, payment.ID, 40)
+	assert.Equal(t, clock.Now(), payment.StoredAt)
+
+	clock.Add(time.Hour)
+	raw, doc = readTestDocument(t, "valid_pain_v09.xml")
+	initiation, err := repository.SaveDocument(raw, doc)
+	require.Nil(t, err)
+
+	stored, err := repository.GetDocument(payment.ID)
+	require.Nil(t, err)
+	assert.Equal(t, payment.Raw, stored.Raw)
+	assert.Equal(t, len(payment.Raw), stored.Size)
+	assert.Equal(t, "pacs.008.001.08", stored.MessageType)
+	assert.Equal(t, "MSG-1", stored.MessageId)
+	assert.Equal(t, time.Date(2022, 1, 2, 10, 0, 0, 0, time.UTC), stored.CreationDateTime.UTC())
+	assert.Equal(t, payment.StoredAt, stored.StoredAt)
+	assert.Equal(t, payment.Transactions, stored.Transactions)
+
+	stored, err = repository.GetDocument("unknown")
+	assert.Nil(t, err)
+	assert.Nil(t, stored)
+
+	ids := func(query server.DocumentQuery) []string {
+		documents, err := repository.SearchDocuments(query)
+		require.Nil(t, err)
+		list := []string{}
+		for _, doc := range documents {
+			assert.Nil(t, doc.Raw)
+			list = append(list, doc.ID)
+		}
+		return list
+	}
+	// the newest documents come first
+	assert.Equal(t, []string{initiation.ID, payment.ID}, ids(server.DocumentQuery{}))
+	assert.Equal(t, []string{payment.ID}, ids(server.DocumentQuery{Offset: 1}))
+	assert.Equal(t, []string{initiation.ID}, ids(server.DocumentQuery{Limit: 1}))
+	assert.Equal(t, []string{payment.ID}, ids(server.DocumentQuery{MessageType: "pacs.008"}))
+	assert.Equal(t, []string{initiation.ID}, ids(server.DocumentQuery{MessageId: "ABC/220315/CCT001"}))
+	assert.Equal(t, []string{payment.ID}, ids(server.DocumentQuery{EndToEndId: "E2E-1"}))
+	assert.Equal(t, []string{initiation.ID}, ids(server.DocumentQuery{Party: "Semiconductors"}))
+	assert.Equal(t, []string{initiation.ID}, ids(server.DocumentQuery{Party: "COBADEFFXXX"}))
+	assert.Equal(t, []string{}, ids(server.DocumentQuery{Party: "Semi%"}))
+	// the references and the party have to match the same transaction
+	assert.Equal(t, []string{}, ids(server.DocumentQuery{EndToEndId: "ABC/4562/2022-03-08", Party: "GHI"}))
+	assert.Equal(t, []string{initiation.ID}, ids(server.DocumentQuery{EndToEndId: "ABC/4563/2022-03-08", Party: "GHI"}))
+	assert.Equal(t, []string{initiation.ID}, ids(server.DocumentQuery{StoredFrom: initiation.StoredAt}))
+	assert.Equal(t, []string{payment.ID}, ids(server.DocumentQuery{StoredTo: initiation.StoredAt}))
+
+	deleted, err := repository.DeleteDocument(payment.ID)
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+	deleted, err = repository.DeleteDocument(payment.ID)
+	assert.Nil(t, err)
+	assert.False(t, deleted)
+	assert.Equal(t, []string{initiation.ID}, ids(server.DocumentQuery{}))
+
+	purged, err := repository.PurgeDocuments(initiation.StoredAt)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), purged)
+	purged, err = repository.PurgeDocuments(initiation.StoredAt.Add(time.Second))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), purged)
+	assert.Equal(t, []string{}, ids(server.DocumentQuery{}))
+}
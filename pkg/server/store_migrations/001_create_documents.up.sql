@@ -0,0 +1,32 @@
+CREATE TABLE documents(
+    document_id VARCHAR(40) PRIMARY KEY,
+    namespace VARCHAR(255) NOT NULL,
+    message_type VARCHAR(20) NOT NULL,
+    message_id VARCHAR(35) NOT NULL,
+    created_at DATETIME NULL,
+    stored_at DATETIME NOT NULL,
+    raw LONGBLOB NOT NULL
+);
+
+CREATE INDEX documents_message_id ON documents(message_id);
+CREATE INDEX documents_stored_at ON documents(stored_at);
+
+CREATE TABLE document_transactions(
+    document_id VARCHAR(40) NOT NULL,
+    seq INTEGER NOT NULL,
+    instruction_id VARCHAR(35) NOT NULL,
+    end_to_end_id VARCHAR(35) NOT NULL,
+    transaction_id VARCHAR(35) NOT NULL,
+    uetr VARCHAR(36) NOT NULL,
+    amount VARCHAR(40) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    debtor VARCHAR(140) NOT NULL,
+    creditor VARCHAR(140) NOT NULL,
+    debtor_agent VARCHAR(35) NOT NULL,
+    creditor_agent VARCHAR(35) NOT NULL,
+    PRIMARY KEY (document_id, seq)
+);
+
+CREATE INDEX document_transactions_end_to_end_id ON document_transactions(end_to_end_id);
+CREATE INDEX document_transactions_transaction_id ON document_transactions(transaction_id);
+CREATE INDEX document_transactions_uetr ON document_transactions(uetr);
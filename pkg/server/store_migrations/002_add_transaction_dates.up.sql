@@ -0,0 +1,2 @@
+ALTER TABLE document_transactions ADD COLUMN payment_information_id VARCHAR(140) NOT NULL DEFAULT '';
+ALTER TABLE document_transactions ADD COLUMN transaction_date VARCHAR(10) NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+ALTER TABLE documents ADD COLUMN original_message_id VARCHAR(35) NOT NULL DEFAULT '';
+ALTER TABLE documents ADD COLUMN status VARCHAR(35) NOT NULL DEFAULT '';
+ALTER TABLE document_transactions ADD COLUMN status VARCHAR(35) NOT NULL DEFAULT '';
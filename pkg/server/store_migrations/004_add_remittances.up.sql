@@ -0,0 +1 @@
+ALTER TABLE document_transactions ADD COLUMN remittance VARCHAR(140) NOT NULL DEFAULT '';
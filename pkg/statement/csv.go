@@ -0,0 +1,189 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Column is a column of the csv export. The record of a row is balance, entry or transaction, the entry and
+// the transaction are the positions of the line in its statement and in its entry, counted from 1. A balance
+// row gives the date of the balance as its booking date. Amounts are negative for a debit.
+type Column string
+
+const (
+	Record                   Column = "record"
+	MessageId                Column = "message_id"
+	StatementId              Column = "statement_id"
+	Account                  Column = "account"
+	BalanceType              Column = "balance_type"
+	Entry                    Column = "entry"
+	Transaction              Column = "transaction"
+	BookingDate              Column = "booking_date"
+	ValueDate                Column = "value_date"
+	Amount                   Column = "amount"
+	Currency                 Column = "currency"
+	CreditDebit              Column = "credit_debit"
+	Reversal                 Column = "reversal"
+	Status                   Column = "status"
+	BankTransactionCode      Column = "bank_transaction_code"
+	ProprietaryCode          Column = "proprietary_code"
+	Reference                Column = "reference"
+	AccountServicerReference Column = "account_servicer_reference"
+	InstructionId            Column = "instruction_id"
+	EndToEndId               Column = "end_to_end_id"
+	TransactionId            Column = "transaction_id"
+	UETR                     Column = "uetr"
+	Counterparty             Column = "counterparty"
+	CounterpartyAccount      Column = "counterparty_account"
+	Remittance               Column = "remittance"
+	Information              Column = "information"
+)
+
+// Columns are all the columns, written by default
+var Columns = []Column{Record, MessageId, StatementId, Account, BalanceType, Entry, Transaction, BookingDate, ValueDate,
+	Amount, Currency, CreditDebit, Reversal, Status, BankTransactionCode, ProprietaryCode, Reference,
+	AccountServicerReference, InstructionId, EndToEndId, TransactionId, UETR, Counterparty, CounterpartyAccount,
+	Remittance, Information}
+
+// ParseColumn returns the column of the name, ignoring case
+func ParseColumn(name string) (Column, error) {
+	for _, column := range Columns {
+		if strings.EqualFold(string(column), strings.TrimSpace(name)) {
+			return column, nil
+		}
+	}
+	return "", utils.NewErrUnknownExportColumn(name)
+}
+
+// writeCSV writes a header, then the balances and the lines of each statement
+func (e *Exporter) writeCSV(w io.Writer, statements []Statement) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = e.delimiter
+
+	header := make([]string, len(e.columns))
+	for i, column := range e.columns {
+		header[i] = string(column)
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, statement := range statements {
+		for _, balance := range statement.Balances {
+			row := make([]string, len(e.columns))
+			for i, column := range e.columns {
+				row[i] = balanceValue(column, statement, balance)
+			}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+		for _, line := range statement.Lines {
+			row := make([]string, len(e.columns))
+			for i, column := range e.columns {
+				row[i] = lineValue(column, statement, line)
+			}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func statementValue(column Column, statement Statement) string {
+	switch column {
+	case MessageId:
+		return statement.MessageId
+	case StatementId:
+		return statement.Id
+	case Account:
+		return statement.Account
+	}
+	return ""
+}
+
+func balanceValue(column Column, statement Statement, balance Balance) string {
+	switch column {
+	case Record:
+		return "balance"
+	case BalanceType:
+		return balance.Type
+	case BookingDate:
+		return balance.Date
+	case Amount:
+		return balance.Amount.String()
+	case Currency:
+		return balance.Currency
+	case CreditDebit:
+		if balance.Amount.Sign() < 0 {
+			return "DBIT"
+		}
+		return "CRDT"
+	}
+	return statementValue(column, statement)
+}
+
+func lineValue(column Column, statement Statement, line Line) string {
+	switch column {
+	case Record:
+		if line.Transaction < 0 {
+			return "entry"
+		}
+		return "transaction"
+	case Entry:
+		return strconv.Itoa(line.Entry + 1)
+	case Transaction:
+		if line.Transaction < 0 {
+			return ""
+		}
+		return strconv.Itoa(line.Transaction + 1)
+	case BookingDate:
+		return line.BookingDate
+	case ValueDate:
+		return line.ValueDate
+	case Amount:
+		return line.Amount.String()
+	case Currency:
+		return line.Currency
+	case CreditDebit:
+		return line.CreditDebit
+	case Reversal:
+		return strconv.FormatBool(line.Reversal)
+	case Status:
+		return line.Status
+	case BankTransactionCode:
+		return line.BankTransactionCode
+	case ProprietaryCode:
+		return line.ProprietaryCode
+	case Reference:
+		return line.Reference
+	case AccountServicerReference:
+		return line.AccountServicerReference
+	case InstructionId:
+		return line.InstructionId
+	case EndToEndId:
+		return line.EndToEndId
+	case TransactionId:
+		return line.TransactionId
+	case UETR:
+		return line.UETR
+	case Counterparty:
+		return line.Counterparty
+	case CounterpartyAccount:
+		return line.CounterpartyAccount
+	case Remittance:
+		return line.Remittance
+	case Information:
+		return line.Information
+	}
+	return statementValue(column, statement)
+}
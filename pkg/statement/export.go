@@ -0,0 +1,172 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"io"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Format is a flat file format the statements are exported to
+type Format string
+
+const (
+	// CSV writes a row for each balance and each line
+	CSV Format = "csv"
+	// OFX writes an OFX 2.2 bank statement response for each statement
+	OFX Format = "ofx"
+	// QIF writes a bank account section of Quicken interchange format for each statement
+	QIF Format = "qif"
+)
+
+// Formats are all the export formats
+var Formats = []Format{CSV, OFX, QIF}
+
+// ParseFormat returns the format of the name, ignoring case
+func ParseFormat(name string) (Format, error) {
+	for _, format := range Formats {
+		if strings.EqualFold(string(format), strings.TrimSpace(name)) {
+			return format, nil
+		}
+	}
+	return "", utils.NewErrUnsupportedExportFormat(name)
+}
+
+// ContentType returns the media type of the format
+func (f Format) ContentType() string {
+	switch f {
+	case OFX:
+		return "application/x-ofx"
+	case QIF:
+		return "application/qif"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// DefaultStatuses are the statuses of the entries exported by default, the booked entries only
+var DefaultStatuses = []string{"BOOK"}
+
+// Exporter writes statements in a flat file format
+type Exporter struct {
+	format    Format
+	columns   []Column
+	delimiter rune
+	statuses  map[string]bool
+}
+
+// Option changes the settings of an exporter
+type Option func(*Exporter)
+
+// WithFormat sets the format of the export, CSV by default
+func WithFormat(format Format) Option {
+	return func(e *Exporter) {
+		e.format = format
+	}
+}
+
+// WithColumns sets the columns of the csv export and their order, all of them by default
+func WithColumns(columns ...Column) Option {
+	return func(e *Exporter) {
+		e.columns = columns
+	}
+}
+
+// WithDelimiter sets the field delimiter of the csv export, a comma by default
+func WithDelimiter(delimiter rune) Option {
+	return func(e *Exporter) {
+		e.delimiter = delimiter
+	}
+}
+
+// WithStatuses sets the statuses of the entries exported, e.g. BOOK and PDNG, DefaultStatuses by default
+func WithStatuses(statuses ...string) Option {
+	return func(e *Exporter) {
+		e.statuses = map[string]bool{}
+		for _, status := range statuses {
+			e.statuses[strings.ToUpper(strings.TrimSpace(status))] = true
+		}
+	}
+}
+
+// NewExporter returns an exporter writing the booked entries in every column of a comma separated csv by default
+func NewExporter(opts ...Option) *Exporter {
+	e := &Exporter{
+		format:    CSV,
+		columns:   Columns,
+		delimiter: ',',
+	}
+	WithStatuses(DefaultStatuses...)(e)
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Export writes the statements of a camt.052.001.08 account report, a camt.053.001.08 statement or
+// a camt.054.001.08 notification
+func (e *Exporter) Export(w io.Writer, doc document.Iso20022Document) error {
+	statements, err := Flatten(doc)
+	if err != nil {
+		return err
+	}
+	return e.Write(w, statements)
+}
+
+// Write writes the statements, without the lines of the entries whose status isn't exported
+func (e *Exporter) Write(w io.Writer, statements []Statement) error {
+	statements = e.filter(statements)
+	switch e.format {
+	case CSV:
+		return e.writeCSV(w, statements)
+	case OFX:
+		return writeOFX(w, statements)
+	case QIF:
+		return writeQIF(w, statements)
+	}
+	return utils.NewErrUnsupportedExportFormat(string(e.format))
+}
+
+// filter returns the statements with the lines of the exported statuses only
+func (e *Exporter) filter(statements []Statement) []Statement {
+	filtered := make([]Statement, len(statements))
+	for i, statement := range statements {
+		lines := statement.Lines
+		statement.Lines = nil
+		for _, line := range lines {
+			if e.statuses[line.Status] {
+				statement.Lines = append(statement.Lines, line)
+			}
+		}
+		filtered[i] = statement
+	}
+	return filtered
+}
+
+// postingDate returns the booking date of the line, or its value date
+func postingDate(line Line) string {
+	if line.BookingDate != "" {
+		return line.BookingDate
+	}
+	return line.ValueDate
+}
+
+// memo returns the remittance information of the line, or its additional information
+func memo(line Line) string {
+	if line.Remittance != "" {
+		return line.Remittance
+	}
+	return line.Information
+}
+
+// truncate returns the first characters of the text
+func truncate(text string, length int) string {
+	if runes := []rune(text); len(runes) > length {
+		return string(runes[:length])
+	}
+	return text
+}
@@ -0,0 +1,223 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+`
+
+// ofxNameLength is the maximum length of the NAME of a transaction
+const ofxNameLength = 32
+
+type ofxDocument struct {
+	XMLName xml.Name        `xml:"OFX"`
+	Signon  ofxSignon       `xml:"SIGNONMSGSRSV1>SONRS"`
+	Bank    []ofxStatements `xml:"BANKMSGSRSV1>STMTTRNRS"`
+}
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxSignon struct {
+	Status   ofxStatus `xml:"STATUS"`
+	Server   string    `xml:"DTSERVER"`
+	Language string    `xml:"LANGUAGE"`
+}
+
+type ofxStatements struct {
+	TransactionId string       `xml:"TRNUID"`
+	Status        ofxStatus    `xml:"STATUS"`
+	Statement     ofxStatement `xml:"STMTRS"`
+}
+
+type ofxStatement struct {
+	Currency     string          `xml:"CURDEF"`
+	Account      ofxAccount      `xml:"BANKACCTFROM"`
+	Transactions ofxTransactions `xml:"BANKTRANLIST"`
+	Ledger       *ofxBalance     `xml:"LEDGERBAL,omitempty"`
+	Available    *ofxBalance     `xml:"AVAILBAL,omitempty"`
+}
+
+type ofxAccount struct {
+	BankId    string `xml:"BANKID"`
+	AccountId string `xml:"ACCTID"`
+	Type      string `xml:"ACCTTYPE"`
+}
+
+type ofxTransactions struct {
+	Start        string           `xml:"DTSTART"`
+	End          string           `xml:"DTEND"`
+	Transactions []ofxTransaction `xml:"STMTTRN"`
+}
+
+type ofxTransaction struct {
+	Type      string `xml:"TRNTYPE"`
+	Posted    string `xml:"DTPOSTED"`
+	Available string `xml:"DTAVAIL,omitempty"`
+	Amount    string `xml:"TRNAMT"`
+	Id        string `xml:"FITID"`
+	Name      string `xml:"NAME,omitempty"`
+	Memo      string `xml:"MEMO,omitempty"`
+}
+
+type ofxBalance struct {
+	Amount string `xml:"BALAMT"`
+	AsOf   string `xml:"DTASOF"`
+}
+
+// writeOFX writes a bank statement response for each statement. The bank is identified by the clearing system
+// member id of the servicer, or by the first 8 characters of its BIC. The transaction list covers the period of
+// the statement, or the dates of its lines. The ledger balance is the closing or interim booked balance and the
+// available balance the closing or interim available one, they are left out when the statement doesn't give them.
+func writeOFX(w io.Writer, statements []Statement) error {
+	server := time.Now().UTC()
+	if len(statements) > 0 {
+		server = statements[0].CreationDateTime
+	}
+	doc := ofxDocument{
+		Signon: ofxSignon{
+			Status:   ofxStatus{Severity: "INFO"},
+			Server:   ofxDateTime(server),
+			Language: "ENG",
+		},
+	}
+	for _, statement := range statements {
+		doc.Bank = append(doc.Bank, ofxStatements{
+			TransactionId: statement.MessageId + "/" + statement.Id,
+			Status:        ofxStatus{Severity: "INFO"},
+			Statement:     newOFXStatement(statement),
+		})
+	}
+
+	if _, err := io.WriteString(w, ofxHeader); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "\t")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func newOFXStatement(statement Statement) ofxStatement {
+	bankId := statement.ServicerMemberId
+	if bankId == "" && len(statement.Servicer) >= 8 {
+		bankId = statement.Servicer[:8]
+	}
+	stmt := ofxStatement{
+		Currency:  statement.Currency,
+		Account:   ofxAccount{BankId: bankId, AccountId: statement.Account, Type: "CHECKING"},
+		Ledger:    ofxBalanceOf(statement.Balances, "CLBD", "ITBD"),
+		Available: ofxBalanceOf(statement.Balances, "CLAV", "ITAV"),
+	}
+
+	start, end := statement.FromDate, statement.ToDate
+	for _, line := range statement.Lines {
+		posted := postingDate(line)
+		if posted == "" {
+			posted = statement.CreationDateTime.Format("2006-01-02")
+		}
+		if stmt.Currency == "" {
+			stmt.Currency = line.Currency
+		}
+		if statement.FromDate == "" && (start == "" || posted < start) {
+			start = posted
+		}
+		if statement.ToDate == "" && posted > end {
+			end = posted
+		}
+		stmt.Transactions.Transactions = append(stmt.Transactions.Transactions, ofxTransaction{
+			Type:      ofxTransactionType(line),
+			Posted:    ofxDate(posted),
+			Available: ofxDate(line.ValueDate),
+			Amount:    line.Amount.String(),
+			Id:        fitId(statement, line),
+			Name:      truncate(line.Counterparty, ofxNameLength),
+			Memo:      truncate(memo(line), 255),
+		})
+	}
+	if start == "" {
+		start = statement.CreationDateTime.Format("2006-01-02")
+	}
+	if end == "" {
+		end = start
+	}
+	stmt.Transactions.Start, stmt.Transactions.End = ofxDate(start), ofxDate(end)
+	return stmt
+}
+
+// ofxBalanceOf returns the first balance of the types, or nil
+func ofxBalanceOf(balances []Balance, types ...string) *ofxBalance {
+	for _, tp := range types {
+		for _, balance := range balances {
+			if balance.Type == tp {
+				return &ofxBalance{Amount: balance.Amount.String(), AsOf: ofxDate(balance.Date)}
+			}
+		}
+	}
+	return nil
+}
+
+// ofxTransactionType maps the bank transaction code of the line to a transaction type
+func ofxTransactionType(line Line) string {
+	parts := strings.Split(line.BankTransactionCode, "/")
+	family, subFamily := "", ""
+	if len(parts) == 3 {
+		family, subFamily = parts[1], parts[2]
+	}
+	switch {
+	case subFamily == "CHRG" || subFamily == "COMM" || subFamily == "FEES":
+		return "FEE"
+	case subFamily == "INTR" || family == "INTR":
+		return "INT"
+	case family == "RDDT" && line.Amount.Sign() < 0:
+		return "DIRECTDEBIT"
+	case family == "ICHQ" || family == "RCHQ":
+		return "CHECK"
+	case subFamily == "CWDL":
+		return "ATM"
+	case subFamily == "POSD" || subFamily == "POSC":
+		return "POS"
+	case line.Amount.Sign() < 0:
+		return "DEBIT"
+	}
+	return "CREDIT"
+}
+
+// fitId returns the AcctSvcrRef of the line, or its position in the statement
+func fitId(statement Statement, line Line) string {
+	if line.AccountServicerReference != "" {
+		if line.Transaction > 0 {
+			return fmt.Sprintf("%s-%d", line.AccountServicerReference, line.Transaction+1)
+		}
+		return line.AccountServicerReference
+	}
+	id := fmt.Sprintf("%s-%d", statement.Id, line.Entry+1)
+	if line.Transaction >= 0 {
+		id += fmt.Sprintf("-%d", line.Transaction+1)
+	}
+	return id
+}
+
+// ofxDate returns the date (YYYY-MM-DD) as YYYYMMDD
+func ofxDate(date string) string {
+	return strings.ReplaceAll(date, "-", "")
+}
+
+func ofxDateTime(t time.Time) string {
+	return t.Format("20060102150405")
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// writeQIF writes the lines of the statements as transactions of bank accounts. The account of each statement
+// is named in an !Account section when there are several statements.
+func writeQIF(w io.Writer, statements []Statement) error {
+	writer := bufio.NewWriter(w)
+	for _, statement := range statements {
+		if len(statements) > 1 {
+			fmt.Fprintf(writer, "!Account\nN%s\nTBank\n^\n", qifText(statement.Account))
+		}
+		fmt.Fprintln(writer, "!Type:Bank")
+		for _, line := range statement.Lines {
+			posted := postingDate(line)
+			if posted == "" {
+				posted = statement.CreationDateTime.Format("2006-01-02")
+			}
+			fmt.Fprintf(writer, "D%s\n", qifDate(posted))
+			fmt.Fprintf(writer, "T%s\n", line.Amount.String())
+			if reference := qifReference(line); reference != "" {
+				fmt.Fprintf(writer, "N%s\n", qifText(reference))
+			}
+			if line.Counterparty != "" {
+				fmt.Fprintf(writer, "P%s\n", qifText(line.Counterparty))
+			}
+			if memo := memo(line); memo != "" {
+				fmt.Fprintf(writer, "M%s\n", qifText(memo))
+			}
+			fmt.Fprintln(writer, "^")
+		}
+	}
+	return writer.Flush()
+}
+
+// qifReference returns the end to end id of the line, or its AcctSvcrRef or its entry reference
+func qifReference(line Line) string {
+	for _, reference := range []string{line.EndToEndId, line.AccountServicerReference, line.Reference} {
+		if reference != "" && reference != "NOTPROVIDED" {
+			return reference
+		}
+	}
+	return ""
+}
+
+// qifDate returns the date (YYYY-MM-DD) as MM/DD/YYYY
+func qifDate(date string) string {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return t.Format("01/02/2006")
+}
+
+// qifText returns the text on a single line
+func qifText(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,328 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"strings"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Statement is a flattened statement, account report or notification
+type Statement struct {
+	MessageType string
+	MessageId   string
+	Id          string
+	// CreationDateTime is the creation of the statement, or of the message when the statement doesn't give it
+	CreationDateTime time.Time
+	// FromDate and ToDate are the period of the statement (YYYY-MM-DD), empty when the statement doesn't give it
+	FromDate string `json:",omitempty"`
+	ToDate   string `json:",omitempty"`
+	Account  string `json:",omitempty"`
+	Currency string `json:",omitempty"`
+	Owner    string `json:",omitempty"`
+	// Servicer is the BIC of the bank servicing the account
+	Servicer string `json:",omitempty"`
+	// ServicerMemberId is the clearing system member id of the bank servicing the account, e.g. a routing number
+	ServicerMemberId string    `json:",omitempty"`
+	Balances         []Balance `json:",omitempty"`
+	Lines            []Line    `json:",omitempty"`
+}
+
+// Balance is a balance of a statement
+type Balance struct {
+	// Type is the code of the balance, e.g. OPBD or CLBD, or its proprietary type
+	Type string
+	// Amount is negative for a debit balance
+	Amount   common.Decimal
+	Currency string
+	Date     string `json:",omitempty"`
+}
+
+// Line is an entry of a statement, or a transaction of the entry when the entry details several transactions
+// with their amount
+type Line struct {
+	// Entry is the position of the entry in its statement, counted from 0
+	Entry int
+	// Transaction is the position of the transaction in its entry, counted from 0, or -1 when the line is the entry
+	Transaction              int
+	Reference                string `json:",omitempty"`
+	AccountServicerReference string `json:",omitempty"`
+	InstructionId            string `json:",omitempty"`
+	EndToEndId               string `json:",omitempty"`
+	TransactionId            string `json:",omitempty"`
+	UETR                     string `json:",omitempty"`
+	BookingDate              string `json:",omitempty"`
+	ValueDate                string `json:",omitempty"`
+	// Amount is negative for a debit
+	Amount   common.Decimal
+	Currency string
+	// CreditDebit is CRDT or DBIT
+	CreditDebit string
+	Reversal    bool   `json:",omitempty"`
+	Status      string `json:",omitempty"`
+	// BankTransactionCode is the domain, family and sub family of the transaction, e.g. PMNT/ICDT/ESCT
+	BankTransactionCode string `json:",omitempty"`
+	ProprietaryCode     string `json:",omitempty"`
+	// Counterparty is the creditor of a debit and the debtor of a credit
+	Counterparty        string `json:",omitempty"`
+	CounterpartyAccount string `json:",omitempty"`
+	Remittance          string `json:",omitempty"`
+	Information         string `json:",omitempty"`
+}
+
+// Flatten returns the statements of a camt.052.001.08 account report, a camt.053.001.08 statement or
+// a camt.054.001.08 notification
+func Flatten(doc document.Iso20022Document) ([]Statement, error) {
+	messageType := document.Summarize(doc).MessageType
+	statements := []Statement{}
+	switch message := doc.InspectMessage().(type) {
+	case *camt_v08.BankToCustomerAccountReportV08:
+		for _, report := range message.Rpt {
+			statement := newStatement(messageType, message.GrpHdr, string(report.Id), report.CreDtTm, report.FrToDt, report.Acct)
+			statement.Balances = balances(report.Bal)
+			statement.Lines = lines(report.Ntry)
+			statements = append(statements, statement)
+		}
+	case *camt_v08.BankToCustomerStatementV08:
+		for _, stmt := range message.Stmt {
+			statement := newStatement(messageType, message.GrpHdr, string(stmt.Id), stmt.CreDtTm, stmt.FrToDt, stmt.Acct)
+			statement.Balances = balances(stmt.Bal)
+			statement.Lines = lines(stmt.Ntry)
+			statements = append(statements, statement)
+		}
+	case *camt_v08.BankToCustomerDebitCreditNotificationV08:
+		for _, notification := range message.Ntfctn {
+			account := notification.Acct
+			statement := newStatement(messageType, message.GrpHdr, string(notification.Id), notification.CreDtTm, notification.FrToDt, &account)
+			statement.Lines = lines(notification.Ntry)
+			statements = append(statements, statement)
+		}
+	default:
+		return nil, utils.NewErrUnsupportedExportMessage(messageType)
+	}
+	return statements, nil
+}
+
+func newStatement(messageType string, header camt_v08.GroupHeader81, id string, created *common.ISODateTime,
+	period *camt_v08.DateTimePeriod1, account *camt_v08.CashAccount39) Statement {
+	statement := Statement{
+		MessageType:      messageType,
+		MessageId:        string(header.MsgId),
+		Id:               id,
+		CreationDateTime: time.Time(header.CreDtTm),
+	}
+	if created != nil {
+		statement.CreationDateTime = time.Time(*created)
+	}
+	if period != nil {
+		statement.FromDate = time.Time(period.FrDtTm).Format("2006-01-02")
+		statement.ToDate = time.Time(period.ToDtTm).Format("2006-01-02")
+	}
+	if account == nil {
+		return statement
+	}
+	statement.Account = accountId(&account.Id)
+	statement.Currency = text(account.Ccy)
+	if account.Ownr != nil {
+		statement.Owner = text(account.Ownr.Nm)
+	}
+	if account.Svcr != nil {
+		statement.Servicer = text(account.Svcr.FinInstnId.BICFI)
+		if member := account.Svcr.FinInstnId.ClrSysMmbId; member != nil {
+			statement.ServicerMemberId = string(member.MmbId)
+		}
+	}
+	return statement
+}
+
+func balances(list []camt_v08.CashBalance8) []Balance {
+	var balances []Balance
+	for _, bal := range list {
+		balance := Balance{
+			Type:     text(bal.Tp.CdOrPrtry.Cd),
+			Amount:   signed(common.Decimal(bal.Amt.Value), string(bal.CdtDbtInd)),
+			Currency: string(bal.Amt.Ccy),
+			Date:     date(&bal.Dt),
+		}
+		if balance.Type == "" {
+			balance.Type = text(bal.Tp.CdOrPrtry.Prtry)
+		}
+		balances = append(balances, balance)
+	}
+	return balances
+}
+
+// lines returns a line for each entry, or for each transaction of the entries detailing several transactions
+// with their amount
+func lines(list []camt_v08.ReportEntry10) []Line {
+	var lines []Line
+	for i, ntry := range list {
+		entry := Line{
+			Entry:                    i,
+			Transaction:              -1,
+			Reference:                text(ntry.NtryRef),
+			AccountServicerReference: text(ntry.AcctSvcrRef),
+			BookingDate:              date(ntry.BookgDt),
+			ValueDate:                date(ntry.ValDt),
+			Amount:                   signed(common.Decimal(ntry.Amt.Value), string(ntry.CdtDbtInd)),
+			Currency:                 string(ntry.Amt.Ccy),
+			CreditDebit:              string(ntry.CdtDbtInd),
+			Reversal:                 ntry.RvslInd,
+			Information:              text(ntry.AddtlNtryInf),
+		}
+		switch {
+		case ntry.Sts.Cd != nil:
+			entry.Status = string(*ntry.Sts.Cd)
+		case ntry.Sts.Prtry != nil:
+			entry.Status = string(*ntry.Sts.Prtry)
+		}
+		entry.BankTransactionCode, entry.ProprietaryCode = bankTransactionCode(&ntry.BkTxCd)
+
+		var transactions []camt_v08.EntryTransaction10
+		for _, details := range ntry.NtryDtls {
+			transactions = append(transactions, details.TxDtls...)
+		}
+		split := len(transactions) > 1
+		for _, tx := range transactions {
+			split = split && tx.Amt != nil
+		}
+		switch {
+		case split:
+			for j, tx := range transactions {
+				line := transactionLine(entry, tx)
+				line.Transaction = j
+				lines = append(lines, line)
+			}
+		case len(transactions) == 1:
+			line := transactionLine(entry, transactions[0])
+			line.Transaction = 0
+			lines = append(lines, line)
+		default:
+			lines = append(lines, entry)
+		}
+	}
+	return lines
+}
+
+// transactionLine returns the line of the entry completed by the details of the transaction
+func transactionLine(entry Line, tx camt_v08.EntryTransaction10) Line {
+	line := entry
+	if refs := tx.Refs; refs != nil {
+		line.InstructionId = text(refs.InstrId)
+		line.EndToEndId = text(refs.EndToEndId)
+		line.TransactionId = text(refs.TxId)
+		if refs.AcctSvcrRef != nil {
+			line.AccountServicerReference = string(*refs.AcctSvcrRef)
+		}
+		if refs.UETR != nil {
+			line.UETR = string(*refs.UETR)
+		}
+	}
+	if tx.CdtDbtInd != nil {
+		line.CreditDebit = string(*tx.CdtDbtInd)
+	}
+	if tx.Amt != nil {
+		line.Amount, line.Currency = signed(common.Decimal(tx.Amt.Value), line.CreditDebit), string(tx.Amt.Ccy)
+	}
+	if tx.BkTxCd != nil {
+		if code, proprietary := bankTransactionCode(tx.BkTxCd); code != "" || proprietary != "" {
+			line.BankTransactionCode, line.ProprietaryCode = code, proprietary
+		}
+	}
+	if parties := tx.RltdPties; parties != nil {
+		counterparty, account := parties.Cdtr, parties.CdtrAcct
+		if line.CreditDebit == "CRDT" {
+			counterparty, account = parties.Dbtr, parties.DbtrAcct
+		}
+		line.Counterparty = partyName(counterparty)
+		if account != nil {
+			line.CounterpartyAccount = accountId(account.Id)
+		}
+	}
+	if rmtInf := tx.RmtInf; rmtInf != nil {
+		var remittance []string
+		for _, strd := range rmtInf.Strd {
+			if strd.CdtrRefInf != nil && strd.CdtrRefInf.Ref != nil {
+				remittance = append(remittance, string(*strd.CdtrRefInf.Ref))
+			}
+		}
+		for _, ustrd := range rmtInf.Ustrd {
+			remittance = append(remittance, string(ustrd))
+		}
+		line.Remittance = strings.Join(remittance, " ")
+	}
+	if tx.AddtlTxInf != nil {
+		line.Information = string(*tx.AddtlTxInf)
+	}
+	return line
+}
+
+// bankTransactionCode returns the domain code (DOMN/FMLY/SUBFMLY) and the proprietary code
+func bankTransactionCode(code *camt_v08.BankTransactionCodeStructure4) (string, string) {
+	var domain, proprietary string
+	if code.Domn != nil {
+		domain = strings.Join([]string{string(code.Domn.Cd), string(code.Domn.Fmly.Cd), string(code.Domn.Fmly.SubFmlyCd)}, "/")
+	}
+	if code.Prtry != nil {
+		proprietary = string(code.Prtry.Cd)
+	}
+	return domain, proprietary
+}
+
+func signed(amount common.Decimal, creditDebit string) common.Decimal {
+	if creditDebit == "DBIT" {
+		return amount.Neg()
+	}
+	return amount
+}
+
+// date returns the date of the choice (YYYY-MM-DD), or an empty string
+func date(choice *camt_v08.DateAndDateTime2Choice) string {
+	switch {
+	case choice == nil:
+		return ""
+	case choice.Dt != nil:
+		return time.Time(*choice.Dt).Format("2006-01-02")
+	case choice.DtTm != nil:
+		return time.Time(*choice.DtTm).Format("2006-01-02")
+	}
+	return ""
+}
+
+func accountId(id *camt_v08.AccountIdentification4Choice) string {
+	switch {
+	case id == nil:
+		return ""
+	case id.IBAN != nil:
+		return string(*id.IBAN)
+	case id.Othr != nil:
+		return string(id.Othr.Id)
+	}
+	return ""
+}
+
+func partyName(party *camt_v08.Party40Choice) string {
+	switch {
+	case party == nil:
+		return ""
+	case party.Pty != nil && party.Pty.Nm != nil:
+		return string(*party.Pty.Nm)
+	case party.Agt != nil && party.Agt.FinInstnId.Nm != nil:
+		return string(*party.Agt.FinInstnId.Nm)
+	}
+	return ""
+}
+
+func text[T ~string](value *T) string {
+	if value == nil {
+		return ""
+	}
+	return string(*value)
+}
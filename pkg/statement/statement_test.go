@@ -0,0 +1,304 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package statement
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readDocument(t *testing.T, name string) document.Iso20022Document {
+	raw, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", name))
+	require.Nil(t, err)
+	doc, err := document.ParseIso20022Document(raw)
+	require.Nil(t, err)
+	return doc
+}
+
+func amount(value string) *camt_v08.ActiveOrHistoricCurrencyAndAmount {
+	return &camt_v08.ActiveOrHistoricCurrencyAndAmount{
+		Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(common.MustParseDecimal(value)),
+		Ccy:   "EUR",
+	}
+}
+
+func detail(endToEndId, value string) camt_v08.EntryTransaction10 {
+	id := common.Max35Text(endToEndId)
+	tx := camt_v08.EntryTransaction10{Refs: &camt_v08.TransactionReferences6{EndToEndId: &id}}
+	if value != "" {
+		tx.Amt = amount(value)
+	}
+	return tx
+}
+
+func TestFlatten(t *testing.T) {
+	statements, err := Flatten(readDocument(t, "valid_camt053_v08.xml"))
+	require.Nil(t, err)
+	require.Len(t, statements, 1)
+
+	statement := statements[0]
+	assert.Equal(t, "camt.053.001.08", statement.MessageType)
+	assert.Equal(t, "COBA/220316/STMT001", statement.MessageId)
+	assert.Equal(t, "COBA/220316/001", statement.Id)
+	assert.Equal(t, time.Date(2022, 3, 16, 20, 0, 0, 0, time.UTC), statement.CreationDateTime.UTC())
+	assert.Equal(t, "DE89370400440532013000", statement.Account)
+	assert.Equal(t, "EUR", statement.Currency)
+	assert.Equal(t, "ABC Corporation", statement.Owner)
+	assert.Equal(t, "COBADEFFXXX", statement.Servicer)
+	assert.Equal(t, []Balance{
+		{Type: "OPBD", Amount: common.MustParseDecimal("10000.00"), Currency: "EUR", Date: "2022-03-16"},
+		{Type: "CLBD", Amount: common.MustParseDecimal("8749.00"), Currency: "EUR", Date: "2022-03-16"},
+	}, statement.Balances)
+
+	require.Len(t, statement.Lines, 3)
+	assert.Equal(t, Line{
+		Entry:                    0,
+		Transaction:              -1,
+		Reference:                "1",
+		AccountServicerReference: "COBA/220316/0001",
+		BookingDate:              "2022-03-16",
+		ValueDate:                "2022-03-16",
+		Amount:                   common.MustParseDecimal("-1500.50"),
+		Currency:                 "EUR",
+		CreditDebit:              "DBIT",
+		Status:                   "BOOK",
+		BankTransactionCode:      "PMNT/ICDT/ESCT",
+		Information:              "SEPA credit transfer batch ABC/086",
+	}, statement.Lines[0])
+	assert.Equal(t, Line{
+		Entry:                    1,
+		Transaction:              0,
+		Reference:                "2",
+		AccountServicerReference: "COBA/220316/0002",
+		EndToEndId:               "XYZ/INV/778",
+		BookingDate:              "2022-03-16",
+		ValueDate:                "2022-03-16",
+		Amount:                   common.MustParseDecimal("250.00"),
+		Currency:                 "EUR",
+		CreditDebit:              "CRDT",
+		Status:                   "BOOK",
+		BankTransactionCode:      "PMNT/RCDT/ESCT",
+		Counterparty:             "XYZ Trading",
+		Remittance:               "RF18539007547034",
+	}, statement.Lines[1])
+	assert.Equal(t, "PMNT/CCRD/CHRG", statement.Lines[2].BankTransactionCode)
+	assert.Equal(t, "-0.50", statement.Lines[2].Amount.String())
+
+	_, err = Flatten(readDocument(t, "valid_pain_v09.xml"))
+	assert.EqualError(t, err, "The message pain.001.001.09 is unsupported by the statement export")
+}
+
+func TestLines(t *testing.T) {
+	entry := camt_v08.ReportEntry10{
+		Amt:       *amount("300.00"),
+		CdtDbtInd: "DBIT",
+		BkTxCd: camt_v08.BankTransactionCodeStructure4{
+			Prtry: &camt_v08.ProprietaryBankTransactionCodeStructure1{Cd: "NTRF"},
+		},
+	}
+
+	t.Run("transactions with amounts", func(t *testing.T) {
+		refund := detail("E2E-2", "50.00")
+		credit := common.CreditDebitCode("CRDT")
+		refund.CdtDbtInd = &credit
+		name := common.Max140Text("ACME Ltd")
+		refund.RltdPties = &camt_v08.TransactionParties6{Dbtr: &camt_v08.Party40Choice{Pty: &camt_v08.PartyIdentification135{Nm: &name}}}
+		refund.RmtInf = &camt_v08.RemittanceInformation16{Ustrd: []common.Max140Text{"Refund", "March"}}
+
+		entry.NtryDtls = []camt_v08.EntryDetails9{{TxDtls: []camt_v08.EntryTransaction10{detail("E2E-1", "350.00"), refund}}}
+		list := lines([]camt_v08.ReportEntry10{entry})
+		require.Len(t, list, 2)
+		assert.Equal(t, 0, list[0].Transaction)
+		assert.Equal(t, "E2E-1", list[0].EndToEndId)
+		assert.Equal(t, "-350.00", list[0].Amount.String())
+		assert.Equal(t, "/NTRF", list[0].BankTransactionCode+"/"+list[0].ProprietaryCode)
+		assert.Equal(t, 1, list[1].Transaction)
+		assert.Equal(t, "50.00", list[1].Amount.String())
+		assert.Equal(t, "CRDT", list[1].CreditDebit)
+		assert.Equal(t, "ACME Ltd", list[1].Counterparty)
+		assert.Equal(t, "Refund March", list[1].Remittance)
+	})
+
+	t.Run("transactions without amounts", func(t *testing.T) {
+		entry.NtryDtls = []camt_v08.EntryDetails9{{TxDtls: []camt_v08.EntryTransaction10{detail("E2E-1", ""), detail("E2E-2", "")}}}
+		list := lines([]camt_v08.ReportEntry10{entry})
+		require.Len(t, list, 1)
+		assert.Equal(t, -1, list[0].Transaction)
+		assert.Equal(t, "", list[0].EndToEndId)
+		assert.Equal(t, "-300.00", list[0].Amount.String())
+	})
+
+	t.Run("single transaction", func(t *testing.T) {
+		entry.NtryDtls = []camt_v08.EntryDetails9{{TxDtls: []camt_v08.EntryTransaction10{detail("E2E-1", "")}}}
+		list := lines([]camt_v08.ReportEntry10{entry})
+		require.Len(t, list, 1)
+		assert.Equal(t, 0, list[0].Transaction)
+		assert.Equal(t, "E2E-1", list[0].EndToEndId)
+		assert.Equal(t, "-300.00", list[0].Amount.String())
+	})
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat(" OFX")
+	require.Nil(t, err)
+	assert.Equal(t, OFX, format)
+	assert.Equal(t, "application/x-ofx", format.ContentType())
+
+	_, err = ParseFormat("mt940")
+	assert.EqualError(t, err, "The export format mt940 is unsupported")
+	err = NewExporter(WithFormat("mt940")).Write(io.Discard, nil)
+	assert.EqualError(t, err, "The export format mt940 is unsupported")
+}
+
+func TestParseColumn(t *testing.T) {
+	column, err := ParseColumn("End_To_End_Id")
+	require.Nil(t, err)
+	assert.Equal(t, EndToEndId, column)
+
+	_, err = ParseColumn("creditor")
+	assert.EqualError(t, err, "The export column creditor is unknown")
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	exporter := NewExporter(WithColumns(Record, BalanceType, Entry, Transaction, BookingDate, Amount, CreditDebit,
+		BankTransactionCode, Counterparty, Remittance, Information), WithDelimiter(';'))
+	require.Nil(t, exporter.Export(&buf, readDocument(t, "valid_camt053_v08.xml")))
+	assert.Equal(t, `record;balance_type;entry;transaction;booking_date;amount;credit_debit;bank_transaction_code;counterparty;remittance;information
+balance;OPBD;;;2022-03-16;10000.00;CRDT;;;;
+balance;CLBD;;;2022-03-16;8749.00;CRDT;;;;
+entry;;1;;2022-03-16;-1500.50;DBIT;PMNT/ICDT/ESCT;;;SEPA credit transfer batch ABC/086
+transaction;;2;1;2022-03-16;250.00;CRDT;PMNT/RCDT/ESCT;XYZ Trading;RF18539007547034;
+entry;;3;;2022-03-16;-0.50;DBIT;PMNT/CCRD/CHRG;;;Charges
+`, buf.String())
+
+	buf.Reset()
+	require.Nil(t, NewExporter().Export(&buf, readDocument(t, "valid_camt053_v08.xml")))
+	header := strings.SplitN(buf.String(), "\n", 2)[0]
+	assert.Len(t, strings.Split(header, ","), len(Columns))
+}
+
+func TestExportStatuses(t *testing.T) {
+	statements, err := Flatten(readDocument(t, "valid_camt053_v08.xml"))
+	require.Nil(t, err)
+	pending := &statements[0].Lines[1]
+	pending.Status, pending.BookingDate = "PDNG", ""
+
+	// the pending entry isn't exported by default
+	var buf bytes.Buffer
+	require.Nil(t, NewExporter(WithColumns(Entry, BookingDate, ValueDate, Amount, Status)).Write(&buf, statements))
+	assert.Equal(t, `entry,booking_date,value_date,amount,status
+,2022-03-16,,10000.00,
+,2022-03-16,,8749.00,
+1,2022-03-16,2022-03-16,-1500.50,BOOK
+3,2022-03-16,2022-03-16,-0.50,BOOK
+`, buf.String())
+
+	buf.Reset()
+	require.Nil(t, NewExporter(WithFormat(QIF)).Write(&buf, statements))
+	assert.NotContains(t, buf.String(), "XYZ/INV/778")
+
+	buf.Reset()
+	exporter := NewExporter(WithColumns(Entry, BookingDate, ValueDate, Amount, Status), WithStatuses("book", "PDNG"))
+	require.Nil(t, exporter.Write(&buf, statements))
+	assert.Contains(t, buf.String(), "\n2,,2022-03-16,250.00,PDNG\n")
+
+	// the flattened statements are left as they were
+	assert.Len(t, statements[0].Lines, 3)
+}
+
+func TestWriteOFX(t *testing.T) {
+	var buf bytes.Buffer
+	require.Nil(t, NewExporter(WithFormat(OFX)).Export(&buf, readDocument(t, "valid_camt053_v08.xml")))
+	output := buf.String()
+	assert.True(t, strings.HasPrefix(output, `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>`))
+
+	var doc ofxDocument
+	require.Nil(t, xml.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "20220316200000", doc.Signon.Server)
+	require.Len(t, doc.Bank, 1)
+	statement := doc.Bank[0].Statement
+	assert.Equal(t, "EUR", statement.Currency)
+	assert.Equal(t, ofxAccount{BankId: "COBADEFF", AccountId: "DE89370400440532013000", Type: "CHECKING"}, statement.Account)
+	assert.Equal(t, "20220316", statement.Transactions.Start)
+	assert.Equal(t, "20220316", statement.Transactions.End)
+	assert.Equal(t, []ofxTransaction{
+		{Type: "DEBIT", Posted: "20220316", Available: "20220316", Amount: "-1500.50", Id: "COBA/220316/0001",
+			Memo: "SEPA credit transfer batch ABC/086"},
+		{Type: "CREDIT", Posted: "20220316", Available: "20220316", Amount: "250.00", Id: "COBA/220316/0002",
+			Name: "XYZ Trading", Memo: "RF18539007547034"},
+		{Type: "FEE", Posted: "20220316", Available: "20220316", Amount: "-0.50", Id: "COBA/220316/001-3",
+			Memo: "Charges"},
+	}, statement.Transactions.Transactions)
+	assert.Equal(t, &ofxBalance{Amount: "8749.00", AsOf: "20220316"}, statement.Ledger)
+	assert.Nil(t, statement.Available)
+}
+
+func TestOFXTransactionType(t *testing.T) {
+	debit, credit := common.MustParseDecimal("-1"), common.MustParseDecimal("1")
+	for _, tc := range []struct {
+		line     Line
+		expected string
+	}{
+		{Line{BankTransactionCode: "PMNT/CCRD/CHRG", Amount: debit}, "FEE"},
+		{Line{BankTransactionCode: "ACMT/MDOP/INTR", Amount: credit}, "INT"},
+		{Line{BankTransactionCode: "PMNT/RDDT/ESDD", Amount: debit}, "DIRECTDEBIT"},
+		{Line{BankTransactionCode: "PMNT/ICHQ/CCHQ", Amount: debit}, "CHECK"},
+		{Line{BankTransactionCode: "PMNT/CCRD/CWDL", Amount: debit}, "ATM"},
+		{Line{BankTransactionCode: "PMNT/CCRD/POSD", Amount: debit}, "POS"},
+		{Line{BankTransactionCode: "PMNT/ICDT/ESCT", Amount: debit}, "DEBIT"},
+		{Line{ProprietaryCode: "NTRF", Amount: credit}, "CREDIT"},
+	} {
+		assert.Equal(t, tc.expected, ofxTransactionType(tc.line), tc.line.BankTransactionCode)
+	}
+}
+
+func TestWriteQIF(t *testing.T) {
+	statements, err := Flatten(readDocument(t, "valid_camt053_v08.xml"))
+	require.Nil(t, err)
+
+	var buf bytes.Buffer
+	require.Nil(t, NewExporter(WithFormat(QIF)).Write(&buf, statements))
+	assert.Equal(t, `!Type:Bank
+D03/16/2022
+T-1500.50
+NCOBA/220316/0001
+MSEPA credit transfer batch ABC/086
+^
+D03/16/2022
+T250.00
+NXYZ/INV/778
+PXYZ Trading
+MRF18539007547034
+^
+D03/16/2022
+T-0.50
+N3
+MCharges
+^
+`, buf.String())
+
+	buf.Reset()
+	second := Statement{Id: "2", Account: "CH9300762011623852957", Lines: []Line{
+		{Amount: common.MustParseDecimal("12.00"), ValueDate: "2022-03-17", Status: "BOOK", Information: "Multi\nline"},
+	}}
+	require.Nil(t, NewExporter(WithFormat(QIF)).Write(&buf, append(statements, second)))
+	assert.True(t, strings.HasPrefix(buf.String(), "!Account\nNDE89370400440532013000\nTBank\n^\n!Type:Bank\n"))
+	assert.True(t, strings.HasSuffix(buf.String(), "!Account\nNCH9300762011623852957\nTBank\n^\n!Type:Bank\nD03/17/2022\nT12.00\nMMulti line\n^\n"))
+}
@@ -0,0 +1,161 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package stream
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/camt_v02"
+	"github.com/moov-io/iso20022/pkg/camt_v04"
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/pain_v10"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// layout describes the repeating structure of a bulk message:
+// a group header followed by sections (statements, notifications, payment instructions)
+// which themselves hold the repeating items (entries, transactions)
+type layout struct {
+	message     string
+	section     string
+	item        string
+	header      reflect.Type
+	sectionType reflect.Type
+	itemType    reflect.Type
+	trailer     reflect.Type // nil when the message has no supplementary data
+}
+
+var layouts = map[string]layout{
+	utils.DocumentCamt05300102NameSpace: {
+		message:     "BkToCstmrStmt",
+		section:     "Stmt",
+		item:        "Ntry",
+		header:      reflect.TypeOf(camt_v02.GroupHeader42{}),
+		sectionType: reflect.TypeOf(camt_v02.AccountStatement2{}),
+		itemType:    reflect.TypeOf(camt_v02.ReportEntry2{}),
+	},
+	utils.DocumentCamt05400102NameSpace: {
+		message:     "BkToCstmrDbtCdtNtfctn",
+		section:     "Ntfctn",
+		item:        "Ntry",
+		header:      reflect.TypeOf(camt_v02.GroupHeader42{}),
+		sectionType: reflect.TypeOf(camt_v02.AccountNotification2{}),
+		itemType:    reflect.TypeOf(camt_v02.ReportEntry2{}),
+	},
+	utils.DocumentCamt05300104NameSpace: {
+		message:     "BkToCstmrStmt",
+		section:     "Stmt",
+		item:        "Ntry",
+		header:      reflect.TypeOf(camt_v04.GroupHeader58{}),
+		sectionType: reflect.TypeOf(camt_v04.AccountStatement4{}),
+		itemType:    reflect.TypeOf(camt_v04.ReportEntry4{}),
+		trailer:     reflect.TypeOf(camt_v04.SupplementaryData1{}),
+	},
+	utils.DocumentCamt05400104NameSpace: {
+		message:     "BkToCstmrDbtCdtNtfctn",
+		section:     "Ntfctn",
+		item:        "Ntry",
+		header:      reflect.TypeOf(camt_v04.GroupHeader58{}),
+		sectionType: reflect.TypeOf(camt_v04.AccountNotification7{}),
+		itemType:    reflect.TypeOf(camt_v04.ReportEntry4{}),
+		trailer:     reflect.TypeOf(camt_v04.SupplementaryData1{}),
+	},
+	utils.DocumentCamt05300108NameSpace: {
+		message:     "BkToCstmrStmt",
+		section:     "Stmt",
+		item:        "Ntry",
+		header:      reflect.TypeOf(camt_v08.GroupHeader81{}),
+		sectionType: reflect.TypeOf(camt_v08.AccountStatement9{}),
+		itemType:    reflect.TypeOf(camt_v08.ReportEntry10{}),
+		trailer:     reflect.TypeOf(camt_v08.SupplementaryData1{}),
+	},
+	utils.DocumentCamt05400108NameSpace: {
+		message:     "BkToCstmrDbtCdtNtfctn",
+		section:     "Ntfctn",
+		item:        "Ntry",
+		header:      reflect.TypeOf(camt_v08.GroupHeader81{}),
+		sectionType: reflect.TypeOf(camt_v08.AccountNotification17{}),
+		itemType:    reflect.TypeOf(camt_v08.ReportEntry10{}),
+		trailer:     reflect.TypeOf(camt_v08.SupplementaryData1{}),
+	},
+	utils.DocumentPain00100110NameSpace: {
+		message:     "CstmrCdtTrfInitn",
+		section:     "PmtInf",
+		item:        "CdtTrfTxInf",
+		header:      reflect.TypeOf(pain_v10.GroupHeader95{}),
+		sectionType: reflect.TypeOf(pain_v10.PaymentInstruction34{}),
+		itemType:    reflect.TypeOf(pain_v10.CreditTransferTransaction40{}),
+		trailer:     reflect.TypeOf(pain_v10.SupplementaryData1{}),
+	},
+}
+
+// SupportedNameSpaces returns the sorted namespaces of the messages that can be streamed
+func SupportedNameSpaces() []string {
+	namespaces := make([]string, 0, len(layouts))
+	for namespace := range layouts {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// field is a struct field together with its xml element name and options
+type field struct {
+	index     int
+	name      string
+	omitempty bool
+}
+
+func fields(typ reflect.Type) []field {
+	var list []field
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if !sf.IsExported() || sf.Name == "XMLName" {
+			continue
+		}
+		parts := strings.Split(sf.Tag.Get("xml"), ",")
+		if parts[0] == "" || parts[0] == "-" {
+			continue
+		}
+		f := field{index: i, name: parts[0]}
+		for _, opt := range parts[1:] {
+			if opt == "omitempty" {
+				f.omitempty = true
+			}
+		}
+		list = append(list, f)
+	}
+	return list
+}
+
+func findField(typ reflect.Type, name string) (field, bool) {
+	for _, f := range fields(typ) {
+		if f.name == name {
+			return f, true
+		}
+	}
+	return field{}, false
+}
+
+// isEmptyValue reports whether the value is omitted by encoding/xml when the field has omitempty
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package stream reads and writes bulk ISO 20022 messages one element at a time.
+//
+// Statements (camt.053), notifications (camt.054) and customer credit transfer initiations (pain.001)
+// can hold millions of entries or transactions. The Reader decodes them in a single pass over an io.Reader
+// and never keeps more than one group header, section and item in memory. The Writer produces the same
+// documents from values written in document order.
+package stream
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Reader yields the parts of a bulk message in document order:
+//
+//   - the group header, e.g. *camt_v08.GroupHeader81 or *pain_v10.GroupHeader95
+//   - every section without its items, e.g. *camt_v08.AccountStatement9 or *pain_v10.PaymentInstruction34
+//   - the items of the section, e.g. *camt_v08.ReportEntry10 or *pain_v10.CreditTransferTransaction40
+//   - supplementary data of the message
+//
+// A section is returned before its first item. Elements that follow the items of a section,
+// such as AddtlStmtInf, are set on the same section value once its end has been read.
+type Reader struct {
+	decoder   *xml.Decoder
+	namespace string
+	layout    layout
+
+	section *reflect.Value
+	emitted bool
+	pending interface{}
+	done    bool
+}
+
+// NewReader reads the document up to the message element and returns a Reader for the rest of it.
+// UTF-16 input with a byte order mark and the charsets named by XML declarations are converted into UTF-8.
+func NewReader(r io.Reader) (*Reader, error) {
+	reader := &Reader{decoder: xml.NewDecoder(utils.NewUTF8Reader(r))}
+	reader.decoder.CharsetReader = utils.CharsetReader
+
+	root, err := reader.nextStart()
+	if err != nil {
+		return nil, err
+	}
+	if root.Name.Space == "" {
+		return nil, utils.NewErrOmittedNameSpace()
+	}
+	found := false
+	if reader.layout, found = layouts[root.Name.Space]; !found {
+		return nil, utils.NewErrUnsupportedNameSpace()
+	}
+	reader.namespace = root.Name.Space
+
+	message, err := reader.nextStart()
+	if err != nil {
+		return nil, err
+	}
+	if message.Name.Local != reader.layout.message {
+		return nil, fmt.Errorf("unexpected message element %s, expected %s", message.Name.Local, reader.layout.message)
+	}
+
+	return reader, nil
+}
+
+// NameSpace returns the namespace of the document
+func (r *Reader) NameSpace() string {
+	return r.namespace
+}
+
+func (r *Reader) nextStart() (xml.StartElement, error) {
+	for {
+		token, err := r.token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start, nil
+		}
+	}
+}
+
+func (r *Reader) token() (xml.Token, error) {
+	token, err := r.decoder.Token()
+	if errors.Is(err, io.EOF) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return token, err
+}
+
+func (r *Reader) decode(typ reflect.Type, start xml.StartElement) (reflect.Value, error) {
+	value := reflect.New(typ)
+	err := r.decoder.DecodeElement(value.Interface(), &start)
+	return value, err
+}
+
+// Next returns the next part of the message, or io.EOF once the message element has been closed
+func (r *Reader) Next() (interface{}, error) {
+	if r.pending != nil {
+		item := r.pending
+		r.pending = nil
+		return item, nil
+	}
+
+	for !r.done {
+		token, err := r.token()
+		if err != nil {
+			return nil, err
+		}
+
+		if r.section != nil {
+			item, err := r.nextInSection(token)
+			if err != nil || item != nil {
+				return item, err
+			}
+			continue
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "GrpHdr":
+				value, err := r.decode(r.layout.header, t)
+				if err != nil {
+					return nil, err
+				}
+				return value.Interface(), nil
+			case r.layout.section:
+				section := reflect.New(r.layout.sectionType)
+				r.section = &section
+				r.emitted = false
+			case "SplmtryData":
+				if r.layout.trailer == nil {
+					if err = r.decoder.Skip(); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				value, err := r.decode(r.layout.trailer, t)
+				if err != nil {
+					return nil, err
+				}
+				return value.Interface(), nil
+			default:
+				if err = r.decoder.Skip(); err != nil {
+					return nil, err
+				}
+			}
+		case xml.EndElement:
+			r.done = true
+		}
+	}
+
+	return nil, io.EOF
+}
+
+// nextInSection handles a token inside of a section and returns the value to yield, if any
+func (r *Reader) nextInSection(token xml.Token) (interface{}, error) {
+	section := *r.section
+
+	switch t := token.(type) {
+	case xml.StartElement:
+		if t.Name.Local == r.layout.item {
+			item, err := r.decode(r.layout.itemType, t)
+			if err != nil {
+				return nil, err
+			}
+			if !r.emitted {
+				r.emitted = true
+				r.pending = item.Interface()
+				return section.Interface(), nil
+			}
+			return item.Interface(), nil
+		}
+
+		f, found := findField(r.layout.sectionType, t.Name.Local)
+		if !found {
+			return nil, r.decoder.Skip()
+		}
+		target := section.Elem().Field(f.index)
+		if target.Kind() == reflect.Slice {
+			value, err := r.decode(target.Type().Elem(), t)
+			if err != nil {
+				return nil, err
+			}
+			target.Set(reflect.Append(target, value.Elem()))
+			return nil, nil
+		}
+		return nil, r.decoder.DecodeElement(target.Addr().Interface(), &t)
+
+	case xml.EndElement:
+		r.section = nil
+		if !r.emitted {
+			return section.Interface(), nil
+		}
+	}
+
+	return nil, nil
+}
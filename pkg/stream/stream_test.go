@@ -0,0 +1,310 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package stream
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moov-io/iso20022/pkg/camt_v02"
+	"github.com/moov-io/iso20022/pkg/camt_v04"
+	"github.com/moov-io/iso20022/pkg/camt_v08"
+	"github.com/moov-io/iso20022/pkg/common"
+	"github.com/moov-io/iso20022/pkg/document"
+	"github.com/moov-io/iso20022/pkg/pain_v10"
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+func testEntry(i int) camt_v08.ReportEntry10 {
+	ref := common.Max35Text(fmt.Sprintf("NTRY-%d", i))
+	status := camt_v08.ExternalEntryStatus1Code("BOOK")
+	return camt_v08.ReportEntry10{
+		NtryRef: &ref,
+		Amt: camt_v08.ActiveOrHistoricCurrencyAndAmount{
+			Value: common.ActiveOrHistoricCurrencyAndAmountSimpleType(common.NewDecimal(int64(100+i), 2)),
+			Ccy:   "EUR",
+		},
+		CdtDbtInd: "CRDT",
+		Sts:       camt_v08.EntryStatus1Choice{Cd: &status},
+	}
+}
+
+func testStatement(id string) camt_v08.AccountStatement9 {
+	iban := common.IBAN2007Identifier("DE89370400440532013000")
+	info := common.Max500Text("trailer of " + id)
+	return camt_v08.AccountStatement9{
+		Id:           common.Max35Text(id),
+		Acct:         &camt_v08.CashAccount39{Id: camt_v08.AccountIdentification4Choice{IBAN: &iban}},
+		AddtlStmtInf: &info,
+	}
+}
+
+func writeStatements(t *testing.T, w io.Writer, statements, entries int) {
+	writer, err := NewWriter(w, utils.DocumentCamt05300108NameSpace)
+	assert.Nil(t, err)
+	writer.Indent("", "\t")
+
+	assert.Nil(t, writer.Write(&camt_v08.GroupHeader81{
+		MsgId:   "STMT-MSG",
+		CreDtTm: common.ISODateTime(time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)),
+	}))
+	for s := 0; s < statements; s++ {
+		stmt := testStatement(fmt.Sprintf("STMT-%d", s))
+		assert.Nil(t, writer.Write(&stmt))
+		for e := 0; e < entries; e++ {
+			assert.Nil(t, writer.Write(testEntry(e)))
+		}
+	}
+	assert.Nil(t, writer.Close())
+}
+
+func TestStatementRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	writeStatements(t, &buf, 2, 3)
+
+	// the streamed document is the same as the one of the document package
+	doc, err := document.ParseIso20022Document(buf.Bytes())
+	assert.Nil(t, err)
+	assert.Nil(t, doc.Validate())
+	message := doc.InspectMessage().(*camt_v08.BankToCustomerStatementV08)
+	assert.Equal(t, "STMT-MSG", string(message.GrpHdr.MsgId))
+	assert.Len(t, message.Stmt, 2)
+	assert.Len(t, message.Stmt[1].Ntry, 3)
+	assert.Equal(t, "trailer of STMT-1", string(*message.Stmt[1].AddtlStmtInf))
+
+	reader, err := NewReader(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, utils.DocumentCamt05300108NameSpace, reader.NameSpace())
+
+	var parts []string
+	var statements []*camt_v08.AccountStatement9
+	for {
+		part, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		switch p := part.(type) {
+		case *camt_v08.GroupHeader81:
+			parts = append(parts, "GrpHdr:"+string(p.MsgId))
+		case *camt_v08.AccountStatement9:
+			parts = append(parts, "Stmt:"+string(p.Id))
+			assert.Empty(t, p.Ntry)
+			statements = append(statements, p)
+		case *camt_v08.ReportEntry10:
+			parts = append(parts, "Ntry:"+string(*p.NtryRef)+":"+p.Amt.Value.String())
+		default:
+			t.Errorf("unexpected part %T", part)
+		}
+	}
+
+	assert.Equal(t, []string{
+		"GrpHdr:STMT-MSG",
+		"Stmt:STMT-0", "Ntry:NTRY-0:1.00", "Ntry:NTRY-1:1.01", "Ntry:NTRY-2:1.02",
+		"Stmt:STMT-1", "Ntry:NTRY-0:1.00", "Ntry:NTRY-1:1.01", "Ntry:NTRY-2:1.02",
+	}, parts)
+	assert.Equal(t, "DE89370400440532013000", string(*statements[0].Acct.Id.IBAN))
+	assert.Equal(t, "trailer of STMT-0", string(*statements[0].AddtlStmtInf))
+
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestLargeStatement(t *testing.T) {
+	const entries = 20000
+
+	pr, pw := io.Pipe()
+	go func() {
+		writeStatements(t, pw, 1, entries)
+		pw.Close()
+	}()
+
+	reader, err := NewReader(pr)
+	assert.Nil(t, err)
+
+	count := 0
+	for {
+		part, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		if _, ok := part.(*camt_v08.ReportEntry10); ok {
+			count++
+		}
+	}
+	assert.Equal(t, entries, count)
+}
+
+func TestPaymentInitiationRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, utils.DocumentPain00100110NameSpace)
+	assert.Nil(t, err)
+
+	nbOfTxs := common.Max15NumericText("2")
+	assert.Nil(t, writer.Write(pain_v10.GroupHeader95{MsgId: "PAIN-MSG", NbOfTxs: nbOfTxs}))
+	assert.Nil(t, writer.Write(pain_v10.PaymentInstruction34{PmtInfId: "PMT-1", PmtMtd: "TRF"}))
+	for _, id := range []string{"E2E-1", "E2E-2"} {
+		assert.Nil(t, writer.Write(pain_v10.CreditTransferTransaction40{PmtId: pain_v10.PaymentIdentification6{EndToEndId: common.Max35Text(id)}}))
+	}
+	assert.Nil(t, writer.Write(pain_v10.SupplementaryData1{}))
+	assert.NotNil(t, writer.Write(pain_v10.CreditTransferTransaction40{}))
+	assert.Nil(t, writer.Close())
+	assert.NotNil(t, writer.Write(pain_v10.PaymentInstruction34{}))
+
+	doc, err := document.ParseIso20022Document(buf.Bytes())
+	assert.Nil(t, err)
+	message := doc.InspectMessage().(*pain_v10.CustomerCreditTransferInitiationV10)
+	assert.Len(t, message.PmtInf, 1)
+	assert.Len(t, message.PmtInf[0].CdtTrfTxInf, 2)
+	assert.Len(t, message.SplmtryData, 1)
+
+	reader, err := NewReader(&buf)
+	assert.Nil(t, err)
+	var parts []string
+	for {
+		part, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		switch p := part.(type) {
+		case *pain_v10.GroupHeader95:
+			parts = append(parts, "GrpHdr:"+string(p.MsgId))
+		case *pain_v10.PaymentInstruction34:
+			parts = append(parts, "PmtInf:"+string(p.PmtInfId))
+		case *pain_v10.CreditTransferTransaction40:
+			parts = append(parts, "CdtTrfTxInf:"+string(p.PmtId.EndToEndId))
+		case *pain_v10.SupplementaryData1:
+			parts = append(parts, "SplmtryData")
+		}
+	}
+	assert.Equal(t, []string{"GrpHdr:PAIN-MSG", "PmtInf:PMT-1", "CdtTrfTxInf:E2E-1", "CdtTrfTxInf:E2E-2", "SplmtryData"}, parts)
+}
+
+func TestLegacyStatements(t *testing.T) {
+	countParts := func(fileName string) map[string]int {
+		file, err := os.Open(filepath.Join("..", "..", "test", "testdata", fileName))
+		assert.Nil(t, err)
+		defer file.Close()
+
+		reader, err := NewReader(file)
+		assert.Nil(t, err)
+		counts := make(map[string]int)
+		for {
+			part, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			assert.Nil(t, err)
+			counts[fmt.Sprintf("%T", part)]++
+		}
+		return counts
+	}
+
+	assert.Equal(t, map[string]int{
+		"*camt_v02.GroupHeader42":     1,
+		"*camt_v02.AccountStatement2": 1,
+		"*camt_v02.ReportEntry2":      10,
+	}, countParts("FI_camt_053_sample.xml.xml"))
+
+	assert.Equal(t, map[string]int{
+		"*camt_v04.GroupHeader58":     1,
+		"*camt_v04.AccountStatement4": 1,
+		"*camt_v04.ReportEntry4":      5,
+	}, countParts("camt.053_P_CH2909000000250094239_1110092698_0_2020112503071366.xml"))
+
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, utils.DocumentCamt05400102NameSpace)
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Write(camt_v02.GroupHeader42{MsgId: "NTFCTN-MSG"}))
+	assert.Nil(t, writer.Write(camt_v02.AccountNotification2{Id: "NTFCTN-1"}))
+	assert.Nil(t, writer.Write(camt_v02.ReportEntry2{CdtDbtInd: "DBIT"}))
+	assert.NotNil(t, writer.Write(camt_v04.SupplementaryData1{}))
+	assert.Nil(t, writer.Close())
+
+	doc, err := document.ParseIso20022Document(buf.Bytes())
+	assert.Nil(t, err)
+	message := doc.InspectMessage().(*camt_v02.BankToCustomerDebitCreditNotificationV02)
+	assert.Len(t, message.Ntfctn, 1)
+	assert.Len(t, message.Ntfctn[0].Ntry, 1)
+}
+
+func TestStreamCharsets(t *testing.T) {
+	for _, charset := range []string{"ISO-8859-1", "UTF-16"} {
+		var buf bytes.Buffer
+		writer, err := NewWriter(&buf, utils.DocumentCamt05300108NameSpace)
+		assert.Nil(t, err)
+		writer.Indent("", "\t")
+		assert.Nil(t, writer.Encoding(charset))
+
+		assert.Nil(t, writer.Write(&camt_v08.GroupHeader81{MsgId: "Überweisung €"}))
+		assert.NotNil(t, writer.Encoding(utils.DefaultCharset))
+		stmt := testStatement("STMT-0")
+		assert.Nil(t, writer.Write(&stmt))
+		assert.Nil(t, writer.Write(testEntry(0)))
+		assert.Nil(t, writer.Close())
+
+		if charset == "ISO-8859-1" {
+			assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte(`<?xml version="1.0" encoding="ISO-8859-1"?>`+"\n<Document")))
+			assert.Contains(t, buf.String(), "<MsgId>\xdcberweisung &#8364;</MsgId>")
+		}
+
+		reader, err := NewReader(bytes.NewReader(buf.Bytes()))
+		assert.Nil(t, err, charset)
+		part, err := reader.Next()
+		assert.Nil(t, err, charset)
+		assert.Equal(t, "Überweisung €", string(part.(*camt_v08.GroupHeader81).MsgId), charset)
+
+		doc, err := document.ParseIso20022Document(buf.Bytes())
+		assert.Nil(t, err, charset)
+		assert.Len(t, doc.InspectMessage().(*camt_v08.BankToCustomerStatementV08).Stmt[0].Ntry, 1)
+	}
+
+	writer, err := NewWriter(&bytes.Buffer{}, utils.DocumentCamt05300108NameSpace)
+	assert.Nil(t, err)
+	assert.NotNil(t, writer.Encoding("X-UNKNOWN"))
+}
+
+func TestStreamErrors(t *testing.T) {
+	_, err := NewWriter(&bytes.Buffer{}, utils.DocumentPacs00800108NameSpace)
+	assert.NotNil(t, err)
+
+	writer, err := NewWriter(&bytes.Buffer{}, utils.DocumentCamt05400108NameSpace)
+	assert.Nil(t, err)
+	assert.NotNil(t, writer.Write(camt_v08.AccountNotification17{}))
+	assert.NotNil(t, writer.Write(pain_v10.GroupHeader95{}))
+
+	_, err = NewReader(strings.NewReader(`<Document><BkToCstmrStmt/></Document>`))
+	assert.NotNil(t, err)
+	_, err = NewReader(strings.NewReader(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"/>`))
+	assert.NotNil(t, err)
+	_, err = NewReader(strings.NewReader(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"><BkToCstmrDbtCdtNtfctn/></Document>`))
+	assert.NotNil(t, err)
+
+	reader, err := NewReader(strings.NewReader(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.054.001.08"><BkToCstmrDbtCdtNtfctn><Ntfctn><Id>N1</Id>`))
+	assert.Nil(t, err)
+	_, err = reader.Next()
+	assert.NotNil(t, err)
+	assert.NotEqual(t, io.EOF, err)
+
+	reader, err = NewReader(strings.NewReader(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.054.001.08"><BkToCstmrDbtCdtNtfctn><Ntfctn><Id>N1</Id></Ntfctn></BkToCstmrDbtCdtNtfctn></Document>`))
+	assert.Nil(t, err)
+	part, err := reader.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "N1", string(part.(*camt_v08.AccountNotification17).Id))
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+
+	assert.Len(t, SupportedNameSpaces(), 7)
+}
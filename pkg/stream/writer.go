@@ -0,0 +1,212 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package stream
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/moov-io/iso20022/pkg/utils"
+)
+
+// Writer writes a bulk message from its parts, in the order the Reader yields them:
+// the group header first, then every section followed by its items, and finally supplementary data.
+// Nothing is kept in memory but the section being written.
+type Writer struct {
+	writer  io.Writer
+	encoder *xml.Encoder
+	layout  layout
+
+	// charset of the output, converted by the closer when it isn't UTF-8
+	charset        string
+	closer         io.Closer
+	prefix, indent string
+
+	namespace string
+	started   bool
+	section   *reflect.Value
+	closed    bool
+}
+
+// NewWriter returns a Writer of a document of the namespace
+func NewWriter(w io.Writer, namespace string) (*Writer, error) {
+	l, found := layouts[namespace]
+	if !found {
+		return nil, utils.NewErrUnsupportedNameSpace()
+	}
+	return &Writer{
+		writer:    w,
+		encoder:   xml.NewEncoder(w),
+		layout:    l,
+		charset:   utils.DefaultCharset,
+		namespace: namespace,
+	}, nil
+}
+
+// Indent sets the indentation of the output, see xml.Encoder.Indent
+func (w *Writer) Indent(prefix, indent string) {
+	w.prefix, w.indent = prefix, indent
+	w.encoder.Indent(prefix, indent)
+}
+
+// Encoding sets the charset of the output (UTF-8 by default), e.g. ISO-8859-1 or UTF-16.
+// It has to be called before the group header is written. Characters the charset lacks are written as character references.
+func (w *Writer) Encoding(charset string) error {
+	if w.started {
+		return errors.New("the encoding has to be set before the group header is written")
+	}
+	output := w.writer
+	w.closer = nil
+	if !strings.EqualFold(charset, utils.DefaultCharset) {
+		converter, err := utils.NewCharsetWriter(w.writer, charset)
+		if err != nil {
+			return err
+		}
+		output, w.closer = converter, converter
+	}
+	w.charset = charset
+	w.encoder = xml.NewEncoder(output)
+	w.encoder.Indent(w.prefix, w.indent)
+	return nil
+}
+
+func (w *Writer) start(name string) xml.StartElement {
+	return xml.StartElement{Name: xml.Name{Local: name}}
+}
+
+// Write adds the next part of the message
+func (w *Writer) Write(part interface{}) error {
+	if w.closed {
+		return errors.New("the writer is closed")
+	}
+
+	value := reflect.ValueOf(part)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return errors.New("nil message part")
+		}
+		value = value.Elem()
+	}
+
+	switch value.Type() {
+	case w.layout.header:
+		if w.started {
+			return errors.New("the group header has already been written")
+		}
+		if err := w.encoder.EncodeToken(xml.ProcInst{Target: "xml", Inst: []byte(`version="1.0" encoding="` + w.charset + `"`)}); err != nil {
+			return err
+		}
+		if err := w.encoder.EncodeToken(xml.CharData("\n")); err != nil {
+			return err
+		}
+		document := w.start("Document")
+		document.Attr = []xml.Attr{{Name: xml.Name{Local: utils.XmlDefaultNamespace}, Value: w.namespace}}
+		if err := w.encoder.EncodeToken(document); err != nil {
+			return err
+		}
+		if err := w.encoder.EncodeToken(w.start(w.layout.message)); err != nil {
+			return err
+		}
+		w.started = true
+		return w.encoder.EncodeElement(value.Interface(), w.start("GrpHdr"))
+
+	case w.layout.sectionType:
+		if err := w.closeSection(); err != nil {
+			return err
+		}
+		if err := w.encoder.EncodeToken(w.start(w.layout.section)); err != nil {
+			return err
+		}
+		w.section = &value
+		return w.sectionFields(true)
+
+	case w.layout.itemType:
+		if w.section == nil {
+			return fmt.Errorf("%s must be written within a %s", w.layout.item, w.layout.section)
+		}
+		return w.encoder.EncodeElement(value.Interface(), w.start(w.layout.item))
+
+	case w.layout.trailer:
+		if err := w.closeSection(); err != nil {
+			return err
+		}
+		return w.encoder.EncodeElement(value.Interface(), w.start("SplmtryData"))
+	}
+
+	return fmt.Errorf("%s is not a part of the message", value.Type())
+}
+
+// sectionFields writes the elements of the current section that precede (or follow) its items
+func (w *Writer) sectionFields(leading bool) error {
+	section := *w.section
+	before := true
+	for _, f := range fields(w.layout.sectionType) {
+		if f.name == w.layout.item {
+			before = false
+			continue
+		}
+		if before != leading {
+			continue
+		}
+		value := section.Field(f.index)
+		if f.omitempty && isEmptyValue(value) {
+			continue
+		}
+		if value.Kind() == reflect.Slice {
+			for i := 0; i < value.Len(); i++ {
+				if err := w.encoder.EncodeElement(value.Index(i).Interface(), w.start(f.name)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := w.encoder.EncodeElement(value.Interface(), w.start(f.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Writer) closeSection() error {
+	if !w.started {
+		return errors.New("the group header has to be written first")
+	}
+	if w.section == nil {
+		return nil
+	}
+	if err := w.sectionFields(false); err != nil {
+		return err
+	}
+	w.section = nil
+	return w.encoder.EncodeToken(w.start(w.layout.section).End())
+}
+
+// Close ends the document and flushes the output. It doesn't close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	if err := w.closeSection(); err != nil {
+		return err
+	}
+	w.closed = true
+	if err := w.encoder.EncodeToken(w.start(w.layout.message).End()); err != nil {
+		return err
+	}
+	if err := w.encoder.EncodeToken(w.start("Document").End()); err != nil {
+		return err
+	}
+	if err := w.encoder.Flush(); err != nil {
+		return err
+	}
+	if w.closer != nil {
+		return w.closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// DefaultCharset is the charset of the documents written by the package
+const DefaultCharset = "UTF-8"
+
+var (
+	xmlDeclarationRegexp = regexp.MustCompile(`^\s*<\?xml[^>]*\?>`)
+	xmlEncodingRegexp    = regexp.MustCompile(`encoding\s*=\s*["']([A-Za-z0-9._:-]+)["']`)
+
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+	utf16LEBOM = []byte{0xFF, 0xFE}
+)
+
+// LookupCharset returns the encoding registered by IANA under the name, e.g. ISO-8859-1, windows-1252 or UTF-16
+func LookupCharset(charset string) (encoding.Encoding, error) {
+	enc, err := ianaindex.IANA.Encoding(charset)
+	if err != nil || enc == nil {
+		return nil, NewErrUnsupportedCharset(charset)
+	}
+	return enc, nil
+}
+
+func isUTF8(charset string) bool {
+	return charset == "" || strings.EqualFold(charset, "UTF-8") || strings.EqualFold(charset, "UTF8")
+}
+
+func isUTF16(charset string) bool {
+	return strings.HasPrefix(strings.ToUpper(charset), "UTF-16")
+}
+
+// CharsetReader converts the input of a xml.Decoder from the charset named by the XML declaration into UTF-8.
+// UTF-16 input has to be decoded before the declaration can be read at all (see NewUTF8Reader),
+// so UTF-16 declarations are passed through.
+func CharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	if isUTF8(charset) || isUTF16(charset) {
+		return input, nil
+	}
+	enc, err := LookupCharset(charset)
+	if err != nil {
+		return nil, err
+	}
+	return transform.NewReader(input, enc.NewDecoder()), nil
+}
+
+// NewUTF8Reader decodes UTF-16 input starting with a byte order mark and drops the byte order mark of UTF-8 input
+func NewUTF8Reader(r io.Reader) io.Reader {
+	return transform.NewReader(r, unicode.BOMOverride(transform.Nop))
+}
+
+// DecodeToUTF8 converts a XML or JSON document into UTF-8.
+// The charset is detected from the byte order mark, the byte pattern of UTF-16 input without one,
+// and the encoding of the XML declaration, which is rewritten to UTF-8.
+func DecodeToUTF8(buf []byte) ([]byte, error) {
+	var err error
+	decoded := false
+
+	switch {
+	case bytes.HasPrefix(buf, utf8BOM):
+		buf = buf[len(utf8BOM):]
+		decoded = true
+	case bytes.HasPrefix(buf, utf16BEBOM), bytes.HasPrefix(buf, utf16LEBOM):
+		buf, err = unicode.UTF16(unicode.BigEndian, unicode.ExpectBOM).NewDecoder().Bytes(buf)
+		decoded = true
+	case len(buf) >= 2 && buf[0] == 0 && buf[1] != 0:
+		buf, err = unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewDecoder().Bytes(buf)
+		decoded = true
+	case len(buf) >= 2 && buf[0] != 0 && buf[1] == 0:
+		buf, err = unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewDecoder().Bytes(buf)
+		decoded = true
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	declaration := xmlDeclarationRegexp.Find(buf)
+	if declaration == nil {
+		return buf, nil
+	}
+	match := xmlEncodingRegexp.FindSubmatchIndex(declaration)
+	if match == nil {
+		return buf, nil
+	}
+	charset := string(declaration[match[2]:match[3]])
+	if isUTF8(charset) {
+		return buf, nil
+	}
+
+	// a byte order mark wins over the declaration
+	if !decoded && !isUTF16(charset) {
+		enc, err := LookupCharset(charset)
+		if err != nil {
+			return nil, err
+		}
+		if buf, err = enc.NewDecoder().Bytes(buf); err != nil {
+			return nil, err
+		}
+	}
+
+	output := make([]byte, 0, len(buf))
+	output = append(output, buf[:match[2]]...)
+	output = append(output, DefaultCharset...)
+	return append(output, buf[match[3]:]...), nil
+}
+
+// EncodeFromUTF8 converts a XML or JSON document written by the package into the charset.
+// The encoding of the XML declaration is set (or a declaration added) and characters the charset lacks
+// are written as character references. JSON can only be converted into UTF-16.
+func EncodeFromUTF8(buf []byte, charset string) ([]byte, error) {
+	if isUTF8(charset) {
+		return buf, nil
+	}
+	enc, err := LookupCharset(charset)
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := bytes.TrimSpace(buf)
+	if len(trimmed) > 0 && trimmed[0] != '<' {
+		if !isUTF16(charset) {
+			return nil, NewErrUnsupportedCharset(charset)
+		}
+		return enc.NewEncoder().Bytes(buf)
+	}
+
+	var output []byte
+	if declaration := xmlDeclarationRegexp.FindIndex(buf); declaration != nil {
+		header := xmlEncodingRegexp.ReplaceAll(buf[declaration[0]:declaration[1]], []byte(`encoding="`+charset+`"`))
+		if !xmlEncodingRegexp.Match(header) {
+			header = append(bytes.TrimSuffix(header, []byte("?>")), ` encoding="`+charset+`"?>`...)
+		}
+		output = append(append(output, header...), buf[declaration[1]:]...)
+	} else {
+		output = append([]byte(`<?xml version="1.0" encoding="`+charset+`"?>`+"\n"), buf...)
+	}
+
+	return encoding.HTMLEscapeUnsupported(enc.NewEncoder()).Bytes(output)
+}
+
+// NewCharsetWriter converts the UTF-8 output of a xml.Encoder into the charset.
+// Characters the charset lacks are written as character references; the writer has to be closed to be flushed.
+func NewCharsetWriter(w io.Writer, charset string) (io.WriteCloser, error) {
+	enc, err := LookupCharset(charset)
+	if err != nil {
+		return nil, err
+	}
+	return transform.NewWriter(w, encoding.HTMLEscapeUnsupported(enc.NewEncoder())), nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+/*
+	Choice components (all of the types with a "Choice" suffix) hold every alternative of
+	the XSD choice as an optional field, and exactly one of them must be set.
+	Example:
+		func (r AccountIdentification4Choice) Validate() error {
+			return utils.ValidateChoice(&r)
+		}
+*/
+
+import (
+	"reflect"
+	"strings"
+)
+
+// IsChoice reports whether typ is a choice component
+func IsChoice(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Struct && strings.HasSuffix(typ.Name(), "Choice")
+}
+
+func isBranchSet(data reflect.Value) bool {
+	switch data.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return !data.IsNil()
+	case reflect.Slice, reflect.Map:
+		return data.Len() > 0
+	}
+	return !data.IsZero()
+}
+
+// choiceBranches returns the element names of the branches that are set and of all branches
+func choiceBranches(data reflect.Value) (selected []string, branches []string) {
+	for i := 0; i < data.NumField(); i++ {
+		field := data.Type().Field(i)
+		if !field.IsExported() || field.Name == "XMLName" {
+			continue
+		}
+		name, _ := elementName(field)
+		branches = append(branches, name)
+		if isBranchSet(data.Field(i)) {
+			selected = append(selected, name)
+		}
+	}
+	return selected, branches
+}
+
+func checkChoice(data reflect.Value) error {
+	selected, branches := choiceBranches(data)
+	if len(selected) != 1 {
+		return NewErrChoiceInvalid(data.Type().Name(), branches)
+	}
+	return nil
+}
+
+// ValidateChoice checks that exactly one branch of the choice component is set and validates it
+func ValidateChoice(r interface{}) error {
+	if err := checkChoice(reflect.ValueOf(r).Elem()); err != nil {
+		return err
+	}
+	return Validate(r)
+}
+
+// SelectedChoice returns the element name and the value of the selected branch of a choice component.
+// The value is the field itself, e.g. a pointer for single elements or a slice for repeated ones.
+func SelectedChoice(choice interface{}) (string, interface{}, bool) {
+	data := reflect.ValueOf(choice)
+	for data.Kind() == reflect.Ptr {
+		if data.IsNil() {
+			return "", nil, false
+		}
+		data = data.Elem()
+	}
+	if data.Kind() != reflect.Struct {
+		return "", nil, false
+	}
+	for i := 0; i < data.NumField(); i++ {
+		field := data.Type().Field(i)
+		if !field.IsExported() || field.Name == "XMLName" {
+			continue
+		}
+		if isBranchSet(data.Field(i)) {
+			name, _ := elementName(field)
+			return name, data.Field(i).Interface(), true
+		}
+	}
+	return "", nil, false
+}
+
+// ChoiceValue returns the selected branch of a choice component when it is of type T
+//
+//	if iban, ok := utils.ChoiceValue[common.IBAN2007Identifier](account.Id); ok {
+//		...
+//	}
+func ChoiceValue[T any](choice interface{}) (T, bool) {
+	var zero T
+	_, value, ok := SelectedChoice(choice)
+	if !ok {
+		return zero, false
+	}
+	if typed, ok := value.(T); ok {
+		return typed, true
+	}
+	data := reflect.ValueOf(value)
+	if data.Kind() == reflect.Ptr {
+		if typed, ok := data.Elem().Interface().(T); ok {
+			return typed, true
+		}
+	}
+	return zero, false
+}
@@ -6,22 +6,46 @@ package utils
 
 import (
 	"fmt"
+	"strings"
 )
 
+// ErrFacet is returned by the Validate functions of simple types when a value breaks a rule of its XSD type
+type ErrFacet struct {
+	// TypeName is the name of the XSD type, e.g. Max35Text
+	TypeName string
+	// Rule is the broken rule, e.g. length (minLength:1, maxLength:35)
+	Rule string
+}
+
+func (e *ErrFacet) Error() string {
+	if e.Rule == "value" {
+		return fmt.Sprintf("The value of %s is invalid", e.TypeName)
+	}
+	return fmt.Sprintf("The value of %s has invalid %s", e.TypeName, e.Rule)
+}
+
 // NewErrTextLength returns a error that the length of value is invalid
 func NewErrTextLengthInvalid(typeStr string, min, max int) error {
-	errStr := fmt.Sprintf("The value of %s has invalid length (minLength:%d, maxLength:%d)",
-		typeStr, min, max)
+	rule := fmt.Sprintf("length (minLength:%d, maxLength:%d)", min, max)
 	if max == 0 {
-		errStr = fmt.Sprintf("The value of %s has invalid length (minLength:%d)", typeStr, min)
+		rule = fmt.Sprintf("length (minLength:%d)", min)
 	}
-	return fmt.Errorf(errStr)
+	return &ErrFacet{TypeName: typeStr, Rule: rule}
 }
 
 // NewErrTextLength returns a error that the length of value is invalid
 func NewErrValueInvalid(typeStr string) error {
-	errStr := fmt.Sprintf("The value of %s is invalid", typeStr)
-	return fmt.Errorf(errStr)
+	return &ErrFacet{TypeName: typeStr, Rule: "value"}
+}
+
+// NewErrExternalCodeInvalid returns a error that a value is missing from the external code set of its type
+func NewErrExternalCodeInvalid(typeStr string) error {
+	return &ErrFacet{TypeName: typeStr, Rule: "external code"}
+}
+
+// NewErrChoiceInvalid returns a error that a choice component hasn't exactly one selected branch
+func NewErrChoiceInvalid(typeStr string, branches []string) error {
+	return &ErrFacet{TypeName: typeStr, Rule: fmt.Sprintf("choice (exactly one of %s)", strings.Join(branches, ", "))}
 }
 
 // NewErrInvalidNameSpace returns a error that namespace is invalid
@@ -47,3 +71,135 @@ func NewErrInvalidFileType() error {
 	errStr := fmt.Sprintf("The type of %s is invalid", "file")
 	return fmt.Errorf(errStr)
 }
+
+// NewErrSchemaNotFound returns a error that there isn't a schema for the namespace
+func NewErrSchemaNotFound(namespace string) error {
+	return fmt.Errorf("The schema of %s is not found", namespace)
+}
+
+// NewErrRegisteredNameSpace returns a error that a message type is already registered for the namespace
+func NewErrRegisteredNameSpace(namespace string) error {
+	return fmt.Errorf("The namespace of %s is already registered", namespace)
+}
+
+// NewErrUnsupportedCharset returns a error that there isn't a decoder for the charset
+func NewErrUnsupportedCharset(charset string) error {
+	return fmt.Errorf("The charset %s is unsupported", charset)
+}
+
+// NewErrOmittedTransactions returns a error that a message has no transactions
+func NewErrOmittedTransactions() error {
+	errStr := fmt.Sprintf("The transactions of %s are omitted", "message")
+	return fmt.Errorf(errStr)
+}
+
+// NewErrIncompatibleMessages returns a error that a message can't be converted into another message
+func NewErrIncompatibleMessages(from, to string) error {
+	return fmt.Errorf("The message of %s can't be converted into %s", from, to)
+}
+
+// NewErrInvalidBlock returns a error that a block of a SWIFT MT message is invalid
+func NewErrInvalidBlock(block string) error {
+	return fmt.Errorf("The block %s of MT message is invalid", block)
+}
+
+// NewErrUnsupportedMessageType returns a error that a SWIFT MT message type can't be translated
+func NewErrUnsupportedMessageType(messageType string) error {
+	return fmt.Errorf("The message type %s is unsupported", messageType)
+}
+
+// NewErrOmittedField returns a error that a mandatory field of a SWIFT MT message is omitted
+func NewErrOmittedField(tag string) error {
+	return fmt.Errorf("The field %s of MT message is omitted", tag)
+}
+
+// NewErrInvalidField returns a error that a field of a SWIFT MT message is invalid
+func NewErrInvalidField(tag string) error {
+	return fmt.Errorf("The field %s of MT message is invalid", tag)
+}
+
+// NewErrOmittedBIC returns a error that the BIC of the sender or the receiver of a SWIFT MT message is unknown
+func NewErrOmittedBIC(party string) error {
+	return fmt.Errorf("The %s BIC of MT message is omitted", party)
+}
+
+// NewErrUnknownProfile returns a error that no validation profile is registered with the name
+func NewErrUnknownProfile(name string) error {
+	return fmt.Errorf("The profile %s is unknown", name)
+}
+
+// NewErrRegisteredProfile returns a error that a validation profile is already registered with the name
+func NewErrRegisteredProfile(name string) error {
+	return fmt.Errorf("The profile %s is already registered", name)
+}
+
+// NewErrUnsupportedProfileMessage returns a error that a validation profile doesn't accept the message
+func NewErrUnsupportedProfileMessage(name, namespace string) error {
+	return fmt.Errorf("The namespace of %s is unsupported by the profile %s", namespace, name)
+}
+
+// NewErrUnknownOriginal returns a error that a reference isn't found in the original message
+func NewErrUnknownOriginal(kind, id string) error {
+	return fmt.Errorf("The %s %s of original message is unknown", kind, id)
+}
+
+// NewErrAmbiguousOriginal returns a error that a reference matches several transactions of the original message
+func NewErrAmbiguousOriginal(kind, id string) error {
+	return fmt.Errorf("The %s %s of original message is ambiguous", kind, id)
+}
+
+// NewErrChargesExceedAmount returns a error that the charges leave nothing of a returned or reversed amount
+func NewErrChargesExceedAmount(endToEndId string) error {
+	return fmt.Errorf("The charges of transaction %s exceed its amount", endToEndId)
+}
+
+// NewErrInvalidCodeSet returns a error that a code set file has a set without name or with a empty code
+func NewErrInvalidCodeSet(name string) error {
+	return fmt.Errorf("The code set %s is invalid", name)
+}
+
+// NewErrDocumentNotFound returns a error that no document is stored with the id
+func NewErrDocumentNotFound(id string) error {
+	return fmt.Errorf("The document %s is not found", id)
+}
+
+// NewErrInvalidQueryParameter returns a error that a query parameter of a request is invalid
+func NewErrInvalidQueryParameter(name string) error {
+	return fmt.Errorf("The query parameter %s is invalid", name)
+}
+
+// NewErrDuplicateDocument returns a error that a document, or one of its transactions, was already received
+func NewErrDuplicateDocument(reason, reference, messageId string) error {
+	return fmt.Errorf("The document duplicates the %s %s of message %s", reason, reference, messageId)
+}
+
+// NewErrUnsupportedLifecycleMessage returns a error that a message doesn't take part in the lifecycle of a payment
+func NewErrUnsupportedLifecycleMessage(messageType string) error {
+	return fmt.Errorf("The message %s is unsupported by the payment lifecycle", messageType)
+}
+
+// NewErrUnsupportedReconciliationMessage returns a error that a message is neither an instruction nor a statement
+// which can be reconciled
+func NewErrUnsupportedReconciliationMessage(messageType string) error {
+	return fmt.Errorf("The message %s is unsupported by the reconciliation", messageType)
+}
+
+// NewErrUnknownReconciliationRule returns a error that a matching rule of the reconciliation doesn't exist
+func NewErrUnknownReconciliationRule(rule string) error {
+	return fmt.Errorf("The reconciliation rule %s is unknown", rule)
+}
+
+// NewErrUnsupportedExportMessage returns a error that a message is not a statement which can be exported
+func NewErrUnsupportedExportMessage(messageType string) error {
+	return fmt.Errorf("The message %s is unsupported by the statement export", messageType)
+}
+
+// NewErrUnsupportedExportFormat returns a error that the statements can't be exported in a format
+func NewErrUnsupportedExportFormat(format string) error {
+	return fmt.Errorf("The export format %s is unsupported", format)
+}
+
+// NewErrUnknownExportColumn returns a error that a column of the csv export doesn't exist
+func NewErrUnknownExportColumn(column string) error {
+	return fmt.Errorf("The export column %s is unknown", column)
+}
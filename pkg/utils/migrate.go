@@ -0,0 +1,59 @@
+// Copyright 2022 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"database/sql"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Migrate applies the sql scripts of the directory in the order of their version, the number before the first
+// underscore of their name. The applied versions are recorded in the table, so a script is applied once.
+func Migrate(db *sql.DB, migrations fs.FS, dir, table string) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS ` + table + `(version INTEGER PRIMARY KEY)`); err != nil {
+		return err
+	}
+	entries, err := fs.ReadDir(migrations, dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		version, err := strconv.Atoi(strings.SplitN(entry.Name(), "_", 2)[0])
+		if err != nil {
+			return err
+		}
+		var applied int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM `+table+` WHERE version = ?`, version).Scan(&applied); err != nil {
+			return err
+		}
+		if applied > 0 {
+			continue
+		}
+
+		script, err := fs.ReadFile(migrations, path.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		tx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(string(script)); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if _, err := tx.Exec(`INSERT INTO `+table+`(version) VALUES (?)`, version); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
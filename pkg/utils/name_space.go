@@ -61,6 +61,9 @@ const (
 	DocumentCamt10200101NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.102.001.01"
 	DocumentCamt10300101NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.103.001.01"
 	DocumentCamt10400101NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.104.001.01"
+	DocumentCamt05200102NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.052.001.02"
+	DocumentCamt05300102NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+	DocumentCamt05400102NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.054.001.02"
 	DocumentCamt03500103NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.035.001.03"
 	DocumentCamt06900103NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.069.001.03"
 	DocumentCamt07100103NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.071.001.03"
@@ -74,6 +77,9 @@ const (
 	DocumentCamt03200104NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.032.001.04"
 	DocumentCamt03800104NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.038.001.04"
 	DocumentCamt07000104NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.070.001.04"
+	DocumentCamt05200104NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.052.001.04"
+	DocumentCamt05300104NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.04"
+	DocumentCamt05400104NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.054.001.04"
 	DocumentCamt01800105NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.018.001.05"
 	DocumentCamt02500105NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.025.001.05"
 	DocumentCamt02600105NameSpace = "urn:iso:std:iso:20022:tech:xsd:camt.026.001.05"
@@ -141,6 +147,7 @@ const (
 	DocumentPacs00400110NameSpace = "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.10"
 	DocumentPacs00700110NameSpace = "urn:iso:std:iso:20022:tech:xsd:pacs.007.001.10"
 	DocumentPacs00200111NameSpace = "urn:iso:std:iso:20022:tech:xsd:pacs.002.001.11"
+	DocumentPain00100103NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"
 	DocumentPain00700101NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.017.001.01"
 	DocumentPain01800101NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.018.001.01"
 	DocumentPain00900105NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.009.001.05"
@@ -153,6 +160,7 @@ const (
 	DocumentPain01400107NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.014.001.07"
 	DocumentPain01300108NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.013.001.08"
 	DocumentPain01400108NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.014.001.08"
+	DocumentPain00100109NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.09"
 	DocumentPain00800109NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.008.001.09"
 	DocumentPain00100110NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.10"
 	DocumentPain00700110NameSpace = "urn:iso:std:iso:20022:tech:xsd:pain.007.001.10"
@@ -168,4 +176,7 @@ const (
 	DocumentRemt00100102NameSpace = "urn:iso:std:iso:20022:tech:xsd:remt.001.001.02"
 	DocumentRemt00200102NameSpace = "urn:iso:std:iso:20022:tech:xsd:remt.002.001.02"
 	DocumentRemt00100104NameSpace = "urn:iso:std:iso:20022:tech:xsd:remt.001.001.04"
+
+	// Swiss Payment Standards variant of pain.001.001.03 published by SIX Interbank Clearing
+	DocumentPain00100103CH02NameSpace = "http://www.six-interbank-clearing.com/de/pain.001.001.03.ch.02.xsd"
 )
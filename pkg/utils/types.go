@@ -10,12 +10,14 @@ import (
 const (
 	DocumentTypeJson    = "json"
 	DocumentTypeXml     = "xml"
+	DocumentTypeMt      = "mt"
 	DocumentTypeUnknown = "unknown"
 )
 
 func isValidXML(buf []byte) bool {
 	decoder := xml.NewDecoder(bytes.NewBuffer(buf))
-	err := xml.Unmarshal(buf, new(interface{}))
+	decoder.CharsetReader = CharsetReader
+	err := decoder.Decode(new(interface{}))
 	if err != nil {
 		return false
 	}
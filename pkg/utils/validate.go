@@ -20,7 +20,9 @@ package utils
 */
 
 import (
+	"encoding/xml"
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 )
@@ -101,3 +103,204 @@ func Validate(r interface{}) error {
 
 	return nil
 }
+
+// ValidationError describes a single violation found while validating a document
+type ValidationError struct {
+	// Path is the XPath-style location of the element, e.g. /Document/FIToFICstmrCdtTrf/CdtTrfTxInf[3]/Dbtr/Nm
+	Path string `json:"path"`
+	// Type is the name of the XSD type of the element
+	Type string `json:"type,omitempty"`
+	// Rule is the broken rule, e.g. length (minLength:1, maxLength:35)
+	Rule string `json:"rule"`
+	// Value is the offending value
+	Value string `json:"value"`
+	// Line and Column locate the element in the source document when it is known
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+}
+
+func (e ValidationError) Error() string {
+	msg := fmt.Sprintf("%s: %s has invalid %s (value: %q)", e.Path, e.Type, e.Rule, e.Value)
+	if e.Type == "" {
+		msg = fmt.Sprintf("%s: invalid %s (value: %q)", e.Path, e.Rule, e.Value)
+	}
+	if e.Line > 0 {
+		msg = fmt.Sprintf("%d:%d: %s", e.Line, e.Column, msg)
+	}
+	return msg
+}
+
+// ValidationErrors is the list of violations found in a document
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	lines := make([]string, 0, len(e))
+	for _, verr := range e {
+		lines = append(lines, verr.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ValidateAll walks r like Validate but does not stop at the first failing field.
+// Every violation is returned with the path of its element below the given root path.
+func ValidateAll(r interface{}, path string) ValidationErrors {
+	var errs ValidationErrors
+	collectErrors(reflect.ValueOf(r), path, &errs)
+	return errs
+}
+
+func hasValidateMethod(data reflect.Value) bool {
+	return data.MethodByName(DefaultValidateFunction).IsValid()
+}
+
+func hasExportedFields(typ reflect.Type) bool {
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+// elementName returns the element name of a struct field and whether it is an attribute
+func elementName(field reflect.StructField) (name string, attr bool) {
+	tag := field.Tag.Get("xml")
+	if tag == "-" {
+		return "-", false
+	}
+	options := strings.Split(tag, ",")
+	for _, option := range options[1:] {
+		switch option {
+		case "attr":
+			attr = true
+		case "chardata", "innerxml", "any":
+			return "", false
+		}
+	}
+	name = options[0]
+	if idx := strings.LastIndex(name, " "); idx >= 0 {
+		name = name[idx+1:]
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, attr
+}
+
+// structElementName returns the element name stored in the XMLName field of a struct
+func structElementName(data reflect.Value) string {
+	field, ok := data.Type().FieldByName("XMLName")
+	if !ok {
+		return ""
+	}
+	if name, ok := data.FieldByIndex(field.Index).Interface().(xml.Name); ok && name.Local != "" {
+		return name.Local
+	}
+	name, _ := elementName(field)
+	return name
+}
+
+func collectErrors(data reflect.Value, path string, errs *ValidationErrors) {
+	for data.Kind() == reflect.Ptr || data.Kind() == reflect.Interface {
+		if data.IsNil() {
+			return
+		}
+		data = data.Elem()
+	}
+
+	if data.Kind() == reflect.Struct && hasExportedFields(data.Type()) {
+		if !hasValidateMethod(data) && path != "" {
+			return
+		}
+		if IsChoice(data.Type()) {
+			if err := checkChoice(data); err != nil {
+				selected, _ := choiceBranches(data)
+				facet := err.(*ErrFacet)
+				*errs = append(*errs, ValidationError{
+					Path:  path,
+					Type:  facet.TypeName,
+					Rule:  facet.Rule,
+					Value: strings.Join(selected, ", "),
+				})
+			}
+		}
+		for i := 0; i < data.NumField(); i++ {
+			field := data.Type().Field(i)
+			if !field.IsExported() || field.Name == "XMLName" {
+				continue
+			}
+			name, attr := elementName(field)
+			if name == "-" {
+				continue
+			}
+			fieldPath := path
+			if attr {
+				fieldPath = path + "/@" + name
+			} else if name != "" {
+				fieldPath = path + "/" + name
+			}
+			collectFieldErrors(data.Field(i), fieldPath, errs)
+		}
+		return
+	}
+
+	if err := callValidate(data); err != nil {
+		verr := ValidationError{
+			Path:  path,
+			Type:  data.Type().Name(),
+			Rule:  err.Error(),
+			Value: fmt.Sprintf("%v", data.Interface()),
+		}
+		var facet *ErrFacet
+		if errors.As(err, &facet) {
+			verr.Type = facet.TypeName
+			verr.Rule = facet.Rule
+		}
+		*errs = append(*errs, verr)
+	}
+}
+
+func collectFieldErrors(data reflect.Value, path string, errs *ValidationErrors) {
+	switch data.Kind() {
+	case reflect.Slice:
+		if data.Type().Elem().Kind() == reflect.Uint8 {
+			collectErrors(data, path, errs)
+			return
+		}
+		for i := 0; i < data.Len(); i++ {
+			collectErrors(data.Index(i), fmt.Sprintf("%s[%d]", path, i+1), errs)
+		}
+	case reflect.Map:
+		for _, key := range data.MapKeys() {
+			collectErrors(data.MapIndex(key), fmt.Sprintf("%s[%v]", path, key.Interface()), errs)
+		}
+	case reflect.Interface:
+		if data.IsNil() {
+			return
+		}
+		elem := data.Elem()
+		for elem.Kind() == reflect.Ptr && !elem.IsNil() {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.Struct {
+			if name := structElementName(elem); name != "" && !strings.HasSuffix(path, "/"+name) {
+				path = path + "/" + name
+			}
+		}
+		collectErrors(data, path, errs)
+	default:
+		collectErrors(data, path, errs)
+	}
+}
+
+func callValidate(data reflect.Value) error {
+	method := data.MethodByName(DefaultValidateFunction)
+	if !method.IsValid() {
+		return nil
+	}
+	response := method.Call(nil)
+	if len(response) > 0 && !response[0].IsNil() {
+		return response[0].Interface().(error)
+	}
+	return nil
+}